@@ -35,9 +35,12 @@ func printInfo(cmd *cobra.Command, args []string) error {
 	printTuple(fmat, "Custom Views", config.AppViewsFile, color.Cyan)
 	printTuple(fmat, "Plugins", config.AppPluginsFile, color.Cyan)
 	printTuple(fmat, "Hotkeys", config.AppHotKeysFile, color.Cyan)
+	printTuple(fmat, "KeyMap", config.AppKeyMapFile, color.Cyan)
+	printTuple(fmat, "Macros", config.AppMacrosFile, color.Cyan)
 	printTuple(fmat, "Aliases", config.AppAliasesFile, color.Cyan)
 	printTuple(fmat, "Skins", config.AppSkinsDir, color.Cyan)
 	printTuple(fmat, "Context Configs", config.AppContextsDir, color.Cyan)
+	printTuple(fmat, "State", config.AppStateDir, color.Cyan)
 	printTuple(fmat, "Logs", config.AppLogFile, color.Cyan)
 	printTuple(fmat, "Benchmarks", config.AppBenchmarksDir, color.Cyan)
 	printTuple(fmat, "ScreenDumps", getScreenDumpDirForInfo(), color.Cyan)
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/replay"
+	"github.com/rs/zerolog/log"
+)
+
+// startReplay replays a recording against a fake connection.
+func startReplay(file string, speed float64) (func(), error) {
+	rec, err := replay.LoadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	kubeConfig, err := writeTempKubeConfig("k9s-replay-*.yaml", rec.KubeConfig())
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Msgf("Replaying %d events of context %q over %s", len(rec.Events), rec.Header.Context, rec.Duration())
+
+	*k8sFlags.KubeConfig, *k8sFlags.Context = kubeConfig, replay.ContextName
+	*k8sFlags.ClusterName, *k8sFlags.AuthInfoName = "", ""
+	*k9sFlags.ReadOnly, *k9sFlags.Write = true, false
+
+	ctx, cancel := context.WithCancel(context.Background())
+	initConnection = func(cfg *client.Config) (client.Connection, error) {
+		p := replay.NewPlayer(replay.NewConnection(cfg, rec), rec, speed)
+		// Seed the initial cluster state before the ui comes up.
+		if err := p.Seek(0); err != nil {
+			return p.Conn(), err
+		}
+		go func() {
+			if err := p.Play(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				log.Error().Err(err).Msg("Replay failed")
+				return
+			}
+			log.Info().Msg("Replay completed")
+		}()
+
+		return p.Conn(), nil
+	}
+
+	return func() {
+		cancel()
+		if err := os.Remove(kubeConfig); err != nil {
+			log.Warn().Err(err).Msgf("Unable to remove replay kubeconfig %s", kubeConfig)
+		}
+	}, nil
+}
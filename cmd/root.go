@@ -16,6 +16,12 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/crash"
+	k9sdebug "github.com/derailed/k9s/internal/debug"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/logging"
+	"github.com/derailed/k9s/internal/logring"
+	"github.com/derailed/k9s/internal/remote"
 	"github.com/derailed/k9s/internal/view"
 	"github.com/mattn/go-colorable"
 	"github.com/rs/zerolog"
@@ -47,10 +53,23 @@ var (
 	out = colorable.NewColorableStdout()
 )
 
+// initConnection establishes the api server connection.
+var initConnection = func(cfg *client.Config) (client.Connection, error) {
+	return client.InitConnection(cfg)
+}
+
 type flagError struct{ err error }
 
 func (e flagError) Error() string { return e.err.Error() }
 
+// exitError tracks a command failure along with the process exit status.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e exitError) Error() string { return e.err.Error() }
+
 func init() {
 	if err := config.InitLogLoc(); err != nil {
 		fmt.Printf("Fail to init k9s logs location %s\n", err)
@@ -63,11 +82,17 @@ func init() {
 	rootCmd.AddCommand(versionCmd(), infoCmd())
 	initK9sFlags()
 	initK8sFlags()
+	rootCmd.AddCommand(scriptCmd())
 }
 
 // Execute root command.
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
+		var ee exitError
+		if errors.As(err, &ee) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", ee.err)
+			os.Exit(ee.code)
+		}
 		if !errors.As(err, &flagError{}) {
 			panic(err)
 		}
@@ -78,40 +103,76 @@ func run(cmd *cobra.Command, args []string) error {
 	if err := config.InitLocs(); err != nil {
 		return err
 	}
-	file, err := os.OpenFile(
-		*k9sFlags.LogFile,
-		os.O_CREATE|os.O_APPEND|os.O_WRONLY,
-		data.DefaultFileMod,
-	)
+	file, err := initLogs()
 	if err != nil {
-		return fmt.Errorf("Log file %q init failed: %w", *k9sFlags.LogFile, err)
+		return err
 	}
 	defer func() {
 		if file != nil {
 			_ = file.Close()
 		}
 	}()
+	var app *view.App
 	defer func() {
 		if err := recover(); err != nil {
+			stack := debug.Stack()
 			log.Error().Msgf("Boom! %v", err)
-			log.Error().Msg(string(debug.Stack()))
+			log.Error().Msg(string(stack))
 			printLogo(color.Red)
 			fmt.Printf("%s", color.Colorize("Boom!! ", color.Red))
 			fmt.Printf("%v.\n", err)
+			saveCrashReport(err, stack, app)
 		}
 	}()
 
-	log.Logger = log.Output(zerolog.ConsoleWriter{Out: file})
-	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
+	if *k9sFlags.Snapshot != "" {
+		stop, err := serveSnapshot(*k9sFlags.Snapshot)
+		if err != nil {
+			return fmt.Errorf("snapshot %q load failed: %w", *k9sFlags.Snapshot, err)
+		}
+		defer stop()
+	}
+	if *k9sFlags.Replay != "" {
+		stop, err := startReplay(*k9sFlags.Replay, *k9sFlags.ReplaySpeed)
+		if err != nil {
+			return fmt.Errorf("replay %q load failed: %w", *k9sFlags.Replay, err)
+		}
+		defer stop()
+	}
 
 	cfg, err := loadConfiguration()
 	if err != nil {
 		log.Error().Err(err).Msgf("Fail to load global/context configuration")
 	}
-	app := view.NewApp(cfg)
+	applyLogLevels(cfg.K9s.Logger, cmd.Flags().Changed("logLevel"))
+	stopTracing, err := startTracing(cfg.K9s.Tracing)
+	if err != nil {
+		log.Warn().Err(err).Msg("Tracing init failed")
+	} else {
+		defer stopTracing()
+	}
+	config.SetColorDepth(cfg.K9s.UI.GetColorDepth())
+	if err := i18n.SetLanguage(cfg.K9s.Language); err != nil {
+		log.Warn().Err(err).Msg("Falling back to english")
+	}
+	app = view.NewApp(cfg)
 	if err := app.Init(version, *k9sFlags.RefreshRate); err != nil {
 		return err
 	}
+	if *k9sFlags.DebugListen != "" {
+		srv := k9sdebug.NewServer(*k9sFlags.DebugListen, app.InformerStats)
+		if err := srv.Start(); err != nil {
+			return fmt.Errorf("debug server %q start failed: %w", *k9sFlags.DebugListen, err)
+		}
+		defer srv.Stop()
+	}
+	if *k9sFlags.RemoteSocket != "" {
+		srv := remote.NewServer(*k9sFlags.RemoteSocket, app.RemoteCommand)
+		if err := srv.Start(); err != nil {
+			return fmt.Errorf("remote control socket %q start failed: %w", *k9sFlags.RemoteSocket, err)
+		}
+		defer srv.Stop()
+	}
 	if err := app.Run(); err != nil {
 		return err
 	}
@@ -122,13 +183,51 @@ func run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// initLogs opens the log file and sets up k9s loggers.
+func initLogs() (*os.File, error) {
+	lvl, err := logging.ParseLevel(*k9sFlags.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(
+		*k9sFlags.LogFile,
+		os.O_CREATE|os.O_APPEND|os.O_WRONLY,
+		data.DefaultFileMod,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Log file %q init failed: %w", *k9sFlags.LogFile, err)
+	}
+	logging.Init(
+		zerolog.MultiLevelWriter(zerolog.ConsoleWriter{Out: file}, logring.Default),
+		lvl,
+	)
+
+	return file, nil
+}
+
+func saveCrashReport(e any, stack []byte, app *view.App) {
+	var views []string
+	if app != nil && app.Content != nil {
+		views = app.Content.Stack.Flatten()
+	}
+	path, err := crash.NewReport(version, e, stack, views).Save(config.AppCrashesDir())
+	if err != nil {
+		log.Error().Err(err).Msg("Crash report save failed")
+		return
+	}
+	fmt.Printf("Crash report saved to %s. Use :crashes to browse it.\n", path)
+}
+
 func loadConfiguration() (*config.Config, error) {
 	log.Info().Msg("🐶 K9s starting up...")
 
+	var errs error
+	if err := client.ExpandKubeConfig(k8sFlags); err != nil {
+		errs = errors.Join(errs, err)
+	}
 	k8sCfg := client.NewConfig(k8sFlags)
 	k9sCfg := config.NewConfig(k8sCfg)
-	var errs error
-	conn, err := client.InitConnection(k8sCfg)
+	conn, err := initConnection(k8sCfg)
 	k9sCfg.SetConnection(conn)
 	if err != nil {
 		errs = errors.Join(errs, err)
@@ -142,6 +241,7 @@ func loadConfiguration() (*config.Config, error) {
 		log.Error().Err(err).Msgf("config refine failed")
 		errs = errors.Join(errs, err)
 	}
+	k8sCfg.SetRateLimit(k9sCfg.K9s.Client.QPS, k9sCfg.K9s.Client.Burst)
 	// Try to access server version if that fail. Connectivity issue?
 	if !conn.CheckConnectivity() {
 		errs = errors.Join(errs, fmt.Errorf("cannot connect to context: %s", k9sCfg.K9s.ActiveContextName()))
@@ -159,20 +259,24 @@ func loadConfiguration() (*config.Config, error) {
 	return k9sCfg, errs
 }
 
-func parseLevel(level string) zerolog.Level {
-	switch level {
-	case "trace":
-		return zerolog.TraceLevel
-	case "debug":
-		return zerolog.DebugLevel
-	case "warn":
-		return zerolog.WarnLevel
-	case "error":
-		return zerolog.ErrorLevel
-	case "fatal":
-		return zerolog.FatalLevel
-	default:
-		return zerolog.InfoLevel
+// applyLogLevels applies the configured log levels. An explicit log level
+// flag trumps the configured main level.
+func applyLogLevels(l config.Logger, levelFlag bool) {
+	if l.Level != "" && !levelFlag {
+		if lvl, err := logging.ParseLevel(l.Level); err != nil {
+			log.Warn().Err(err).Msg("Log level ignored")
+		} else {
+			logging.SetLevel(lvl)
+		}
+	}
+	for sub, s := range l.Levels {
+		lvl, err := logging.ParseLevel(s)
+		if err == nil {
+			err = logging.SetSubsystemLevel(sub, lvl)
+		}
+		if err != nil {
+			log.Warn().Err(err).Msgf("Log level ignored for %s", sub)
+		}
 	}
 }
 
@@ -244,6 +348,36 @@ func initK9sFlags() {
 		"",
 		"Sets a path to a dir for a screen dumps",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		"",
+		"Browse a cluster snapshot file in read-only mode",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Replay,
+		"replay",
+		"",
+		"Replay a recorded watch events file in read-only mode",
+	)
+	rootCmd.Flags().Float64Var(
+		k9sFlags.ReplaySpeed,
+		"replay-speed",
+		config.DefaultReplaySpeed,
+		"Sets the replay speed factor. 0 replays all events at once",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.DebugListen,
+		"debug-listen",
+		"",
+		"Serves pprof profiles and informers stats on a localhost address, ie localhost:6060",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.RemoteSocket,
+		"remote-socket",
+		"",
+		"Accepts remote control commands on a unix socket, ie /tmp/k9s.sock",
+	)
 	rootCmd.Flags()
 }
 
@@ -254,7 +388,7 @@ func initK8sFlags() {
 		k8sFlags.KubeConfig,
 		"kubeconfig",
 		"",
-		"Path to the kubeconfig file to use for CLI requests",
+		"Path to the kubeconfig file to use for CLI requests. Multiple paths are merged like KUBECONFIG",
 	)
 
 	rootCmd.Flags().StringVar(
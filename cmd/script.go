@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const stdinScript = "-"
+
+func scriptCmd() *cobra.Command {
+	c := cobra.Command{
+		Use:           "run SCRIPT",
+		Short:         "Runs a script of K9s commands without the ui. Use - to read the script from stdin",
+		Long:          "Runs K9s prompt commands, one per line, ie switch context, load a resource, filter, dump it or run a plugin. Exits non zero on the first failed command.",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE:          runScript,
+	}
+	c.Flags().StringVar(
+		k8sFlags.KubeConfig,
+		"kubeconfig",
+		"",
+		"Path to the kubeconfig file to use for CLI requests. Multiple paths are merged like KUBECONFIG",
+	)
+	c.Flags().StringVar(
+		k8sFlags.Context,
+		"context",
+		"",
+		"The name of the kubeconfig context to use",
+	)
+	c.Flags().StringVarP(
+		k8sFlags.Namespace,
+		"namespace",
+		"n",
+		"",
+		"If present, the namespace scope for this CLI request",
+	)
+	c.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		"",
+		"Runs the script against a cluster snapshot file",
+	)
+	c.Flags().BoolVar(
+		k9sFlags.ReadOnly,
+		"readonly",
+		false,
+		"Sets readOnly mode by overriding readOnly configuration setting",
+	)
+
+	return &c
+}
+
+func runScript(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return exitError{code: 2, err: errors.New("expecting a single script file")}
+	}
+	if err := config.InitLocs(); err != nil {
+		return exitError{code: 1, err: err}
+	}
+	file, err := initLogs()
+	if err != nil {
+		return exitError{code: 1, err: err}
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	r, err := openScript(args[0])
+	if err != nil {
+		return exitError{code: 1, err: err}
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	if *k9sFlags.Snapshot != "" {
+		stop, err := serveSnapshot(*k9sFlags.Snapshot)
+		if err != nil {
+			return exitError{code: 1, err: fmt.Errorf("snapshot %q load failed: %w", *k9sFlags.Snapshot, err)}
+		}
+		defer stop()
+	}
+	cfg, err := loadConfiguration()
+	if err != nil {
+		log.Error().Err(err).Msgf("Fail to load global/context configuration")
+	}
+	applyLogLevels(cfg.K9s.Logger, false)
+
+	h := view.NewHeadless(cfg, os.Stdout, os.Stderr)
+	if err := h.Init(); err != nil {
+		return exitError{code: 1, err: err}
+	}
+	defer h.Stop()
+	if err := h.Run(r); err != nil {
+		return exitError{code: 1, err: err}
+	}
+
+	return nil
+}
+
+func openScript(path string) (io.ReadCloser, error) {
+	if path == stdinScript {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	return os.Open(path)
+}
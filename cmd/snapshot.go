@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+// serveSnapshot serves a snapshot locally and points the kube flags to it.
+func serveSnapshot(file string) (func(), error) {
+	snap, err := snapshot.LoadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	srv := snapshot.NewServer(snap)
+	if err := srv.Start(); err != nil {
+		return nil, err
+	}
+	kubeConfig, err := writeTempKubeConfig("k9s-snapshot-*.yaml", srv.KubeConfig())
+	if err != nil {
+		srv.Stop()
+		return nil, err
+	}
+	log.Info().Msgf("Browsing snapshot of context %q taken at %s", snap.Meta.Context, snap.Meta.CreatedAt)
+
+	*k8sFlags.KubeConfig, *k8sFlags.Context = kubeConfig, snapshot.ContextName
+	*k8sFlags.ClusterName, *k8sFlags.AuthInfoName = "", ""
+	*k9sFlags.ReadOnly, *k9sFlags.Write = true, false
+
+	return func() {
+		srv.Stop()
+		if err := os.Remove(kubeConfig); err != nil {
+			log.Warn().Err(err).Msgf("Unable to remove snapshot kubeconfig %s", kubeConfig)
+		}
+	}, nil
+}
+
+// writeTempKubeConfig writes out a transient kubeconfig.
+func writeTempKubeConfig(pattern string, cfg *api.Config) (string, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	if err := clientcmd.WriteToFile(*cfg, f.Name()); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/tracing"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+)
+
+const (
+	tracesFile      = "traces.json"
+	tracingShutdown = 5 * time.Second
+)
+
+// startTracing installs an OpenTelemetry tracer provider if tracing is enabled.
+func startTracing(cfg config.Tracing) (func(), error) {
+	if !cfg.Enabled {
+		return func() {}, nil
+	}
+	exp, closer, err := newSpanExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(appName),
+			semconv.ServiceVersion(version),
+		)),
+	)
+	tracing.SetProvider(tp)
+	log.Info().Msgf("Tracing enabled using %s exporter", cfg.Exporter)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdown)
+		defer cancel()
+		if err := errors.Join(tp.Shutdown(ctx), closer()); err != nil {
+			log.Warn().Err(err).Msg("Tracing shutdown failed")
+		}
+	}, nil
+}
+
+func newSpanExporter(cfg config.Tracing) (sdktrace.SpanExporter, func() error, error) {
+	if cfg.Exporter == config.TracingFile {
+		path := cfg.File
+		if path == "" {
+			path = filepath.Join(filepath.Dir(config.AppLogFile), tracesFile)
+		}
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, data.DefaultFileMod)
+		if err != nil {
+			return nil, nil, err
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			return nil, nil, errors.Join(err, f.Close())
+		}
+		return exp, f.Close, nil
+	}
+
+	oo := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		oo = append(oo, otlptracehttp.WithInsecure())
+	}
+	exp, err := otlptracehttp.New(context.Background(), oo...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return exp, func() error { return nil }, nil
+}
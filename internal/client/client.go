@@ -15,9 +15,11 @@ import (
 
 	"github.com/rs/zerolog/log"
 	authorizationv1 "k8s.io/api/authorization/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/disk"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -27,11 +29,12 @@ import (
 )
 
 const (
-	cacheSize     = 100
-	cacheExpiry   = 5 * time.Minute
-	cacheMXAPIKey = "metricsAPI"
-	serverVersion = "serverVersion"
-	cacheNSKey    = "validNamespaces"
+	cacheSize       = 100
+	cacheExpiry     = 5 * time.Minute
+	cacheMXAPIKey   = "metricsAPI"
+	cacheSummaryKey = "kubeletSummaryAPI"
+	serverVersion   = "serverVersion"
+	cacheNSKey      = "validNamespaces"
 )
 
 var supportedMetricsAPIVersions = []string{"v1beta1"}
@@ -85,7 +88,8 @@ func (a *APIClient) ConnectionOK() bool {
 	return a.connOK
 }
 
-func makeSAR(ns, gvr, name string) *authorizationv1.SelfSubjectAccessReview {
+// MakeSAR returns a self subject access review for a given resource.
+func MakeSAR(ns, gvr, name string) *authorizationv1.SelfSubjectAccessReview {
 	if ns == ClusterScope {
 		ns = BlankNamespace
 	}
@@ -162,7 +166,7 @@ func (a *APIClient) CanI(ns, gvr, name string, verbs []string) (auth bool, err e
 	if err != nil {
 		return false, err
 	}
-	client, sar := dial.AuthorizationV1().SelfSubjectAccessReviews(), makeSAR(ns, gvr, name)
+	client, sar := dial.AuthorizationV1().SelfSubjectAccessReviews(), MakeSAR(ns, gvr, name)
 
 	ctx, cancel := context.WithTimeout(context.Background(), a.config.CallTimeout())
 	defer cancel()
@@ -177,12 +181,15 @@ func (a *APIClient) CanI(ns, gvr, name string, verbs []string) (auth bool, err e
 		log.Trace().Msgf("  <<%v>>", err)
 		if err != nil {
 			log.Warn().Err(err).Msgf("  Dial Failed!")
-			a.cache.Add(key, false, cacheExpiry)
+			// Don't hold on to rejected credentials as those may get refreshed.
+			if !kerrors.IsUnauthorized(err) {
+				a.cache.Add(key, false, cacheExpiry)
+			}
 			return auth, err
 		}
 		if !resp.Status.Allowed {
 			a.cache.Add(key, false, cacheExpiry)
-			return auth, fmt.Errorf("`%s access denied for user on %q:%s", v, ns, gvr)
+			return auth, &AccessDeniedError{Verb: v, Namespace: ns, GVR: gvr, Name: name}
 		}
 	}
 	auth = true
@@ -294,7 +301,7 @@ func (a *APIClient) CheckConnectivity() bool {
 		return a.connOK
 	}
 	cfg.Timeout = a.config.CallTimeout()
-	client, err := kubernetes.NewForConfig(cfg)
+	client, err := newClientset(cfg)
 	if err != nil {
 		log.Error().Err(err).Msgf("Unable to connect to api server")
 		a.setConnOK(false)
@@ -303,10 +310,14 @@ func (a *APIClient) CheckConnectivity() bool {
 
 	// Check connection
 	if _, err := client.ServerVersion(); err == nil {
+		a.config.auth.Clear()
 		if !a.getConnOK() {
 			a.reset()
 		}
 	} else {
+		if kerrors.IsUnauthorized(err) {
+			a.config.auth.Record()
+		}
 		log.Error().Err(err).Msgf("can't connect to cluster")
 		a.setConnOK(false)
 	}
@@ -319,8 +330,14 @@ func (a *APIClient) Config() *Config {
 	return a.config
 }
 
-// HasMetrics checks if the cluster supports metrics.
+// HasMetrics checks if the cluster supports metrics either via metrics-server
+// or the kubelets summary API.
 func (a *APIClient) HasMetrics() bool {
+	return a.HasMetricsAPI() || a.supportsKubeletSummary()
+}
+
+// HasMetricsAPI checks if the cluster runs a metrics-server.
+func (a *APIClient) HasMetricsAPI() bool {
 	return a.supportsMetricsResources() == nil
 }
 
@@ -422,7 +439,7 @@ func (a *APIClient) DialLogs() (kubernetes.Interface, error) {
 		return nil, err
 	}
 	cfg.Timeout = 0
-	c, err := kubernetes.NewForConfig(cfg)
+	c, err := newClientset(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -444,7 +461,7 @@ func (a *APIClient) Dial() (kubernetes.Interface, error) {
 	if err != nil {
 		return nil, err
 	}
-	if c, err := kubernetes.NewForConfig(cfg); err != nil {
+	if c, err := newClientset(cfg); err != nil {
 		return nil, err
 	} else {
 		a.setClient(c)
@@ -459,7 +476,7 @@ func (a *APIClient) RestConfig() (*restclient.Config, error) {
 }
 
 // CachedDiscovery returns a cached discovery client.
-func (a *APIClient) CachedDiscovery() (*disk.CachedDiscoveryClient, error) {
+func (a *APIClient) CachedDiscovery() (discovery.CachedDiscoveryInterface, error) {
 	if !a.getConnOK() {
 		return nil, errors.New("no connection to cached dial")
 	}
@@ -500,7 +517,11 @@ func (a *APIClient) DynDial() (dynamic.Interface, error) {
 	if err != nil {
 		return nil, err
 	}
-	c, err := dynamic.NewForConfig(cfg)
+	hc, err := httpClientFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c, err := dynamic.NewForConfigAndClient(cfg, hc)
 	if err != nil {
 		return nil, err
 	}
@@ -520,7 +541,11 @@ func (a *APIClient) MXDial() (*versioned.Clientset, error) {
 		return nil, err
 	}
 
-	c, err := versioned.NewForConfig(cfg)
+	hc, err := httpClientFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c, err := versioned.NewForConfigAndClient(cfg, hc)
 	if err != nil {
 		return nil, err
 	}
@@ -529,6 +554,15 @@ func (a *APIClient) MXDial() (*versioned.Clientset, error) {
 	return a.getMxsClient(), err
 }
 
+func newClientset(cfg *restclient.Config) (*kubernetes.Clientset, error) {
+	hc, err := httpClientFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfigAndClient(cfg, hc)
+}
+
 func (a *APIClient) invalidateCache() error {
 	dial, err := a.CachedDiscovery()
 	if err != nil {
@@ -558,6 +592,44 @@ func (a *APIClient) SwitchContext(name string) error {
 	return nil
 }
 
+// Impersonate reconnects to the api server under a given identity.
+func (a *APIClient) Impersonate(user string, groups []string) error {
+	log.Debug().Msgf("Impersonating %q %v", user, groups)
+	a.config.Impersonate(user, groups)
+	if err := a.invalidateCache(); err != nil {
+		return err
+	}
+	a.reset()
+	ResetMetrics()
+
+	if !a.CheckConnectivity() {
+		return fmt.Errorf("unable to connect as %q", user)
+	}
+
+	return nil
+}
+
+// Relogin reconnects to the api server with renewed credentials. A blank
+// token reloads the kubeconfig credentials, rerunning exec plugins if any.
+func (a *APIClient) Relogin(token string) error {
+	log.Debug().Msg("Renewing session credentials")
+	a.config.SetToken(token)
+	if err := a.invalidateCache(); err != nil {
+		return err
+	}
+	a.reset()
+	ResetMetrics()
+
+	if !a.CheckConnectivity() {
+		if _, ok := a.config.auth.Expired(); ok {
+			return errors.New("credentials rejected by the api server")
+		}
+		return errors.New("unable to reach the api server")
+	}
+
+	return nil
+}
+
 func (a *APIClient) reset() {
 	a.config.reset()
 	a.cache = cache.NewLRUExpireCache(cacheSize)
@@ -615,6 +687,21 @@ func (a *APIClient) supportsMetricsResources() error {
 	return metricsUnsupportedErr
 }
 
+// supportsKubeletSummary checks if the user can proxy nodes kubelet stats.
+func (a *APIClient) supportsKubeletSummary() bool {
+	if supported, ok := a.checkCacheBool(cacheSummaryKey); ok {
+		return supported
+	}
+
+	supported, err := a.CanI(ClusterScope, nodeProxyGVR, "", GetAccess)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to check kubelet summary access")
+	}
+	a.cache.Add(cacheSummaryKey, supported, cacheExpiry)
+
+	return supported
+}
+
 func checkMetricsVersion(grp metav1.APIGroup) bool {
 	for _, v := range grp.Versions {
 		for _, supportedVersion := range supportedMetricsAPIVersions {
@@ -6,10 +6,13 @@ package client
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/derailed/k9s/internal/tracing"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	restclient "k8s.io/client-go/rest"
 	clientcmd "k8s.io/client-go/tools/clientcmd"
@@ -25,17 +28,49 @@ const (
 
 // Config tracks a kubernetes configuration.
 type Config struct {
-	flags *genericclioptions.ConfigFlags
-	mx    sync.RWMutex
+	flags        *genericclioptions.ConfigFlags
+	governor     *Governor
+	limited      bool
+	deprecations *Deprecations
+	auth         *AuthExpiry
+	mx           sync.RWMutex
 }
 
 // NewConfig returns a new k8s config or an error if the flags are invalid.
 func NewConfig(f *genericclioptions.ConfigFlags) *Config {
 	return &Config{
-		flags: f,
+		flags:        f,
+		governor:     NewGovernor(DefaultQPS, DefaultBurst),
+		deprecations: NewDeprecations(),
+		auth:         NewAuthExpiry(),
 	}
 }
 
+// SetRateLimit sets the api server client rate limits. Unless either limit
+// is set, clients keep client-go own rate limiters.
+func (c *Config) SetRateLimit(qps float32, burst int) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.limited = qps > 0 || burst > 0
+	c.governor.Reset(qps, burst)
+}
+
+// Governor returns the api server client rate limiter.
+func (c *Config) Governor() *Governor {
+	return c.governor
+}
+
+// Deprecations returns the api server deprecation warnings.
+func (c *Config) Deprecations() *Deprecations {
+	return c.deprecations
+}
+
+// AuthExpiry returns the session credentials expiry tracker.
+func (c *Config) AuthExpiry() *AuthExpiry {
+	return c.auth
+}
+
 // CallTimeout returns the call timeout if set or the default if not set.
 func (c *Config) CallTimeout() time.Duration {
 	if !isSet(c.flags.Timeout) {
@@ -50,7 +85,20 @@ func (c *Config) CallTimeout() time.Duration {
 }
 
 func (c *Config) RESTConfig() (*restclient.Config, error) {
-	return c.clientConfig().ClientConfig()
+	cfg, err := c.clientConfig().ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	if c.isLimited() {
+		cfg.QPS, cfg.Burst = c.governor.limits()
+		cfg.RateLimiter = c.governor
+		cfg.Wrap(c.governor.Wrap)
+	}
+	cfg.Wrap(c.deprecations.Wrap)
+	cfg.Wrap(c.auth.Wrap)
+	cfg.Wrap(tracing.WrapTransport)
+
+	return cfg, nil
 }
 
 // Flags returns configuration flags.
@@ -68,6 +116,13 @@ func (c *Config) clientConfig() clientcmd.ClientConfig {
 
 func (c *Config) reset() {}
 
+func (c *Config) isLimited() bool {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return c.limited
+}
+
 // SwitchContext changes the kubeconfig context to a new cluster.
 func (c *Config) SwitchContext(name string) error {
 	ct, err := c.GetContext(name)
@@ -80,11 +135,59 @@ func (c *Config) SwitchContext(name string) error {
 	flags.Namespace = c.flags.Namespace
 	flags.Timeout = c.flags.Timeout
 	flags.KubeConfig = c.flags.KubeConfig
+	flags.Impersonate, flags.ImpersonateGroup = c.flags.Impersonate, c.flags.ImpersonateGroup
 	c.flags = flags
+	c.deprecations.Clear()
+	c.auth.Clear()
 
 	return nil
 }
 
+// ContextRESTConfig returns a standalone rest config for a given context
+// leaving the active context untouched.
+func (c *Config) ContextRESTConfig(name string) (*restclient.Config, error) {
+	ct, err := c.GetContext(name)
+	if err != nil {
+		return nil, err
+	}
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.Context, flags.ClusterName = &name, &ct.Cluster
+	flags.Timeout = c.flags.Timeout
+	flags.KubeConfig = c.flags.KubeConfig
+	cfg, err := flags.ToRawKubeConfigLoader().ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.Timeout = c.CallTimeout()
+
+	return cfg, nil
+}
+
+// Impersonate sets the user and groups to impersonate. A blank user reverts
+// to the kubeconfig identity.
+func (c *Config) Impersonate(user string, groups []string) {
+	if user == "" {
+		groups = nil
+	}
+	flags := c.cloneFlags()
+	flags.Impersonate, flags.ImpersonateGroup = &user, &groups
+	c.flags = flags
+}
+
+// SetToken sets a bearer token overriding the kubeconfig credentials. A blank
+// token reverts to the kubeconfig credentials.
+func (c *Config) SetToken(token string) {
+	flags := c.cloneFlags()
+	flags.BearerToken = &token
+	c.flags = flags
+	c.auth.Clear()
+}
+
+// IsImpersonating checks if an impersonated identity is active.
+func (c *Config) IsImpersonating() bool {
+	return isSet(c.flags.Impersonate)
+}
+
 func (c *Config) Clone(ns string) (*genericclioptions.ConfigFlags, error) {
 	flags := genericclioptions.NewConfigFlags(false)
 	ct, err := c.CurrentContextName()
@@ -99,6 +202,7 @@ func (c *Config) Clone(ns string) (*genericclioptions.ConfigFlags, error) {
 	flags.Namespace = &ns
 	flags.Timeout = c.Flags().Timeout
 	flags.KubeConfig = c.Flags().KubeConfig
+	flags.Impersonate, flags.ImpersonateGroup = c.Flags().Impersonate, c.Flags().ImpersonateGroup
 
 	return flags, nil
 }
@@ -232,6 +336,31 @@ func (c *Config) RenameContext(old string, new string) error {
 	return nil
 }
 
+// SetContextNamespace sets a context default namespace. The change is persisted
+// to the kubeconfig file the context originates from.
+func (c *Config) SetContextNamespace(n, ns string) error {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return err
+	}
+	ct, ok := cfg.Contexts[n]
+	if !ok {
+		return fmt.Errorf("context %q does not exist", n)
+	}
+	ct.Namespace = ns
+	acc, err := c.ConfigAccess()
+	if err != nil {
+		return err
+	}
+	if err := clientcmd.ModifyConfig(acc, cfg, true); err != nil {
+		return err
+	}
+	// Persistent flags cache the loaded configuration so a new set is required.
+	c.flags = c.cloneFlags()
+
+	return nil
+}
+
 // ContextNames fetch all available contexts.
 func (c *Config) ContextNames() (map[string]struct{}, error) {
 	cfg, err := c.RawConfig()
@@ -322,9 +451,38 @@ func (c *Config) ConfigAccess() (clientcmd.ConfigAccess, error) {
 	return c.clientConfig().ConfigAccess(), nil
 }
 
+// ExpandKubeConfig honors a list of kubeconfig paths. The kubeconfig flag only
+// loads a single file, so lists are merged KUBECONFIG style instead.
+func ExpandKubeConfig(f *genericclioptions.ConfigFlags) error {
+	if !isSet(f.KubeConfig) || !strings.ContainsRune(*f.KubeConfig, filepath.ListSeparator) {
+		return nil
+	}
+	if err := os.Setenv(clientcmd.RecommendedConfigPathEnvVar, *f.KubeConfig); err != nil {
+		return err
+	}
+	*f.KubeConfig = ""
+
+	return nil
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// cloneFlags returns a new set of flags. Persistent flags cache their loader
+// so a new set is required to pick up identity or kubeconfig changes.
+func (c *Config) cloneFlags() *genericclioptions.ConfigFlags {
+	flags := genericclioptions.NewConfigFlags(UsePersistentConfig)
+	flags.Context, flags.ClusterName = c.flags.Context, c.flags.ClusterName
+	flags.AuthInfoName = c.flags.AuthInfoName
+	flags.Namespace = c.flags.Namespace
+	flags.Timeout = c.flags.Timeout
+	flags.KubeConfig = c.flags.KubeConfig
+	flags.Impersonate, flags.ImpersonateGroup = c.flags.Impersonate, c.flags.ImpersonateGroup
+	flags.BearerToken = c.flags.BearerToken
+
+	return flags
+}
+
 func isSet(s *string) bool {
 	return s != nil && len(*s) != 0
 }
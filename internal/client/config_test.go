@@ -6,6 +6,8 @@ package client_test
 import (
 	"errors"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 func init() {
@@ -215,6 +218,69 @@ func TestConfigSwitchContext(t *testing.T) {
 	assert.Equal(t, "blee", ctx)
 }
 
+func TestConfigImpersonate(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	uu := map[string]struct {
+		user        string
+		groups      []string
+		e           string
+		eg          string
+		impersonate bool
+	}{
+		"user": {
+			user:        "blee",
+			e:           "blee",
+			impersonate: true,
+		},
+		"groups": {
+			user:        "blee",
+			groups:      []string{"g1", "g2"},
+			e:           "blee",
+			eg:          "g1,g2",
+			impersonate: true,
+		},
+		"reset": {
+			groups: []string{"g1"},
+			e:      "fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+			cfg.Impersonate(u.user, u.groups)
+			assert.Equal(t, u.impersonate, cfg.IsImpersonating())
+			n, err := cfg.CurrentUserName()
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, n)
+			gg, _ := cfg.ImpersonateGroups()
+			assert.Equal(t, u.eg, gg)
+
+			assert.Nil(t, cfg.SwitchContext("blee"))
+			assert.Equal(t, u.impersonate, cfg.IsImpersonating())
+		})
+	}
+}
+
+func TestConfigSetToken(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+	cfg.AuthExpiry().Record()
+
+	cfg.SetToken("blee")
+	_, ok := cfg.AuthExpiry().Expired()
+	assert.False(t, ok)
+	rc, err := cfg.RESTConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", rc.BearerToken)
+
+	cfg.Impersonate("fred", nil)
+	rc, err = cfg.RESTConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", rc.BearerToken)
+}
+
 func TestConfigAccess(t *testing.T) {
 	context, kubeConfig := "duh", "./testdata/config"
 	flags := genericclioptions.ConfigFlags{
@@ -307,3 +373,84 @@ func cp(src string, dst string) error {
 
 	return os.WriteFile(dst, data, 0600)
 }
+
+func TestConfigSetContextNamespace(t *testing.T) {
+	kubeConfig := filepath.Join(t.TempDir(), "config")
+	assert.NoError(t, cp("./testdata/config", kubeConfig))
+
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+	assert.NoError(t, cfg.SetContextNamespace("fred", "blee"))
+	assert.Error(t, cfg.SetContextNamespace("bozo", "blee"))
+
+	ct, err := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig}).GetContext("fred")
+	assert.NoError(t, err)
+	assert.Equal(t, "blee", ct.Namespace)
+}
+
+func TestExpandKubeConfig(t *testing.T) {
+	t.Setenv(clientcmd.RecommendedConfigPathEnvVar, "")
+	dir := t.TempDir()
+	c1, c2 := filepath.Join(dir, "config"), filepath.Join(dir, "config.3")
+	assert.NoError(t, cp("./testdata/config", c1))
+	assert.NoError(t, cp("./testdata/config.3", c2))
+
+	paths := strings.Join([]string{c1, c2}, string(filepath.ListSeparator))
+	kubeConfig := paths
+	flags := genericclioptions.ConfigFlags{KubeConfig: &kubeConfig}
+	assert.NoError(t, client.ExpandKubeConfig(&flags))
+	assert.Empty(t, *flags.KubeConfig)
+	assert.Equal(t, paths, os.Getenv(clientcmd.RecommendedConfigPathEnvVar))
+
+	cfg := client.NewConfig(&flags)
+	cc, err := cfg.Contexts()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(cc))
+	assert.Equal(t, c1, cc["fred"].LocationOfOrigin)
+	assert.Equal(t, c2, cc["zorg"].LocationOfOrigin)
+
+	assert.NoError(t, cfg.SetContextNamespace("zorg", "blee"))
+	ct, err := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &c2}).GetContext("zorg")
+	assert.NoError(t, err)
+	assert.Equal(t, "blee", ct.Namespace)
+	_, err = client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &c1}).GetContext("zorg")
+	assert.Error(t, err)
+}
+
+func TestConfigRateLimit(t *testing.T) {
+	uu := map[string]struct {
+		qps     float32
+		burst   int
+		eQPS    float32
+		eBurst  int
+		limited bool
+	}{
+		"unset": {},
+		"qps": {
+			qps:     20,
+			eQPS:    20,
+			eBurst:  client.DefaultBurst,
+			limited: true,
+		},
+		"custom": {
+			qps:     20,
+			burst:   40,
+			eQPS:    20,
+			eBurst:  40,
+			limited: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			kubeConfig := "./testdata/config"
+			cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+			cfg.SetRateLimit(u.qps, u.burst)
+			rc, err := cfg.RESTConfig()
+			assert.NoError(t, err)
+			assert.Equal(t, u.eQPS, rc.QPS)
+			assert.Equal(t, u.eBurst, rc.Burst)
+			assert.Equal(t, u.limited, rc.RateLimiter != nil)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	restclient "k8s.io/client-go/rest"
+)
+
+// refreshRoundTripper retries a request once if the api server rejects its
+// credentials. Exec plugins, auth providers and token files invalidate their
+// cached credentials on a 401 so the retry is issued with fresh ones.
+type refreshRoundTripper struct {
+	rt http.RoundTripper
+}
+
+// RoundTrip executes a http request.
+func (r *refreshRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.rt.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	retry, ok := replay(req)
+	if !ok {
+		return resp, nil
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	log.Debug().Msgf("Credentials rejected. Retrying %s %s", req.Method, req.URL.Path)
+
+	return r.rt.RoundTrip(retry)
+}
+
+// hasRefreshableCreds checks if the config credentials can be renewed mid-session.
+func hasRefreshableCreds(cfg *restclient.Config) bool {
+	return cfg.ExecProvider != nil || cfg.AuthProvider != nil || cfg.BearerTokenFile != ""
+}
+
+// httpClientFor returns an http client that recovers from expired credentials.
+func httpClientFor(cfg *restclient.Config) (*http.Client, error) {
+	c, err := restclient.HTTPClientFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if hasRefreshableCreds(cfg) {
+		c.Transport = &refreshRoundTripper{rt: c.Transport}
+	}
+
+	return c, nil
+}
+
+// replay clones a request so it can be sent again.
+func replay(req *http.Request) (*http.Request, bool) {
+	r := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return r, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	r.Body = body
+
+	return r, true
+}
+
+// AuthExpiry tracks the api server rejecting the session credentials, ie an
+// expired OIDC session or exec plugin token that could not be renewed.
+type AuthExpiry struct {
+	expiredAt time.Time
+	mx        sync.RWMutex
+}
+
+// NewAuthExpiry returns a new credentials expiry tracker.
+func NewAuthExpiry() *AuthExpiry {
+	return &AuthExpiry{}
+}
+
+// Record flags the session credentials as rejected.
+func (a *AuthExpiry) Record() {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	if a.expiredAt.IsZero() {
+		log.Warn().Msg("API server rejected the session credentials")
+		a.expiredAt = time.Now()
+	}
+}
+
+// Clear flags the session credentials as valid.
+func (a *AuthExpiry) Clear() {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.expiredAt = time.Time{}
+}
+
+// Expired returns when the session credentials got rejected if they are.
+func (a *AuthExpiry) Expired() (time.Time, bool) {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	return a.expiredAt, !a.expiredAt.IsZero()
+}
+
+// Wrap returns a round tripper recording credentials rejections.
+func (a *AuthExpiry) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &authRoundTripper{rt: rt, expiry: a}
+}
+
+type authRoundTripper struct {
+	rt     http.RoundTripper
+	expiry *AuthExpiry
+}
+
+// RoundTrip executes a http request.
+func (t *authRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		t.expiry.Record()
+	} else {
+		t.expiry.Clear()
+	}
+
+	return resp, err
+}
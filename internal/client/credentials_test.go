@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	restclient "k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestRefreshRoundTripper(t *testing.T) {
+	uu := map[string]struct {
+		method string
+		body   io.Reader
+		codes  []int
+		calls  int
+		e      int
+	}{
+		"ok": {
+			method: http.MethodGet,
+			codes:  []int{http.StatusOK},
+			calls:  1,
+			e:      http.StatusOK,
+		},
+		"refreshed": {
+			method: http.MethodGet,
+			codes:  []int{http.StatusUnauthorized, http.StatusOK},
+			calls:  2,
+			e:      http.StatusOK,
+		},
+		"refreshed-body": {
+			method: http.MethodPost,
+			body:   strings.NewReader("blee"),
+			codes:  []int{http.StatusUnauthorized, http.StatusCreated},
+			calls:  2,
+			e:      http.StatusCreated,
+		},
+		"retry-once": {
+			method: http.MethodGet,
+			codes:  []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusOK},
+			calls:  2,
+			e:      http.StatusUnauthorized,
+		},
+		"forbidden": {
+			method: http.MethodGet,
+			codes:  []int{http.StatusForbidden, http.StatusOK},
+			calls:  1,
+			e:      http.StatusForbidden,
+		},
+		"no-replay": {
+			method: http.MethodPost,
+			body:   io.NopCloser(strings.NewReader("blee")),
+			codes:  []int{http.StatusUnauthorized, http.StatusOK},
+			calls:  1,
+			e:      http.StatusUnauthorized,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rt := stubRoundTripper{codes: u.codes}
+			req, err := http.NewRequest(u.method, "https://fred/api", u.body)
+			assert.NoError(t, err)
+
+			resp, err := (&refreshRoundTripper{rt: &rt}).RoundTrip(req)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, resp.StatusCode)
+			assert.Equal(t, u.calls, rt.calls)
+			if u.body != nil && u.calls > 1 {
+				assert.Equal(t, []string{"blee", "blee"}, rt.bodies)
+			}
+		})
+	}
+}
+
+func TestHasRefreshableCreds(t *testing.T) {
+	uu := map[string]struct {
+		cfg restclient.Config
+		e   bool
+	}{
+		"none": {},
+		"token": {
+			cfg: restclient.Config{BearerToken: "fred"},
+		},
+		"token-file": {
+			cfg: restclient.Config{BearerTokenFile: "/var/run/secrets/token"},
+			e:   true,
+		},
+		"exec": {
+			cfg: restclient.Config{ExecProvider: &clientcmdapi.ExecConfig{Command: "aws"}},
+			e:   true,
+		},
+		"auth-provider": {
+			cfg: restclient.Config{AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "oidc"}},
+			e:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, hasRefreshableCreds(&u.cfg))
+		})
+	}
+}
+
+func TestAuthExpiryWrap(t *testing.T) {
+	uu := map[string]struct {
+		codes   []int
+		expired bool
+	}{
+		"ok": {
+			codes: []int{http.StatusOK},
+		},
+		"rejected": {
+			codes:   []int{http.StatusUnauthorized},
+			expired: true,
+		},
+		"forbidden": {
+			codes: []int{http.StatusForbidden},
+		},
+		"renewed": {
+			codes: []int{http.StatusUnauthorized, http.StatusOK},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := NewAuthExpiry()
+			rt := a.Wrap(&stubRoundTripper{codes: u.codes})
+			for range u.codes {
+				req, err := http.NewRequest(http.MethodGet, "https://blee/api/v1/pods", http.NoBody)
+				assert.NoError(t, err)
+				_, err = rt.RoundTrip(req)
+				assert.NoError(t, err)
+			}
+			at, ok := a.Expired()
+			assert.Equal(t, u.expired, ok)
+			assert.Equal(t, u.expired, !at.IsZero())
+		})
+	}
+}
+
+// Helpers...
+
+type stubRoundTripper struct {
+	codes  []int
+	calls  int
+	bodies []string
+}
+
+func (s *stubRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		bb, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		s.bodies = append(s.bodies, string(bb))
+	}
+	code := s.codes[s.calls]
+	s.calls++
+
+	return &http.Response{
+		StatusCode: code,
+		Body:       io.NopCloser(bytes.NewReader(nil)),
+		Request:    req,
+	}, nil
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+const warningHeader = "Warning"
+
+// Deprecation represents an api server deprecation warning for a resource.
+type Deprecation struct {
+	GVR      string
+	Message  string
+	Count    int
+	LastSeen time.Time
+}
+
+// Deprecations tracks the deprecation warnings issued by the api server.
+type Deprecations struct {
+	warnings map[string]*Deprecation
+	mx       sync.RWMutex
+}
+
+// NewDeprecations returns a new deprecations tracker.
+func NewDeprecations() *Deprecations {
+	return &Deprecations{
+		warnings: make(map[string]*Deprecation),
+	}
+}
+
+// Record tracks a deprecation warning for a given resource.
+func (d *Deprecations) Record(gvr, msg string) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	dep, ok := d.warnings[gvr]
+	if !ok {
+		log.Warn().Msgf("API deprecation detected for %s: %s", gvr, msg)
+		dep = &Deprecation{GVR: gvr}
+		d.warnings[gvr] = dep
+	}
+	dep.Message, dep.LastSeen = msg, time.Now()
+	dep.Count++
+}
+
+// Lookup returns the deprecation warning for a given resource if any.
+func (d *Deprecations) Lookup(gvr string) (Deprecation, bool) {
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+
+	dep, ok := d.warnings[gvr]
+	if !ok {
+		return Deprecation{}, false
+	}
+
+	return *dep, true
+}
+
+// List returns all deprecation warnings sorted by resource.
+func (d *Deprecations) List() []Deprecation {
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+
+	dd := make([]Deprecation, 0, len(d.warnings))
+	for _, dep := range d.warnings {
+		dd = append(dd, *dep)
+	}
+	sort.Slice(dd, func(i, j int) bool {
+		return dd[i].GVR < dd[j].GVR
+	})
+
+	return dd
+}
+
+// Clear resets all tracked warnings.
+func (d *Deprecations) Clear() {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	d.warnings = make(map[string]*Deprecation)
+}
+
+// Wrap returns a round tripper recording deprecation warnings.
+func (d *Deprecations) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &deprecationRoundTripper{rt: rt, deprecations: d}
+}
+
+type deprecationRoundTripper struct {
+	rt           http.RoundTripper
+	deprecations *Deprecations
+}
+
+// RoundTrip executes a http request.
+func (t *deprecationRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	hh := resp.Header.Values(warningHeader)
+	if len(hh) == 0 {
+		return resp, err
+	}
+	gvr, ok := gvrFromPath(req.URL.Path)
+	if !ok {
+		return resp, err
+	}
+	ww, _ := utilnet.ParseWarningHeaders(hh)
+	for _, w := range ww {
+		if strings.Contains(strings.ToLower(w.Text), "deprecated") {
+			t.deprecations.Record(gvr, w.Text)
+		}
+	}
+
+	return resp, err
+}
+
+// gvrFromPath extracts a resource gvr from an api server request path.
+func gvrFromPath(path string) (string, bool) {
+	tokens := strings.Split(strings.Trim(path, "/"), "/")
+	var gv string
+	switch {
+	case len(tokens) >= 3 && tokens[0] == "api":
+		gv, tokens = tokens[1], tokens[2:]
+	case len(tokens) >= 4 && tokens[0] == "apis":
+		gv, tokens = tokens[1]+"/"+tokens[2], tokens[3:]
+	default:
+		return "", false
+	}
+	res := tokens[0]
+	if res == "namespaces" && len(tokens) >= 3 {
+		res = tokens[2]
+	}
+
+	return gv + "/" + res, true
+}
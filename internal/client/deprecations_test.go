@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGVRFromPath(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		gvr  string
+		ok   bool
+	}{
+		"core": {
+			path: "/api/v1/pods",
+			gvr:  "v1/pods",
+			ok:   true,
+		},
+		"core-namespaced": {
+			path: "/api/v1/namespaces/default/pods/fred",
+			gvr:  "v1/pods",
+			ok:   true,
+		},
+		"namespace": {
+			path: "/api/v1/namespaces/default",
+			gvr:  "v1/namespaces",
+			ok:   true,
+		},
+		"group": {
+			path: "/apis/policy/v1beta1/namespaces/default/poddisruptionbudgets",
+			gvr:  "policy/v1beta1/poddisruptionbudgets",
+			ok:   true,
+		},
+		"discovery": {
+			path: "/apis/policy",
+		},
+		"version": {
+			path: "/version",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvr, ok := gvrFromPath(u.path)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.gvr, gvr)
+		})
+	}
+}
+
+func TestDeprecationsWrap(t *testing.T) {
+	uu := map[string]struct {
+		path     string
+		warnings []string
+		e        []Deprecation
+	}{
+		"none": {
+			path: "/api/v1/pods",
+			e:    []Deprecation{},
+		},
+		"deprecated": {
+			path: "/apis/policy/v1beta1/namespaces/default/poddisruptionbudgets",
+			warnings: []string{
+				`299 - "policy/v1beta1 PodDisruptionBudget is deprecated in v1.21+, unavailable in v1.25+; use policy/v1 PodDisruptionBudget"`,
+			},
+			e: []Deprecation{
+				{
+					GVR:     "policy/v1beta1/poddisruptionbudgets",
+					Message: "policy/v1beta1 PodDisruptionBudget is deprecated in v1.21+, unavailable in v1.25+; use policy/v1 PodDisruptionBudget",
+					Count:   1,
+				},
+			},
+		},
+		"other-warning": {
+			path:     "/api/v1/namespaces/default/pods",
+			warnings: []string{`299 - "spec.nodeSelector: unknown field"`},
+			e:        []Deprecation{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d := NewDeprecations()
+			req, err := http.NewRequest(http.MethodGet, "https://fred"+u.path, nil)
+			assert.NoError(t, err)
+
+			rt := d.Wrap(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				resp := http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(nil))}
+				for _, w := range u.warnings {
+					resp.Header.Add(warningHeader, w)
+				}
+				return &resp, nil
+			}))
+			_, err = rt.RoundTrip(req)
+			assert.NoError(t, err)
+
+			dd := d.List()
+			for i := range dd {
+				assert.False(t, dd[i].LastSeen.IsZero())
+				dd[i].LastSeen = u.e[i].LastSeen
+			}
+			assert.Equal(t, u.e, dd)
+		})
+	}
+}
+
+func TestDeprecationsRecord(t *testing.T) {
+	d := NewDeprecations()
+	d.Record("extensions/v1beta1/ingresses", "deprecated")
+	d.Record("extensions/v1beta1/ingresses", "deprecated")
+
+	dep, ok := d.Lookup("extensions/v1beta1/ingresses")
+	assert.True(t, ok)
+	assert.Equal(t, 2, dep.Count)
+
+	_, ok = d.Lookup("v1/pods")
+	assert.False(t, ok)
+
+	d.Clear()
+	assert.Empty(t, d.List())
+}
@@ -3,7 +3,11 @@
 
 package client
 
-import metricsapi "k8s.io/metrics/pkg/apis/metrics"
+import (
+	"fmt"
+
+	metricsapi "k8s.io/metrics/pkg/apis/metrics"
+)
 
 // Error represents an error.
 type Error string
@@ -17,3 +21,13 @@ const (
 	noMetricServerErr     = Error("No metrics-server detected")
 	metricsUnsupportedErr = Error("No metrics api group " + metricsapi.GroupName + " found on cluster")
 )
+
+// AccessDeniedError represents a denied access review.
+type AccessDeniedError struct {
+	Verb, Namespace, GVR, Name string
+}
+
+// Error returns the error text.
+func (e *AccessDeniedError) Error() string {
+	return fmt.Sprintf("`%s access denied for user on %q:%s", e.Verb, e.Namespace, e.GVR)
+}
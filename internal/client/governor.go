@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	// DefaultQPS tracks the default api server queries per second.
+	DefaultQPS float32 = rest.DefaultQPS
+
+	// DefaultBurst tracks the default api server queries burst.
+	DefaultBurst = rest.DefaultBurst
+
+	minGovernorQPS   float32 = 1
+	governorGrace            = time.Second
+	governorCooldown         = 30 * time.Second
+)
+
+var _ flowcontrol.RateLimiter = (*Governor)(nil)
+
+// Governor rate limits api server requests. The rate is halved whenever the
+// api server throttles requests and restored gradually once it calms down.
+type Governor struct {
+	qps, current float32
+	burst        int
+	limiter      flowcontrol.RateLimiter
+	throttledAt  time.Time
+	mx           sync.RWMutex
+}
+
+// NewGovernor returns a new governor.
+func NewGovernor(qps float32, burst int) *Governor {
+	var g Governor
+	g.Reset(qps, burst)
+
+	return &g
+}
+
+// Reset sets the governor nominal rate.
+func (g *Governor) Reset(qps float32, burst int) {
+	if qps <= 0 {
+		qps = DefaultQPS
+	}
+	if burst <= 0 {
+		burst = DefaultBurst
+	}
+
+	g.mx.Lock()
+	defer g.mx.Unlock()
+	g.qps, g.burst, g.throttledAt = qps, burst, time.Time{}
+	g.setRate(qps)
+}
+
+// Throttle halves the current rate.
+func (g *Governor) Throttle() {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	// Throttled responses come in bursts, only back off once per grace period.
+	if time.Since(g.throttledAt) < governorGrace {
+		return
+	}
+	g.throttledAt = time.Now()
+	qps := g.current / 2
+	if qps < minGovernorQPS {
+		qps = minGovernorQPS
+	}
+	if qps == g.current {
+		return
+	}
+	log.Warn().Msgf("API server throttling detected. Lowering client rate to %.1f qps", qps)
+	g.setRate(qps)
+}
+
+// IsThrottled returns the current rate and whether it is below the nominal rate.
+func (g *Governor) IsThrottled() (float32, bool) {
+	g.mx.RLock()
+	defer g.mx.RUnlock()
+
+	return g.current, g.current < g.qps
+}
+
+// TryAccept returns true if a token is taken immediately.
+func (g *Governor) TryAccept() bool {
+	return g.rateLimiter().TryAccept()
+}
+
+// Accept returns once a token becomes available.
+func (g *Governor) Accept() {
+	g.rateLimiter().Accept()
+}
+
+// Wait returns nil if a token is taken before the context is done.
+func (g *Governor) Wait(ctx context.Context) error {
+	return g.rateLimiter().Wait(ctx)
+}
+
+// Stop stops the rate limiter.
+func (g *Governor) Stop() {}
+
+// QPS returns the current rate.
+func (g *Governor) QPS() float32 {
+	g.mx.RLock()
+	defer g.mx.RUnlock()
+
+	return g.current
+}
+
+func (g *Governor) limits() (float32, int) {
+	g.mx.RLock()
+	defer g.mx.RUnlock()
+
+	return g.current, g.burst
+}
+
+// Wrap returns a round tripper throttling the governor on 429s.
+func (g *Governor) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &throttleRoundTripper{rt: rt, governor: g}
+}
+
+func (g *Governor) rateLimiter() flowcontrol.RateLimiter {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	if g.current < g.qps && !g.throttledAt.IsZero() && time.Since(g.throttledAt) > governorCooldown {
+		qps := g.current * 2
+		if qps > g.qps {
+			qps = g.qps
+		}
+		g.throttledAt = time.Now()
+		log.Info().Msgf("API server throttling cleared. Raising client rate to %.1f qps", qps)
+		g.setRate(qps)
+	}
+
+	return g.limiter
+}
+
+func (g *Governor) setRate(qps float32) {
+	burst := g.burst
+	if qps < g.qps {
+		// Scale burst down along with the rate.
+		burst = int(float32(g.burst) * qps / g.qps)
+		if burst < 1 {
+			burst = 1
+		}
+	}
+	g.current, g.limiter = qps, flowcontrol.NewTokenBucketRateLimiter(qps, burst)
+}
+
+type throttleRoundTripper struct {
+	rt       http.RoundTripper
+	governor *Governor
+}
+
+// RoundTrip executes a http request.
+func (t *throttleRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.rt.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		t.governor.Throttle()
+	}
+
+	return resp, err
+}
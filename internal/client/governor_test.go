@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGovernorReset(t *testing.T) {
+	uu := map[string]struct {
+		qps    float32
+		burst  int
+		eQPS   float32
+		eBurst int
+	}{
+		"defaults": {
+			eQPS:   DefaultQPS,
+			eBurst: DefaultBurst,
+		},
+		"custom": {
+			qps:    10,
+			burst:  20,
+			eQPS:   10,
+			eBurst: 20,
+		},
+		"negative": {
+			qps:    -1,
+			burst:  -1,
+			eQPS:   DefaultQPS,
+			eBurst: DefaultBurst,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			g := NewGovernor(u.qps, u.burst)
+			qps, burst := g.limits()
+			assert.Equal(t, u.eQPS, qps)
+			assert.Equal(t, u.eBurst, burst)
+			_, ok := g.IsThrottled()
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestGovernorThrottle(t *testing.T) {
+	g := NewGovernor(8, 16)
+
+	g.Throttle()
+	qps, ok := g.IsThrottled()
+	assert.True(t, ok)
+	assert.Equal(t, float32(4), qps)
+
+	// Bursts of throttled responses only back off once.
+	g.Throttle()
+	qps, _ = g.IsThrottled()
+	assert.Equal(t, float32(4), qps)
+
+	g.throttledAt = time.Now().Add(-governorGrace)
+	g.Throttle()
+	qps, _ = g.IsThrottled()
+	assert.Equal(t, float32(2), qps)
+
+	g.throttledAt = time.Now().Add(-governorCooldown)
+	assert.True(t, g.TryAccept())
+	qps, ok = g.IsThrottled()
+	assert.True(t, ok)
+	assert.Equal(t, float32(4), qps)
+
+	g.Reset(8, 16)
+	qps, ok = g.IsThrottled()
+	assert.False(t, ok)
+	assert.Equal(t, float32(8), qps)
+}
+
+func TestGovernorFloor(t *testing.T) {
+	g := NewGovernor(1, 1)
+	g.Throttle()
+
+	qps, ok := g.IsThrottled()
+	assert.False(t, ok)
+	assert.Equal(t, minGovernorQPS, qps)
+}
+
+func TestGovernorWrap(t *testing.T) {
+	uu := map[string]struct {
+		code int
+		e    bool
+	}{
+		"ok": {
+			code: http.StatusOK,
+		},
+		"unavailable": {
+			code: http.StatusServiceUnavailable,
+		},
+		"throttled": {
+			code: http.StatusTooManyRequests,
+			e:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			g := NewGovernor(10, 10)
+			req, err := http.NewRequest(http.MethodGet, "https://fred/api", nil)
+			assert.NoError(t, err)
+
+			rt := g.Wrap(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: u.code, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+			}))
+			resp, err := rt.RoundTrip(req)
+			assert.NoError(t, err)
+			assert.Equal(t, u.code, resp.StatusCode)
+			_, ok := g.IsThrottled()
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
+
+// Helpers...
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	summaryCacheExpiry = 15 * time.Second
+	nodeProxyGVR       = "v1/nodes:proxy"
+)
+
+// KubeletSummary represents a subset of a kubelet stats summary.
+type KubeletSummary struct {
+	Node NodeStats  `json:"node"`
+	Pods []PodStats `json:"pods"`
+}
+
+// NodeStats represents a node stats.
+type NodeStats struct {
+	NodeName string       `json:"nodeName"`
+	CPU      *CPUStats    `json:"cpu,omitempty"`
+	Memory   *MemoryStats `json:"memory,omitempty"`
+}
+
+// PodStats represents a pod stats.
+type PodStats struct {
+	PodRef     PodReference     `json:"podRef"`
+	Containers []ContainerStats `json:"containers,omitempty"`
+	Volumes    []VolumeStats    `json:"volume,omitempty"`
+}
+
+// ContainerStats represents a container stats.
+type ContainerStats struct {
+	Name   string       `json:"name"`
+	CPU    *CPUStats    `json:"cpu,omitempty"`
+	Memory *MemoryStats `json:"memory,omitempty"`
+}
+
+// CPUStats represents cpu usage.
+type CPUStats struct {
+	Time           metav1.Time `json:"time"`
+	UsageNanoCores *uint64     `json:"usageNanoCores,omitempty"`
+}
+
+// MemoryStats represents memory usage.
+type MemoryStats struct {
+	Time            metav1.Time `json:"time"`
+	WorkingSetBytes *uint64     `json:"workingSetBytes,omitempty"`
+}
+
+// PodReference represents a pod reference.
+type PodReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// VolumeStats represents a pod volume stats.
+type VolumeStats struct {
+	Name           string        `json:"name"`
+	PVCRef         *PVCReference `json:"pvcRef,omitempty"`
+	CapacityBytes  *uint64       `json:"capacityBytes,omitempty"`
+	UsedBytes      *uint64       `json:"usedBytes,omitempty"`
+	AvailableBytes *uint64       `json:"availableBytes,omitempty"`
+}
+
+// PVCReference represents a persistent volume claim reference.
+type PVCReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// PVCStats tracks a persistent volume claim usage.
+type PVCStats struct {
+	CapacityBytes, UsedBytes int64
+}
+
+// PVCsStatsMap tracks pvcs usage by fqn.
+type PVCsStatsMap map[string]*PVCStats
+
+// FetchKubeletSummary fetches a node kubelet stats summary.
+func (m *MetricsServer) FetchKubeletSummary(ctx context.Context, node string) (*KubeletSummary, error) {
+	auth, err := m.CanI(ClusterScope, nodeProxyGVR, node, GetAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to proxy node %q", node)
+	}
+
+	key := FQN("summary", node)
+	if entry, ok := m.cache.Get(key); ok {
+		summary, ok := entry.(*KubeletSummary)
+		if !ok {
+			return nil, fmt.Errorf("expected KubeletSummary but got %T", entry)
+		}
+		return summary, nil
+	}
+
+	dial, err := m.Dial()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := dial.CoreV1().RESTClient().
+		Get().
+		Resource("nodes").
+		Name(node).
+		SubResource("proxy").
+		Suffix("stats/summary").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var summary KubeletSummary
+	if err := json.Unmarshal(raw, &summary); err != nil {
+		return nil, err
+	}
+	m.cache.Add(key, &summary, summaryCacheExpiry)
+
+	return &summary, nil
+}
+
+// FetchPVCsStats fetches pvcs usage from the given nodes kubelets.
+func (m *MetricsServer) FetchPVCsStats(ctx context.Context, nodes []string) (PVCsStatsMap, error) {
+	mm := make(PVCsStatsMap)
+	for _, n := range nodes {
+		summary, err := m.FetchKubeletSummary(ctx, n)
+		if err != nil {
+			return mm, err
+		}
+		summary.PVCsStats(mm)
+	}
+
+	return mm, nil
+}
+
+// PVCsStats collects pvcs usage from the summary.
+func (s *KubeletSummary) PVCsStats(mm PVCsStatsMap) {
+	for _, p := range s.Pods {
+		for _, v := range p.Volumes {
+			if v.PVCRef == nil || v.CapacityBytes == nil || v.UsedBytes == nil {
+				continue
+			}
+			mm[FQN(v.PVCRef.Namespace, v.PVCRef.Name)] = &PVCStats{
+				CapacityBytes: int64(*v.CapacityBytes),
+				UsedBytes:     int64(*v.UsedBytes),
+			}
+		}
+	}
+}
+
+// FetchSummaryNodesMetrics collects nodes metrics from the kubelets summary API.
+func (m *MetricsServer) FetchSummaryNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error) {
+	ss, err := m.fetchSummaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mx := new(mv1beta1.NodeMetricsList)
+	for _, s := range ss {
+		mx.Items = append(mx.Items, s.NodeMetrics())
+	}
+
+	return mx, nil
+}
+
+// FetchSummaryPodsMetrics collects pods metrics in a given namespace from the kubelets summary API.
+func (m *MetricsServer) FetchSummaryPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error) {
+	ss, err := m.fetchSummaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mx := new(mv1beta1.PodMetricsList)
+	for _, s := range ss {
+		mx.Items = append(mx.Items, s.PodsMetrics(ns)...)
+	}
+
+	return mx, nil
+}
+
+func (m *MetricsServer) fetchSummaries(ctx context.Context) ([]*KubeletSummary, error) {
+	dial, err := m.Dial()
+	if err != nil {
+		return nil, err
+	}
+	nn, err := dial.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ss := make([]*KubeletSummary, 0, len(nn.Items))
+	for _, n := range nn.Items {
+		s, err := m.FetchKubeletSummary(ctx, n.Name)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to fetch kubelet summary for node %q", n.Name)
+			continue
+		}
+		ss = append(ss, s)
+	}
+
+	return ss, nil
+}
+
+// NodeMetrics returns the node usage as metrics-server node metrics.
+func (s *KubeletSummary) NodeMetrics() mv1beta1.NodeMetrics {
+	return mv1beta1.NodeMetrics{
+		ObjectMeta: metav1.ObjectMeta{Name: s.Node.NodeName},
+		Timestamp:  statsTime(s.Node.CPU, s.Node.Memory),
+		Usage:      toUsage(s.Node.CPU, s.Node.Memory),
+	}
+}
+
+// PodsMetrics returns pods usage in a given namespace as metrics-server pod metrics.
+func (s *KubeletSummary) PodsMetrics(ns string) []mv1beta1.PodMetrics {
+	mm := make([]mv1beta1.PodMetrics, 0, len(s.Pods))
+	for _, p := range s.Pods {
+		if IsNamespaced(ns) && p.PodRef.Namespace != ns {
+			continue
+		}
+		mx := mv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: p.PodRef.Namespace,
+				Name:      p.PodRef.Name,
+			},
+			Containers: make([]mv1beta1.ContainerMetrics, 0, len(p.Containers)),
+		}
+		for _, c := range p.Containers {
+			if t := statsTime(c.CPU, c.Memory); !t.IsZero() {
+				mx.Timestamp = t
+			}
+			mx.Containers = append(mx.Containers, mv1beta1.ContainerMetrics{
+				Name:  c.Name,
+				Usage: toUsage(c.CPU, c.Memory),
+			})
+		}
+		mm = append(mm, mx)
+	}
+
+	return mm
+}
+
+func statsTime(cpu *CPUStats, mem *MemoryStats) metav1.Time {
+	switch {
+	case cpu != nil:
+		return cpu.Time
+	case mem != nil:
+		return mem.Time
+	default:
+		return metav1.Time{}
+	}
+}
+
+func toUsage(cpu *CPUStats, mem *MemoryStats) v1.ResourceList {
+	ll := make(v1.ResourceList, 2)
+	if cpu != nil && cpu.UsageNanoCores != nil {
+		ll[v1.ResourceCPU] = *resource.NewScaledQuantity(int64(*cpu.UsageNanoCores), resource.Nano)
+	}
+	if mem != nil && mem.WorkingSetBytes != nil {
+		ll[v1.ResourceMemory] = *resource.NewQuantity(int64(*mem.WorkingSetBytes), resource.BinarySI)
+	}
+
+	return ll
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubeletSummaryPVCsStats(t *testing.T) {
+	raw, err := os.ReadFile("testdata/summary.json")
+	assert.NoError(t, err)
+	var s client.KubeletSummary
+	assert.NoError(t, json.Unmarshal(raw, &s))
+
+	mm := make(client.PVCsStatsMap)
+	s.PVCsStats(mm)
+
+	assert.Equal(t, 1, len(mm))
+	assert.Equal(t, &client.PVCStats{CapacityBytes: 1073741824, UsedBytes: 268435456}, mm["default/data-p1"])
+}
+
+func TestKubeletSummaryNodeMetrics(t *testing.T) {
+	s := loadSummary(t)
+
+	mx := s.NodeMetrics()
+	assert.Equal(t, "n1", mx.Name)
+	assert.Equal(t, int64(250), mx.Usage.Cpu().MilliValue())
+	assert.Equal(t, int64(1073741824), mx.Usage.Memory().Value())
+}
+
+func TestKubeletSummaryPodsMetrics(t *testing.T) {
+	uu := map[string]struct {
+		ns    string
+		count int
+	}{
+		"all": {
+			ns:    client.NamespaceAll,
+			count: 2,
+		},
+		"blank": {
+			ns:    client.BlankNamespace,
+			count: 2,
+		},
+		"ns": {
+			ns:    "default",
+			count: 1,
+		},
+		"none": {
+			ns: "fred",
+		},
+	}
+
+	s := loadSummary(t)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.count, len(s.PodsMetrics(u.ns)))
+		})
+	}
+}
+
+func TestKubeletSummaryPodsMetricsUsage(t *testing.T) {
+	mm := loadSummary(t).PodsMetrics("default")
+
+	assert.Equal(t, 1, len(mm))
+	assert.Equal(t, "default", mm[0].Namespace)
+	assert.Equal(t, "p1", mm[0].Name)
+	assert.Equal(t, 2, len(mm[0].Containers))
+	c1 := mm[0].Containers[0]
+	assert.Equal(t, "c1", c1.Name)
+	assert.Equal(t, int64(10), c1.Usage.Cpu().MilliValue())
+	assert.Equal(t, int64(20971520), c1.Usage.Memory().Value())
+	assert.True(t, mm[0].Containers[1].Usage.Cpu().IsZero())
+}
+
+// Helpers...
+
+func loadSummary(t *testing.T) *client.KubeletSummary {
+	raw, err := os.ReadFile("testdata/summary.json")
+	assert.NoError(t, err)
+	var s client.KubeletSummary
+	assert.NoError(t, json.Unmarshal(raw, &s))
+
+	return &s
+}
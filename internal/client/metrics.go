@@ -93,7 +93,11 @@ func (m *MetricsServer) checkAccess(ns, gvr, msg string) error {
 		return errors.New("no metrics-server detected on cluster")
 	}
 
-	auth, err := m.CanI(ns, gvr, "", ListAccess)
+	verbs := ListAccess
+	if !m.HasMetricsAPI() {
+		ns, gvr, verbs = ClusterScope, nodeProxyGVR, GetAccess
+	}
+	auth, err := m.CanI(ns, gvr, "", verbs)
 	if err != nil {
 		return err
 	}
@@ -164,11 +168,7 @@ func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMe
 		return mxList, nil
 	}
 
-	client, err := m.MXDial()
-	if err != nil {
-		return mx, err
-	}
-	mxList, err := client.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+	mxList, err := m.listNodesMetrics(ctx)
 	if err != nil {
 		return mx, err
 	}
@@ -177,6 +177,18 @@ func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMe
 	return mxList, nil
 }
 
+func (m *MetricsServer) listNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error) {
+	if !m.HasMetricsAPI() {
+		return m.FetchSummaryNodesMetrics(ctx)
+	}
+	client, err := m.MXDial()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+}
+
 // FetchNodeMetrics return all metrics for nodes.
 func (m *MetricsServer) FetchNodeMetrics(ctx context.Context, n string) (*mv1beta1.NodeMetrics, error) {
 	const msg = "user is not authorized to list node metrics"
@@ -235,11 +247,7 @@ func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1be
 		return mxList, nil
 	}
 
-	client, err := m.MXDial()
-	if err != nil {
-		return mx, err
-	}
-	mxList, err := client.MetricsV1beta1().PodMetricses(ns).List(ctx, metav1.ListOptions{})
+	mxList, err := m.listPodsMetrics(ctx, ns)
 	if err != nil {
 		return mx, err
 	}
@@ -248,6 +256,18 @@ func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1be
 	return mxList, err
 }
 
+func (m *MetricsServer) listPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error) {
+	if !m.HasMetricsAPI() {
+		return m.FetchSummaryPodsMetrics(ctx, ns)
+	}
+	client, err := m.MXDial()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.MetricsV1beta1().PodMetricses(ns).List(ctx, metav1.ListOptions{})
+}
+
 // FetchContainersMetrics returns a pod's containers metrics.
 func (m *MetricsServer) FetchContainersMetrics(ctx context.Context, fqn string) (ContainersMetrics, error) {
 	mm, err := m.FetchPodMetrics(ctx, fqn)
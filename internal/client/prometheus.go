@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	promQueryRangePath = "/api/v1/query_range"
+	promTimeout        = 10 * time.Second
+	promRateWindow     = "2m"
+)
+
+// PromSeries describes a prometheus time-series graph.
+type PromSeries struct {
+	Name  string
+	Unit  string
+	Query string
+}
+
+// PromGraph describes a graph with up to two series.
+type PromGraph struct {
+	Title  string
+	S1, S2 *PromSeries
+}
+
+// PromRange tracks a range query window.
+type PromRange struct {
+	Start, End time.Time
+	Step       time.Duration
+}
+
+// NewPromRange returns a window spanning the given duration until now.
+func NewPromRange(span, step time.Duration) PromRange {
+	end := time.Now()
+
+	return PromRange{Start: end.Add(-span), End: end, Step: step}
+}
+
+// Prometheus represents a prometheus datasource.
+type Prometheus struct {
+	url    string
+	client *http.Client
+}
+
+// NewPrometheus returns a new prometheus datasource.
+func NewPrometheus(u string, insecure bool) *Prometheus {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		// nolint:gosec
+		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return &Prometheus{
+		url:    strings.TrimSuffix(u, "/"),
+		client: &http.Client{Transport: tr, Timeout: promTimeout},
+	}
+}
+
+type promResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Values [][2]interface{} `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// QueryRange runs a range query and returns the samples summed across all series
+// in chronological order.
+func (p *Prometheus) QueryRange(ctx context.Context, q string, r PromRange) ([]float64, error) {
+	params := url.Values{}
+	params.Set("query", q)
+	params.Set("start", strconv.FormatInt(r.Start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(r.End.Unix(), 10))
+	params.Set("step", strconv.Itoa(int(r.Step.Seconds())))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+promQueryRangePath+"?"+params.Encode(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var res promResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("invalid prometheus response (%s): %w", resp.Status, err)
+	}
+
+	return res.samples()
+}
+
+func (r promResponse) samples() ([]float64, error) {
+	if r.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s", r.Error)
+	}
+	if r.Data.ResultType != "matrix" {
+		return nil, fmt.Errorf("expecting a matrix result but got %q", r.Data.ResultType)
+	}
+
+	sums := make(map[float64]float64)
+	for _, s := range r.Data.Result {
+		for _, v := range s.Values {
+			ts, ok := v[0].(float64)
+			if !ok {
+				return nil, fmt.Errorf("invalid sample timestamp %v", v[0])
+			}
+			raw, ok := v[1].(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid sample value %v", v[1])
+			}
+			f, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return nil, err
+			}
+			sums[ts] += f
+		}
+	}
+	tt := make([]float64, 0, len(sums))
+	for t := range sums {
+		tt = append(tt, t)
+	}
+	sort.Float64s(tt)
+	ff := make([]float64, 0, len(tt))
+	for _, t := range tt {
+		ff = append(ff, sums[t])
+	}
+
+	return ff, nil
+}
+
+// PodPromGraphs returns cpu, memory and network graphs for a given pod.
+func PodPromGraphs(fqn string) []PromGraph {
+	ns, n := Namespaced(fqn)
+	sel := fmt.Sprintf(`namespace=%q,pod=%q`, ns, n)
+
+	return promGraphs(sel+`,container!=""`, sel)
+}
+
+// NodePromGraphs returns cpu, memory and network graphs for a given node.
+func NodePromGraphs(n string) []PromGraph {
+	sel := fmt.Sprintf(`node=%q,id="/"`, n)
+
+	return promGraphs(sel, sel)
+}
+
+func promGraphs(sel, netSel string) []PromGraph {
+	return []PromGraph{
+		{
+			Title: "CPU",
+			S1: &PromSeries{
+				Name:  "cpu",
+				Unit:  "m",
+				Query: fmt.Sprintf(`sum(rate(container_cpu_usage_seconds_total{%s}[%s]))*1000`, sel, promRateWindow),
+			},
+		},
+		{
+			Title: "MEM",
+			S1: &PromSeries{
+				Name:  "mem",
+				Unit:  "Mi",
+				Query: fmt.Sprintf(`sum(container_memory_working_set_bytes{%s})/1048576`, sel),
+			},
+		},
+		{
+			Title: "NET",
+			S1: &PromSeries{
+				Name:  "rx",
+				Unit:  "KiB/s",
+				Query: fmt.Sprintf(`sum(rate(container_network_receive_bytes_total{%s}[%s]))/1024`, netSel, promRateWindow),
+			},
+			S2: &PromSeries{
+				Name:  "tx",
+				Unit:  "KiB/s",
+				Query: fmt.Sprintf(`sum(rate(container_network_transmit_bytes_total{%s}[%s]))/1024`, netSel, promRateWindow),
+			},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusQueryRange(t *testing.T) {
+	uu := map[string]struct {
+		body string
+		e    []float64
+		err  string
+	}{
+		"happy": {
+			body: `{"status":"success","data":{"resultType":"matrix","result":[
+				{"metric":{"pod":"p1"},"values":[[1700000030,"2"],[1700000000,"1"]]},
+				{"metric":{"pod":"p2"},"values":[[1700000000,"3"],[1700000030,"4"]]}
+			]}}`,
+			e: []float64{4, 6},
+		},
+		"empty": {
+			body: `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			e:    []float64{},
+		},
+		"failed": {
+			body: `{"status":"error","error":"bad query"}`,
+			err:  "prometheus query failed: bad query",
+		},
+		"vector": {
+			body: `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			err:  `expecting a matrix result but got "vector"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/query_range", r.URL.Path)
+				assert.Equal(t, "up", r.URL.Query().Get("query"))
+				assert.Equal(t, "30", r.URL.Query().Get("step"))
+				_, _ = w.Write([]byte(u.body))
+			}))
+			defer srv.Close()
+
+			p := client.NewPrometheus(srv.URL+"/", false)
+			ff, err := p.QueryRange(context.Background(), "up", client.NewPromRange(time.Hour, 30*time.Second))
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, ff)
+		})
+	}
+}
+
+func TestPodPromGraphs(t *testing.T) {
+	gg := client.PodPromGraphs("ns1/p1")
+
+	assert.Equal(t, 3, len(gg))
+	assert.Equal(t, `sum(rate(container_cpu_usage_seconds_total{namespace="ns1",pod="p1",container!=""}[2m]))*1000`, gg[0].S1.Query)
+	assert.Nil(t, gg[0].S2)
+	assert.Equal(t, `sum(rate(container_network_transmit_bytes_total{namespace="ns1",pod="p1"}[2m]))/1024`, gg[2].S2.Query)
+}
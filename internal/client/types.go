@@ -5,7 +5,7 @@ package client
 
 import (
 	"k8s.io/apimachinery/pkg/version"
-	"k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
@@ -105,8 +105,14 @@ type Connection interface {
 	// SwitchContext switches cluster based on context.
 	SwitchContext(ctx string) error
 
+	// Impersonate switches the connection identity.
+	Impersonate(user string, groups []string) error
+
+	// Relogin reconnects with renewed credentials.
+	Relogin(token string) error
+
 	// CachedDiscovery connects to discovery client.
-	CachedDiscovery() (*disk.CachedDiscoveryClient, error)
+	CachedDiscovery() (discovery.CachedDiscoveryInterface, error)
 
 	// RestConfig connects to rest client.
 	RestConfig() (*restclient.Config, error)
@@ -117,9 +123,12 @@ type Connection interface {
 	// DynDial connects to dynamic client.
 	DynDial() (dynamic.Interface, error)
 
-	// HasMetrics checks if metrics server is available.
+	// HasMetrics checks if metrics are available.
 	HasMetrics() bool
 
+	// HasMetricsAPI checks if metrics server is available.
+	HasMetricsAPI() bool
+
 	// ValidNamespaceNames returns all available namespace names.
 	ValidNamespaceNames() (NamespaceNames, error)
 
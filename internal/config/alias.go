@@ -188,6 +188,8 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare("benchmarks", "benchmark", "bench")
 	a.declare("screendumps", "screendump", "sd")
 	a.declare("pulses", "pulse", "pu", "hz")
+	a.declare("pluginjobs", "pluginjob", "pjobs", "pj")
+	a.declare("keys", "keybindings", "kb")
 	a.declare("xrays", "xray", "x")
 	a.declare("workloads", "workload", "wk")
 }
@@ -4,6 +4,7 @@
 package config
 
 import (
+	"fmt"
 	"net/http"
 	"os"
 
@@ -22,6 +23,7 @@ type (
 	// Benchmarks tracks K9s benchmarks configuration.
 	Benchmarks struct {
 		Defaults   Benchmark              `yaml:"defaults"`
+		Profiles   map[string]BenchConfig `yaml:"profiles"`
 		Services   map[string]BenchConfig `yam':"services"`
 		Containers map[string]BenchConfig `yam':"containers"`
 	}
@@ -41,6 +43,7 @@ type (
 	// HTTP represents an http request.
 	HTTP struct {
 		Method  string      `yaml:"method"`
+		Scheme  string      `yaml:"scheme"`
 		Host    string      `yaml:"host"`
 		Path    string      `yaml:"path"`
 		HTTP2   bool        `yaml:"http2"`
@@ -50,11 +53,12 @@ type (
 
 	// BenchConfig represents a service benchmark.
 	BenchConfig struct {
-		Name string
-		C    int  `yaml:"concurrency"`
-		N    int  `yaml:"requests"`
-		Auth Auth `yaml:"auth"`
-		HTTP HTTP `yaml:"http"`
+		Name    string
+		Profile string `yaml:"profile"`
+		C       int    `yaml:"concurrency"`
+		N       int    `yaml:"requests"`
+		Auth    Auth   `yaml:"auth"`
+		HTTP    HTTP   `yaml:"http"`
 	}
 )
 
@@ -65,6 +69,8 @@ const (
 	DefaultN = 200
 	// DefaultMethod default http verb.
 	DefaultMethod = "GET"
+	// DefaultScheme default url scheme.
+	DefaultScheme = "http"
 )
 
 // DefaultBenchSpec returns a default bench spec.
@@ -79,6 +85,15 @@ func DefaultBenchSpec() BenchConfig {
 	}
 }
 
+// URLScheme returns the request url scheme.
+func (h HTTP) URLScheme() string {
+	if h.Scheme == "" {
+		return DefaultScheme
+	}
+
+	return h.Scheme
+}
+
 func newBenchmark() Benchmark {
 	return Benchmark{
 		C: DefaultC,
@@ -91,6 +106,43 @@ func (b Benchmark) Empty() bool {
 	return b.C == 0 && b.N == 0
 }
 
+// Resolve returns a benchmark config inheriting its unset settings from its
+// profile if any.
+func (b *Benchmarks) Resolve(cfg BenchConfig) (BenchConfig, error) {
+	if cfg.Profile == "" {
+		return cfg, nil
+	}
+	p, ok := b.Profiles[cfg.Profile]
+	if !ok {
+		return cfg, fmt.Errorf("no benchmark profile named %q", cfg.Profile)
+	}
+
+	if cfg.C == 0 {
+		cfg.C = p.C
+	}
+	if cfg.N == 0 {
+		cfg.N = p.N
+	}
+	if cfg.Auth.User == "" && cfg.Auth.Password == "" {
+		cfg.Auth = p.Auth
+	}
+	cfg.HTTP.HTTP2 = cfg.HTTP.HTTP2 || p.HTTP.HTTP2
+	cfg.HTTP.Method = orDefault(cfg.HTTP.Method, p.HTTP.Method)
+	cfg.HTTP.Scheme = orDefault(cfg.HTTP.Scheme, p.HTTP.Scheme)
+	cfg.HTTP.Host = orDefault(cfg.HTTP.Host, p.HTTP.Host)
+	cfg.HTTP.Path = orDefault(cfg.HTTP.Path, p.HTTP.Path)
+	cfg.HTTP.Body = orDefault(cfg.HTTP.Body, p.HTTP.Body)
+	if len(p.HTTP.Headers) > 0 {
+		hh := p.HTTP.Headers.Clone()
+		for k, v := range cfg.HTTP.Headers {
+			hh[k] = v
+		}
+		cfg.HTTP.Headers = hh
+	}
+
+	return cfg, nil
+}
+
 func newBenchmarks() *Benchmarks {
 	return &Benchmarks{
 		Defaults: newBenchmark(),
@@ -118,3 +170,11 @@ func (s *Bench) load(path string) error {
 
 	return yaml.Unmarshal(f, &s)
 }
+
+func orDefault(v, def string) string {
+	if v == "" {
+		return def
+	}
+
+	return v
+}
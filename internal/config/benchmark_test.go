@@ -191,3 +191,68 @@ func TestBenchContainerLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchResolve(t *testing.T) {
+	bb := Benchmarks{
+		Profiles: map[string]BenchConfig{
+			"h2": {
+				C: 10,
+				N: 100,
+				HTTP: HTTP{
+					Method:  "POST",
+					Scheme:  "https",
+					Path:    "/api",
+					HTTP2:   true,
+					Headers: http.Header{"Accept": {"application/json"}, "X-Fred": {"blee"}},
+				},
+				Auth: Auth{User: "fred", Password: "blee"},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		cfg BenchConfig
+		e   BenchConfig
+		err string
+	}{
+		"none": {
+			cfg: BenchConfig{C: 1, N: 10},
+			e:   BenchConfig{C: 1, N: 10},
+		},
+		"inherit": {
+			cfg: BenchConfig{Profile: "h2", C: 2, HTTP: HTTP{Path: "/zorg", Headers: http.Header{"Accept": {"text/html"}}}},
+			e: BenchConfig{
+				Profile: "h2",
+				C:       2,
+				N:       100,
+				HTTP: HTTP{
+					Method:  "POST",
+					Scheme:  "https",
+					Path:    "/zorg",
+					HTTP2:   true,
+					Headers: http.Header{"Accept": {"text/html"}, "X-Fred": {"blee"}},
+				},
+				Auth: Auth{User: "fred", Password: "blee"},
+			},
+		},
+		"missing": {
+			cfg: BenchConfig{Profile: "zorg"},
+			e:   BenchConfig{Profile: "zorg"},
+			err: `no benchmark profile named "zorg"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg, err := bb.Resolve(u.cfg)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, u.e, cfg)
+		})
+	}
+	assert.Equal(t, []string{"application/json"}, bb.Profiles["h2"].HTTP.Headers["Accept"])
+}
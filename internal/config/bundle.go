@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// BundleVersion tracks the config bundle format version.
+	BundleVersion = 1
+
+	bundleSkinsDir = "skins"
+)
+
+// Bundle represents a portable K9s setup ie config, skins, aliases,
+// hotkeys, plugins and views. Files are keyed by their path relative to
+// the config dir.
+type Bundle struct {
+	Version   int               `yaml:"version"`
+	CreatedAt time.Time         `yaml:"createdAt"`
+	Files     map[string]string `yaml:"files"`
+}
+
+// NewBundle collects the current K9s setup.
+func NewBundle() (*Bundle, error) {
+	b := Bundle{
+		Version:   BundleVersion,
+		CreatedAt: time.Now().UTC(),
+		Files:     make(map[string]string),
+	}
+	for n, p := range bundleFiles() {
+		bb, err := os.ReadFile(p)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		b.Files[n] = string(bb)
+	}
+
+	return &b, nil
+}
+
+// LoadBundle loads a bundle from file.
+func LoadBundle(path string) (*Bundle, error) {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var b Bundle
+	if err := yaml.Unmarshal(bb, &b); err != nil {
+		return nil, fmt.Errorf("invalid config bundle %q: %w", path, err)
+	}
+	if b.Version != BundleVersion {
+		return nil, fmt.Errorf("unsupported config bundle version %d", b.Version)
+	}
+	for n := range b.Files {
+		if _, err := bundleTarget(n); err != nil {
+			return nil, err
+		}
+	}
+
+	return &b, nil
+}
+
+// Names returns the bundled file names.
+func (b *Bundle) Names() []string {
+	nn := make([]string, 0, len(b.Files))
+	for n := range b.Files {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// Save writes the bundle to file.
+func (b *Bundle) Save(path string) error {
+	return saveYAML(path, b)
+}
+
+// Conflicts returns the bundled files that differ from the local ones.
+func (b *Bundle) Conflicts() []string {
+	var cc []string
+	for _, n := range b.Names() {
+		p, err := bundleTarget(n)
+		if err != nil {
+			continue
+		}
+		bb, err := os.ReadFile(p)
+		if err != nil {
+			continue
+		}
+		if string(bb) != b.Files[n] {
+			cc = append(cc, n)
+		}
+	}
+
+	return cc
+}
+
+// Import installs the bundled files, leaving the kept ones untouched. It
+// returns the number of files written.
+func (b *Bundle) Import(keep map[string]struct{}) (int, error) {
+	var (
+		count int
+		errs  error
+	)
+	for _, n := range b.Names() {
+		if _, ok := keep[n]; ok {
+			continue
+		}
+		p, err := bundleTarget(n)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if bb, err := os.ReadFile(p); err == nil && string(bb) == b.Files[n] {
+			continue
+		}
+		if err := data.EnsureDirPath(p, data.DefaultDirMod); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if err := os.WriteFile(p, []byte(b.Files[n]), data.DefaultFileMod); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		count++
+	}
+
+	return count, errs
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// bundleFiles returns the local files making up a bundle.
+func bundleFiles() map[string]string {
+	ff := map[string]string{
+		data.MainConfigFile: AppConfigFile,
+		"aliases.yaml":      AppAliasesFile,
+		"hotkeys.yaml":      AppHotKeysFile,
+		"plugins.yaml":      AppPluginsFile,
+		"views.yaml":        AppViewsFile,
+	}
+	nn, _ := SkinNames()
+	for _, n := range nn {
+		ff[path.Join(bundleSkinsDir, n+".yaml")] = SkinFileFromName(n)
+	}
+
+	return ff
+}
+
+// bundleTarget returns the local path of a bundled file. Only known config
+// files and skins are allowed.
+func bundleTarget(n string) (string, error) {
+	if p, ok := bundleFiles()[n]; ok {
+		return p, nil
+	}
+	dir, f := path.Split(n)
+	if dir == bundleSkinsDir+"/" && path.Ext(f) == ".yaml" && !strings.HasPrefix(f, ".") && filepath.Base(f) == f {
+		return SkinFileFromName(strings.TrimSuffix(f, ".yaml")), nil
+	}
+
+	return "", fmt.Errorf("invalid config bundle entry %q", n)
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleExportImport(t *testing.T) {
+	setBundleLocs(t, t.TempDir())
+	require.NoError(t, os.WriteFile(config.AppAliasesFile, []byte("aliases:\n  pp: v1/pods\n"), data.DefaultFileMod))
+	require.NoError(t, os.WriteFile(config.AppHotKeysFile, []byte("hotKeys: {}\n"), data.DefaultFileMod))
+	require.NoError(t, os.WriteFile(config.SkinFileFromName("fred"), []byte("k9s: {}\n"), data.DefaultFileMod))
+
+	b, err := config.NewBundle()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"aliases.yaml", "hotkeys.yaml", "skins/fred.yaml"}, b.Names())
+	path := filepath.Join(t.TempDir(), "bundle.yaml")
+	require.NoError(t, b.Save(path))
+
+	// Import on another machine with diverging files.
+	setBundleLocs(t, t.TempDir())
+	require.NoError(t, os.WriteFile(config.AppAliasesFile, []byte("aliases:\n  dp: apps/v1/deployments\n"), data.DefaultFileMod))
+	require.NoError(t, os.WriteFile(config.AppHotKeysFile, []byte("hotKeys: {}\n"), data.DefaultFileMod))
+
+	b, err = config.LoadBundle(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"aliases.yaml"}, b.Conflicts())
+
+	n, err := b.Import(map[string]struct{}{"aliases.yaml": {}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	bb, err := os.ReadFile(config.AppAliasesFile)
+	require.NoError(t, err)
+	assert.Equal(t, "aliases:\n  dp: apps/v1/deployments\n", string(bb))
+	bb, err = os.ReadFile(config.SkinFileFromName("fred"))
+	require.NoError(t, err)
+	assert.Equal(t, "k9s: {}\n", string(bb))
+
+	n, err = b.Import(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Empty(t, b.Conflicts())
+}
+
+func TestLoadBundleInvalid(t *testing.T) {
+	setBundleLocs(t, t.TempDir())
+
+	uu := map[string]struct {
+		bundle string
+	}{
+		"version": {
+			bundle: "version: 2\nfiles: {}\n",
+		},
+		"unknown": {
+			bundle: "version: 1\nfiles:\n  bashrc: fred\n",
+		},
+		"escape": {
+			bundle: "version: 1\nfiles:\n  skins/../../fred.yaml: fred\n",
+		},
+		"nested": {
+			bundle: "version: 1\nfiles:\n  skins/blee/fred.yaml: fred\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "bundle.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(u.bundle), data.DefaultFileMod))
+			_, err := config.LoadBundle(path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// Helpers...
+
+func setBundleLocs(t *testing.T, dir string) {
+	cfgFile, aliases, hotkeys := config.AppConfigFile, config.AppAliasesFile, config.AppHotKeysFile
+	plugins, views, skins := config.AppPluginsFile, config.AppViewsFile, config.AppSkinsDir
+	t.Cleanup(func() {
+		config.AppConfigFile, config.AppAliasesFile, config.AppHotKeysFile = cfgFile, aliases, hotkeys
+		config.AppPluginsFile, config.AppViewsFile, config.AppSkinsDir = plugins, views, skins
+	})
+
+	config.AppConfigFile = filepath.Join(dir, data.MainConfigFile)
+	config.AppAliasesFile = filepath.Join(dir, "aliases.yaml")
+	config.AppHotKeysFile = filepath.Join(dir, "hotkeys.yaml")
+	config.AppPluginsFile = filepath.Join(dir, "plugins.yaml")
+	config.AppViewsFile = filepath.Join(dir, "views.yaml")
+	config.AppSkinsDir = filepath.Join(dir, "skins")
+	require.NoError(t, data.EnsureFullPath(config.AppSkinsDir, data.DefaultDirMod))
+}
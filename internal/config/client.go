@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// Client tracks api server client options. Rate limits are left to client-go
+// unless set.
+type Client struct {
+	QPS              float32 `json:"qps" yaml:"qps,omitempty"`
+	Burst            int     `json:"burst" yaml:"burst,omitempty"`
+	ReleaseInformers bool    `json:"releaseInformers" yaml:"releaseInformers"`
+}
+
+// NewClient returns a new instance.
+func NewClient() Client {
+	return Client{}
+}
+
+// Validate checks rate limits and make sure we're cool. If not use defaults.
+func (c Client) Validate() Client {
+	if c.QPS < 0 {
+		c.QPS = 0
+	}
+	if c.Burst < 0 {
+		c.Burst = 0
+	}
+
+	return c
+}
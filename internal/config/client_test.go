@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientValidate(t *testing.T) {
+	uu := map[string]struct {
+		c, e config.Client
+	}{
+		"empty": {
+			e: config.NewClient(),
+		},
+		"custom": {
+			c: config.Client{QPS: 10, Burst: 20},
+			e: config.Client{QPS: 10, Burst: 20},
+		},
+		"negative": {
+			c: config.Client{QPS: -1, Burst: 20},
+			e: config.Client{Burst: 20},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.c.Validate())
+		})
+	}
+}
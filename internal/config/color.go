@@ -5,6 +5,9 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"strings"
+	"sync"
 
 	"github.com/derailed/tcell/v2"
 )
@@ -17,6 +20,75 @@ const (
 	TransparentColor Color = "-"
 )
 
+// ColorDepth represents the number of colors supported by a terminal.
+type ColorDepth int
+
+const (
+	// ColorDepth16 represents a basic ANSI terminal.
+	ColorDepth16 ColorDepth = 16
+
+	// ColorDepth256 represents a 256 colors terminal.
+	ColorDepth256 ColorDepth = 256
+
+	// ColorDepthTrue represents a 24-bit colors terminal.
+	ColorDepthTrue ColorDepth = 1 << 24
+)
+
+var (
+	colorDepth = ColorDepthTrue
+	fitColors  sync.Map
+)
+
+// SetColorDepth sets the color depth skin colors get degraded to.
+func SetColorDepth(d ColorDepth) {
+	if d == colorDepth {
+		return
+	}
+	colorDepth = d
+	fitColors = sync.Map{}
+}
+
+// DetectColorDepth returns the terminal color depth based on its environment.
+func DetectColorDepth() ColorDepth {
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ColorDepthTrue
+	}
+	term := os.Getenv("TERM")
+	switch {
+	case term == "", strings.Contains(term, "truecolor"), strings.Contains(term, "direct"):
+		return ColorDepthTrue
+	case strings.Contains(term, "256color"):
+		return ColorDepth256
+	default:
+		return ColorDepth16
+	}
+}
+
+// palette returns the terminal palette for a given color depth.
+func (d ColorDepth) palette() []tcell.Color {
+	cc := make([]tcell.Color, 0, d)
+	for i := 0; i < int(d); i++ {
+		cc = append(cc, tcell.PaletteColor(i))
+	}
+
+	return cc
+}
+
+// fit returns the closest color supported by the current color depth.
+func fit(c tcell.Color) tcell.Color {
+	if colorDepth >= ColorDepthTrue || !c.Valid() {
+		return c
+	}
+	if f, ok := fitColors.Load(c); ok {
+		return f.(tcell.Color)
+	}
+	f := tcell.FindColor(c, colorDepth.palette())
+	fitColors.Store(c, f)
+
+	return f
+}
+
 // Colors tracks multiple colors.
 type Colors []Color
 
@@ -40,7 +112,7 @@ func NewColor(c string) Color {
 
 // String returns color as string.
 func (c Color) String() string {
-	if c.isHex() {
+	if c.isHex() && colorDepth >= ColorDepthTrue {
 		return string(c)
 	}
 	if c == DefaultColor {
@@ -64,5 +136,5 @@ func (c Color) Color() tcell.Color {
 		return tcell.ColorDefault
 	}
 
-	return tcell.GetColor(string(c)).TrueColor()
+	return fit(tcell.GetColor(string(c)).TrueColor())
 }
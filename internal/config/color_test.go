@@ -117,3 +117,92 @@ func TestColorToColor(t *testing.T) {
 		})
 	}
 }
+
+func TestColorDepth(t *testing.T) {
+	uu := map[string]struct {
+		setting, colorTerm, term string
+		e                        config.ColorDepth
+	}{
+		"colorterm": {
+			colorTerm: "truecolor",
+			term:      "xterm",
+			e:         config.ColorDepthTrue,
+		},
+		"256": {
+			term: "screen-256color",
+			e:    config.ColorDepth256,
+		},
+		"16": {
+			term: "xterm",
+			e:    config.ColorDepth16,
+		},
+		"no-term": {
+			e: config.ColorDepthTrue,
+		},
+		"setting": {
+			setting:   "256",
+			colorTerm: "truecolor",
+			e:         config.ColorDepth256,
+		},
+		"setting-true": {
+			setting: "truecolor",
+			term:    "xterm",
+			e:       config.ColorDepthTrue,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			t.Setenv("COLORTERM", u.colorTerm)
+			t.Setenv("TERM", u.term)
+			ui := config.UI{ColorDepth: u.setting}
+			assert.Equal(t, u.e, ui.GetColorDepth())
+		})
+	}
+}
+
+func TestColorFit(t *testing.T) {
+	defer config.SetColorDepth(config.ColorDepthTrue)
+
+	uu := map[string]struct {
+		depth config.ColorDepth
+		c     string
+		e     tcell.Color
+		s     string
+	}{
+		"true": {
+			depth: config.ColorDepthTrue,
+			c:     "#ff0001",
+			e:     tcell.NewHexColor(0xff0001),
+			s:     "#ff0001",
+		},
+		"256": {
+			depth: config.ColorDepth256,
+			c:     "#ff0001",
+			e:     tcell.ColorRed,
+			s:     "#ff0000",
+		},
+		"16": {
+			depth: config.ColorDepth16,
+			c:     "#0000d0",
+			e:     tcell.ColorBlue,
+			s:     "#0000ff",
+		},
+		"default": {
+			depth: config.ColorDepth16,
+			c:     "default",
+			e:     tcell.ColorDefault,
+			s:     "-",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			config.SetColorDepth(u.depth)
+			assert.Equal(t, u.e, config.NewColor(u.c).Color())
+			assert.Equal(t, u.s, config.NewColor(u.c).String())
+		})
+	}
+}
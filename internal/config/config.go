@@ -42,6 +42,26 @@ func (c *Config) ContextHotkeysPath() string {
 	return AppContextHotkeysFile(ct.ClusterName, c.K9s.activeContextName)
 }
 
+// ContextPulsesPath returns a context specific pulses file spec.
+func (c *Config) ContextPulsesPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextPulsesFile(ct.GetClusterName(), c.K9s.activeContextName)
+}
+
+// ContextNotificationsPath returns a context specific notifications file spec.
+func (c *Config) ContextNotificationsPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextNotificationsFile(ct.GetClusterName(), c.K9s.activeContextName)
+}
+
 // ContextAliasesPath returns a context specific aliases file spec.
 func (c *Config) ContextAliasesPath() string {
 	ct, err := c.K9s.ActiveContext()
@@ -159,7 +179,30 @@ func (c *Config) SetActiveNamespace(ns string) error {
 		return err
 	}
 
-	return ct.Namespace.SetActive(ns, c.settings)
+	prev := ct.Namespace.Active
+	if err := ct.Namespace.SetActive(ns, c.settings); err != nil {
+		return err
+	}
+	if ct.Namespace.Active != prev {
+		if err := c.K9s.saveNamespaceUsage(ct.Namespace.UsageCounts()); err != nil {
+			log.Warn().Err(err).Msgf("Unable to save namespace usage")
+		}
+	}
+	if !c.K9s.Favorites.DisableLearning {
+		ct.Namespace.LearnFavorites(c.K9s.Favorites.MaxLearned)
+	}
+
+	return nil
+}
+
+// NamespaceUsage returns namespaces access counts in the current context.
+func (c *Config) NamespaceUsage() map[string]int {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil || c.K9s.Favorites.DisableLearning {
+		return nil
+	}
+
+	return ct.Namespace.UsageCounts()
 }
 
 // ActiveView returns the active view in the current context.
@@ -20,6 +20,8 @@ type Context struct {
 	View               *View        `yaml:"view"`
 	FeatureGates       FeatureGates `yaml:"featureGates"`
 	PortForwardAddress string       `yaml:"portForwardAddress"`
+	Prometheus         *Prometheus  `yaml:"prometheus,omitempty"`
+	Session            *Session     `yaml:"session,omitempty"` // Legacy, migrated to the context state.
 	mx                 sync.RWMutex
 }
 
@@ -4,6 +4,7 @@
 package data
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/derailed/k9s/internal/client"
@@ -17,9 +18,10 @@ const (
 
 // Namespace tracks active and favorites namespaces.
 type Namespace struct {
-	Active        string   `yaml:"active"`
-	LockFavorites bool     `yaml:"lockFavorites"`
-	Favorites     []string `yaml:"favorites"`
+	Active        string         `yaml:"active"`
+	LockFavorites bool           `yaml:"lockFavorites"`
+	Favorites     []string       `yaml:"favorites"`
+	Usage         map[string]int `yaml:"-"`
 	mx            sync.RWMutex
 }
 
@@ -68,6 +70,11 @@ func (n *Namespace) Validate(c client.Connection) {
 			n.rmFavNS(ns)
 		}
 	}
+	for ns := range n.Usage {
+		if !c.IsValidNamespace(ns) {
+			delete(n.Usage, ns)
+		}
+	}
 }
 
 // SetActive set the active namespace.
@@ -82,6 +89,9 @@ func (n *Namespace) SetActive(ns string, ks KubeSettings) error {
 	if ns == client.BlankNamespace {
 		ns = client.NamespaceAll
 	}
+	if ns != n.Active {
+		n.recordUsage(ns)
+	}
 	n.Active = ns
 
 	if ns != "" && !n.LockFavorites {
@@ -91,6 +101,79 @@ func (n *Namespace) SetActive(ns string, ks KubeSettings) error {
 	return nil
 }
 
+// UsageCounts returns the number of times each namespace was switched to.
+func (n *Namespace) UsageCounts() map[string]int {
+	n.mx.RLock()
+	defer n.mx.RUnlock()
+
+	cc := make(map[string]int, len(n.Usage))
+	for ns, c := range n.Usage {
+		cc[ns] = c
+	}
+
+	return cc
+}
+
+// SetUsage restores the namespaces usage counts.
+func (n *Namespace) SetUsage(uu map[string]int) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	n.Usage = make(map[string]int, len(uu))
+	for ns, c := range uu {
+		n.Usage[ns] = c
+	}
+}
+
+// LearnFavorites promotes the most used namespaces to the head of the favorites.
+func (n *Namespace) LearnFavorites(max int) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	if n.LockFavorites || max <= 0 {
+		return
+	}
+	nfv := make([]string, 0, MaxFavoritesNS)
+	for _, ns := range n.mostUsed(max) {
+		nfv = append(nfv, ns)
+	}
+	for _, ns := range n.Favorites {
+		if len(nfv) >= MaxFavoritesNS {
+			break
+		}
+		if !InList(nfv, ns) {
+			nfv = append(nfv, ns)
+		}
+	}
+	n.Favorites = nfv
+}
+
+// mostUsed returns up to max namespaces ordered by usage.
+func (n *Namespace) mostUsed(max int) []string {
+	nn := make([]string, 0, len(n.Usage))
+	for ns := range n.Usage {
+		nn = append(nn, ns)
+	}
+	sort.Slice(nn, func(i, j int) bool {
+		if n.Usage[nn[i]] != n.Usage[nn[j]] {
+			return n.Usage[nn[i]] > n.Usage[nn[j]]
+		}
+		return nn[i] < nn[j]
+	})
+	if len(nn) > max {
+		nn = nn[:max]
+	}
+
+	return nn
+}
+
+func (n *Namespace) recordUsage(ns string) {
+	if n.Usage == nil {
+		n.Usage = make(map[string]int)
+	}
+	n.Usage[ns]++
+}
+
 func (n *Namespace) isAllNamespaces() bool {
 	return n.Active == client.NamespaceAll || n.Active == ""
 }
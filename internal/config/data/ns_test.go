@@ -65,3 +65,53 @@ func TestNSValidateRmFavs(t *testing.T) {
 
 	assert.Equal(t, []string{"default", "fred"}, ns.Favorites)
 }
+
+func TestNSLearnFavorites(t *testing.T) {
+	uu := map[string]struct {
+		switches []string
+		max      int
+		lock     bool
+		fav      []string
+	}{
+		"most-used": {
+			switches: []string{"ns1", "ns2", "ns1", "ns3", "ns1", "ns2"},
+			max:      2,
+			fav:      []string{"ns1", "ns2", "ns3", "default"},
+		},
+		"ties": {
+			switches: []string{"ns2", "ns1"},
+			max:      1,
+			fav:      []string{"ns1", "ns2", "default"},
+		},
+		"locked": {
+			switches: []string{"ns1", "ns2", "ns1"},
+			max:      2,
+			lock:     true,
+			fav:      []string{"default"},
+		},
+	}
+
+	mk := mock.NewMockKubeSettings(makeFlags("cl-1", "ct-1"))
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ns := data.NewNamespace()
+			ns.LockFavorites = u.lock
+			for _, s := range u.switches {
+				assert.NoError(t, ns.SetActive(s, mk))
+			}
+			ns.LearnFavorites(u.max)
+			assert.Equal(t, u.fav, ns.Favorites)
+		})
+	}
+}
+
+func TestNSUsageCounts(t *testing.T) {
+	mk := mock.NewMockKubeSettings(makeFlags("cl-1", "ct-1"))
+	ns := data.NewNamespace()
+	for _, s := range []string{"ns1", "ns1", "ns2", "ns1"} {
+		assert.NoError(t, ns.SetActive(s, mk))
+	}
+
+	assert.Equal(t, map[string]int{"ns1": 2, "ns2": 1}, ns.UsageCounts())
+}
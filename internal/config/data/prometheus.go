@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultPromRange = 30 * time.Minute
+	defaultPromStep  = 30 * time.Second
+)
+
+// Prometheus tracks a context prometheus datasource.
+type Prometheus struct {
+	URL                string `yaml:"url"`
+	Range              string `yaml:"range,omitempty"`
+	Step               string `yaml:"step,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify,omitempty"`
+}
+
+// IsSet checks if a datasource is configured.
+func (p *Prometheus) IsSet() bool {
+	return p != nil && p.URL != ""
+}
+
+// QueryRange returns the time-series window to query.
+func (p *Prometheus) QueryRange() time.Duration {
+	return parseDuration(p.Range, defaultPromRange)
+}
+
+// QueryStep returns the time-series resolution.
+func (p *Prometheus) QueryStep() time.Duration {
+	return parseDuration(p.Step, defaultPromStep)
+}
+
+func parseDuration(s string, dflt time.Duration) time.Duration {
+	if s == "" {
+		return dflt
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		log.Warn().Err(err).Msgf("Invalid prometheus duration %q. Using default %s", s, dflt)
+		return dflt
+	}
+
+	return d
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+import "strings"
+
+// Session tracks the workspace saved on exit.
+type Session struct {
+	Namespace string        `yaml:"namespace,omitempty"`
+	Views     []SessionView `yaml:"views"`
+	Split     *SessionSplit `yaml:"split,omitempty"`
+}
+
+// SessionSplit tracks the views of a secondary pane.
+type SessionSplit struct {
+	Vertical bool          `yaml:"vertical,omitempty"`
+	Views    []SessionView `yaml:"views"`
+}
+
+// SessionView tracks a saved view.
+type SessionView struct {
+	Command    string `yaml:"command"`
+	Filter     string `yaml:"filter,omitempty"`
+	SortColumn string `yaml:"sortColumn,omitempty"`
+	SortAsc    bool   `yaml:"sortAsc,omitempty"`
+}
+
+// IsEmpty returns true if no views were saved.
+func (s *Session) IsEmpty() bool {
+	return s == nil || len(s.Views) == 0
+}
+
+// IsCustomized returns true if the session carries more than the active view.
+func (s *Session) IsCustomized() bool {
+	if s.IsEmpty() {
+		return false
+	}
+	if len(s.Views) > 1 || s.Split != nil {
+		return true
+	}
+
+	return s.Views[0].Filter != "" || s.Views[0].SortColumn != ""
+}
+
+// String returns the session view stack.
+func (s *Session) String() string {
+	if s.IsEmpty() {
+		return ""
+	}
+	if s.Split == nil {
+		return viewsString(s.Views)
+	}
+
+	return viewsString(s.Views) + " | " + viewsString(s.Split.Views)
+}
+
+func viewsString(vv []SessionView) string {
+	cc := make([]string, 0, len(vv))
+	for _, v := range vv {
+		c := v.Command
+		if v.Filter != "" {
+			c += " /" + v.Filter
+		}
+		cc = append(cc, c)
+	}
+
+	return strings.Join(cc, " > ")
+}
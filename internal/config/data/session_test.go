@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionIsCustomized(t *testing.T) {
+	uu := map[string]struct {
+		s *data.Session
+		e bool
+	}{
+		"nil": {},
+		"empty": {
+			s: &data.Session{Namespace: "fred"},
+		},
+		"plain": {
+			s: &data.Session{Views: []data.SessionView{{Command: "v1/pods"}}},
+		},
+		"filter": {
+			s: &data.Session{Views: []data.SessionView{{Command: "v1/pods", Filter: "fred"}}},
+			e: true,
+		},
+		"sort": {
+			s: &data.Session{Views: []data.SessionView{{Command: "v1/pods", SortColumn: "AGE"}}},
+			e: true,
+		},
+		"split": {
+			s: &data.Session{
+				Views: []data.SessionView{{Command: "v1/pods"}},
+				Split: &data.SessionSplit{Views: []data.SessionView{{Command: "v1/services"}}},
+			},
+			e: true,
+		},
+		"stack": {
+			s: &data.Session{Views: []data.SessionView{{Command: "v1/pods"}, {Command: "v1/services"}}},
+			e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.s.IsCustomized())
+		})
+	}
+}
+
+func TestSessionString(t *testing.T) {
+	s := data.Session{
+		Views: []data.SessionView{
+			{Command: "v1/pods", Filter: "fred"},
+			{Command: "v1/services"},
+		},
+	}
+
+	assert.Equal(t, "v1/pods /fred > v1/services", s.String())
+	s.Split = &data.SessionSplit{Views: []data.SessionView{{Command: "v1/events"}}}
+	assert.Equal(t, "v1/pods /fred > v1/services | v1/events", s.String())
+	assert.Equal(t, "", (*data.Session)(nil).String())
+}
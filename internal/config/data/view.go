@@ -7,7 +7,8 @@ const DefaultView = "po"
 
 // View tracks view configuration options.
 type View struct {
-	Active string `yaml:"active"`
+	Active string            `yaml:"active"`
+	Sorts  map[string]string `yaml:"sorts,omitempty"`
 }
 
 // NewView creates a new view configuration.
@@ -21,3 +22,16 @@ func (v *View) Validate() {
 		v.Active = DefaultView
 	}
 }
+
+// SortFor returns the sort spec saved for a given resource view if any.
+func (v *View) SortFor(gvr string) string {
+	return v.Sorts[gvr]
+}
+
+// SetSort saves the sort spec of a given resource view.
+func (v *View) SetSort(gvr, spec string) {
+	if v.Sorts == nil {
+		v.Sorts = make(map[string]string)
+	}
+	v.Sorts[gvr] = spec
+}
@@ -26,3 +26,12 @@ func TestViewValidateBlank(t *testing.T) {
 	v.Validate()
 	assert.Equal(t, "po", v.Active)
 }
+
+func TestViewSorts(t *testing.T) {
+	var v data.View
+	assert.Equal(t, "", v.SortFor("v1/pods"))
+
+	v.SetSort("v1/pods", "STATUS:asc,NAME:asc")
+	assert.Equal(t, "STATUS:asc,NAME:asc", v.SortFor("v1/pods"))
+	assert.Equal(t, "", v.SortFor("v1/services"))
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "github.com/derailed/k9s/internal/config/data"
+
+const defaultMaxLearnedNS = 5
+
+// Favorites tracks favorite namespaces learning options.
+type Favorites struct {
+	DisableLearning bool `json:"disableLearning" yaml:"disableLearning"`
+	MaxLearned      int  `json:"maxLearned" yaml:"maxLearned"`
+}
+
+// NewFavorites returns a new instance.
+func NewFavorites() Favorites {
+	return Favorites{
+		MaxLearned: defaultMaxLearnedNS,
+	}
+}
+
+// Validate checks the learning cap and make sure we're cool. If not use defaults.
+func (f Favorites) Validate() Favorites {
+	if f.MaxLearned <= 0 {
+		f.MaxLearned = defaultMaxLearnedNS
+	}
+	if f.MaxLearned > data.MaxFavoritesNS {
+		f.MaxLearned = data.MaxFavoritesNS
+	}
+
+	return f
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFavoritesValidate(t *testing.T) {
+	uu := map[string]struct {
+		f, e config.Favorites
+	}{
+		"empty": {
+			e: config.NewFavorites(),
+		},
+		"custom": {
+			f: config.Favorites{DisableLearning: true, MaxLearned: 3},
+			e: config.Favorites{DisableLearning: true, MaxLearned: 3},
+		},
+		"toast": {
+			f: config.Favorites{MaxLearned: 20},
+			e: config.Favorites{MaxLearned: 9},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.f.Validate())
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/derailed/k9s/internal/config/data"
 
@@ -45,6 +46,14 @@ var (
 	//go:embed templates/stock-skin.yaml
 	// stockSkinTpl tracks stock skin template
 	stockSkinTpl []byte
+
+	//go:embed templates/palettes/high-contrast.yaml
+	// highContrastTpl tracks the high contrast palette template
+	highContrastTpl []byte
+
+	//go:embed templates/palettes/colorblind.yaml
+	// colorblindTpl tracks the colorblind safe palette template
+	colorblindTpl []byte
 )
 
 var (
@@ -54,6 +63,9 @@ var (
 	// AppSkinsDir tracks skins data directory.
 	AppSkinsDir string
 
+	// AppTemplatesDir tracks user resource templates directory.
+	AppTemplatesDir string
+
 	// AppBenchmarksDir tracks benchmarks results directory.
 	AppBenchmarksDir string
 
@@ -63,6 +75,12 @@ var (
 	// AppContextsDir tracks contexts data directory.
 	AppContextsDir string
 
+	// AppStateDir tracks runtime state directory ie sessions and history.
+	AppStateDir string
+
+	// AppHistoryFile tracks commands and filters history file.
+	AppHistoryFile string
+
 	// AppConfigFile tracks k9s config file.
 	AppConfigFile string
 
@@ -80,6 +98,21 @@ var (
 
 	// AppHotKeysFile tracks hotkeys config file.
 	AppHotKeysFile string
+
+	// AppKeyMapFile tracks key bindings config file.
+	AppKeyMapFile string
+
+	// AppMacrosFile tracks macros config file.
+	AppMacrosFile string
+
+	// AppFiltersFile tracks saved filters config file.
+	AppFiltersFile string
+
+	// AppPulsesFile tracks pulses config file.
+	AppPulsesFile string
+
+	// AppNotificationsFile tracks notifications config file.
+	AppNotificationsFile string
 )
 
 // InitLogLoc initializes K9s logs location.
@@ -136,13 +169,25 @@ func initK9sEnvLocs() error {
 	if err := data.EnsureFullPath(AppSkinsDir, data.DefaultDirMod); err != nil {
 		log.Warn().Err(err).Msgf("Unable to create skins dir: %s", AppSkinsDir)
 	}
+	AppTemplatesDir = filepath.Join(AppConfigDir, "templates")
 	AppContextsDir = filepath.Join(AppConfigDir, "clusters")
 	if err := data.EnsureFullPath(AppContextsDir, data.DefaultDirMod); err != nil {
 		log.Warn().Err(err).Msgf("Unable to create clusters dir: %s", AppContextsDir)
 	}
 
+	AppStateDir = filepath.Join(AppConfigDir, "state")
+	if err := data.EnsureFullPath(AppStateDir, data.DefaultDirMod); err != nil {
+		log.Warn().Err(err).Msgf("Unable to create state dir: %s", AppStateDir)
+	}
+	AppHistoryFile = filepath.Join(AppStateDir, "history.yaml")
+
 	AppConfigFile = filepath.Join(AppConfigDir, data.MainConfigFile)
 	AppHotKeysFile = filepath.Join(AppConfigDir, "hotkeys.yaml")
+	AppKeyMapFile = filepath.Join(AppConfigDir, "keymap.yaml")
+	AppMacrosFile = filepath.Join(AppConfigDir, "macros.yaml")
+	AppFiltersFile = filepath.Join(AppConfigDir, "filters.yaml")
+	AppPulsesFile = filepath.Join(AppConfigDir, "pulses.yaml")
+	AppNotificationsFile = filepath.Join(AppConfigDir, "notifications.yaml")
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
@@ -164,6 +209,11 @@ func initXDGLocs() error {
 	}
 
 	AppHotKeysFile = filepath.Join(AppConfigDir, "hotkeys.yaml")
+	AppKeyMapFile = filepath.Join(AppConfigDir, "keymap.yaml")
+	AppMacrosFile = filepath.Join(AppConfigDir, "macros.yaml")
+	AppFiltersFile = filepath.Join(AppConfigDir, "filters.yaml")
+	AppPulsesFile = filepath.Join(AppConfigDir, "pulses.yaml")
+	AppNotificationsFile = filepath.Join(AppConfigDir, "notifications.yaml")
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
@@ -172,6 +222,7 @@ func initXDGLocs() error {
 	if err := data.EnsureFullPath(AppSkinsDir, data.DefaultDirMod); err != nil {
 		log.Warn().Err(err).Msgf("No skins dir detected")
 	}
+	AppTemplatesDir = filepath.Join(AppConfigDir, "templates")
 
 	AppDumpsDir, err = xdg.StateFile(filepath.Join(AppName, "screen-dumps"))
 	if err != nil {
@@ -183,6 +234,15 @@ func initXDGLocs() error {
 		log.Warn().Err(err).Msgf("No benchmarks dir detected")
 	}
 
+	AppStateDir, err = xdg.StateFile(AppName)
+	if err != nil {
+		return err
+	}
+	if err := data.EnsureFullPath(AppStateDir, data.DefaultDirMod); err != nil {
+		log.Warn().Err(err).Msgf("No state dir detected")
+	}
+	AppHistoryFile = filepath.Join(AppStateDir, "history.yaml")
+
 	dataDir, err := xdg.DataFile(AppName)
 	if err != nil {
 		return err
@@ -215,6 +275,31 @@ func AppContextHotkeysFile(cluster, context string) string {
 	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "hotkeys.yaml")
 }
 
+// AppContextPulsesFile generates a valid context specific pulses file path.
+func AppContextPulsesFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "pulses.yaml")
+}
+
+// AppContextNotificationsFile generates a valid context specific notifications file path.
+func AppContextNotificationsFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "notifications.yaml")
+}
+
+// AppCrashesDir returns the crash reports dir.
+func AppCrashesDir() string {
+	return filepath.Join(AppStateDir, "crashes")
+}
+
+// AppContextStateDir generates a valid context runtime state dir.
+func AppContextStateDir(cluster, context string) string {
+	return filepath.Join(AppStateDir, "clusters", data.SanitizeContextSubpath(cluster, context))
+}
+
+// AppContextStateFile generates a valid context runtime state file path.
+func AppContextStateFile(cluster, context string) string {
+	return filepath.Join(AppContextStateDir(cluster, context), StateFile)
+}
+
 // AppContextConfig generates a valid context config file path.
 func AppContextConfig(cluster, context string) string {
 	return filepath.Join(AppContextDir(cluster, context), data.MainConfigFile)
@@ -281,3 +366,20 @@ func SkinFileFromName(n string) string {
 
 	return filepath.Join(AppSkinsDir, n+".yaml")
 }
+
+// SkinNames returns the names of all skins in the skins dir.
+func SkinNames() ([]string, error) {
+	ee, err := os.ReadDir(AppSkinsDir)
+	if err != nil {
+		return nil, err
+	}
+	nn := make([]string, 0, len(ee))
+	for _, e := range ee {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".yaml" {
+			continue
+		}
+		nn = append(nn, strings.TrimSuffix(e.Name(), ".yaml"))
+	}
+
+	return nn, nil
+}
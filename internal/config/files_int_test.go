@@ -38,6 +38,9 @@ func Test_initXDGLocs(t *testing.T) {
 		dumpsDir           string
 		benchDir           string
 		hkFile             string
+		stateDir           string
+		contextStateFile   string
+		historyFile        string
 	}{
 		"check-env": {
 			configDir:          filepath.Join(tmp, "k9s-xdg", "config", "k9s"),
@@ -49,6 +52,9 @@ func Test_initXDGLocs(t *testing.T) {
 			dumpsDir:           filepath.Join(tmp, "k9s-xdg", "state", "k9s", "screen-dumps", "cl-1", "ct-1-1"),
 			benchDir:           filepath.Join(tmp, "k9s-xdg", "state", "k9s", "benchmarks", "cl-1", "ct-1-1"),
 			hkFile:             filepath.Join(tmp, "k9s-xdg", "config", "k9s", "hotkeys.yaml"),
+			stateDir:           filepath.Join(tmp, "k9s-xdg", "state", "k9s"),
+			contextStateFile:   filepath.Join(tmp, "k9s-xdg", "state", "k9s", "clusters", "cl-1", "ct-1-1", StateFile),
+			historyFile:        filepath.Join(tmp, "k9s-xdg", "state", "k9s", "history.yaml"),
 		},
 	}
 
@@ -71,6 +77,9 @@ func Test_initXDGLocs(t *testing.T) {
 			hk, err := EnsureHotkeysCfgFile()
 			assert.NoError(t, err)
 			assert.Equal(t, u.hkFile, hk)
+			assert.Equal(t, u.stateDir, AppStateDir)
+			assert.Equal(t, u.contextStateFile, AppContextStateFile("cl-1", "ct-1-1"))
+			assert.Equal(t, u.historyFile, AppHistoryFile)
 		})
 	}
 }
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+// Filters represents named filters saved per view.
+type Filters struct {
+	Views map[string]ViewFilters `yaml:"filters"`
+}
+
+// ViewFilters tracks a view filters by name.
+type ViewFilters map[string]string
+
+// Names returns the sorted filter names.
+func (v ViewFilters) Names() []string {
+	nn := make([]string, 0, len(v))
+	for n := range v {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// NewFilters returns a new filters collection.
+func NewFilters() Filters {
+	return Filters{
+		Views: make(map[string]ViewFilters),
+	}
+}
+
+// Load loads filters from a given file.
+func (f Filters) Load(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.FiltersSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var ff Filters
+	if err := yaml.Unmarshal(bb, &ff); err != nil {
+		return err
+	}
+	for view, vf := range ff.Views {
+		for name, filter := range vf {
+			f.Add(view, name, filter)
+		}
+	}
+
+	return nil
+}
+
+// Save saves filters to a given file.
+func (f Filters) Save(path string) error {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	bb, err := yaml.Marshal(f)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bb, data.DefaultFileMod)
+}
+
+// For returns the filters of a given view.
+func (f Filters) For(view string) ViewFilters {
+	return f.Views[view]
+}
+
+// Get returns a view filter by name.
+func (f Filters) Get(view, name string) (string, error) {
+	filter, ok := f.Views[view][name]
+	if !ok {
+		return "", fmt.Errorf("no filter named %q for %s", name, view)
+	}
+
+	return filter, nil
+}
+
+// Add adds or replaces a named view filter.
+func (f Filters) Add(view, name, filter string) {
+	if _, ok := f.Views[view]; !ok {
+		f.Views[view] = make(ViewFilters)
+	}
+	f.Views[view][name] = filter
+}
+
+// Delete removes a named view filter.
+func (f Filters) Delete(view, name string) error {
+	if _, ok := f.Views[view][name]; !ok {
+		return fmt.Errorf("no filter named %q for %s", name, view)
+	}
+	delete(f.Views[view], name)
+	if len(f.Views[view]) == 0 {
+		delete(f.Views, view)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFiltersLoad(t *testing.T) {
+	f := config.NewFilters()
+	assert.NoError(t, f.Load("testdata/filters/filters.yaml"))
+	assert.Equal(t, 2, len(f.Views))
+	assert.Equal(t, []string{"crashing", "fred"}, f.For("v1/pods").Names())
+
+	filter, err := f.Get("v1/pods", "fred")
+	assert.NoError(t, err)
+	assert.Equal(t, "app=fred", filter)
+	_, err = f.Get("v1/pods", "zorg")
+	assert.Equal(t, `no filter named "zorg" for v1/pods`, err.Error())
+	assert.Empty(t, f.For("v1/services").Names())
+}
+
+func TestFiltersLoadToast(t *testing.T) {
+	f := config.NewFilters()
+	assert.Error(t, f.Load("testdata/filters/toast.yaml"))
+	assert.NoError(t, f.Load("testdata/filters/missing.yaml"))
+}
+
+func TestFiltersSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "filters.yaml")
+
+	f := config.NewFilters()
+	f.Add("v1/pods", "fred", "app=fred")
+	f.Add("v1/pods", "blee", "/blee")
+	assert.NoError(t, f.Delete("v1/pods", "blee"))
+	assert.Error(t, f.Delete("v1/pods", "blee"))
+	assert.NoError(t, f.Save(path))
+
+	f1 := config.NewFilters()
+	assert.NoError(t, f1.Load(path))
+	assert.Equal(t, f.Views, f1.Views)
+
+	assert.NoError(t, f1.Delete("v1/pods", "fred"))
+	assert.Empty(t, f1.Views)
+}
@@ -12,6 +12,9 @@ const (
 
 	// DefaultCommand represents the default command to run.
 	DefaultCommand = ""
+
+	// DefaultReplaySpeed represents the default recordings replay speed.
+	DefaultReplaySpeed = 1.0
 )
 
 // Flags represents K9s configuration flags.
@@ -27,6 +30,11 @@ type Flags struct {
 	Write         *bool
 	Crumbsless    *bool
 	ScreenDumpDir *string
+	Snapshot      *string
+	Replay        *string
+	ReplaySpeed   *float64
+	DebugListen   *string
+	RemoteSocket  *string
 }
 
 // NewFlags returns new configuration flags.
@@ -43,6 +51,11 @@ func NewFlags() *Flags {
 		Write:         boolPtr(false),
 		Crumbsless:    boolPtr(false),
 		ScreenDumpDir: strPtr(AppDumpsDir),
+		Snapshot:      strPtr(""),
+		Replay:        strPtr(""),
+		ReplaySpeed:   floatPtr(DefaultReplaySpeed),
+		DebugListen:   strPtr(""),
+		RemoteSocket:  strPtr(""),
 	}
 }
 
@@ -54,6 +67,10 @@ func intPtr(i int) *int {
 	return &i
 }
 
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
 func strPtr(s string) *string {
 	return &s
 }
@@ -26,4 +26,7 @@ func TestNewFlags(t *testing.T) {
 	assert.False(t, *f.ReadOnly)
 	assert.False(t, *f.Write)
 	assert.False(t, *f.Crumbsless)
+	assert.Empty(t, *f.Snapshot)
+	assert.Empty(t, *f.Replay)
+	assert.Equal(t, 1.0, *f.ReplaySpeed)
 }
@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// Fleet tracks the contexts summarized side by side in the fleet view.
+type Fleet struct {
+	Contexts []string `json:"contexts" yaml:"contexts"`
+}
@@ -25,6 +25,7 @@ type HotKey struct {
 	Override    bool   `yaml:"override"`
 	Description string `yaml:"description"`
 	Command     string `yaml:"command"`
+	Macro       string `yaml:"macro"`
 	KeepHistory bool   `yaml:"keepHistory"`
 }
 
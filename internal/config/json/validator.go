@@ -28,6 +28,21 @@ const (
 	// HotkeysSchema describes hotkeys schema.
 	HotkeysSchema = "hotkeys.json"
 
+	// KeyMapSchema describes keymap schema.
+	KeyMapSchema = "keymap.json"
+
+	// MacrosSchema describes macros schema.
+	MacrosSchema = "macros.json"
+
+	// FiltersSchema describes saved filters schema.
+	FiltersSchema = "filters.json"
+
+	// PulsesSchema describes pulses schema.
+	PulsesSchema = "pulses.json"
+
+	// NotificationsSchema describes notifications schema.
+	NotificationsSchema = "notifications.json"
+
 	// K9sSchema describes k9s config schema.
 	K9sSchema = "k9s.json"
 
@@ -57,6 +72,21 @@ var (
 	//go:embed schemas/hotkeys.json
 	hotkeysSchema string
 
+	//go:embed schemas/keymap.json
+	keyMapSchema string
+
+	//go:embed schemas/macros.json
+	macrosSchema string
+
+	//go:embed schemas/filters.json
+	filtersSchema string
+
+	//go:embed schemas/pulses.json
+	pulsesSchema string
+
+	//go:embed schemas/notifications.json
+	notificationsSchema string
+
 	//go:embed schemas/skin.json
 	skinSchema string
 )
@@ -71,13 +101,18 @@ type Validator struct {
 func NewValidator() *Validator {
 	v := Validator{
 		schemas: map[string]gojsonschema.JSONLoader{
-			K9sSchema:     gojsonschema.NewStringLoader(k9sSchema),
-			ContextSchema: gojsonschema.NewStringLoader(contextSchema),
-			AliasesSchema: gojsonschema.NewStringLoader(aliasSchema),
-			ViewsSchema:   gojsonschema.NewStringLoader(viewsSchema),
-			PluginsSchema: gojsonschema.NewStringLoader(pluginSchema),
-			HotkeysSchema: gojsonschema.NewStringLoader(hotkeysSchema),
-			SkinSchema:    gojsonschema.NewStringLoader(skinSchema),
+			K9sSchema:           gojsonschema.NewStringLoader(k9sSchema),
+			ContextSchema:       gojsonschema.NewStringLoader(contextSchema),
+			AliasesSchema:       gojsonschema.NewStringLoader(aliasSchema),
+			ViewsSchema:         gojsonschema.NewStringLoader(viewsSchema),
+			PluginsSchema:       gojsonschema.NewStringLoader(pluginSchema),
+			HotkeysSchema:       gojsonschema.NewStringLoader(hotkeysSchema),
+			KeyMapSchema:        gojsonschema.NewStringLoader(keyMapSchema),
+			MacrosSchema:        gojsonschema.NewStringLoader(macrosSchema),
+			FiltersSchema:       gojsonschema.NewStringLoader(filtersSchema),
+			PulsesSchema:        gojsonschema.NewStringLoader(pulsesSchema),
+			NotificationsSchema: gojsonschema.NewStringLoader(notificationsSchema),
+			SkinSchema:          gojsonschema.NewStringLoader(skinSchema),
 		},
 	}
 	v.register()
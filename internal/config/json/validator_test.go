@@ -149,6 +149,11 @@ func TestValidatePlugins(t *testing.T) {
 scopes is required
 shortCut is required`,
 		},
+		"v2": {
+			f: "testdata/plugins/v2.yaml",
+			err: `plugins.notes.apiVersion must be one of the following: "v1", "v2"
+plugins.notes.output must be one of the following: "text", "table"`,
+		},
 	}
 
 	v := json.NewValidator()
@@ -221,3 +226,65 @@ columns is required`,
 		})
 	}
 }
+
+func TestValidatePulses(t *testing.T) {
+	uu := map[string]struct {
+		f   string
+		err string
+	}{
+		"happy": {
+			f: "testdata/pulses/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/pulses/toast.yaml",
+			err: `Additional property pulse is not allowed
+panels is required`,
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.f)
+			assert.NoError(t, err)
+			err = v.Validate(json.PulsesSchema, bb)
+			if u.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, u.err, err.Error())
+		})
+	}
+}
+
+func TestValidateNotifications(t *testing.T) {
+	uu := map[string]struct {
+		f   string
+		err string
+	}{
+		"happy": {
+			f: "testdata/notifications/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/notifications/toast.yaml",
+			err: `sinks.0.type must be one of the following: "bell", "osc", "webhook", "slack"
+status is required`,
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.f)
+			assert.NoError(t, err)
+			err = v.Validate(json.NotificationsSchema, bb)
+			if u.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, u.err, err.Error())
+		})
+	}
+}
@@ -18,19 +18,28 @@ import (
 
 // K9s tracks K9s configuration options.
 type K9s struct {
-	LiveViewAutoRefresh bool       `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
-	ScreenDumpDir       string     `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
-	RefreshRate         int        `json:"refreshRate" yaml:"refreshRate"`
-	MaxConnRetry        int        `json:"maxConnRetry" yaml:"maxConnRetry"`
-	ReadOnly            bool       `json:"readOnly" yaml:"readOnly"`
-	NoExitOnCtrlC       bool       `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
-	UI                  UI         `json:"ui" yaml:"ui"`
-	SkipLatestRevCheck  bool       `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
-	DisablePodCounting  bool       `json:"disablePodCounting" yaml:"disablePodCounting"`
-	ShellPod            ShellPod   `json:"shellPod" yaml:"shellPod"`
-	ImageScans          ImageScans `json:"imageScans" yaml:"imageScans"`
-	Logger              Logger     `json:"logger" yaml:"logger"`
-	Thresholds          Threshold  `json:"thresholds" yaml:"thresholds"`
+	LiveViewAutoRefresh bool        `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
+	ScreenDumpDir       string      `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
+	RefreshRate         int         `json:"refreshRate" yaml:"refreshRate"`
+	MaxConnRetry        int         `json:"maxConnRetry" yaml:"maxConnRetry"`
+	ReadOnly            bool        `json:"readOnly" yaml:"readOnly"`
+	NoExitOnCtrlC       bool        `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
+	UI                  UI          `json:"ui" yaml:"ui"`
+	Language            string      `json:"language" yaml:"language,omitempty"`
+	SkipLatestRevCheck  bool        `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
+	DisablePodCounting  bool        `json:"disablePodCounting" yaml:"disablePodCounting"`
+	ShellPod            ShellPod    `json:"shellPod" yaml:"shellPod"`
+	ImageScans          ImageScans  `json:"imageScans" yaml:"imageScans"`
+	Popeye              Popeye      `json:"popeye" yaml:"popeye,omitempty"`
+	Logger              Logger      `json:"logger" yaml:"logger"`
+	Client              Client      `json:"client" yaml:"client"`
+	Watch               Watch       `json:"watch" yaml:"watch"`
+	Favorites           Favorites   `json:"favorites" yaml:"favorites"`
+	Thresholds          Threshold   `json:"thresholds" yaml:"thresholds"`
+	Protect             Protections `json:"protect" yaml:"protect,omitempty"`
+	Fleet               Fleet       `json:"fleet" yaml:"fleet,omitempty"`
+	ScreenDumps         ScreenDumps `json:"screenDumps" yaml:"screenDumps,omitempty"`
+	Tracing             Tracing     `json:"tracing" yaml:"tracing,omitempty"`
 	manualRefreshRate   int
 	manualHeadless      *bool
 	manualLogoless      *bool
@@ -53,9 +62,11 @@ func NewK9s(conn client.Connection, ks data.KubeSettings) *K9s {
 		MaxConnRetry:  defaultMaxConnRetry,
 		ScreenDumpDir: AppDumpsDir,
 		Logger:        NewLogger(),
+		Client:        NewClient(),
 		Thresholds:    NewThreshold(),
 		ShellPod:      NewShellPod(),
 		ImageScans:    NewImageScans(),
+		Favorites:     NewFavorites(),
 		dir:           data.NewDir(AppContextsDir),
 		conn:          conn,
 		ks:            ks,
@@ -104,7 +115,15 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.DisablePodCounting = k1.DisablePodCounting
 	k.ShellPod = k1.ShellPod
 	k.Logger = k1.Logger
+	k.Client = k1.Client
+	k.Watch = k1.Watch
+	k.Favorites = k1.Favorites
 	k.ImageScans = k1.ImageScans
+	k.Popeye = k1.Popeye
+	k.Protect = k1.Protect
+	k.Fleet = k1.Fleet
+	k.ScreenDumps = k1.ScreenDumps
+	k.Tracing = k1.Tracing
 	if k1.Thresholds != nil {
 		k.Thresholds = k1.Thresholds
 	}
@@ -129,6 +148,25 @@ func (k *K9s) ContextScreenDumpDir() string {
 	return filepath.Join(k.AppScreenDumpDir(), k.contextPath())
 }
 
+// ContextPopeyeScoresFile returns the active context popeye scores file.
+func (k *K9s) ContextPopeyeScoresFile() string {
+	return filepath.Join(k.contextStateDir(), PopeyeScoresFile)
+}
+
+// ContextStateFile returns the active context runtime state file.
+func (k *K9s) ContextStateFile() string {
+	return filepath.Join(k.contextStateDir(), StateFile)
+}
+
+// ContextSnapshotsDir returns the active context snapshots dir.
+func (k *K9s) ContextSnapshotsDir() string {
+	return filepath.Join(AppStateDir, "snapshots", k.contextPath())
+}
+
+func (k *K9s) contextStateDir() string {
+	return filepath.Join(AppStateDir, "clusters", k.contextPath())
+}
+
 func (k *K9s) contextPath() string {
 	if k.getActiveConfig() == nil {
 		return "na"
@@ -226,6 +264,10 @@ func (k *K9s) ActivateContext(n string) (*data.Context, error) {
 	if k.getActiveConfig().Context == nil {
 		return nil, fmt.Errorf("context activation failed for: %s", n)
 	}
+	if err := k.migrateState(); err != nil {
+		log.Warn().Err(err).Msgf("State migration failed for context %q", n)
+	}
+	k.loadState()
 
 	return k.getActiveConfig().Context, nil
 }
@@ -243,6 +285,7 @@ func (k *K9s) Reload() error {
 	}
 	k.setActiveConfig(cfg)
 	k.getActiveConfig().Validate(k.conn, k.ks)
+	k.loadState()
 
 	return nil
 }
@@ -332,6 +375,12 @@ func (k *K9s) Validate(c client.Connection, ks data.KubeSettings) {
 	}
 	k.ShellPod = k.ShellPod.Validate()
 	k.Logger = k.Logger.Validate()
+	k.Client = k.Client.Validate()
+	k.Watch = k.Watch.Validate()
+	if k.Tracing.Enabled {
+		k.Tracing = k.Tracing.Validate()
+	}
+	k.Favorites = k.Favorites.Validate()
 	k.Thresholds = k.Thresholds.Validate()
 
 	if cfg := k.getActiveConfig(); cfg != nil {
@@ -146,3 +146,29 @@ func TestAppScreenDumpDir(t *testing.T) {
 	assert.Nil(t, cfg.Load("testdata/configs/k9s.yaml", true))
 	assert.Equal(t, "/tmp/k9s-test/screen-dumps", cfg.K9s.AppScreenDumpDir())
 }
+
+func TestTracingValidate(t *testing.T) {
+	uu := map[string]struct {
+		t, e config.Tracing
+	}{
+		"defaults": {
+			t: config.Tracing{Enabled: true},
+			e: config.Tracing{Enabled: true, Exporter: config.TracingOTLP, Endpoint: config.DefaultTracingEndpoint, SampleRatio: 1},
+		},
+		"file": {
+			t: config.Tracing{Enabled: true, Exporter: config.TracingFile, SampleRatio: 0.5},
+			e: config.Tracing{Enabled: true, Exporter: config.TracingFile, SampleRatio: 0.5},
+		},
+		"bad-exporter": {
+			t: config.Tracing{Exporter: "zipkin", Endpoint: "fred:4318", SampleRatio: 2},
+			e: config.Tracing{Exporter: config.TracingOTLP, Endpoint: "fred:4318", SampleRatio: 1},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.t.Validate())
+		})
+	}
+}
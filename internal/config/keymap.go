@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+// KeyMapAllViews tracks bindings applying to all views.
+const KeyMapAllViews = "all"
+
+// KeyMaps represents a collection of per view key bindings.
+type KeyMaps struct {
+	KeyMap map[string]KeyBindings `yaml:"keyMap"`
+}
+
+// KeyBindings maps action names to shortcuts.
+type KeyBindings map[string]string
+
+// NewKeyMaps returns a new keymap.
+func NewKeyMaps() KeyMaps {
+	return KeyMaps{
+		KeyMap: make(map[string]KeyBindings),
+	}
+}
+
+// Load loads key bindings from a given file.
+func (k KeyMaps) Load(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.KeyMapSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var kk KeyMaps
+	if err := yaml.Unmarshal(bb, &kk); err != nil {
+		return err
+	}
+	for v, bb := range kk.KeyMap {
+		k.KeyMap[v] = bb
+	}
+
+	return nil
+}
+
+// BindingsFor returns the bindings for a view given its aliases.
+// View specific bindings supersede the ones defined for all views.
+func (k KeyMaps) BindingsFor(aliases map[string]struct{}) KeyBindings {
+	kb := make(KeyBindings)
+	for a, s := range k.KeyMap[KeyMapAllViews] {
+		kb[a] = s
+	}
+	for v, bb := range k.KeyMap {
+		if _, ok := aliases[v]; !ok || v == KeyMapAllViews {
+			continue
+		}
+		for a, s := range bb {
+			kb[a] = s
+		}
+	}
+
+	return kb
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyMapLoad(t *testing.T) {
+	k := config.NewKeyMaps()
+	assert.NoError(t, k.Load("testdata/keymap/keymap.yaml"))
+	assert.Equal(t, 3, len(k.KeyMap))
+
+	assert.NoError(t, k.Load("testdata/keymap/missing.yaml"))
+	assert.Error(t, k.Load("testdata/hotkeys/hotkeys.yaml"))
+}
+
+func TestKeyMapBindingsFor(t *testing.T) {
+	k := config.NewKeyMaps()
+	assert.NoError(t, k.Load("testdata/keymap/keymap.yaml"))
+
+	uu := map[string]struct {
+		aliases map[string]struct{}
+		e       config.KeyBindings
+	}{
+		"none": {
+			e: config.KeyBindings{"Describe": "Shift-D", "Edit": "Shift-E"},
+		},
+		"pods": {
+			aliases: map[string]struct{}{"pods": {}, "po": {}},
+			e:       config.KeyBindings{"Describe": "Shift-D", "Edit": "Ctrl-E", "Logs": "Shift-L"},
+		},
+		"deployments": {
+			aliases: map[string]struct{}{"deployments": {}, "dp": {}},
+			e:       config.KeyBindings{"Describe": "Shift-D", "Edit": "Shift-E", "Scale": "Shift-S"},
+		},
+	}
+
+	for k1 := range uu {
+		u := uu[k1]
+		t.Run(k1, func(t *testing.T) {
+			assert.Equal(t, u.e, k.BindingsFor(u.aliases))
+		})
+	}
+}
@@ -3,6 +3,11 @@
 
 package config
 
+import (
+	"github.com/derailed/k9s/internal/logging"
+	"github.com/rs/zerolog/log"
+)
+
 const (
 	// DefaultLoggerTailCount tracks default log tail size.
 	DefaultLoggerTailCount = 100
@@ -21,6 +26,12 @@ type Logger struct {
 	SinceSeconds int64 `json:"sinceSeconds" yaml:"sinceSeconds"`
 	TextWrap     bool  `json:"textWrap" yaml:"textWrap"`
 	ShowTime     bool  `json:"showTime" yaml:"showTime"`
+
+	// Level tracks k9s own log level.
+	Level string `json:"level,omitempty" yaml:"level,omitempty"`
+
+	// Levels tracks k9s own log levels per subsystem ie watch, ui or dao.
+	Levels map[string]string `json:"levels,omitempty" yaml:"levels,omitempty"`
 }
 
 // NewLogger returns a new instance.
@@ -46,6 +57,18 @@ func (l Logger) Validate() Logger {
 	if l.SinceSeconds == 0 {
 		l.SinceSeconds = DefaultSinceSeconds
 	}
+	if l.Level != "" {
+		if _, err := logging.ParseLevel(l.Level); err != nil {
+			log.Warn().Err(err).Msg("Logger level reset")
+			l.Level = ""
+		}
+	}
+	for k, v := range l.Levels {
+		if _, err := logging.ParseLevel(v); err != nil {
+			log.Warn().Err(err).Msgf("Logger %s level reset", k)
+			delete(l.Levels, k)
+		}
+	}
 
 	return l
 }
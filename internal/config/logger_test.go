@@ -25,3 +25,14 @@ func TestLoggerValidate(t *testing.T) {
 	assert.Equal(t, int64(100), l.TailCount)
 	assert.Equal(t, 5000, l.BufferSize)
 }
+
+func TestLoggerValidateLevels(t *testing.T) {
+	l := config.Logger{
+		Level:  "blee",
+		Levels: map[string]string{"watch": "debug", "dao": "toast"},
+	}
+	l = l.Validate()
+
+	assert.Equal(t, "", l.Level)
+	assert.Equal(t, map[string]string{"watch": "debug"}, l.Levels)
+}
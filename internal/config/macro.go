@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+// Macros represents a collection of macros.
+type Macros struct {
+	Macro map[string]Macro `yaml:"macros"`
+}
+
+// Macro describes a named sequence of commands and key actions.
+type Macro struct {
+	Description string      `yaml:"description"`
+	Steps       []MacroStep `yaml:"steps"`
+}
+
+// MacroStep describes a macro step. Only one of command, filter or key is set.
+type MacroStep struct {
+	Command string `yaml:"command,omitempty"`
+	Filter  string `yaml:"filter,omitempty"`
+	Key     string `yaml:"key,omitempty"`
+}
+
+func (s MacroStep) String() string {
+	switch {
+	case s.Command != "":
+		return ":" + s.Command
+	case s.Filter != "":
+		return "/" + s.Filter
+	default:
+		return s.Key
+	}
+}
+
+// NewMacros returns a new macros collection.
+func NewMacros() Macros {
+	return Macros{
+		Macro: make(map[string]Macro),
+	}
+}
+
+// Load loads macros from a given file.
+func (m Macros) Load(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.MacrosSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var mm Macros
+	if err := yaml.Unmarshal(bb, &mm); err != nil {
+		return err
+	}
+	for k, v := range mm.Macro {
+		m.Macro[k] = v
+	}
+
+	return nil
+}
+
+// Get returns a macro by name.
+func (m Macros) Get(name string) (Macro, error) {
+	mc, ok := m.Macro[name]
+	if !ok {
+		return Macro{}, fmt.Errorf("no macro named %q", name)
+	}
+	if len(mc.Steps) == 0 {
+		return Macro{}, fmt.Errorf("macro %q has no steps", name)
+	}
+
+	return mc, nil
+}
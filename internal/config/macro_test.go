@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMacrosLoad(t *testing.T) {
+	m := config.NewMacros()
+	assert.NoError(t, m.Load("testdata/macros/macros.yaml"))
+	assert.Equal(t, 2, len(m.Macro))
+
+	mc, err := m.Get("incident")
+	assert.NoError(t, err)
+	assert.Equal(t, "Incident triage", mc.Description)
+	assert.Equal(t, []config.MacroStep{
+		{Command: "ctx prod"},
+		{Command: "pods prod"},
+		{Filter: "-l app=fred"},
+		{Key: "Shift-S"},
+	}, mc.Steps)
+
+	_, err = m.Get("empty")
+	assert.Equal(t, `macro "empty" has no steps`, err.Error())
+	_, err = m.Get("zorg")
+	assert.Equal(t, `no macro named "zorg"`, err.Error())
+}
+
+func TestMacrosLoadToast(t *testing.T) {
+	m := config.NewMacros()
+	assert.Error(t, m.Load("testdata/macros/toast.yaml"))
+	assert.NoError(t, m.Load("testdata/macros/missing.yaml"))
+}
+
+func TestMacroStepString(t *testing.T) {
+	uu := map[string]struct {
+		s config.MacroStep
+		e string
+	}{
+		"command": {s: config.MacroStep{Command: "pods"}, e: ":pods"},
+		"filter":  {s: config.MacroStep{Filter: "fred"}, e: "/fred"},
+		"key":     {s: config.MacroStep{Key: "Shift-S"}, e: "Shift-S"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.s.String())
+		})
+	}
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	version "k8s.io/apimachinery/pkg/version"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
-	disk "k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/discovery"
 	dynamic "k8s.io/client-go/dynamic"
 	kubernetes "k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
@@ -35,6 +35,8 @@ func EnsureDir(d string) error {
 
 func NewMockConfig() *config.Config {
 	config.AppContextsDir = "/tmp/test"
+	config.AppStateDir = "/tmp/test-state"
+	_ = os.RemoveAll(config.AppStateDir)
 	cl, ct := "cl-1", "ct-1-1"
 	flags := genericclioptions.ConfigFlags{
 		ClusterName: &cl,
@@ -135,7 +137,13 @@ func (m mockConnection) DialLogs() (kubernetes.Interface, error) {
 func (m mockConnection) SwitchContext(ctx string) error {
 	return nil
 }
-func (m mockConnection) CachedDiscovery() (*disk.CachedDiscoveryClient, error) {
+func (m mockConnection) Impersonate(string, []string) error {
+	return nil
+}
+func (m mockConnection) Relogin(string) error {
+	return nil
+}
+func (m mockConnection) CachedDiscovery() (discovery.CachedDiscoveryInterface, error) {
 	return nil, nil
 }
 func (m mockConnection) RestConfig() (*restclient.Config, error) {
@@ -150,6 +158,9 @@ func (m mockConnection) DynDial() (dynamic.Interface, error) {
 func (m mockConnection) HasMetrics() bool {
 	return false
 }
+func (m mockConnection) HasMetricsAPI() bool {
+	return false
+}
 func (m mockConnection) ValidNamespaceNames() (client.NamespaceNames, error) {
 	return nil, nil
 }
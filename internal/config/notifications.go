@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// SinkBell rings the terminal bell.
+	SinkBell = "bell"
+
+	// SinkOSC issues an OSC 9 desktop notification.
+	SinkOSC = "osc"
+
+	// SinkWebhook posts notifications to a generic webhook.
+	SinkWebhook = "webhook"
+
+	// SinkSlack posts notifications to a Slack incoming webhook.
+	SinkSlack = "slack"
+)
+
+// Notifications represents a collection of notification sinks and rules.
+type Notifications struct {
+	Sinks []NotifySink `yaml:"sinks"`
+	Rules []NotifyRule `yaml:"rules"`
+}
+
+// NotifySink describes where notifications are sent.
+type NotifySink struct {
+	Type    string            `yaml:"type"`
+	URL     string            `yaml:"url,omitempty"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+// NotifyRule describes a resource status triggering a notification.
+type NotifyRule struct {
+	Name       string   `yaml:"name,omitempty"`
+	GVR        string   `yaml:"gvr"`
+	Status     string   `yaml:"status"`
+	Namespaces []string `yaml:"namespaces,omitempty"`
+	Favorites  bool     `yaml:"favorites,omitempty"`
+}
+
+// NewNotifications returns a new notifications configuration.
+func NewNotifications() *Notifications {
+	return &Notifications{}
+}
+
+// Load loads the notifications. Context specific sinks and rules supersede global ones.
+func (n *Notifications) Load(path string) error {
+	if err := n.LoadNotifications(AppNotificationsFile); err != nil {
+		return err
+	}
+
+	return n.LoadNotifications(path)
+}
+
+// LoadNotifications loads notifications from a given file.
+func (n *Notifications) LoadNotifications(path string) error {
+	if path == "" {
+		return nil
+	}
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.NotificationsSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var nn Notifications
+	if err := yaml.Unmarshal(bb, &nn); err != nil {
+		return err
+	}
+	if len(nn.Sinks) > 0 {
+		n.Sinks = nn.Sinks
+	}
+	if len(nn.Rules) > 0 {
+		n.Rules = nn.Rules
+	}
+
+	return nil
+}
+
+// IsActive returns true if notifications can be delivered.
+func (n *Notifications) IsActive() bool {
+	return len(n.Sinks) > 0
+}
+
+// Title returns the rule title.
+func (r NotifyRule) Title() string {
+	if r.Name != "" {
+		return r.Name
+	}
+
+	return r.GVR + " " + r.Status
+}
+
+// Matches checks if a resource status satisfies the rule. Composite statuses
+// ie NotReady,SchedulingDisabled match on any of their parts.
+func (r NotifyRule) Matches(status string) bool {
+	for _, s := range strings.Split(status, ",") {
+		if strings.EqualFold(strings.TrimSpace(s), r.Status) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InNamespace checks if a namespace is in scope given the favorite namespaces.
+func (r NotifyRule) InNamespace(ns string, favs []string) bool {
+	if ns == "" || (len(r.Namespaces) == 0 && !r.Favorites) {
+		return true
+	}
+	for _, n := range r.Namespaces {
+		if n == ns {
+			return true
+		}
+	}
+	if r.Favorites {
+		for _, n := range favs {
+			if n == ns {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationsLoad(t *testing.T) {
+	n := config.NewNotifications()
+	assert.False(t, n.IsActive())
+
+	assert.NoError(t, n.LoadNotifications("testdata/notifications/cool.yaml"))
+	assert.True(t, n.IsActive())
+	assert.Equal(t, 2, len(n.Sinks))
+	assert.Equal(t, config.SinkWebhook, n.Sinks[1].Type)
+	assert.Equal(t, 2, len(n.Rules))
+	assert.Equal(t, "Crashing pods", n.Rules[0].Title())
+	assert.Equal(t, "v1/nodes NotReady", n.Rules[1].Title())
+}
+
+func TestNotificationsLoadMissing(t *testing.T) {
+	n := config.NewNotifications()
+	assert.NoError(t, n.LoadNotifications("testdata/notifications/bozo.yaml"))
+	assert.False(t, n.IsActive())
+}
+
+func TestNotifyRuleMatches(t *testing.T) {
+	uu := map[string]struct {
+		status string
+		e      bool
+	}{
+		"exact": {
+			status: "NotReady",
+			e:      true,
+		},
+		"composite": {
+			status: "NotReady,SchedulingDisabled",
+			e:      true,
+		},
+		"case": {
+			status: "notready",
+			e:      true,
+		},
+		"miss": {
+			status: "Ready",
+		},
+	}
+
+	r := config.NotifyRule{GVR: "v1/nodes", Status: "NotReady"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, r.Matches(u.status))
+		})
+	}
+}
+
+func TestNotifyRuleInNamespace(t *testing.T) {
+	uu := map[string]struct {
+		rule config.NotifyRule
+		ns   string
+		e    bool
+	}{
+		"all": {
+			rule: config.NotifyRule{},
+			ns:   "fred",
+			e:    true,
+		},
+		"cluster-scoped": {
+			rule: config.NotifyRule{Namespaces: []string{"fred"}},
+			e:    true,
+		},
+		"listed": {
+			rule: config.NotifyRule{Namespaces: []string{"fred"}},
+			ns:   "fred",
+			e:    true,
+		},
+		"not-listed": {
+			rule: config.NotifyRule{Namespaces: []string{"fred"}},
+			ns:   "blee",
+		},
+		"favorite": {
+			rule: config.NotifyRule{Favorites: true},
+			ns:   "zorg",
+			e:    true,
+		},
+		"not-favorite": {
+			rule: config.NotifyRule{Favorites: true},
+			ns:   "blee",
+		},
+	}
+
+	favs := []string{"default", "zorg"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.rule.InNamespace(u.ns, favs))
+		})
+	}
+}
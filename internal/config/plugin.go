@@ -18,7 +18,18 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-const k9sPluginsDir = "k9s/plugins"
+const (
+	k9sPluginsDir = "k9s/plugins"
+
+	// PluginAPIv2 tracks plugins receiving their context on stdin.
+	PluginAPIv2 = "v2"
+
+	// PluginOutputText renders a plugin output as text.
+	PluginOutputText = "text"
+
+	// PluginOutputTable renders a plugin output as a table.
+	PluginOutputTable = "table"
+)
 
 // Plugins represents a collection of plugins.
 type Plugins struct {
@@ -38,12 +49,44 @@ type Plugin struct {
 	Background      bool     `yaml:"background"`
 	Dangerous       bool     `yaml:"dangerous"`
 	OverwriteOutput bool     `yaml:"overwriteOutput"`
+	APIVersion      string   `yaml:"apiVersion"`
+	Output          string   `yaml:"output"`
+	ReadOnly        bool     `yaml:"readOnly"`
 }
 
 func (p Plugin) String() string {
 	return fmt.Sprintf("[%s] %s(%s)", p.ShortCut, p.Command, strings.Join(p.Args, " "))
 }
 
+// IsV2 returns true if the plugin uses the v2 plugin api.
+func (p Plugin) IsV2() bool {
+	return p.APIVersion == PluginAPIv2
+}
+
+// CapturesOutput returns true if the plugin output is rendered by K9s.
+func (p Plugin) CapturesOutput() bool {
+	return p.IsV2() && (p.Output == PluginOutputText || p.Output == PluginOutputTable)
+}
+
+// AllowedInReadOnly returns true if the plugin can run in read-only mode.
+// V2 plugins must explicitly declare read-only compatibility.
+func (p Plugin) AllowedInReadOnly() bool {
+	if p.Dangerous {
+		return false
+	}
+	if p.IsV2() {
+		return p.ReadOnly
+	}
+
+	return true
+}
+
+// NeedsConfirm returns true if the plugin must be confirmed prior to running.
+// Dangerous v2 plugins always require a confirmation.
+func (p Plugin) NeedsConfirm() bool {
+	return p.Confirm || (p.IsV2() && p.Dangerous)
+}
+
 // NewPlugins returns a new plugin.
 func NewPlugins() Plugins {
 	return Plugins{
@@ -87,3 +87,91 @@ func TestMultiplePluginFilesLoad(t *testing.T) {
 		assert.ObjectsAreEqual(expectedPlugin, k)
 	}
 }
+
+func TestPluginReadOnly(t *testing.T) {
+	uu := map[string]struct {
+		p Plugin
+		e bool
+	}{
+		"v1": {
+			e: true,
+		},
+		"v1-dangerous": {
+			p: Plugin{Dangerous: true},
+		},
+		"v2": {
+			p: Plugin{APIVersion: PluginAPIv2},
+		},
+		"v2-read-only": {
+			p: Plugin{APIVersion: PluginAPIv2, ReadOnly: true},
+			e: true,
+		},
+		"v2-dangerous": {
+			p: Plugin{APIVersion: PluginAPIv2, ReadOnly: true, Dangerous: true},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.p.AllowedInReadOnly())
+		})
+	}
+}
+
+func TestPluginNeedsConfirm(t *testing.T) {
+	uu := map[string]struct {
+		p Plugin
+		e bool
+	}{
+		"none": {},
+		"confirm": {
+			p: Plugin{Confirm: true},
+			e: true,
+		},
+		"v1-dangerous": {
+			p: Plugin{Dangerous: true},
+		},
+		"v2-dangerous": {
+			p: Plugin{APIVersion: PluginAPIv2, Dangerous: true},
+			e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.p.NeedsConfirm())
+		})
+	}
+}
+
+func TestPluginCapturesOutput(t *testing.T) {
+	uu := map[string]struct {
+		p Plugin
+		e bool
+	}{
+		"none": {},
+		"v1": {
+			p: Plugin{Output: PluginOutputText},
+		},
+		"v2-blank": {
+			p: Plugin{APIVersion: PluginAPIv2},
+		},
+		"v2-text": {
+			p: Plugin{APIVersion: PluginAPIv2, Output: PluginOutputText},
+			e: true,
+		},
+		"v2-table": {
+			p: Plugin{APIVersion: PluginAPIv2, Output: PluginOutputTable},
+			e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.p.CapturesOutput())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// PopeyeScoresFile tracks the name of the context popeye scores file.
+	PopeyeScoresFile = "popeye_scores.yaml"
+
+	defaultPopeyeInterval  = time.Hour
+	minPopeyeInterval      = 5 * time.Minute
+	defaultPopeyeMaxScores = 100
+)
+
+// Popeye tracks popeye scheduled scans options.
+type Popeye struct {
+	Enable    bool   `json:"enable" yaml:"enable"`
+	Interval  string `json:"interval,omitempty" yaml:"interval,omitempty"`
+	MaxScores int    `json:"maxScores,omitempty" yaml:"maxScores,omitempty"`
+}
+
+// ScanInterval returns the delay between scheduled scans.
+func (p Popeye) ScanInterval() time.Duration {
+	if p.Interval == "" {
+		return defaultPopeyeInterval
+	}
+	d, err := time.ParseDuration(p.Interval)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Invalid popeye interval %q. Using default", p.Interval)
+		return defaultPopeyeInterval
+	}
+	if d < minPopeyeInterval {
+		return minPopeyeInterval
+	}
+
+	return d
+}
+
+// MaxHistory returns the max number of scores to retain per context.
+func (p Popeye) MaxHistory() int {
+	if p.MaxScores <= 0 {
+		return defaultPopeyeMaxScores
+	}
+
+	return p.MaxScores
+}
+
+// PopeyeScore tracks a popeye scan outcome.
+type PopeyeScore struct {
+	Time   time.Time `yaml:"time"`
+	Score  int       `yaml:"score"`
+	Errors int       `yaml:"errors"`
+}
+
+// PopeyeScores tracks a context popeye scans history.
+type PopeyeScores struct {
+	Scores []PopeyeScore `yaml:"scores"`
+}
+
+// LoadPopeyeScores loads a scores history. A missing file yields an empty history.
+func LoadPopeyeScores(path string) (*PopeyeScores, error) {
+	var ss PopeyeScores
+	bb, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &ss, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(bb, &ss); err != nil {
+		return nil, err
+	}
+
+	return &ss, nil
+}
+
+// Save persists the scores history.
+func (p *PopeyeScores) Save(path string) error {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	bb, err := yaml.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bb, data.DefaultFileMod)
+}
+
+// Add records a new score, evicting the oldest entries past max.
+func (p *PopeyeScores) Add(s PopeyeScore, max int) {
+	p.Scores = append(p.Scores, s)
+	if max > 0 && len(p.Scores) > max {
+		p.Scores = p.Scores[len(p.Scores)-max:]
+	}
+}
+
+// Last returns the most recent score if any.
+func (p *PopeyeScores) Last() (PopeyeScore, bool) {
+	if len(p.Scores) == 0 {
+		return PopeyeScore{}, false
+	}
+
+	return p.Scores[len(p.Scores)-1], true
+}
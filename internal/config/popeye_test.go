@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopeyeScanInterval(t *testing.T) {
+	uu := map[string]struct {
+		interval string
+		e        time.Duration
+	}{
+		"default": {
+			e: time.Hour,
+		},
+		"custom": {
+			interval: "30m",
+			e:        30 * time.Minute,
+		},
+		"too-short": {
+			interval: "1s",
+			e:        5 * time.Minute,
+		},
+		"invalid": {
+			interval: "bozo",
+			e:        time.Hour,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.Popeye{Interval: u.interval}.ScanInterval())
+		})
+	}
+}
+
+func TestPopeyeScoresAdd(t *testing.T) {
+	var ss config.PopeyeScores
+	_, ok := ss.Last()
+	assert.False(t, ok)
+
+	for i := 1; i <= 5; i++ {
+		ss.Add(config.PopeyeScore{Score: i * 10}, 3)
+	}
+	assert.Equal(t, 3, len(ss.Scores))
+	last, ok := ss.Last()
+	assert.True(t, ok)
+	assert.Equal(t, 50, last.Score)
+	assert.Equal(t, 30, ss.Scores[0].Score)
+}
+
+func TestPopeyeScoresSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ctx", config.PopeyeScoresFile)
+	ss, err := config.LoadPopeyeScores(path)
+	assert.NoError(t, err)
+	assert.Empty(t, ss.Scores)
+
+	ss.Add(config.PopeyeScore{Time: time.Now().UTC().Truncate(time.Second), Score: 88, Errors: 2}, 0)
+	assert.NoError(t, ss.Save(path))
+
+	ss1, err := config.LoadPopeyeScores(path)
+	assert.NoError(t, err)
+	assert.Equal(t, ss.Scores, ss1.Scores)
+}
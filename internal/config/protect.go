@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Protections tracks resources guarded against accidental deletions. A rule
+// either names a resource ie nodes, crds or globs a resource name or namespace
+// ie prod-*.
+type Protections []string
+
+// IsProtected checks if a resource known by the given names is protected.
+func (p Protections) IsProtected(rr []string, ns, n string) bool {
+	for _, rule := range p {
+		for _, r := range rr {
+			if strings.EqualFold(rule, r) {
+				return true
+			}
+		}
+		if globMatch(rule, n) || globMatch(rule, ns) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func globMatch(rule, s string) bool {
+	if s == "" {
+		return false
+	}
+	ok, err := filepath.Match(rule, s)
+
+	return err == nil && ok
+}
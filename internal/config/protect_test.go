@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtectionsIsProtected(t *testing.T) {
+	uu := map[string]struct {
+		rr    []string
+		ns, n string
+		e     bool
+	}{
+		"resource": {
+			rr: []string{"nodes", "node", "no"},
+			n:  "n1",
+			e:  true,
+		},
+		"alias": {
+			rr: []string{"customresourcedefinitions", "crd", "crds"},
+			n:  "freds.blee.io",
+			e:  true,
+		},
+		"name": {
+			rr: []string{"pods"},
+			ns: "default",
+			n:  "prod-fred",
+			e:  true,
+		},
+		"namespace": {
+			rr: []string{"pods"},
+			ns: "prod-eu",
+			n:  "fred",
+			e:  true,
+		},
+		"unprotected": {
+			rr: []string{"pods"},
+			ns: "default",
+			n:  "fred",
+		},
+	}
+
+	p := config.Protections{"nodes", "CRDs", "prod-*"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, p.IsProtected(u.rr, u.ns, u.n))
+		})
+	}
+}
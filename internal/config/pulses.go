@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// PulseGauge renders a panel as a gauge.
+	PulseGauge = "gauge"
+
+	// PulseChart renders a panel as a sparkline chart.
+	PulseChart = "chart"
+)
+
+// Pulses represents a collection of pulses dashboard panels.
+type Pulses struct {
+	Panels []PulsePanel `yaml:"panels"`
+}
+
+// PulsePanel describes a pulses dashboard panel.
+type PulsePanel struct {
+	GVR           string    `yaml:"gvr"`
+	Title         string    `yaml:"title,omitempty"`
+	Type          string    `yaml:"type,omitempty"`
+	Namespace     string    `yaml:"namespace,omitempty"`
+	Selector      string    `yaml:"selector,omitempty"`
+	HealthColumn  string    `yaml:"healthColumn,omitempty"`
+	HealthyValues []string  `yaml:"healthyValues,omitempty"`
+	Threshold     *Severity `yaml:"threshold,omitempty"`
+	Colors        []string  `yaml:"colors,omitempty"`
+}
+
+// NewPulses returns the stock pulses panels.
+func NewPulses() *Pulses {
+	return &Pulses{
+		Panels: []PulsePanel{
+			{GVR: "apps/v1/deployments", Type: PulseGauge},
+			{GVR: "apps/v1/replicasets", Type: PulseGauge},
+			{GVR: "apps/v1/statefulsets", Type: PulseGauge},
+			{GVR: "apps/v1/daemonsets", Type: PulseGauge},
+			{GVR: "v1/pods", Type: PulseChart},
+			{GVR: "v1/events", Type: PulseChart},
+			{GVR: "batch/v1/jobs", Type: PulseChart},
+			{GVR: "v1/persistentvolumes", Type: PulseChart},
+		},
+	}
+}
+
+// Load loads the pulses panels. Context specific panels supersede global ones.
+func (p *Pulses) Load(path string) error {
+	if err := p.LoadPulses(AppPulsesFile); err != nil {
+		return err
+	}
+
+	return p.LoadPulses(path)
+}
+
+// LoadPulses loads pulses panels from a given file.
+func (p *Pulses) LoadPulses(path string) error {
+	if path == "" {
+		return nil
+	}
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.PulsesSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var pp Pulses
+	if err := yaml.Unmarshal(bb, &pp); err != nil {
+		return err
+	}
+	if len(pp.Panels) > 0 {
+		p.Panels = pp.Panels
+	}
+
+	return nil
+}
+
+// ID returns the panel identifier.
+func (p PulsePanel) ID() string {
+	id := p.GVR
+	if p.Namespace != "" {
+		id += "@" + p.Namespace
+	}
+	if p.Selector != "" {
+		id += "?" + p.Selector
+	}
+
+	return id
+}
+
+// IsGauge returns true if the panel renders as a gauge.
+func (p PulsePanel) IsGauge() bool {
+	return p.Type == PulseGauge
+}
+
+// IsHealthy checks if a resource health column value is deemed healthy.
+func (p PulsePanel) IsHealthy(v string) bool {
+	for _, h := range p.HealthyValues {
+		if h == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SeverityColor returns a color based on the percentage of unhealthy resources.
+func (p PulsePanel) SeverityColor(perc int) string {
+	if p.Threshold == nil {
+		return ""
+	}
+	t := Threshold{p.GVR: p.Threshold}
+
+	return t.SeverityColor(p.GVR, perc)
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPulsesLoad(t *testing.T) {
+	p := config.NewPulses()
+	assert.Equal(t, 8, len(p.Panels))
+
+	assert.NoError(t, p.LoadPulses("testdata/pulses/cool.yaml"))
+	assert.Equal(t, 2, len(p.Panels))
+	assert.Equal(t, "v1/pods", p.Panels[0].ID())
+	assert.True(t, p.Panels[0].IsGauge())
+	assert.Equal(t, "longhorn.io/v1beta2/volumes@longhorn-system?app=fred", p.Panels[1].ID())
+	assert.True(t, p.Panels[1].IsHealthy("healthy"))
+	assert.False(t, p.Panels[1].IsHealthy("degraded"))
+}
+
+func TestPulsesLoadMissing(t *testing.T) {
+	p := config.NewPulses()
+	assert.NoError(t, p.LoadPulses("testdata/pulses/bozo.yaml"))
+	assert.Equal(t, 8, len(p.Panels))
+}
+
+func TestPulsePanelSeverityColor(t *testing.T) {
+	uu := map[string]struct {
+		panel config.PulsePanel
+		perc  int
+		e     string
+	}{
+		"none": {
+			panel: config.PulsePanel{GVR: "v1/pods"},
+			perc:  50,
+		},
+		"ok": {
+			panel: config.PulsePanel{GVR: "v1/pods", Threshold: &config.Severity{Warn: 10, Critical: 20}},
+			perc:  5,
+			e:     "green",
+		},
+		"warn": {
+			panel: config.PulsePanel{GVR: "v1/pods", Threshold: &config.Severity{Warn: 10, Critical: 20}},
+			perc:  15,
+			e:     "orangered",
+		},
+		"critical": {
+			panel: config.PulsePanel{GVR: "v1/pods", Threshold: &config.Severity{Warn: 10, Critical: 20}},
+			perc:  20,
+			e:     "red",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.panel.SeverityColor(u.perc))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+const resourceTplDir = "templates/resources"
+
+var (
+	//go:embed templates/resources/*.yaml
+	// resourceTpls tracks the stock resource templates.
+	resourceTpls embed.FS
+)
+
+// TemplateVars represents the variables available to resource templates.
+type TemplateVars struct {
+	Namespace string
+	Name      string
+}
+
+// ResourceTemplate returns a resource template rendered with the given variables.
+// Templates located in the user templates dir take precedence over stock ones.
+func ResourceTemplate(kind string, vars TemplateVars) ([]byte, error) {
+	kind = strings.ToLower(kind)
+	raw, err := os.ReadFile(filepath.Join(AppTemplatesDir, kind+".yaml"))
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		raw, err = resourceTpls.ReadFile(path.Join(resourceTplDir, kind+".yaml"))
+		if err != nil {
+			return nil, fmt.Errorf("no template found for kind %q", kind)
+		}
+	}
+
+	tpl, err := template.New(kind).Parse(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %q template: %w", kind, err)
+	}
+	var buff bytes.Buffer
+	if err := tpl.Execute(&buff, vars); err != nil {
+		return nil, fmt.Errorf("unable to render %q template: %w", kind, err)
+	}
+
+	return buff.Bytes(), nil
+}
+
+// ResourceTemplateNames returns the sorted kinds of all available resource templates.
+func ResourceTemplateNames() []string {
+	nn := make([]string, 0, 20)
+	if ee, err := resourceTpls.ReadDir(resourceTplDir); err == nil {
+		nn = append(nn, yamlNames(ee)...)
+	}
+	if ee, err := os.ReadDir(AppTemplatesDir); err == nil {
+		for _, n := range yamlNames(ee) {
+			if !slices.Contains(nn, n) {
+				nn = append(nn, n)
+			}
+		}
+	}
+	slices.Sort(nn)
+
+	return nn
+}
+
+func yamlNames(ee []fs.DirEntry) []string {
+	nn := make([]string, 0, len(ee))
+	for _, e := range ee {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".yaml" {
+			continue
+		}
+		nn = append(nn, strings.TrimSuffix(e.Name(), ".yaml"))
+	}
+
+	return nn
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceTemplate(t *testing.T) {
+	dir := t.TempDir()
+	config.AppTemplatesDir = dir
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "configmap.yaml"), []byte("name: {{ .Name }}\nns: {{ .Namespace }}\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bozo.yaml"), []byte("kind: {{ .Bozo }}\n"), 0600))
+
+	uu := map[string]struct {
+		kind string
+		e    string
+		err  bool
+	}{
+		"stock": {
+			kind: "Service",
+			e:    "  name: fred\n  namespace: ns1\n",
+		},
+		"user": {
+			kind: "configmap",
+			e:    "name: fred\nns: ns1\n",
+		},
+		"bad-var": {
+			kind: "bozo",
+			err:  true,
+		},
+		"missing": {
+			kind: "fred",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := config.ResourceTemplate(u.kind, config.TemplateVars{Namespace: "ns1", Name: "fred"})
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, string(bb), u.e)
+		})
+	}
+}
+
+func TestResourceTemplateNames(t *testing.T) {
+	dir := t.TempDir()
+	config.AppTemplatesDir = dir
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "configmap.yaml"), []byte("kind: ConfigMap\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "mycrd.yaml"), []byte("kind: MyCRD\n"), 0600))
+
+	nn := config.ResourceTemplateNames()
+	assert.Contains(t, nn, "deployment")
+	assert.Contains(t, nn, "mycrd")
+	var count int
+	for _, n := range nn {
+		if n == "configmap" {
+			count++
+		}
+	}
+	assert.Equal(t, 1, count)
+}
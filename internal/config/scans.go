@@ -51,6 +51,7 @@ func (b ScanExcludes) exclude(ns string, ll map[string]string) bool {
 // ImageScans tracks vul scans options.
 type ImageScans struct {
 	Enable     bool         `json:"enable" yaml:"enable"`
+	Scanner    string       `json:"scanner,omitempty" yaml:"scanner,omitempty"`
 	Exclusions ScanExcludes `json:"exclusions" yaml:"exclusions"`
 }
 
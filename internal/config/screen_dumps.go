@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ScreenDumps tracks the screen dumps retention policies. Zero values
+// disable a given policy.
+type ScreenDumps struct {
+	// MaxFiles caps the number of dumps per context.
+	MaxFiles int `json:"maxFiles" yaml:"maxFiles"`
+
+	// MaxAge prunes dumps older than a given duration ie 168h.
+	MaxAge string `json:"maxAge" yaml:"maxAge"`
+
+	// MaxSizeMB caps the dumps total size per context in megabytes.
+	MaxSizeMB int `json:"maxSizeMB" yaml:"maxSizeMB"`
+}
+
+// IsSet checks if any retention policy is set.
+func (s ScreenDumps) IsSet() bool {
+	return s.MaxFiles > 0 || s.MaxAgeDuration() > 0 || s.MaxSizeMB > 0
+}
+
+// MaxAgeDuration returns the dumps max age or zero if not set.
+func (s ScreenDumps) MaxAgeDuration() time.Duration {
+	if s.MaxAge == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(s.MaxAge)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Invalid screen dumps max age %q", s.MaxAge)
+		return 0
+	}
+
+	return d
+}
+
+// MaxSize returns the dumps max size in bytes.
+func (s ScreenDumps) MaxSize() int64 {
+	return int64(s.MaxSizeMB) * 1024 * 1024
+}
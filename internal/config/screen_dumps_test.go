@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScreenDumpsPolicies(t *testing.T) {
+	uu := map[string]struct {
+		s    config.ScreenDumps
+		set  bool
+		age  time.Duration
+		size int64
+	}{
+		"empty": {},
+		"files": {
+			s:   config.ScreenDumps{MaxFiles: 10},
+			set: true,
+		},
+		"age": {
+			s:   config.ScreenDumps{MaxAge: "24h"},
+			set: true,
+			age: 24 * time.Hour,
+		},
+		"bad-age": {
+			s: config.ScreenDumps{MaxAge: "fred"},
+		},
+		"size": {
+			s:    config.ScreenDumps{MaxSizeMB: 2},
+			set:  true,
+			size: 2 * 1024 * 1024,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.set, u.s.IsSet())
+			assert.Equal(t, u.age, u.s.MaxAgeDuration())
+			assert.Equal(t, u.size, u.s.MaxSize())
+		})
+	}
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+)
+
+// StateFile tracks the name of a context runtime state file.
+const StateFile = "state.yaml"
+
+// State tracks a context runtime state. State lives apart from the context
+// configuration so dotfile managed configs do not churn.
+type State struct {
+	Session        *data.Session  `yaml:"session,omitempty"`
+	NamespaceUsage map[string]int `yaml:"namespaceUsage,omitempty"`
+}
+
+// LoadState loads a context state. A missing file yields an empty state.
+func LoadState(path string) (*State, error) {
+	var s State
+	if err := loadYAML(path, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Save persists the context state.
+func (s *State) Save(path string) error {
+	return saveYAML(path, s)
+}
+
+// History tracks the commands and filters history across sessions.
+type History struct {
+	Commands []string `yaml:"commands,omitempty"`
+	Filters  []string `yaml:"filters,omitempty"`
+}
+
+// LoadHistory loads the history. A missing file yields an empty history.
+func LoadHistory(path string) (*History, error) {
+	var h History
+	if err := loadYAML(path, &h); err != nil {
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+// Save persists the history.
+func (h *History) Save(path string) error {
+	return saveYAML(path, h)
+}
+
+// migrateState moves the runtime state older releases kept alongside the
+// active context configuration to the state dir.
+func (k *K9s) migrateState() error {
+	cfg := k.getActiveConfig()
+	if cfg == nil || cfg.Context == nil {
+		return nil
+	}
+
+	var errs error
+	legacy := filepath.Join(AppContextsDir, k.contextPath(), PopeyeScoresFile)
+	if err := moveFile(legacy, k.ContextPopeyeScoresFile()); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	cfgPath := filepath.Join(AppContextsDir, k.contextPath(), data.MainConfigFile)
+	usage, err := legacyUsage(cfgPath)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+	if cfg.Context.Session == nil && len(usage) == 0 {
+		return errs
+	}
+	path := k.ContextStateFile()
+	s, err := LoadState(path)
+	if err != nil {
+		return errors.Join(errs, err)
+	}
+	if s.Session == nil {
+		s.Session = cfg.Context.Session
+	}
+	if s.NamespaceUsage == nil {
+		s.NamespaceUsage = usage
+	}
+	if err := s.Save(path); err != nil {
+		return errors.Join(errs, err)
+	}
+	cfg.Context.Session = nil
+	log.Debug().Msgf("Migrated runtime state to %q", path)
+
+	return errors.Join(errs, k.dir.Save(cfgPath, cfg))
+}
+
+// loadState restores the active context runtime state.
+func (k *K9s) loadState() {
+	cfg := k.getActiveConfig()
+	if cfg == nil || cfg.Context == nil || cfg.Context.Namespace == nil {
+		return
+	}
+	s, err := LoadState(k.ContextStateFile())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to load state for context %q", k.getActiveContextName())
+		return
+	}
+	cfg.Context.Namespace.SetUsage(s.NamespaceUsage)
+}
+
+// saveNamespaceUsage persists the active context namespaces usage counts.
+func (k *K9s) saveNamespaceUsage(uu map[string]int) error {
+	path := k.ContextStateFile()
+	s, err := LoadState(path)
+	if err != nil {
+		return err
+	}
+	s.NamespaceUsage = uu
+
+	return s.Save(path)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// moveFile moves a file unless the target already exists. A missing source
+// is a noop.
+func moveFile(src, dst string) error {
+	if _, err := os.Stat(src); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if _, err := os.Stat(dst); err == nil {
+		log.Warn().Msgf("Skipping migration of %q. Target %q already exists", src, dst)
+		return nil
+	}
+	if err := data.EnsureDirPath(dst, data.DefaultDirMod); err != nil {
+		return err
+	}
+	if err := os.Rename(src, dst); err == nil {
+		log.Debug().Msgf("Migrated %q to %q", src, dst)
+		return nil
+	}
+
+	// Rename fails across devices, fall back to copying.
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, data.DefaultFileMod)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		return errors.Join(err, out.Close())
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	log.Debug().Msgf("Migrated %q to %q", src, dst)
+
+	return os.Remove(src)
+}
+
+// legacyUsage reads the namespaces usage counts older releases kept in the
+// context configuration.
+func legacyUsage(path string) (map[string]int, error) {
+	var cfg struct {
+		Context struct {
+			Namespace struct {
+				Usage map[string]int `yaml:"usage"`
+			} `yaml:"namespace"`
+		} `yaml:"k9s"`
+	}
+	if err := loadYAML(path, &cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg.Context.Namespace.Usage, nil
+}
+
+func loadYAML(path string, o any) error {
+	bb, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return yaml.Unmarshal(bb, o)
+}
+
+func saveYAML(path string, o any) error {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	bb, err := yaml.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bb, data.DefaultFileMod)
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestStateLoadSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", config.StateFile)
+
+	s, err := config.LoadState(path)
+	require.NoError(t, err)
+	assert.Nil(t, s.Session)
+
+	s.Session = &data.Session{
+		Namespace: "fred",
+		Views:     []data.SessionView{{Command: "v1/pods", Filter: "blee"}},
+	}
+	require.NoError(t, s.Save(path))
+
+	s1, err := config.LoadState(path)
+	require.NoError(t, err)
+	assert.Equal(t, s, s1)
+}
+
+func TestHistoryLoadSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.yaml")
+
+	h, err := config.LoadHistory(path)
+	require.NoError(t, err)
+	assert.Empty(t, h.Commands)
+
+	h.Commands, h.Filters = []string{"po", "svc"}, []string{"nginx"}
+	require.NoError(t, h.Save(path))
+
+	h1, err := config.LoadHistory(path)
+	require.NoError(t, err)
+	assert.Equal(t, h, h1)
+}
+
+func TestK9sMigrateState(t *testing.T) {
+	ctxDir, stateDir := config.AppContextsDir, config.AppStateDir
+	t.Cleanup(func() {
+		config.AppContextsDir, config.AppStateDir = ctxDir, stateDir
+	})
+	tmp := t.TempDir()
+	config.AppContextsDir = filepath.Join(tmp, "clusters")
+	config.AppStateDir = filepath.Join(tmp, "state")
+
+	cl, ct := "cl-1", "ct-1-1"
+	legacyCfg := config.AppContextConfig(cl, ct)
+	require.NoError(t, data.EnsureDirPath(legacyCfg, data.DefaultDirMod))
+	require.NoError(t, os.WriteFile(legacyCfg, []byte(`k9s:
+  cluster: cl-1
+  namespace:
+    active: default
+    usage:
+      fred: 2
+  view:
+    active: po
+  session:
+    namespace: fred
+    views:
+      - command: v1/pods
+        filter: blee
+`), data.DefaultFileMod))
+	legacyScores := filepath.Join(config.AppContextDir(cl, ct), config.PopeyeScoresFile)
+	require.NoError(t, os.WriteFile(legacyScores, []byte("scores:\n- score: 90\n"), data.DefaultFileMod))
+
+	k := config.NewK9s(
+		mock.NewMockConnection(),
+		mock.NewMockKubeSettings(&genericclioptions.ConfigFlags{
+			ClusterName: &cl,
+			Context:     &ct,
+		}),
+	)
+	c, err := k.ActivateContext(ct)
+	require.NoError(t, err)
+	assert.Nil(t, c.Session)
+	assert.Equal(t, map[string]int{"fred": 2}, c.Namespace.UsageCounts())
+
+	s, err := config.LoadState(config.AppContextStateFile(cl, ct))
+	require.NoError(t, err)
+	require.NotNil(t, s.Session)
+	assert.Equal(t, "fred", s.Session.Namespace)
+	assert.Equal(t, []data.SessionView{{Command: "v1/pods", Filter: "blee"}}, s.Session.Views)
+	assert.Equal(t, map[string]int{"fred": 2}, s.NamespaceUsage)
+
+	bb, err := os.ReadFile(legacyCfg)
+	require.NoError(t, err)
+	assert.NotContains(t, string(bb), "session:")
+	assert.NotContains(t, string(bb), "usage:")
+
+	assert.NoFileExists(t, legacyScores)
+	ss, err := config.LoadPopeyeScores(k.ContextPopeyeScoresFile())
+	require.NoError(t, err)
+	assert.Len(t, ss.Scores, 1)
+}
@@ -6,11 +6,13 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/config/json"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
 	"gopkg.in/yaml.v2"
 )
 
@@ -36,6 +38,9 @@ type (
 		Info   Info   `json:"info" yaml:"info"`
 		Views  Views  `json:"views" yaml:"views"`
 		Dialog Dialog `json:"dialog" yaml:"dialog"`
+
+		// Overrides tracks partial styles keyed by view name, ie pods, logs or xray.
+		Overrides map[string]interface{} `json:"overrides,omitempty" yaml:"overrides,omitempty"`
 	}
 
 	// Prompt tracks command styles
@@ -439,6 +444,7 @@ func NewStyles() *Styles {
 
 // Reset resets styles.
 func (s *Styles) Reset() {
+	s.K9s.Overrides = nil
 	if err := yaml.Unmarshal(stockSkinTpl, s); err != nil {
 		s.K9s = newStyle()
 	}
@@ -530,6 +536,47 @@ func (s *Styles) Views() Views {
 	return s.K9s.Views
 }
 
+// For returns the styles of a given view with its overrides applied.
+func (s *Styles) For(view string) *Styles {
+	o, ok := s.K9s.Overrides[strings.ToLower(view)]
+	if !ok {
+		return s
+	}
+
+	var st Styles
+	bb, err := yaml.Marshal(s.K9s)
+	if err != nil {
+		return s
+	}
+	if err := yaml.Unmarshal(bb, &st.K9s); err != nil {
+		return s
+	}
+	if bb, err = yaml.Marshal(o); err != nil {
+		return s
+	}
+	if err := yaml.Unmarshal(bb, &st.K9s); err != nil {
+		log.Warn().Err(err).Msgf("Invalid style overrides for view %q", view)
+		return s
+	}
+	st.K9s.Overrides = nil
+
+	return &st
+}
+
+// LoadPalette loads a built-in palette.
+func (s *Styles) LoadPalette(name string) error {
+	bb, ok := map[string][]byte{
+		"high-contrast": highContrastTpl,
+		"colorblind":    colorblindTpl,
+	}[name]
+	if !ok {
+		return fmt.Errorf("unknown palette %q", name)
+	}
+	s.Reset()
+
+	return yaml.Unmarshal(bb, s)
+}
+
 // Load K9s configuration from file.
 func (s *Styles) Load(path string) error {
 	bb, err := os.ReadFile(path)
@@ -539,6 +586,7 @@ func (s *Styles) Load(path string) error {
 	if err := data.JSONValidator.Validate(json.SkinSchema, bb); err != nil {
 		return err
 	}
+	s.K9s.Overrides = nil
 	if err := yaml.Unmarshal(bb, s); err != nil {
 		return err
 	}
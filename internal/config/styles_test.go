@@ -84,3 +84,35 @@ Invalid type. Expected: object, given: array`,
 		})
 	}
 }
+
+func TestSkinFor(t *testing.T) {
+	s := config.NewStyles()
+	assert.Nil(t, s.Load("testdata/skins/overrides.yaml"))
+
+	assert.Same(t, s, s.For("deployments"))
+
+	pods := s.For("Pods")
+	assert.Equal(t, "#000080", pods.Table().BgColor.String())
+	assert.Equal(t, "#8b0000", pods.Prompt().BgColor.String())
+	assert.Equal(t, "#ffffff", pods.Body().FgColor.String())
+	assert.Nil(t, pods.K9s.Overrides)
+
+	logs := s.For("logs")
+	assert.Equal(t, "#90ee90", logs.Views().Log.FgColor.String())
+	assert.Equal(t, "#000000", logs.Table().BgColor.String())
+
+	assert.Equal(t, "#000000", s.Table().BgColor.String())
+	assert.Equal(t, "#87cefa", s.Views().Log.FgColor.String())
+}
+
+func TestSkinLoadPalette(t *testing.T) {
+	s := config.NewStyles()
+	assert.Nil(t, s.LoadPalette("high-contrast"))
+	assert.Equal(t, "#ffff00", s.Table().CursorBgColor.String())
+	assert.Equal(t, "#ffffff", s.Body().FgColor.String())
+
+	assert.Nil(t, s.LoadPalette("colorblind"))
+	assert.Equal(t, "#d55e00", s.Frame().Status.ErrorColor.String())
+
+	assert.Equal(t, `unknown palette "blee"`, s.LoadPalette("blee").Error())
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+const (
+	// TracingOTLP exports spans to an OTLP/HTTP collector.
+	TracingOTLP = "otlp"
+
+	// TracingFile exports spans as json lines to a file.
+	TracingFile = "file"
+
+	// DefaultTracingEndpoint tracks the default OTLP/HTTP collector address.
+	DefaultTracingEndpoint = "localhost:4318"
+)
+
+// Tracing tracks OpenTelemetry tracing options.
+type Tracing struct {
+	Enabled bool `json:"enabled" yaml:"enabled,omitempty"`
+
+	// Exporter tracks the spans exporter ie otlp or file.
+	Exporter string `json:"exporter" yaml:"exporter,omitempty"`
+
+	// Endpoint tracks the OTLP/HTTP collector host:port.
+	Endpoint string `json:"endpoint" yaml:"endpoint,omitempty"`
+
+	// Insecure disables TLS when talking to the collector.
+	Insecure bool `json:"insecure" yaml:"insecure,omitempty"`
+
+	// File tracks the spans file path for the file exporter.
+	File string `json:"file" yaml:"file,omitempty"`
+
+	// SampleRatio tracks the fraction of traces to record. Zero records all.
+	SampleRatio float64 `json:"sampleRatio" yaml:"sampleRatio,omitempty"`
+}
+
+// Validate checks the tracing options and make sure we're cool.
+func (t Tracing) Validate() Tracing {
+	if t.Exporter != TracingFile {
+		t.Exporter = TracingOTLP
+	}
+	if t.Exporter == TracingOTLP && t.Endpoint == "" {
+		t.Endpoint = DefaultTracingEndpoint
+	}
+	if t.SampleRatio <= 0 || t.SampleRatio > 1 {
+		t.SampleRatio = 1
+	}
+
+	return t
+}
@@ -3,9 +3,24 @@
 
 package config
 
+import "strings"
+
 const (
 	defaultRefreshRate  = 2
 	defaultMaxConnRetry = 5
+
+	// AnnounceStatus announces selection changes on a status line.
+	AnnounceStatus = "status"
+
+	// AnnounceOSC announces selection changes via the terminal title.
+	AnnounceOSC = "osc"
+
+	// DefaultChangesWindow tracks the number of refreshes a row must remain
+	// unchanged before being hidden in changes only mode.
+	DefaultChangesWindow = 3
+
+	// DefaultSparklineSamples tracks the number of usage samples shown in sparklines.
+	DefaultSparklineSamples = 10
 )
 
 // UI tracks ui specific configs.
@@ -34,4 +49,64 @@ type UI struct {
 
 	// DefaultsToFullScreen toggles fullscreen on views like logs, yaml, details.
 	DefaultsToFullScreen bool `json:"defaultsToFullScreen" yaml:"defaultsToFullScreen"`
+
+	// HighlightChanges toggles table cells highlighting when their value changed.
+	HighlightChanges bool `json:"highlightChanges" yaml:"highlightChanges"`
+
+	// ChangesWindow specifies the number of refreshes a row must remain unchanged
+	// before being hidden in changes only mode.
+	ChangesWindow int `json:"changesWindow" yaml:"changesWindow,omitempty"`
+
+	// ColorDepth specifies the terminal colors, ie auto, truecolor, 256 or 16.
+	// Skin colors are degraded to the closest supported colors.
+	ColorDepth string `json:"colorDepth" yaml:"colorDepth,omitempty"`
+
+	// Palette specifies a built-in palette that supersedes skins, ie high-contrast or colorblind.
+	Palette string `json:"palette" yaml:"palette,omitempty"`
+
+	// Accessible toggles the accessibility mode. Borders use plain ascii, row
+	// statuses are conveyed by markers and selection changes are announced.
+	Accessible bool `json:"accessible" yaml:"accessible,omitempty"`
+
+	// Announce specifies how selection changes are announced, ie status or osc.
+	Announce string `json:"announce" yaml:"announce,omitempty"`
+
+	// Sparklines toggles cpu and memory usage trends in pod and node views.
+	Sparklines bool `json:"sparklines" yaml:"sparklines,omitempty"`
+
+	// SparklineSamples specifies the number of usage samples kept per resource.
+	SparklineSamples int `json:"sparklineSamples" yaml:"sparklineSamples,omitempty"`
+}
+
+// GetChangesWindow returns the changes only mode refreshes window.
+func (u UI) GetChangesWindow() int {
+	if u.ChangesWindow <= 0 {
+		return DefaultChangesWindow
+	}
+
+	return u.ChangesWindow
+}
+
+// GetSparklineSamples returns the number of usage samples kept per resource.
+func (u UI) GetSparklineSamples() int {
+	if u.SparklineSamples <= 0 {
+		return DefaultSparklineSamples
+	}
+
+	return u.SparklineSamples
+}
+
+// GetColorDepth returns the terminal color depth. Unless specified, the
+// color depth is detected from the terminal environment.
+func (u UI) GetColorDepth() ColorDepth {
+	switch strings.ToLower(u.ColorDepth) {
+	case "truecolor", "24bit":
+		return ColorDepthTrue
+	case "256":
+		return ColorDepth256
+	case "16":
+		return ColorDepth16
+	default:
+		return DetectColorDepth()
+	}
 }
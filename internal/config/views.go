@@ -22,10 +22,18 @@ type ViewConfigListener interface {
 	ViewSettingsChanged(ViewSetting)
 }
 
+// jsonPathSep separates a custom column name from its jsonpath expression.
+const jsonPathSep = ":"
+
+// JSONPathColumn represents a custom column evaluated from a jsonpath expression.
+type JSONPathColumn struct {
+	Name, Path string
+}
+
 // ViewSetting represents a view configuration.
 type ViewSetting struct {
 	Columns    []string `yaml:"columns"`
-	SortColumn string   `yaml:"sortColumn"`
+	SortColumn string   `yaml:"sortColumn,omitempty"`
 }
 
 func (v *ViewSetting) HasCols() bool {
@@ -36,6 +44,46 @@ func (v *ViewSetting) IsBlank() bool {
 	return v == nil || len(v.Columns) == 0
 }
 
+// ColumnNames returns the view columns names.
+func (v *ViewSetting) ColumnNames() []string {
+	if v == nil {
+		return nil
+	}
+	cc := make([]string, 0, len(v.Columns))
+	for _, c := range v.Columns {
+		n, _ := ParseColumn(c)
+		cc = append(cc, n)
+	}
+
+	return cc
+}
+
+// JSONPathColumns returns the view columns evaluated from a jsonpath
+// expression ie IMAGE:.spec.containers[0].image.
+func (v *ViewSetting) JSONPathColumns() []JSONPathColumn {
+	if v == nil {
+		return nil
+	}
+	var cc []JSONPathColumn
+	for _, c := range v.Columns {
+		if n, p := ParseColumn(c); p != "" {
+			cc = append(cc, JSONPathColumn{Name: n, Path: p})
+		}
+	}
+
+	return cc
+}
+
+// ParseColumn splits a column spec into a name and a jsonpath expression if any.
+func ParseColumn(spec string) (string, string) {
+	n, p, ok := strings.Cut(spec, jsonPathSep)
+	if !ok {
+		return spec, ""
+	}
+
+	return strings.TrimSpace(n), strings.TrimSpace(p)
+}
+
 func (v *ViewSetting) SortCol() (string, bool, error) {
 	if v == nil || v.SortColumn == "" {
 		return "", false, fmt.Errorf("no sort column specified")
@@ -91,6 +139,45 @@ func (v *CustomView) Load(path string) error {
 	return nil
 }
 
+// ViewSettingFor returns the view configuration for a given resource if any.
+func (v *CustomView) ViewSettingFor(gvr string) (ViewSetting, bool) {
+	vs, ok := v.Views[gvr]
+
+	return vs, ok
+}
+
+// AddColumn appends a custom column to a resource view. Blank views are seeded
+// with the given default columns so the resource layout is preserved.
+func (v *CustomView) AddColumn(gvr string, defaults []string, col string) {
+	vs := v.Views[gvr]
+	if len(vs.Columns) == 0 {
+		vs.Columns = append(vs.Columns, defaults...)
+	}
+	name, _ := ParseColumn(col)
+	for i, c := range vs.Columns {
+		if n, _ := ParseColumn(c); n == name {
+			vs.Columns[i] = col
+			v.Views[gvr] = vs
+			return
+		}
+	}
+	vs.Columns = append(vs.Columns, col)
+	v.Views[gvr] = vs
+}
+
+// Save saves the views configurations to disk.
+func (v *CustomView) Save(path string) error {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	bb, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bb, data.DefaultFileMod)
+}
+
 // AddListener registers a new listener.
 func (v *CustomView) AddListener(gvr string, l ViewConfigListener) {
 	v.listeners[gvr] = l
@@ -17,3 +17,53 @@ func TestViewSettingsLoad(t *testing.T) {
 	assert.Equal(t, 1, len(cfg.Views))
 	assert.Equal(t, 4, len(cfg.Views["v1/pods"].Columns))
 }
+
+func TestParseColumn(t *testing.T) {
+	uu := map[string]struct {
+		spec, n, p string
+	}{
+		"plain": {
+			spec: "NAME",
+			n:    "NAME",
+		},
+		"jsonpath": {
+			spec: "IMAGE:.spec.containers[0].image",
+			n:    "IMAGE",
+			p:    ".spec.containers[0].image",
+		},
+		"spaces": {
+			spec: "NODE: .spec.nodeName",
+			n:    "NODE",
+			p:    ".spec.nodeName",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n, p := config.ParseColumn(u.spec)
+			assert.Equal(t, u.n, n)
+			assert.Equal(t, u.p, p)
+		})
+	}
+}
+
+func TestViewSettingJSONPathColumns(t *testing.T) {
+	vs := config.ViewSetting{
+		Columns: []string{"NAME", "IMAGE:.spec.containers[0].image", "AGE"},
+	}
+
+	assert.Equal(t, []string{"NAME", "IMAGE", "AGE"}, vs.ColumnNames())
+	assert.Equal(t, []config.JSONPathColumn{
+		{Name: "IMAGE", Path: ".spec.containers[0].image"},
+	}, vs.JSONPathColumns())
+}
+
+func TestCustomViewAddColumn(t *testing.T) {
+	cv := config.NewCustomView()
+	cv.AddColumn("v1/pods", []string{"NAME", "AGE"}, "NODE:.spec.nodeName")
+	assert.Equal(t, []string{"NAME", "AGE", "NODE:.spec.nodeName"}, cv.Views["v1/pods"].Columns)
+
+	cv.AddColumn("v1/pods", []string{"NAME"}, "NODE:.status.hostIP")
+	assert.Equal(t, []string{"NAME", "AGE", "NODE:.status.hostIP"}, cv.Views["v1/pods"].Columns)
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// Watch tracks resource watches options.
+type Watch struct {
+	Metrics bool `json:"metrics" yaml:"metrics"`
+
+	// MemoryCeiling tracks the k9s memory ceiling in MiB past which idle
+	// informers get evicted. Zero disables evictions.
+	MemoryCeiling int `json:"memoryCeiling" yaml:"memoryCeiling"`
+}
+
+// Validate checks the watch options and make sure we're cool.
+func (w Watch) Validate() Watch {
+	if w.MemoryCeiling < 0 {
+		w.MemoryCeiling = 0
+	}
+
+	return w
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package crash
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/logring"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	reportPrefix = "crash-"
+	reportExt    = ".yaml"
+	timeFmt      = "20060102-150405.000000"
+)
+
+// Report represents a k9s crash report.
+type Report struct {
+	Time    time.Time `yaml:"time"`
+	Version string    `yaml:"version"`
+	Reason  string    `yaml:"reason"`
+	Views   []string  `yaml:"views,omitempty"`
+	Stack   string    `yaml:"stack"`
+	Logs    []string  `yaml:"logs,omitempty"`
+}
+
+// NewReport returns a new crash report for a given panic.
+func NewReport(version string, reason any, stack []byte, views []string) Report {
+	return Report{
+		Time:    time.Now(),
+		Version: version,
+		Reason:  fmt.Sprintf("%v", reason),
+		Views:   views,
+		Stack:   string(stack),
+		Logs:    logring.Default.Lines(),
+	}
+}
+
+// Save writes the report into the given directory and returns its path.
+func (r Report) Save(dir string) (string, error) {
+	if err := data.EnsureFullPath(dir, data.DefaultDirMod); err != nil {
+		return "", err
+	}
+	raw, err := yaml.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, reportPrefix+r.Time.Format(timeFmt)+reportExt)
+
+	return path, os.WriteFile(path, raw, data.DefaultFileMod)
+}
+
+// Load loads a crash report from disk.
+func Load(path string) (Report, error) {
+	var r Report
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return r, err
+	}
+	if err := yaml.Unmarshal(raw, &r); err != nil {
+		return r, fmt.Errorf("invalid crash report %q: %w", path, err)
+	}
+
+	return r, nil
+}
+
+// List returns the crash reports paths in a directory, most recent first.
+func List(dir string) ([]string, error) {
+	ee, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	pp := make([]string, 0, len(ee))
+	for _, e := range ee {
+		if e.IsDir() || !IsReport(e.Name()) {
+			continue
+		}
+		pp = append(pp, filepath.Join(dir, e.Name()))
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(pp)))
+
+	return pp, nil
+}
+
+// IsReport checks if a file name looks like a crash report.
+func IsReport(name string) bool {
+	return strings.HasPrefix(name, reportPrefix) && strings.HasSuffix(name, reportExt)
+}
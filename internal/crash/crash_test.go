@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package crash_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/crash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportSaveLoad(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "crashes")
+	r := crash.NewReport("v1.0.0", "boom", []byte("goroutine 1"), []string{"pods", "logs"})
+
+	path, err := r.Save(dir)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("blee"), 0600))
+
+	pp, err := crash.List(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{path}, pp)
+
+	r1, err := crash.Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0", r1.Version)
+	assert.Equal(t, "boom", r1.Reason)
+	assert.Equal(t, "goroutine 1", r1.Stack)
+	assert.Equal(t, []string{"pods", "logs"}, r1.Views)
+}
+
+func TestListNoDir(t *testing.T) {
+	pp, err := crash.List(filepath.Join(t.TempDir(), "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, pp)
+}
@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	accessCheckReview  = "review"
+	accessCheckBinding = "binding"
+)
+
+var (
+	_ Accessor = (*AccessReview)(nil)
+
+	// reviewVerbs tracks the verbs inspected by an access review.
+	reviewVerbs = []string{
+		client.GetVerb,
+		client.ListVerb,
+		client.WatchVerb,
+		client.CreateVerb,
+		client.UpdateVerb,
+		client.PatchVerb,
+		client.DeleteVerb,
+	}
+)
+
+// AccessReview breaks down a denied access and lists the bindings granting it.
+type AccessReview struct {
+	NonResource
+}
+
+// List returns the access review breakdown for a denied access.
+func (a *AccessReview) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	denied, ok := ctx.Value(internal.KeyAccessDenied).(*client.AccessDeniedError)
+	if !ok || denied == nil {
+		return nil, errors.New("no access denial in context")
+	}
+
+	rr, err := a.review(ctx, denied)
+	if err != nil {
+		return nil, err
+	}
+	bb, err := a.grants(denied)
+	if err != nil {
+		rr = append(rr, render.AccessReviewRes{
+			Check:     accessCheckBinding,
+			Verb:      denied.Verb,
+			Resource:  denied.GVR,
+			Namespace: denied.Namespace,
+			Err:       fmt.Errorf("unable to list bindings: %w", err),
+		})
+	}
+	rr = append(rr, bb...)
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// Get returns a given access review entry.
+func (a *AccessReview) Get(ctx context.Context, path string) (runtime.Object, error) {
+	oo, err := a.List(ctx, client.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		if r, ok := o.(render.AccessReviewRes); ok && r.ID() == path {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no access review entry found for %q", path)
+}
+
+// review checks the user access to the denied resource for all common verbs.
+func (a *AccessReview) review(ctx context.Context, denied *client.AccessDeniedError) ([]render.AccessReviewRes, error) {
+	dial, err := a.getFactory().Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	c, sar := dial.AuthorizationV1().SelfSubjectAccessReviews(), client.MakeSAR(denied.Namespace, denied.GVR, denied.Name)
+
+	rr := make([]render.AccessReviewRes, 0, len(reviewVerbs))
+	for _, v := range reviewVerbs {
+		sar.Spec.ResourceAttributes.Verb = v
+		r := render.AccessReviewRes{
+			Check:     accessCheckReview,
+			Verb:      v,
+			Resource:  denied.GVR,
+			Namespace: denied.Namespace,
+		}
+		resp, err := c.Create(ctx, sar, metav1.CreateOptions{})
+		switch {
+		case err != nil:
+			r.Err = err
+		case !resp.Status.Allowed:
+			r.Reason = resp.Status.Reason
+			r.Err = errors.New("denied")
+			if resp.Status.EvaluationError != "" {
+				r.Err = errors.New(resp.Status.EvaluationError)
+			}
+		default:
+			r.Allowed, r.Reason = true, resp.Status.Reason
+		}
+		rr = append(rr, r)
+	}
+
+	return rr, nil
+}
+
+// grants lists the role bindings granting the denied access.
+func (a *AccessReview) grants(denied *client.AccessDeniedError) ([]render.AccessReviewRes, error) {
+	gvr := client.NewGVR(denied.GVR)
+	group, res := gvr.G(), gvr.R()
+	if sub := gvr.SubResource(); sub != "" {
+		res += "/" + sub
+	}
+
+	crs, err := a.clusterRoleRules()
+	if err != nil {
+		return nil, err
+	}
+	crbs, err := fetchClusterRoleBindings(a.getFactory())
+	if err != nil {
+		return nil, err
+	}
+	var rr []render.AccessReviewRes
+	for _, crb := range crbs {
+		if !rulesGrant(crs[crb.RoleRef.Name], denied.Verb, group, res) {
+			continue
+		}
+		rr = append(rr, grantRes(denied, "ClusterRoleBinding:"+crb.Name, crb.RoleRef, crb.Subjects))
+	}
+
+	ns := denied.Namespace
+	if !client.IsNamespaced(ns) {
+		return rr, nil
+	}
+	ros, err := a.roleRules(ns)
+	if err != nil {
+		return nil, err
+	}
+	rbs, err := fetchRoleBindings(a.getFactory())
+	if err != nil {
+		return nil, err
+	}
+	for _, rb := range rbs {
+		if rb.Namespace != ns {
+			continue
+		}
+		rules := ros[rb.RoleRef.Name]
+		if rb.RoleRef.Kind == "ClusterRole" {
+			rules = crs[rb.RoleRef.Name]
+		}
+		if !rulesGrant(rules, denied.Verb, group, res) {
+			continue
+		}
+		rr = append(rr, grantRes(denied, "RoleBinding:"+client.FQN(rb.Namespace, rb.Name), rb.RoleRef, rb.Subjects))
+	}
+
+	return rr, nil
+}
+
+func (a *AccessReview) clusterRoleRules() (map[string][]rbacv1.PolicyRule, error) {
+	oo, err := a.getFactory().List(crGVR, client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	mm := make(map[string][]rbacv1.PolicyRule, len(oo))
+	for _, o := range oo {
+		var cr rbacv1.ClusterRole
+		if err := fromUnstructured(o, &cr); err != nil {
+			return nil, err
+		}
+		mm[cr.Name] = cr.Rules
+	}
+
+	return mm, nil
+}
+
+func (a *AccessReview) roleRules(ns string) (map[string][]rbacv1.PolicyRule, error) {
+	oo, err := a.getFactory().List(rGVR, ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	mm := make(map[string][]rbacv1.PolicyRule, len(oo))
+	for _, o := range oo {
+		var ro rbacv1.Role
+		if err := fromUnstructured(o, &ro); err != nil {
+			return nil, err
+		}
+		mm[ro.Name] = ro.Rules
+	}
+
+	return mm, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func grantRes(denied *client.AccessDeniedError, binding string, ref rbacv1.RoleRef, ss []rbacv1.Subject) render.AccessReviewRes {
+	return render.AccessReviewRes{
+		Check:     accessCheckBinding,
+		Verb:      denied.Verb,
+		Resource:  denied.GVR,
+		Namespace: denied.Namespace,
+		Binding:   binding,
+		Role:      ref.Kind + ":" + ref.Name,
+		Subjects:  subjectNames(ss),
+	}
+}
+
+// rulesGrant checks if a set of policy rules grants a verb on a resource.
+func rulesGrant(rules []rbacv1.PolicyRule, verb, group, res string) bool {
+	for _, r := range rules {
+		if len(r.ResourceNames) > 0 {
+			continue
+		}
+		if matchRule(r.Verbs, rbacv1.VerbAll, verb) &&
+			matchRule(r.APIGroups, rbacv1.APIGroupAll, group) &&
+			matchRule(r.Resources, rbacv1.ResourceAll, res) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchRule(ss []string, all, s string) bool {
+	return slices.Contains(ss, all) || slices.Contains(ss, s)
+}
+
+func subjectNames(ss []rbacv1.Subject) string {
+	nn := make([]string, 0, len(ss))
+	for _, s := range ss {
+		n := s.Kind + ":" + s.Name
+		if s.Namespace != "" {
+			n = s.Kind + ":" + client.FQN(s.Namespace, s.Name)
+		}
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return strings.Join(nn, ",")
+}
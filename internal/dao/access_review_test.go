@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestRulesGrant(t *testing.T) {
+	uu := map[string]struct {
+		rules            []rbacv1.PolicyRule
+		verb, group, res string
+		e                bool
+	}{
+		"exact": {
+			rules: []rbacv1.PolicyRule{{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+			verb:  "list",
+			res:   "pods",
+			e:     true,
+		},
+		"wildcards": {
+			rules: []rbacv1.PolicyRule{{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}}},
+			verb:  "delete",
+			group: "apps",
+			res:   "deployments",
+			e:     true,
+		},
+		"verb": {
+			rules: []rbacv1.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+			verb:  "list",
+			res:   "pods",
+		},
+		"group": {
+			rules: []rbacv1.PolicyRule{{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"deployments"}}},
+			verb:  "list",
+			group: "apps",
+			res:   "deployments",
+		},
+		"subresource": {
+			rules: []rbacv1.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods/log"}}},
+			verb:  "get",
+			res:   "pods/log",
+			e:     true,
+		},
+		"resource-names": {
+			rules: []rbacv1.PolicyRule{{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"pods"}, ResourceNames: []string{"p1"}}},
+			verb:  "list",
+			res:   "pods",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rulesGrant(u.rules, u.verb, u.group, u.res))
+		})
+	}
+}
+
+func TestSubjectNames(t *testing.T) {
+	ss := []rbacv1.Subject{
+		{Kind: "User", Name: "fred"},
+		{Kind: "ServiceAccount", Namespace: "ns1", Name: "sa1"},
+		{Kind: "Group", Name: "devs"},
+	}
+
+	assert.Equal(t, "Group:devs,ServiceAccount:ns1/sa1,User:fred", subjectNames(ss))
+}
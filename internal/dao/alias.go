@@ -73,6 +73,9 @@ func (a *Alias) AsGVR(c string) (client.GVR, string, bool) {
 	}
 	p := cmd.NewInterpreter(exp)
 	if strings.Contains(p.Cmd(), "/") {
+		if len(strings.Fields(exp)) > 1 {
+			return client.NewGVR(p.Cmd()), exp, true
+		}
 		return client.NewGVR(p.Cmd()), "", true
 	}
 	if gvr, ok := a.Aliases.Get(p.Cmd()); ok {
@@ -19,11 +19,13 @@ func TestAsGVR(t *testing.T) {
 	a := dao.NewAlias(makeFactory())
 	a.Aliases.Define("v1/pods", "po", "pod", "pods")
 	a.Aliases.Define("workloads", "workloads", "workload", "wkl")
+	a.Aliases.Define("v1/pods -l app=$1", "wp")
 
 	uu := map[string]struct {
 		cmd string
 		ok  bool
 		gvr client.GVR
+		exp string
 	}{
 		"ok": {
 			cmd: "pods",
@@ -42,16 +44,24 @@ func TestAsGVR(t *testing.T) {
 			cmd: "wkl",
 			ok:  true,
 			gvr: client.NewGVR("workloads"),
+			exp: "workloads",
+		},
+		"alias-args": {
+			cmd: "wp",
+			ok:  true,
+			gvr: client.NewGVR("v1/pods"),
+			exp: "v1/pods -l app=$1",
 		},
 	}
 
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			gvr, _, ok := a.AsGVR(u.cmd)
+			gvr, exp, ok := a.AsGVR(u.cmd)
 			assert.Equal(t, u.ok, ok)
 			if u.ok {
 				assert.Equal(t, u.gvr, gvr)
+				assert.Equal(t, u.exp, exp)
 			}
 		})
 	}
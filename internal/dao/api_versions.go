@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// resourcesForFunc returns the resources served by a given group version.
+type resourcesForFunc func(gv string) (*metav1.APIResourceList, error)
+
+// ResourceVersions returns all the api versions serving a given resource along
+// with the server preferred version.
+func (m *Meta) ResourceVersions(f Factory, gvr client.GVR) (client.GVRs, string, error) {
+	if f.Client() == nil || !f.Client().ConnectionOK() {
+		return nil, "", errors.New("no api server connection")
+	}
+	dial, err := f.Client().CachedDiscovery()
+	if err != nil {
+		return nil, "", err
+	}
+	gg, err := dial.ServerGroups()
+	if err != nil {
+		return nil, "", err
+	}
+
+	vv, pref := versionsFor(gg, dial.ServerResourcesForGroupVersion, gvr)
+
+	return vv, pref, nil
+}
+
+// RegisterVersion registers an alternate version of a known resource so it
+// can be viewed like the preferred one.
+func (m *Meta) RegisterVersion(gvr, alt client.GVR) error {
+	if _, err := m.MetaFor(alt); err == nil {
+		return nil
+	}
+	meta, err := m.MetaFor(gvr)
+	if err != nil {
+		return err
+	}
+	meta.Version = alt.V()
+	m.RegisterMeta(alt.String(), meta)
+
+	return nil
+}
+
+// versionsFor returns the versions serving a resource in a given api group.
+func versionsFor(gg *metav1.APIGroupList, resFn resourcesForFunc, gvr client.GVR) (client.GVRs, string) {
+	var (
+		vv   client.GVRs
+		pref string
+	)
+	for _, g := range gg.Groups {
+		if g.Name != gvr.G() {
+			continue
+		}
+		pref = g.PreferredVersion.Version
+		for _, v := range g.Versions {
+			rl, err := resFn(v.GroupVersion)
+			if err != nil {
+				log.Debug().Err(err).Msgf("Unable to list %s resources", v.GroupVersion)
+				continue
+			}
+			if slices.ContainsFunc(rl.APIResources, func(r metav1.APIResource) bool {
+				return r.Name == gvr.R()
+			}) {
+				vv = append(vv, client.FromGVAndR(v.GroupVersion, gvr.R()))
+			}
+		}
+	}
+
+	return vv, pref
+}
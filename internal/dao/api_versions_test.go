@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVersionsFor(t *testing.T) {
+	gg := metav1.APIGroupList{
+		Groups: []metav1.APIGroup{
+			{
+				Name: "fred.io",
+				Versions: []metav1.GroupVersionForDiscovery{
+					{GroupVersion: "fred.io/v1", Version: "v1"},
+					{GroupVersion: "fred.io/v1beta1", Version: "v1beta1"},
+					{GroupVersion: "fred.io/v1alpha1", Version: "v1alpha1"},
+				},
+				PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "fred.io/v1", Version: "v1"},
+			},
+			{
+				Name: "blee.io",
+				Versions: []metav1.GroupVersionForDiscovery{
+					{GroupVersion: "blee.io/v1", Version: "v1"},
+				},
+			},
+		},
+	}
+	resFn := func(gv string) (*metav1.APIResourceList, error) {
+		switch gv {
+		case "fred.io/v1", "fred.io/v1beta1":
+			return &metav1.APIResourceList{
+				GroupVersion: gv,
+				APIResources: []metav1.APIResource{{Name: "widgets", Kind: "Widget"}},
+			}, nil
+		case "fred.io/v1alpha1":
+			return &metav1.APIResourceList{GroupVersion: gv}, nil
+		default:
+			return nil, errors.New("boom")
+		}
+	}
+
+	uu := map[string]struct {
+		gvr  client.GVR
+		vv   client.GVRs
+		pref string
+	}{
+		"multi": {
+			gvr:  client.NewGVR("fred.io/v1/widgets"),
+			vv:   client.GVRs{client.NewGVR("fred.io/v1/widgets"), client.NewGVR("fred.io/v1beta1/widgets")},
+			pref: "v1",
+		},
+		"toast": {
+			gvr: client.NewGVR("blee.io/v1/widgets"),
+		},
+		"none": {
+			gvr: client.NewGVR("zorg.io/v1/widgets"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vv, pref := versionsFor(&gg, resFn, u.gvr)
+			assert.Equal(t, u.vv, vv)
+			assert.Equal(t, u.pref, pref)
+		})
+	}
+}
+
+func TestMetaRegisterVersion(t *testing.T) {
+	m := NewMeta()
+	gvr, alt := client.NewGVR("fred.io/v1/widgets"), client.NewGVR("fred.io/v1beta1/widgets")
+	assert.Error(t, m.RegisterVersion(gvr, alt))
+
+	m.RegisterMeta(gvr.String(), metav1.APIResource{Name: "widgets", Kind: "Widget", Group: "fred.io", Version: "v1", Namespaced: true})
+	assert.NoError(t, m.RegisterVersion(gvr, alt))
+
+	meta, err := m.MetaFor(alt)
+	assert.NoError(t, err)
+	assert.Equal(t, metav1.APIResource{Name: "widgets", Kind: "Widget", Group: "fred.io", Version: "v1beta1", Namespaced: true}, meta)
+}
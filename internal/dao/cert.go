@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// CertificateGVR tracks cert-manager certificates.
+	CertificateGVR = "cert-manager.io/v1/certificates"
+
+	secretKind      = "Secret"
+	certificateKind = "Certificate"
+)
+
+var _ Accessor = (*Cert)(nil)
+
+// Cert tracks tls secrets and cert-manager certificates expiry.
+type Cert struct {
+	NonResource
+}
+
+// List returns certificates sorted by soonest expiry.
+func (c *Cert) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	cc, err := c.tlsSecrets(ns)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := MetaAccess.MetaFor(client.NewGVR(CertificateGVR)); err == nil {
+		certs, err := c.certificates(ns)
+		if err != nil {
+			return nil, err
+		}
+		cc = append(cc, certs...)
+	}
+	sort.SliceStable(cc, func(i, j int) bool {
+		return cc[i].NotAfter.Before(cc[j].NotAfter)
+	})
+
+	oo := make([]runtime.Object, 0, len(cc))
+	for _, c := range cc {
+		oo = append(oo, c)
+	}
+
+	return oo, nil
+}
+
+// Get returns a given certificate.
+func (c *Cert) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, fmt.Errorf("nyi")
+}
+
+func (c *Cert) tlsSecrets(ns string) ([]render.CertRes, error) {
+	oo, err := c.getFactory().List("v1/secrets", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	cc := make([]render.CertRes, 0, len(oo))
+	for _, o := range oo {
+		var sec v1.Secret
+		if err := fromUnstructured(o, &sec); err != nil {
+			return nil, err
+		}
+		if sec.Type != v1.SecretTypeTLS {
+			continue
+		}
+		na, err := render.CertNotAfter(sec.Data[v1.TLSCertKey])
+		if err != nil {
+			log.Debug().Err(err).Msgf("Unable to parse tls secret %s", client.FQN(sec.Namespace, sec.Name))
+			continue
+		}
+		cc = append(cc, render.CertRes{
+			Namespace: sec.Namespace,
+			Name:      sec.Name,
+			Kind:      secretKind,
+			NotAfter:  na,
+		})
+	}
+
+	return cc, nil
+}
+
+func (c *Cert) certificates(ns string) ([]render.CertRes, error) {
+	oo, err := c.getFactory().List(CertificateGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	cc := make([]render.CertRes, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		na, err := render.CertificateNotAfter(u)
+		if err != nil || na == nil {
+			continue
+		}
+		cc = append(cc, render.CertRes{
+			Namespace: u.GetNamespace(),
+			Name:      u.GetName(),
+			Kind:      certificateKind,
+			NotAfter:  *na,
+		})
+	}
+
+	return cc, nil
+}
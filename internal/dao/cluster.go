@@ -11,7 +11,6 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
-	"github.com/rs/zerolog/log"
 )
 
 // RefScanner represents a resource reference scanner.
@@ -25,8 +24,9 @@ type RefScanner interface {
 
 // Ref represents a resource reference.
 type Ref struct {
-	GVR string
-	FQN string
+	GVR   string
+	FQN   string
+	Usage string
 }
 
 // Refs represents a collection of resource references.
@@ -38,7 +38,7 @@ var (
 	_ RefScanner = (*DaemonSet)(nil)
 	_ RefScanner = (*Job)(nil)
 	_ RefScanner = (*CronJob)(nil)
-	// _ RefScanner = (*Pod)(nil)
+	_ RefScanner = (*Pod)(nil)
 )
 
 func scanners() map[string]RefScanner {
@@ -48,7 +48,7 @@ func scanners() map[string]RefScanner {
 		"apps/v1/daemonsets":   &DaemonSet{},
 		"batch/v1/jobs":        &Job{},
 		"batch/v1/cronjobs":    &CronJob{},
-		// "v1/pods":              &Pod{},
+		"v1/pods":              &Pod{},
 	}
 }
 
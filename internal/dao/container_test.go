@@ -17,7 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/version"
-	"k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -45,20 +45,23 @@ func makeConn() *conn {
 	return &conn{}
 }
 
-func (c *conn) Config() *client.Config                                { return nil }
-func (c *conn) Dial() (kubernetes.Interface, error)                   { return nil, nil }
-func (c *conn) DialLogs() (kubernetes.Interface, error)               { return nil, nil }
-func (c *conn) ConnectionOK() bool                                    { return true }
-func (c *conn) SwitchContext(ctx string) error                        { return nil }
-func (c *conn) CachedDiscovery() (*disk.CachedDiscoveryClient, error) { return nil, nil }
-func (c *conn) RestConfig() (*restclient.Config, error)               { return nil, nil }
-func (c *conn) MXDial() (*versioned.Clientset, error)                 { return nil, nil }
-func (c *conn) DynDial() (dynamic.Interface, error)                   { return nil, nil }
-func (c *conn) HasMetrics() bool                                      { return false }
-func (c *conn) CheckConnectivity() bool                               { return false }
-func (c *conn) IsNamespaced(n string) bool                            { return false }
-func (c *conn) SupportsResource(group string) bool                    { return false }
-func (c *conn) ValidNamespaces() ([]v1.Namespace, error)              { return nil, nil }
+func (c *conn) Config() *client.Config                                       { return nil }
+func (c *conn) Dial() (kubernetes.Interface, error)                          { return nil, nil }
+func (c *conn) DialLogs() (kubernetes.Interface, error)                      { return nil, nil }
+func (c *conn) ConnectionOK() bool                                           { return true }
+func (c *conn) SwitchContext(ctx string) error                               { return nil }
+func (c *conn) Impersonate(string, []string) error                           { return nil }
+func (c *conn) Relogin(string) error                                         { return nil }
+func (c *conn) CachedDiscovery() (discovery.CachedDiscoveryInterface, error) { return nil, nil }
+func (c *conn) RestConfig() (*restclient.Config, error)                      { return nil, nil }
+func (c *conn) MXDial() (*versioned.Clientset, error)                        { return nil, nil }
+func (c *conn) DynDial() (dynamic.Interface, error)                          { return nil, nil }
+func (c *conn) HasMetrics() bool                                             { return false }
+func (c *conn) HasMetricsAPI() bool                                          { return false }
+func (c *conn) CheckConnectivity() bool                                      { return false }
+func (c *conn) IsNamespaced(n string) bool                                   { return false }
+func (c *conn) SupportsResource(group string) bool                           { return false }
+func (c *conn) ValidNamespaces() ([]v1.Namespace, error)                     { return nil, nil }
 func (c *conn) SupportsRes(grp string, versions []string) (string, bool, error) {
 	return "", false, nil
 }
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// serverFields tracks server populated fields stripped from copied resources
+// by kind.
+var serverFields = map[string][][]string{
+	"Service": {
+		{"spec", "clusterIP"},
+		{"spec", "clusterIPs"},
+		{"spec", "healthCheckNodePort"},
+	},
+	"PersistentVolumeClaim": {
+		{"spec", "volumeName"},
+	},
+	"Pod": {
+		{"spec", "nodeName"},
+	},
+	"Job": {
+		{"spec", "selector"},
+		{"spec", "template", "metadata", "labels", "controller-uid"},
+		{"spec", "template", "metadata", "labels", "batch.kubernetes.io/controller-uid"},
+	},
+}
+
+// CopyOf returns a copy of a resource stripped of its server side fields and
+// relocated to a given namespace. Cluster scoped resources are left in place.
+func CopyOf(o *unstructured.Unstructured, ns string) *unstructured.Unstructured {
+	c := o.DeepCopy()
+	for _, f := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds", "selfLink", "managedFields", "ownerReferences"} {
+		unstructured.RemoveNestedField(c.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(c.Object, "metadata", "annotations", lastAppliedAnnotation)
+	if len(c.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(c.Object, "metadata", "annotations")
+	}
+	unstructured.RemoveNestedField(c.Object, "status")
+	for _, ff := range serverFields[c.GetKind()] {
+		unstructured.RemoveNestedField(c.Object, ff...)
+	}
+	if c.GetNamespace() != "" && ns != "" {
+		c.SetNamespace(ns)
+	}
+
+	return c
+}
+
+// CopyManifest returns a multi documents manifest of the resources copies.
+func CopyManifest(oo []*unstructured.Unstructured, ns string) ([]byte, error) {
+	if len(oo) == 0 {
+		return nil, errors.New("no resources to copy")
+	}
+
+	var (
+		buff bytes.Buffer
+		p    printers.YAMLPrinter
+	)
+	for _, o := range oo {
+		if err := p.PrintObj(CopyOf(o, ns), &buff); err != nil {
+			return nil, err
+		}
+	}
+
+	return buff.Bytes(), nil
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCopyOf(t *testing.T) {
+	uu := map[string]struct {
+		o  map[string]interface{}
+		ns string
+		e  map[string]interface{}
+	}{
+		"service": {
+			o: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata": map[string]interface{}{
+					"name":              "svc1",
+					"namespace":         "ns1",
+					"uid":               "123",
+					"resourceVersion":   "10",
+					"creationTimestamp": "2024-01-01T00:00:00Z",
+					"managedFields":     []interface{}{},
+					"annotations": map[string]interface{}{
+						"kubectl.kubernetes.io/last-applied-configuration": "{}",
+					},
+					"labels": map[string]interface{}{"app": "fred"},
+				},
+				"spec": map[string]interface{}{
+					"clusterIP":  "10.0.0.1",
+					"clusterIPs": []interface{}{"10.0.0.1"},
+					"selector":   map[string]interface{}{"app": "fred"},
+				},
+				"status": map[string]interface{}{},
+			},
+			ns: "ns2",
+			e: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata": map[string]interface{}{
+					"name":      "svc1",
+					"namespace": "ns2",
+					"labels":    map[string]interface{}{"app": "fred"},
+				},
+				"spec": map[string]interface{}{
+					"selector": map[string]interface{}{"app": "fred"},
+				},
+			},
+		},
+		"cluster-scoped": {
+			o: map[string]interface{}{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind":       "ClusterRole",
+				"metadata": map[string]interface{}{
+					"name": "cr1",
+					"uid":  "123",
+				},
+			},
+			ns: "ns2",
+			e: map[string]interface{}{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind":       "ClusterRole",
+				"metadata": map[string]interface{}{
+					"name": "cr1",
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: u.o}
+			assert.Equal(t, u.e, dao.CopyOf(&o, u.ns).Object)
+			assert.Contains(t, o.Object, "metadata")
+		})
+	}
+}
+
+func TestCopyManifest(t *testing.T) {
+	cm := func(n string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": n, "namespace": "ns1", "uid": "1"},
+		}}
+	}
+
+	bb, err := dao.CopyManifest([]*unstructured.Unstructured{cm("cm1"), cm("cm2")}, "ns2")
+	assert.NoError(t, err)
+	assert.Equal(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+  namespace: ns2
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+  namespace: ns2
+`, string(bb))
+
+	_, err = dao.CopyManifest(nil, "ns2")
+	assert.Error(t, err)
+}
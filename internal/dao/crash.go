@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/crash"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*Crash)(nil)
+	_ Nuker    = (*Crash)(nil)
+)
+
+// Crash represents k9s crash reports.
+type Crash struct {
+	NonResource
+}
+
+// Delete a crash report.
+func (c *Crash) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
+	return os.Remove(path)
+}
+
+// List returns a collection of crash reports.
+func (c *Crash) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	dir, ok := ctx.Value(internal.KeyDir).(string)
+	if !ok {
+		return nil, errors.New("no crashes dir found in context")
+	}
+	pp, err := crash.List(dir)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, p := range pp {
+		r, err := crash.Load(p)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping crash report %q", p)
+			continue
+		}
+		oo = append(oo, render.CrashRes{Path: p, Report: r})
+	}
+
+	return oo, nil
+}
+
+// Get returns a given crash report.
+func (c *Crash) Get(_ context.Context, path string) (runtime.Object, error) {
+	if !crash.IsReport(filepath.Base(path)) {
+		return nil, fmt.Errorf("not a crash report %q", path)
+	}
+	r, err := crash.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return render.CrashRes{Path: path, Report: r}, nil
+}
@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	schemaObject  = "object"
+	schemaArray   = "array"
+	schemaString  = "string"
+	schemaInteger = "integer"
+	schemaNumber  = "number"
+	schemaBoolean = "boolean"
+)
+
+// SchemaIssue represents a custom resource schema violation.
+type SchemaIssue struct {
+	Path    string
+	Field   string
+	Message string
+}
+
+// String returns the issue as a string.
+func (s SchemaIssue) String() string {
+	return s.Path + ": " + s.Message
+}
+
+// CRDSchema returns a custom resource openAPI schema if any.
+func CRDSchema(f Factory, gvr client.GVR) (*apiextv1.JSONSchemaProps, error) {
+	v, err := crdVersion(f, gvr)
+	if err != nil || v == nil || v.Schema == nil {
+		return nil, err
+	}
+
+	return v.Schema.OpenAPIV3Schema, nil
+}
+
+// CREditPreview computes a custom resource edit diff along with the edited
+// manifest schema violations.
+func CREditPreview(f Factory, gvr client.GVR, path string, before, after []byte) (string, []SchemaIssue, error) {
+	diff, err := unifiedDiff(path, string(before), string(after))
+	if err != nil {
+		return "", nil, err
+	}
+	s, err := CRDSchema(f, gvr)
+	if err != nil || s == nil {
+		return diff, nil, err
+	}
+	ii, err := ValidateSchema(s, after)
+
+	return diff, ii, err
+}
+
+// ValidateSchema checks a custom resource manifest for unknown fields and type
+// mismatches against its schema.
+func ValidateSchema(s *apiextv1.JSONSchemaProps, raw []byte) ([]SchemaIssue, error) {
+	var o map[string]interface{}
+	if err := yaml.Unmarshal(raw, &o); err != nil {
+		return nil, err
+	}
+	// Object metadata is validated by the api server.
+	delete(o, "metadata")
+
+	return validateValue(s, o, "", "", nil), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func crdVersion(f Factory, gvr client.GVR) (*apiextv1.CustomResourceDefinitionVersion, error) {
+	if gvr.G() == "" {
+		return nil, nil
+	}
+	o, err := f.Get(crdGVR, gvr.R()+"."+gvr.G(), false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var crd apiextv1.CustomResourceDefinition
+	if err := fromUnstructured(o, &crd); err != nil {
+		return nil, err
+	}
+	for i := range crd.Spec.Versions {
+		if crd.Spec.Versions[i].Name == gvr.V() {
+			return &crd.Spec.Versions[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func validateValue(s *apiextv1.JSONSchemaProps, v interface{}, path, field string, ii []SchemaIssue) []SchemaIssue {
+	if v == nil {
+		return ii
+	}
+	if s.XIntOrString {
+		if !isInteger(v) && !isString(v) {
+			ii = append(ii, typeIssue(path, field, "integer or string", v))
+		}
+		return ii
+	}
+
+	switch s.Type {
+	case schemaObject:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return append(ii, typeIssue(path, field, s.Type, v))
+		}
+		return validateObject(s, m, path, ii)
+	case schemaArray:
+		aa, ok := v.([]interface{})
+		if !ok {
+			return append(ii, typeIssue(path, field, s.Type, v))
+		}
+		if s.Items == nil || s.Items.Schema == nil {
+			return ii
+		}
+		for i, a := range aa {
+			ii = validateValue(s.Items.Schema, a, path+"["+strconv.Itoa(i)+"]", field, ii)
+		}
+	case schemaString:
+		if !isString(v) {
+			ii = append(ii, typeIssue(path, field, s.Type, v))
+		}
+	case schemaInteger:
+		if !isInteger(v) {
+			ii = append(ii, typeIssue(path, field, s.Type, v))
+		}
+	case schemaNumber:
+		if _, ok := v.(float64); !ok {
+			ii = append(ii, typeIssue(path, field, s.Type, v))
+		}
+	case schemaBoolean:
+		if _, ok := v.(bool); !ok {
+			ii = append(ii, typeIssue(path, field, s.Type, v))
+		}
+	}
+
+	return ii
+}
+
+func validateObject(s *apiextv1.JSONSchemaProps, m map[string]interface{}, path string, ii []SchemaIssue) []SchemaIssue {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	for _, k := range kk {
+		p := k
+		if path != "" {
+			p = path + "." + k
+		}
+		if ps, ok := s.Properties[k]; ok {
+			ii = validateValue(&ps, m[k], p, k, ii)
+			continue
+		}
+		switch {
+		case s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil:
+			ii = validateValue(s.AdditionalProperties.Schema, m[k], p, k, ii)
+		case s.AdditionalProperties != nil && s.AdditionalProperties.Allows:
+		case s.XPreserveUnknownFields != nil && *s.XPreserveUnknownFields:
+		default:
+			ii = append(ii, SchemaIssue{Path: p, Field: k, Message: "unknown field"})
+		}
+	}
+
+	return ii
+}
+
+func typeIssue(path, field, expected string, v interface{}) SchemaIssue {
+	return SchemaIssue{
+		Path:    path,
+		Field:   field,
+		Message: fmt.Sprintf("expected %s but got %s", expected, jsonType(v)),
+	}
+}
+
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}:
+		return schemaObject
+	case []interface{}:
+		return schemaArray
+	case string:
+		return schemaString
+	case bool:
+		return schemaBoolean
+	case float64:
+		if isInteger(v) {
+			return schemaInteger
+		}
+		return schemaNumber
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func isString(v interface{}) bool {
+	_, ok := v.(string)
+
+	return ok
+}
+
+func isInteger(v interface{}) bool {
+	f, ok := v.(float64)
+
+	return ok && f == float64(int64(f))
+}
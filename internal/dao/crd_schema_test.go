@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestValidateSchema(t *testing.T) {
+	uu := map[string]struct {
+		raw    string
+		issues []string
+		err    bool
+	}{
+		"happy": {
+			raw: `
+apiVersion: fred.io/v1
+kind: Fred
+metadata:
+  name: fred
+  labels:
+    a: b
+spec:
+  replicas: 2
+  port: http
+  ratio: 0.5
+  enabled: true
+  hosts:
+  - a.com
+  env:
+    A: "1"
+  extra:
+    whatever: true
+`,
+		},
+		"unknown": {
+			raw: `
+apiVersion: fred.io/v1
+kind: Fred
+spec:
+  replica: 2
+  hosts:
+  - a.com
+`,
+			issues: []string{"spec.replica: unknown field"},
+		},
+		"mismatch": {
+			raw: `
+apiVersion: fred.io/v1
+kind: Fred
+spec:
+  replicas: "2"
+  port: true
+  ratio: fast
+  enabled: "yes"
+  hosts: a.com
+  env:
+    A: 1
+`,
+			issues: []string{
+				"spec.enabled: expected boolean but got string",
+				"spec.env.A: expected string but got integer",
+				"spec.hosts: expected array but got string",
+				"spec.port: expected integer or string but got boolean",
+				"spec.ratio: expected number but got string",
+				"spec.replicas: expected integer but got string",
+			},
+		},
+		"items": {
+			raw: `
+spec:
+  hosts:
+  - a.com
+  - 10
+`,
+			issues: []string{"spec.hosts[1]: expected string but got integer"},
+		},
+		"toast": {
+			raw: "spec: [",
+			err: true,
+		},
+	}
+
+	s := makeSchema()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ii, err := ValidateSchema(s, []byte(u.raw))
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			ss := make([]string, 0, len(ii))
+			for _, i := range ii {
+				ss = append(ss, i.String())
+			}
+			assert.Equal(t, len(u.issues), len(ss))
+			if len(u.issues) > 0 {
+				assert.Equal(t, u.issues, ss)
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func makeSchema() *apiextv1.JSONSchemaProps {
+	preserve := true
+
+	return &apiextv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextv1.JSONSchemaProps{
+			"apiVersion": {Type: "string"},
+			"kind":       {Type: "string"},
+			"metadata":   {Type: "object"},
+			"spec": {
+				Type: "object",
+				Properties: map[string]apiextv1.JSONSchemaProps{
+					"replicas": {Type: "integer"},
+					"port":     {XIntOrString: true},
+					"ratio":    {Type: "number"},
+					"enabled":  {Type: "boolean"},
+					"hosts": {
+						Type:  "array",
+						Items: &apiextv1.JSONSchemaPropsOrArray{Schema: &apiextv1.JSONSchemaProps{Type: "string"}},
+					},
+					"env": {
+						Type:                 "object",
+						AdditionalProperties: &apiextv1.JSONSchemaPropsOrBool{Schema: &apiextv1.JSONSchemaProps{Type: "string"}},
+					},
+					"extra": {
+						Type:                   "object",
+						XPreserveUnknownFields: &preserve,
+					},
+				},
+			},
+		},
+	}
+}
@@ -10,7 +10,6 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
-	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -47,6 +46,9 @@ func (c *CronJob) ListImages(ctx context.Context, fqn string) ([]string, error)
 
 // Run a CronJob.
 func (c *CronJob) Run(path string) error {
+	if err := ensureWritable("trigger", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	auth, err := c.Client().CanI(ns, jobGVR, n, []string{client.GetVerb, client.CreateVerb})
 	if err != nil {
@@ -143,6 +145,9 @@ func (c *CronJob) GetInstance(fqn string) (*batchv1.CronJob, error) {
 
 // ToggleSuspend toggles suspend/resume on a CronJob.
 func (c *CronJob) ToggleSuspend(ctx context.Context, path string) error {
+	if err := ensureWritable("suspend", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	auth, err := c.Client().CanI(ns, c.GVR(), n, []string{client.GetVerb, client.UpdateVerb})
 	if err != nil {
@@ -189,25 +194,28 @@ func (c *CronJob) Scan(ctx context.Context, gvr client.GVR, fqn string, wait boo
 		}
 		switch gvr {
 		case CmGVR:
-			if !hasConfigMap(&cj.Spec.JobTemplate.Spec.Template.Spec, n) {
+			usage := configMapUsage(&cj.Spec.JobTemplate.Spec.Template.Spec, n)
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: c.GVR(),
-				FQN: client.FQN(cj.Namespace, cj.Name),
+				GVR:   c.GVR(),
+				FQN:   client.FQN(cj.Namespace, cj.Name),
+				Usage: usage,
 			})
 		case SecGVR:
-			found, err := hasSecret(c.Factory, &cj.Spec.JobTemplate.Spec.Template.Spec, cj.Namespace, n, wait)
+			usage, err := secretUsage(c.Factory, &cj.Spec.JobTemplate.Spec.Template.Spec, cj.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("locate secret %q", fqn)
 				continue
 			}
-			if !found {
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: c.GVR(),
-				FQN: client.FQN(cj.Namespace, cj.Name),
+				GVR:   c.GVR(),
+				FQN:   client.FQN(cj.Namespace, cj.Name),
+				Usage: usage,
 			})
 		case PcGVR:
 			if !hasPC(&cj.Spec.JobTemplate.Spec.Template.Spec, n) {
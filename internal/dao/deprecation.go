@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Deprecation)(nil)
+
+// Deprecation represents the api server deprecation warnings.
+type Deprecation struct {
+	NonResource
+}
+
+// List returns the deprecation warnings issued so far.
+func (d *Deprecation) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	dd := d.getFactory().Client().Config().Deprecations().List()
+	oo := make([]runtime.Object, 0, len(dd))
+	for _, dep := range dd {
+		oo = append(oo, render.DeprecationRes{Deprecation: dep})
+	}
+
+	return oo, nil
+}
+
+// Get returns the deprecation warning for a given resource.
+func (d *Deprecation) Get(_ context.Context, path string) (runtime.Object, error) {
+	dep, ok := d.getFactory().Client().Config().Deprecations().Lookup(path)
+	if !ok {
+		return nil, fmt.Errorf("no deprecation found for %q", path)
+	}
+
+	return render.DeprecationRes{Deprecation: dep}, nil
+}
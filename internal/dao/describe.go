@@ -5,7 +5,6 @@ package dao
 
 import (
 	"github.com/derailed/k9s/internal/client"
-	"github.com/rs/zerolog/log"
 	"k8s.io/kubectl/pkg/describe"
 )
 
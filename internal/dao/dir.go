@@ -6,6 +6,7 @@ package dao
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -15,6 +16,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 )
 
 var _ Accessor = (*Dir)(nil)
@@ -31,7 +33,11 @@ func NewDir(f Factory) *Dir {
 	return &a
 }
 
-var yamlRX = regexp.MustCompile(`.*\.(yml|yaml|json)`)
+var (
+	yamlRX = regexp.MustCompile(`.*\.(yml|yaml|json)`)
+
+	kustomizeFiles = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+)
 
 // List returns a collection of aliases.
 func (a *Dir) List(ctx context.Context, _ string) ([]runtime.Object, error) {
@@ -50,9 +56,11 @@ func (a *Dir) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 		if strings.HasPrefix(f.Name(), ".") || !f.IsDir() && !yamlRX.MatchString(f.Name()) {
 			continue
 		}
+		path := filepath.Join(dir, f.Name())
 		oo = append(oo, render.DirRes{
-			Path:  filepath.Join(dir, f.Name()),
-			Entry: f,
+			Path:       path,
+			Entry:      f,
+			Kustomized: f.IsDir() && IsKustomized(path),
 		})
 	}
 
@@ -63,3 +71,58 @@ func (a *Dir) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 func (a *Dir) Get(_ context.Context, _ string) (runtime.Object, error) {
 	return nil, errors.New("NYI!!")
 }
+
+// IsKustomized checks if a directory holds a kustomization.
+func IsKustomized(dir string) bool {
+	return kustomizationFile(dir) != ""
+}
+
+// KustomizeLabels returns the labels a kustomization applies to all its
+// resources.
+func KustomizeLabels(dir string) (map[string]string, error) {
+	file := kustomizationFile(dir)
+	if file == "" {
+		return nil, fmt.Errorf("no kustomization found in %s", dir)
+	}
+	bb, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var k struct {
+		CommonLabels map[string]string `json:"commonLabels"`
+		Labels       []struct {
+			Pairs            map[string]string `json:"pairs"`
+			IncludeSelectors bool              `json:"includeSelectors"`
+		} `json:"labels"`
+	}
+	if err := yaml.Unmarshal(bb, &k); err != nil {
+		return nil, err
+	}
+	ll := make(map[string]string, len(k.CommonLabels))
+	for key, v := range k.CommonLabels {
+		ll[key] = v
+	}
+	for _, l := range k.Labels {
+		for key, v := range l.Pairs {
+			ll[key] = v
+		}
+	}
+
+	return ll, nil
+}
+
+func kustomizationFile(dir string) string {
+	ff, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+	for _, f := range ff {
+		for _, k := range kustomizeFiles {
+			if f.Name() == k {
+				return filepath.Join(dir, k)
+			}
+		}
+	}
+
+	return ""
+}
@@ -20,3 +20,34 @@ func TestNewDir(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(oo))
 }
+
+func TestKustomizeLabels(t *testing.T) {
+	uu := map[string]struct {
+		dir string
+		e   map[string]string
+		err string
+	}{
+		"kustomized": {
+			dir: "testdata/kustomize",
+			e:   map[string]string{"app": "fred", "team": "blee"},
+		},
+		"plain": {
+			dir: "testdata/dir",
+			err: "no kustomization found in testdata/dir",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.err == "", dao.IsKustomized(u.dir))
+			ll, err := dao.KustomizeLabels(u.dir)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, ll)
+		})
+	}
+}
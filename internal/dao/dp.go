@@ -7,10 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
-	"github.com/rs/zerolog/log"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -51,6 +52,9 @@ func (d *Deployment) ListImages(ctx context.Context, fqn string) ([]string, erro
 
 // Scale a Deployment.
 func (d *Deployment) Scale(ctx context.Context, path string, replicas int32) error {
+	if err := ensureWritable("scale", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	auth, err := d.Client().CanI(ns, "apps/v1/deployments:scale", n, []string{client.GetVerb, client.UpdateVerb})
 	if err != nil {
@@ -76,6 +80,9 @@ func (d *Deployment) Scale(ctx context.Context, path string, replicas int32) err
 
 // Restart a Deployment rollout.
 func (d *Deployment) Restart(ctx context.Context, path string) error {
+	if err := ensureWritable("restart", path); err != nil {
+		return err
+	}
 	o, err := d.getFactory().Get("apps/v1/deployments", path, true, labels.Everything())
 	if err != nil {
 		return err
@@ -205,25 +212,28 @@ func (d *Deployment) Scan(ctx context.Context, gvr client.GVR, fqn string, wait
 		}
 		switch gvr {
 		case CmGVR:
-			if !hasConfigMap(&dp.Spec.Template.Spec, n) {
+			usage := configMapUsage(&dp.Spec.Template.Spec, n)
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: d.GVR(),
-				FQN: client.FQN(dp.Namespace, dp.Name),
+				GVR:   d.GVR(),
+				FQN:   client.FQN(dp.Namespace, dp.Name),
+				Usage: usage,
 			})
 		case SecGVR:
-			found, err := hasSecret(d.Factory, &dp.Spec.Template.Spec, dp.Namespace, n, wait)
+			usage, err := secretUsage(d.Factory, &dp.Spec.Template.Spec, dp.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("scanning secret %q", fqn)
 				continue
 			}
-			if !found {
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: d.GVR(),
-				FQN: client.FQN(dp.Namespace, dp.Name),
+				GVR:   d.GVR(),
+				FQN:   client.FQN(dp.Namespace, dp.Name),
+				Usage: usage,
 			})
 		case PvcGVR:
 			if !hasPVC(&dp.Spec.Template.Spec, n) {
@@ -260,6 +270,9 @@ func (d *Deployment) GetPodSpec(path string) (*v1.PodSpec, error) {
 
 // SetImages sets container images.
 func (d *Deployment) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) error {
+	if err := ensureWritable("set image", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	auth, err := d.Client().CanI(ns, "apps/v1/deployments", n, client.PatchAccess)
 	if err != nil {
@@ -299,75 +312,117 @@ func hasPC(spec *v1.PodSpec, name string) bool {
 	return spec.PriorityClassName == name
 }
 
-func hasConfigMap(spec *v1.PodSpec, name string) bool {
-	for _, c := range spec.InitContainers {
-		if containerHasConfigMap(c, name) {
-			return true
-		}
+const (
+	usageEnv         = "env"
+	usageEnvFrom     = "envFrom"
+	usageVolume      = "volume"
+	usageProjected   = "projected"
+	usageImagePull   = "imagePull"
+	usageServiceAcct = "serviceAccount"
+)
+
+// usageSet tracks how a resource is referenced by a pod spec.
+type usageSet map[string]struct{}
+
+func (u usageSet) add(s string) {
+	u[s] = struct{}{}
+}
+
+// String returns the sorted usages.
+func (u usageSet) String() string {
+	ss := make([]string, 0, len(u))
+	for k := range u {
+		ss = append(ss, k)
 	}
-	for _, c := range spec.Containers {
-		if containerHasConfigMap(c, name) {
-			return true
-		}
+	sort.Strings(ss)
+
+	return strings.Join(ss, ",")
+}
+
+// podContainers returns all containers including init and ephemeral ones.
+func podContainers(spec *v1.PodSpec) []v1.Container {
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers)+len(spec.EphemeralContainers))
+	cc = append(cc, spec.InitContainers...)
+	cc = append(cc, spec.Containers...)
+	for _, ec := range spec.EphemeralContainers {
+		cc = append(cc, v1.Container(ec.EphemeralContainerCommon))
 	}
 
+	return cc
+}
+
+// configMapUsage returns how a pod spec references a configmap if at all.
+func configMapUsage(spec *v1.PodSpec, name string) string {
+	uu := make(usageSet)
+	for _, c := range podContainers(spec) {
+		containerConfigMapUsage(c, name, uu)
+	}
 	for _, v := range spec.Volumes {
-		if cm := v.VolumeSource.ConfigMap; cm != nil {
-			if cm.LocalObjectReference.Name == name {
-				return true
+		if cm := v.VolumeSource.ConfigMap; cm != nil && cm.LocalObjectReference.Name == name {
+			uu.add(usageVolume)
+		}
+		if p := v.VolumeSource.Projected; p != nil {
+			for _, src := range p.Sources {
+				if src.ConfigMap != nil && src.ConfigMap.Name == name {
+					uu.add(usageProjected)
+				}
 			}
 		}
 	}
-	return false
+
+	return uu.String()
 }
 
-// BOZO !! Need to deal with ephemeral containers.
-func hasSecret(f Factory, spec *v1.PodSpec, ns, name string, wait bool) (bool, error) {
-	for _, c := range spec.InitContainers {
-		if containerHasSecret(c, name) {
-			return true, nil
-		}
+// secretUsage returns how a pod spec references a secret if at all.
+func secretUsage(f Factory, spec *v1.PodSpec, ns, name string, wait bool) (string, error) {
+	uu := make(usageSet)
+	for _, c := range podContainers(spec) {
+		containerSecretUsage(c, name, uu)
 	}
-	for _, c := range spec.Containers {
-		if containerHasSecret(c, name) {
-			return true, nil
+	for _, s := range spec.ImagePullSecrets {
+		if s.Name == name {
+			uu.add(usageImagePull)
 		}
 	}
 
-	saName := spec.ServiceAccountName
-	if saName != "" {
+	if saName := spec.ServiceAccountName; saName != "" {
 		o, err := f.Get("v1/serviceaccounts", client.FQN(ns, saName), wait, labels.Everything())
 		if err != nil {
-			return false, err
+			return "", err
 		}
 
 		var sa v1.ServiceAccount
 		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &sa)
 		if err != nil {
-			return false, errors.New("expecting ServiceAccount resource")
+			return "", errors.New("expecting ServiceAccount resource")
 		}
-
 		for _, ref := range sa.Secrets {
-			if ref.Namespace == ns && ref.Name == name {
-				return true, nil
+			if (ref.Namespace == "" || ref.Namespace == ns) && ref.Name == name {
+				uu.add(usageServiceAcct)
 			}
 		}
 	}
 
 	for _, v := range spec.Volumes {
-		if sec := v.VolumeSource.Secret; sec != nil {
-			if sec.SecretName == name {
-				return true, nil
+		if sec := v.VolumeSource.Secret; sec != nil && sec.SecretName == name {
+			uu.add(usageVolume)
+		}
+		if p := v.VolumeSource.Projected; p != nil {
+			for _, src := range p.Sources {
+				if src.Secret != nil && src.Secret.Name == name {
+					uu.add(usageProjected)
+				}
 			}
 		}
 	}
-	return false, nil
+
+	return uu.String(), nil
 }
 
-func containerHasSecret(c v1.Container, name string) bool {
+func containerSecretUsage(c v1.Container, name string, uu usageSet) {
 	for _, e := range c.EnvFrom {
 		if e.SecretRef != nil && e.SecretRef.Name == name {
-			return true
+			uu.add(usageEnvFrom)
 		}
 	}
 	for _, e := range c.Env {
@@ -375,17 +430,15 @@ func containerHasSecret(c v1.Container, name string) bool {
 			continue
 		}
 		if e.ValueFrom.SecretKeyRef.Name == name {
-			return true
+			uu.add(usageEnv)
 		}
 	}
-
-	return false
 }
 
-func containerHasConfigMap(c v1.Container, name string) bool {
+func containerConfigMapUsage(c v1.Container, name string, uu usageSet) {
 	for _, e := range c.EnvFrom {
 		if e.ConfigMapRef != nil && e.ConfigMapRef.Name == name {
-			return true
+			uu.add(usageEnvFrom)
 		}
 	}
 	for _, e := range c.Env {
@@ -393,9 +446,7 @@ func containerHasConfigMap(c v1.Container, name string) bool {
 			continue
 		}
 		if e.ValueFrom.ConfigMapKeyRef.Name == name {
-			return true
+			uu.add(usageEnv)
 		}
 	}
-
-	return false
 }
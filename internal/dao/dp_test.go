@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestConfigMapUsage(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    string
+	}{
+		"none": {},
+		"env": {
+			spec: v1.PodSpec{
+				Containers: []v1.Container{
+					{
+						Env: []v1.EnvVar{
+							{
+								Name: "a",
+								ValueFrom: &v1.EnvVarSource{
+									ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+										LocalObjectReference: v1.LocalObjectReference{Name: "cm1"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			e: "env",
+		},
+		"multi": {
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{
+					{
+						EnvFrom: []v1.EnvFromSource{
+							{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+						},
+					},
+				},
+				Volumes: []v1.Volume{
+					{
+						VolumeSource: v1.VolumeSource{
+							ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}},
+						},
+					},
+					{
+						VolumeSource: v1.VolumeSource{
+							Projected: &v1.ProjectedVolumeSource{
+								Sources: []v1.VolumeProjection{
+									{ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+								},
+							},
+						},
+					},
+				},
+			},
+			e: "envFrom,projected,volume",
+		},
+		"other": {
+			spec: v1.PodSpec{
+				Volumes: []v1.Volume{
+					{
+						VolumeSource: v1.VolumeSource{
+							ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm2"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, configMapUsage(&u.spec, "cm1"))
+		})
+	}
+}
+
+func TestSecretUsage(t *testing.T) {
+	spec := v1.PodSpec{
+		ImagePullSecrets: []v1.LocalObjectReference{{Name: "s1"}},
+		EphemeralContainers: []v1.EphemeralContainer{
+			{
+				EphemeralContainerCommon: v1.EphemeralContainerCommon{
+					EnvFrom: []v1.EnvFromSource{
+						{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}}},
+					},
+				},
+			},
+		},
+		Volumes: []v1.Volume{
+			{
+				VolumeSource: v1.VolumeSource{
+					Secret: &v1.SecretVolumeSource{SecretName: "s1"},
+				},
+			},
+		},
+	}
+
+	usage, err := secretUsage(nil, &spec, "default", "s1", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "envFrom,imagePull,volume", usage)
+}
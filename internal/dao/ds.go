@@ -5,15 +5,16 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/watch"
-	"github.com/rs/zerolog/log"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -21,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/kubectl/pkg/polymorphichelpers"
 	"k8s.io/kubectl/pkg/scheme"
@@ -53,6 +55,9 @@ func (d *DaemonSet) ListImages(ctx context.Context, fqn string) ([]string, error
 
 // Restart a DaemonSet rollout.
 func (d *DaemonSet) Restart(ctx context.Context, path string) error {
+	if err := ensureWritable("restart", path); err != nil {
+		return err
+	}
 	o, err := d.getFactory().Get("apps/v1/daemonsets", path, true, labels.Everything())
 	if err != nil {
 		return err
@@ -166,6 +171,115 @@ func (d *DaemonSet) Pod(fqn string) (string, error) {
 	return podFromSelector(d.Factory, ds.Namespace, ds.Spec.Selector.MatchLabels)
 }
 
+// MaxUnavailable returns a DaemonSet rolling update max unavailable pods.
+func MaxUnavailable(ds *appsv1.DaemonSet) string {
+	if ru := ds.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.MaxUnavailable != nil {
+		return ru.MaxUnavailable.String()
+	}
+
+	return "1"
+}
+
+// SetMaxUnavailable sets a DaemonSet rolling update max unavailable pods
+// either as a count or a percentage of the desired pods ie 2 or 25%.
+func (d *DaemonSet) SetMaxUnavailable(ctx context.Context, path, v string) error {
+	if err := ensureWritable("set max unavailable", path); err != nil {
+		return err
+	}
+	mu := intstr.Parse(v)
+	if n, err := intstr.GetScaledValueFromIntOrPercent(&mu, 100, true); err != nil || n < 0 {
+		return fmt.Errorf("invalid max unavailable %q. Expecting a count or a percentage", v)
+	}
+	ds, err := d.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	if ds.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+		return fmt.Errorf("daemonset %s uses an %s update strategy", path, ds.Spec.UpdateStrategy.Type)
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := d.Client().CanI(ns, "apps/v1/daemonsets", n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch a daemonset")
+	}
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"updateStrategy": map[string]any{
+				"rollingUpdate": map[string]any{
+					"maxUnavailable": mu,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	dial, err := d.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.AppsV1().DaemonSets(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		patch,
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
+
+// Nodes returns the sorted names of the nodes running the DaemonSet pods.
+func (d *DaemonSet) Nodes(path string) ([]string, error) {
+	ds, err := d.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+	pp, err := ownedPods(d.Factory, ds.Namespace, ds.Spec.Selector, ds.UID)
+	if err != nil {
+		return nil, err
+	}
+	nn := make([]string, 0, len(pp))
+	for _, p := range pp {
+		if p.Spec.NodeName != "" && !slices.Contains(nn, p.Spec.NodeName) {
+			nn = append(nn, p.Spec.NodeName)
+		}
+	}
+	slices.Sort(nn)
+
+	return nn, nil
+}
+
+// RestartNode deletes the DaemonSet pod running on the given node and waits
+// for its replacement to be ready.
+func (d *DaemonSet) RestartNode(ctx context.Context, path, node string) error {
+	if err := ensureWritable("restart", path); err != nil {
+		return err
+	}
+	ds, err := d.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	pp, err := ownedPods(d.Factory, ds.Namespace, ds.Spec.Selector, ds.UID)
+	if err != nil {
+		return err
+	}
+	for _, p := range pp {
+		if p.Spec.NodeName != node {
+			continue
+		}
+		d.Forwarders().Kill(client.FQN(p.Namespace, p.Name))
+		return recyclePod(ctx, d.Client(), p, ds.Spec.Selector, func(p1 *v1.Pod) bool {
+			return p1.Spec.NodeName == node
+		})
+	}
+
+	return fmt.Errorf("no daemonset %s pod found on node %s", path, node)
+}
+
 // GetInstance returns a daemonset instance.
 func (d *DaemonSet) GetInstance(fqn string) (*appsv1.DaemonSet, error) {
 	o, err := d.getFactory().Get(d.gvrStr(), fqn, true, labels.Everything())
@@ -225,25 +339,28 @@ func (d *DaemonSet) Scan(ctx context.Context, gvr client.GVR, fqn string, wait b
 		}
 		switch gvr {
 		case CmGVR:
-			if !hasConfigMap(&ds.Spec.Template.Spec, n) {
+			usage := configMapUsage(&ds.Spec.Template.Spec, n)
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: d.GVR(),
-				FQN: client.FQN(ds.Namespace, ds.Name),
+				GVR:   d.GVR(),
+				FQN:   client.FQN(ds.Namespace, ds.Name),
+				Usage: usage,
 			})
 		case SecGVR:
-			found, err := hasSecret(d.Factory, &ds.Spec.Template.Spec, ds.Namespace, n, wait)
+			usage, err := secretUsage(d.Factory, &ds.Spec.Template.Spec, ds.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("locate secret %q", fqn)
 				continue
 			}
-			if !found {
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: d.GVR(),
-				FQN: client.FQN(ds.Namespace, ds.Name),
+				GVR:   d.GVR(),
+				FQN:   client.FQN(ds.Namespace, ds.Name),
+				Usage: usage,
 			})
 		case PvcGVR:
 			if !hasPVC(&ds.Spec.Template.Spec, n) {
@@ -279,6 +396,9 @@ func (d *DaemonSet) GetPodSpec(path string) (*v1.PodSpec, error) {
 
 // SetImages sets container images.
 func (d *DaemonSet) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) error {
+	if err := ensureWritable("set image", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	auth, err := d.Client().CanI(ns, "apps/v1/daemonset", n, client.PatchAccess)
 	if err != nil {
@@ -0,0 +1,269 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// MaxFindResults tracks the maximum number of search results.
+	MaxFindResults = 500
+
+	findListLimit = 500
+
+	findSourceCache = "cache"
+	findSourceList  = "list"
+)
+
+var (
+	_ Accessor = (*Find)(nil)
+
+	// findFields tracks the resource fields inspected by a search.
+	findFields = [][]string{
+		{"spec", "nodeName"},
+		{"spec", "serviceAccountName"},
+		{"spec", "clusterIP"},
+		{"spec", "volumeName"},
+		{"status", "podIP"},
+		{"status", "hostIP"},
+	}
+
+	// findContainers tracks the resource containers specs inspected by a search.
+	findContainers = [][]string{
+		{"spec", "containers"},
+		{"spec", "template", "spec", "containers"},
+		{"spec", "jobTemplate", "spec", "template", "spec", "containers"},
+	}
+
+	// findSkip tracks noisy resources excluded from uncached searches.
+	findSkip = []string{"v1/events", "events.k8s.io/v1/events"}
+)
+
+// informerStatter represents a factory reporting its informers.
+type informerStatter interface {
+	Stats() watch.FactoryStats
+}
+
+// Find represents a cluster wide resources search.
+type Find struct {
+	NonResource
+}
+
+// List returns the resources matching the search query ranked by relevance.
+func (f *Find) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	q, ok := ctx.Value(internal.KeyQuery).(string)
+	if !ok || q == "" {
+		return nil, errors.New("no search query in context")
+	}
+	st, ok := f.getFactory().(informerStatter)
+	if !ok {
+		return nil, errors.New("expecting a factory reporting its informers")
+	}
+
+	rr, cached := f.findCached(strings.ToLower(q), st.Stats())
+	if all, _ := ctx.Value(internal.KeyListUncached).(bool); all {
+		rr = append(rr, f.findUncached(ctx, strings.ToLower(q), ns, cached)...)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		if rr[i].Score != rr[j].Score {
+			return rr[i].Score > rr[j].Score
+		}
+		return rr[i].ID() < rr[j].ID()
+	})
+	if len(rr) > MaxFindResults {
+		rr = rr[:MaxFindResults]
+	}
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// Get returns a search result.
+func (f *Find) Get(ctx context.Context, path string) (runtime.Object, error) {
+	oo, err := f.List(ctx, client.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		if r, ok := o.(render.FindRes); ok && r.ID() == path {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no search result found for %q", path)
+}
+
+// findCached searches the synced informers caches.
+func (f *Find) findCached(q string, stats watch.FactoryStats) ([]render.FindRes, map[string]struct{}) {
+	var (
+		rr     []render.FindRes
+		seen   = make(map[string]struct{})
+		cached = make(map[string]struct{})
+	)
+	for _, i := range stats.Informers {
+		if !i.Synced {
+			continue
+		}
+		cached[i.GVR] = struct{}{}
+		inf, err := f.getFactory().ForResource(i.Namespace, i.GVR)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Find skipping informer %s:%s", i.Namespace, i.GVR)
+			continue
+		}
+		oo, err := inf.Lister().List(labels.Everything())
+		if err != nil {
+			continue
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			if r, ok := matchResource(q, i.GVR, u); ok {
+				if _, dup := seen[r.ID()]; dup {
+					continue
+				}
+				seen[r.ID()] = struct{}{}
+				r.Source = findSourceCache
+				rr = append(rr, r)
+			}
+		}
+	}
+
+	return rr, cached
+}
+
+// findUncached issues namespace scoped list calls for resources without informers.
+func (f *Find) findUncached(ctx context.Context, q, ns string, cached map[string]struct{}) []render.FindRes {
+	dial, err := f.getFactory().Client().DynDial()
+	if err != nil {
+		log.Error().Err(err).Msg("Find dial failed")
+		return nil
+	}
+	ns = client.CleanseNamespace(ns)
+
+	var rr []render.FindRes
+	for _, gvr := range MetaAccess.AllGVRs() {
+		if _, ok := cached[gvr.String()]; ok || slices.Contains(findSkip, gvr.String()) {
+			continue
+		}
+		meta, err := MetaAccess.MetaFor(gvr)
+		if err != nil || !IsK8sMeta(meta) || !slices.Contains(meta.Verbs, client.ListVerb) {
+			continue
+		}
+		if meta.Namespaced && client.IsNamespaced(ns) {
+			if ok, err := f.getFactory().Client().CanI(ns, gvr.String(), "", client.ListAccess); !ok || err != nil {
+				continue
+			}
+		}
+		res := dial.Resource(gvr.GVR())
+		var ll *unstructured.UnstructuredList
+		if meta.Namespaced {
+			ll, err = res.Namespace(ns).List(ctx, metav1.ListOptions{Limit: findListLimit})
+		} else {
+			ll, err = res.List(ctx, metav1.ListOptions{Limit: findListLimit})
+		}
+		if err != nil {
+			log.Debug().Err(err).Msgf("Find list failed for %s", gvr)
+			continue
+		}
+		for i := range ll.Items {
+			if r, ok := matchResource(q, gvr.String(), &ll.Items[i]); ok {
+				r.Source = findSourceList
+				rr = append(rr, r)
+			}
+		}
+	}
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// matchResource checks if a resource name, labels or selected fields match
+// a query and ranks the best match.
+func matchResource(q, gvr string, u *unstructured.Unstructured) (render.FindRes, bool) {
+	r := render.FindRes{
+		GVR:       gvr,
+		Namespace: u.GetNamespace(),
+		Name:      u.GetName(),
+	}
+	name := strings.ToLower(u.GetName())
+	switch {
+	case name == q:
+		r.Match, r.Value, r.Score = "name", u.GetName(), 100
+		return r, true
+	case strings.HasPrefix(name, q):
+		r.Match, r.Value, r.Score = "name", u.GetName(), 80
+		return r, true
+	case strings.Contains(name, q):
+		r.Match, r.Value, r.Score = "name", u.GetName(), 60
+		return r, true
+	}
+
+	for _, k := range sortedKeys(u.GetLabels()) {
+		v := u.GetLabels()[k]
+		if strings.Contains(strings.ToLower(k), q) || strings.Contains(strings.ToLower(v), q) {
+			r.Match, r.Value, r.Score = "label", k+"="+v, 40
+			return r, true
+		}
+	}
+
+	for _, ff := range findFields {
+		v, ok, _ := unstructured.NestedString(u.Object, ff...)
+		if ok && strings.Contains(strings.ToLower(v), q) {
+			r.Match, r.Value, r.Score = strings.Join(ff, "."), v, 20
+			return r, true
+		}
+	}
+	for _, ff := range findContainers {
+		cc, ok, _ := unstructured.NestedSlice(u.Object, ff...)
+		if !ok {
+			continue
+		}
+		for _, c := range cc {
+			co, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			img, _, _ := unstructured.NestedString(co, "image")
+			if strings.Contains(strings.ToLower(img), q) {
+				r.Match, r.Value, r.Score = "image", img, 20
+				return r, true
+			}
+		}
+	}
+
+	return r, false
+}
+
+func sortedKeys(m map[string]string) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMatchResource(t *testing.T) {
+	uu := map[string]struct {
+		q  string
+		ok bool
+		e  render.FindRes
+	}{
+		"exact": {
+			q:  "fred",
+			ok: true,
+			e:  render.FindRes{Match: "name", Value: "fred", Score: 100},
+		},
+		"prefix": {
+			q:  "fr",
+			ok: true,
+			e:  render.FindRes{Match: "name", Value: "fred", Score: 80},
+		},
+		"contains": {
+			q:  "re",
+			ok: true,
+			e:  render.FindRes{Match: "name", Value: "fred", Score: 60},
+		},
+		"label": {
+			q:  "blee",
+			ok: true,
+			e:  render.FindRes{Match: "label", Value: "app=blee", Score: 40},
+		},
+		"field": {
+			q:  "node-1",
+			ok: true,
+			e:  render.FindRes{Match: "spec.nodeName", Value: "node-1", Score: 20},
+		},
+		"image": {
+			q:  "nginx",
+			ok: true,
+			e:  render.FindRes{Match: "image", Value: "nginx:1.25", Score: 20},
+		},
+		"none": {
+			q: "zorg",
+		},
+	}
+
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":      "fred",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "blee"},
+		},
+		"spec": map[string]interface{}{
+			"nodeName": "node-1",
+			"containers": []interface{}{
+				map[string]interface{}{"name": "c1", "image": "nginx:1.25"},
+			},
+		},
+	}}
+
+	for k := range uu {
+		u1 := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, ok := matchResource(u1.q, "v1/pods", &u)
+			assert.Equal(t, u1.ok, ok)
+			if !ok {
+				return
+			}
+			u1.e.GVR, u1.e.Namespace, u1.e.Name = "v1/pods", "default", "fred"
+			assert.Equal(t, u1.e, r)
+		})
+	}
+}
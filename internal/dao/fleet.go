@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	certv1 "k8s.io/api/certificates/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+// failingPodsSelector selects pods neither running nor completed.
+const failingPodsSelector = "status.phase!=Running,status.phase!=Succeeded"
+
+var _ Accessor = (*Fleet)(nil)
+
+// fleetConns tracks the fleet contexts connections.
+var fleetConns = struct {
+	dials map[string]kubernetes.Interface
+	mx    sync.Mutex
+}{
+	dials: make(map[string]kubernetes.Interface),
+}
+
+// Fleet represents a health summary of several contexts.
+type Fleet struct {
+	NonResource
+}
+
+// List returns the health summary of the fleet contexts.
+func (f *Fleet) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	conn := f.getFactory().Client()
+	if conn == nil {
+		return nil, errors.New("no client connection")
+	}
+	cc, err := fleetContexts(ctx, conn.Config())
+	if err != nil {
+		return nil, err
+	}
+	active, _ := conn.Config().CurrentContextName()
+
+	rr := make([]render.FleetRes, len(cc))
+	var wg sync.WaitGroup
+	wg.Add(len(cc))
+	for i, c := range cc {
+		go func(i int, c string) {
+			defer wg.Done()
+			rr[i] = contextHealth(ctx, conn.Config(), c)
+			rr[i].Active = c == active
+		}(i, c)
+	}
+	wg.Wait()
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// Get returns a given context health summary.
+func (f *Fleet) Get(ctx context.Context, path string) (runtime.Object, error) {
+	conn := f.getFactory().Client()
+	if conn == nil {
+		return nil, errors.New("no client connection")
+	}
+
+	return contextHealth(ctx, conn.Config(), path), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// fleetContexts returns the configured fleet contexts or all contexts if none.
+func fleetContexts(ctx context.Context, cfg *client.Config) ([]string, error) {
+	if cc, ok := ctx.Value(internal.KeyFleet).([]string); ok && len(cc) > 0 {
+		return cc, nil
+	}
+	ctxs, err := cfg.Contexts()
+	if err != nil {
+		return nil, err
+	}
+	cc := make([]string, 0, len(ctxs))
+	for k := range ctxs {
+		cc = append(cc, k)
+	}
+	sort.Strings(cc)
+
+	return cc, nil
+}
+
+func contextHealth(ctx context.Context, cfg *client.Config, name string) render.FleetRes {
+	r := render.FleetRes{Context: name}
+	if ct, err := cfg.GetContext(name); err == nil {
+		r.Cluster = ct.Cluster
+	}
+	dial, err := fleetDial(cfg, name)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.CallTimeout())
+	defer cancel()
+
+	return fleetHealth(ctx, dial, r)
+}
+
+// fleetDial returns a lightweight connection to a given context.
+func fleetDial(cfg *client.Config, name string) (kubernetes.Interface, error) {
+	fleetConns.mx.Lock()
+	defer fleetConns.mx.Unlock()
+
+	if dial, ok := fleetConns.dials[name]; ok {
+		return dial, nil
+	}
+	rc, err := cfg.ContextRESTConfig(name)
+	if err != nil {
+		return nil, err
+	}
+	dial, err := kubernetes.NewForConfig(rc)
+	if err != nil {
+		return nil, err
+	}
+	fleetConns.dials[name] = dial
+
+	return dial, nil
+}
+
+// fleetHealth summarizes nodes readiness, failing pods and pending CSRs.
+// Resources the user can't list are reported as unknown.
+func fleetHealth(ctx context.Context, dial kubernetes.Interface, r render.FleetRes) render.FleetRes {
+	r.Nodes, r.NodesReady, r.FailingPods, r.PendingCSRs = -1, -1, -1, -1
+
+	rev, err := dial.Discovery().ServerVersion()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	r.Version = rev.GitVersion
+
+	var errs []error
+	if nn, err := dial.CoreV1().Nodes().List(ctx, metav1.ListOptions{}); err == nil {
+		r.Nodes, r.NodesReady = len(nn.Items), 0
+		for i := range nn.Items {
+			if isNodeReady(&nn.Items[i]) {
+				r.NodesReady++
+			}
+		}
+	} else if !apierrors.IsForbidden(err) {
+		errs = append(errs, err)
+	}
+
+	pp, err := dial.CoreV1().Pods(client.BlankNamespace).List(ctx, metav1.ListOptions{FieldSelector: failingPodsSelector})
+	if err == nil {
+		r.FailingPods = len(pp.Items)
+	} else if !apierrors.IsForbidden(err) {
+		errs = append(errs, err)
+	}
+
+	if cc, err := dial.CertificatesV1().CertificateSigningRequests().List(ctx, metav1.ListOptions{}); err == nil {
+		r.PendingCSRs = 0
+		for i := range cc.Items {
+			if isCSRPending(&cc.Items[i]) {
+				r.PendingCSRs++
+			}
+		}
+	} else if !apierrors.IsForbidden(err) {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		r.Err = fmt.Errorf("health check failed: %w", errors.Join(errs...))
+	}
+
+	return r
+}
+
+func isNodeReady(no *v1.Node) bool {
+	for _, c := range no.Status.Conditions {
+		if c.Type == v1.NodeReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// isCSRPending checks if a CSR was neither approved, denied nor failed.
+func isCSRPending(csr *certv1.CertificateSigningRequest) bool {
+	for _, c := range csr.Status.Conditions {
+		switch c.Type {
+		case certv1.CertificateApproved, certv1.CertificateDenied, certv1.CertificateFailed:
+			return false
+		}
+	}
+
+	return true
+}
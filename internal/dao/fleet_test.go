@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	certv1 "k8s.io/api/certificates/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestFleetHealth(t *testing.T) {
+	oo := []runtime.Object{
+		makeFleetNode("n1", v1.ConditionTrue),
+		makeFleetNode("n2", v1.ConditionFalse),
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+			Status:     v1.PodStatus{Phase: v1.PodPending},
+		},
+		&certv1.CertificateSigningRequest{ObjectMeta: metav1.ObjectMeta{Name: "csr1"}},
+		&certv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "csr2"},
+			Status: certv1.CertificateSigningRequestStatus{
+				Conditions: []certv1.CertificateSigningRequestCondition{{Type: certv1.CertificateApproved}},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		forbid string
+		fail   string
+		e      render.FleetRes
+		err    bool
+	}{
+		"plain": {
+			e: render.FleetRes{Context: "fred", Nodes: 2, NodesReady: 1, FailingPods: 1, PendingCSRs: 1},
+		},
+		"forbidden": {
+			forbid: "certificatesigningrequests",
+			e:      render.FleetRes{Context: "fred", Nodes: 2, NodesReady: 1, FailingPods: 1, PendingCSRs: -1},
+		},
+		"failed": {
+			fail: "pods",
+			e:    render.FleetRes{Context: "fred", Nodes: 2, NodesReady: 1, FailingPods: -1, PendingCSRs: 1},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dial := fake.NewSimpleClientset(oo...)
+			if u.forbid != "" {
+				dial.PrependReactor("list", u.forbid, func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: u.forbid}, "", errors.New("denied"))
+				})
+			}
+			if u.fail != "" {
+				dial.PrependReactor("list", u.fail, func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("boom")
+				})
+			}
+			r := fleetHealth(context.Background(), dial, render.FleetRes{Context: "fred"})
+			assert.Equal(t, u.err, r.Err != nil)
+			r.Err, r.Version = nil, ""
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
+
+// Helpers...
+
+func makeFleetNode(n string, s v1.ConditionStatus) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: n},
+		Status: v1.NodeStatus{
+			Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: s}},
+		},
+	}
+}
@@ -12,7 +12,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
 )
 
 type Grace int64
@@ -28,7 +30,10 @@ const (
 	NowGrace Grace = 1
 )
 
-var _ Describer = (*Generic)(nil)
+var (
+	_ Describer = (*Generic)(nil)
+	_ Patcher   = (*Generic)(nil)
+)
 
 // Generic represents a generic resource.
 type Generic struct {
@@ -43,20 +48,17 @@ func (g *Generic) List(ctx context.Context, ns string) ([]runtime.Object, error)
 		ns = client.BlankNamespace
 	}
 
-	var (
-		ll  *unstructured.UnstructuredList
-		err error
-	)
 	dial, err := g.dynClient()
 	if err != nil {
 		return nil, err
 	}
 
-	if client.IsClusterScoped(ns) {
-		ll, err = dial.List(ctx, metav1.ListOptions{LabelSelector: labelSel})
-	} else {
-		ll, err = dial.Namespace(ns).List(ctx, metav1.ListOptions{LabelSelector: labelSel})
-	}
+	ll, err := pagedList(ctx, ns, metav1.ListOptions{LabelSelector: labelSel}, func(opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+		if client.IsClusterScoped(ns) {
+			return dial.List(ctx, opts)
+		}
+		return dial.Namespace(ns).List(ctx, opts)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +107,9 @@ func (g *Generic) ToYAML(path string, showManaged bool) (string, error) {
 
 // Delete deletes a resource.
 func (g *Generic) Delete(ctx context.Context, path string, propagation *metav1.DeletionPropagation, grace Grace) error {
+	if err := ensureWritable("delete", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	auth, err := g.Client().CanI(ns, g.gvrStr(), n, []string{client.DeleteVerb})
 	if err != nil {
@@ -136,6 +141,68 @@ func (g *Generic) Delete(ctx context.Context, path string, propagation *metav1.D
 	return dial.Namespace(ns).Delete(ctx, n, opts)
 }
 
+// Patch applies a merge patch to a resource.
+func (g *Generic) Patch(ctx context.Context, path string, data []byte) error {
+	if err := ensureWritable("patch", path); err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvrStr(), n, []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.Client().Config().CallTimeout())
+	defer cancel()
+	if client.IsClusterScoped(ns) {
+		_, err = dial.Patch(ctx, n, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}
+	_, err = dial.Namespace(ns).Patch(ctx, n, types.MergePatchType, data, metav1.PatchOptions{})
+
+	return err
+}
+
+// Update replaces a resource with the given manifest.
+func (g *Generic) Update(ctx context.Context, path string, raw []byte) error {
+	if err := ensureWritable("update", path); err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvrStr(), n, []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s", path)
+	}
+
+	var u unstructured.Unstructured
+	if err := yaml.Unmarshal(raw, &u.Object); err != nil {
+		return err
+	}
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.Client().Config().CallTimeout())
+	defer cancel()
+	if client.IsClusterScoped(ns) {
+		_, err = dial.Update(ctx, &u, metav1.UpdateOptions{})
+		return err
+	}
+	_, err = dial.Namespace(ns).Update(ctx, &u, metav1.UpdateOptions{})
+
+	return err
+}
+
 func (g *Generic) dynClient() (dynamic.NamespaceableResourceInterface, error) {
 	dial, err := g.Client().DynDial()
 	if err != nil {
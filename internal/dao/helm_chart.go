@@ -7,15 +7,18 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render/helm"
-	"github.com/rs/zerolog/log"
+	"github.com/pmezard/go-difflib/difflib"
 	"gopkg.in/yaml.v2"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	k8syaml "sigs.k8s.io/yaml"
 )
 
 var (
@@ -85,6 +88,73 @@ func (h *HelmChart) GetValues(path string, allValues bool) ([]byte, error) {
 	return yaml.Marshal(resp)
 }
 
+// UpgradeDiff performs a dry-run upgrade of a release with the given values
+// and returns the resulting values and manifest diff.
+func (h *HelmChart) UpgradeDiff(ctx context.Context, path string, values []byte) (string, error) {
+	cur, next, err := h.upgrade(ctx, path, values, true)
+	if err != nil {
+		return "", err
+	}
+	curVals, err := yaml.Marshal(cur.Config)
+	if err != nil {
+		return "", err
+	}
+	vd, err := unifiedDiff("values", string(curVals), string(values))
+	if err != nil {
+		return "", err
+	}
+	md, err := unifiedDiff("manifest", cur.Manifest, next.Manifest)
+	if err != nil {
+		return "", err
+	}
+	if vd == "" && md == "" {
+		return "", nil
+	}
+
+	return vd + md, nil
+}
+
+// Upgrade upgrades a release in place using the given values.
+func (h *HelmChart) Upgrade(ctx context.Context, path string, values []byte) error {
+	if err := ensureWritable("upgrade", path); err != nil {
+		return err
+	}
+	_, _, err := h.upgrade(ctx, path, values, false)
+
+	return err
+}
+
+// upgrade upgrades a release to the same chart version with new values.
+func (h *HelmChart) upgrade(ctx context.Context, path string, values []byte, dryRun bool) (*release.Release, *release.Release, error) {
+	var vals map[string]interface{}
+	if err := k8syaml.Unmarshal(values, &vals); err != nil {
+		return nil, nil, fmt.Errorf("invalid values: %w", err)
+	}
+	ns, n := client.Namespaced(path)
+	flags := h.Client().Config().Flags()
+	flags.Namespace = &ns
+	cfg, err := ensureHelmConfig(flags, ns)
+	if err != nil {
+		return nil, nil, err
+	}
+	cur, err := action.NewGet(cfg).Run(n)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := action.NewUpgrade(cfg)
+	u.Namespace = ns
+	if dryRun {
+		u.DryRun, u.DryRunOption = true, "server"
+	}
+	next, err := u.RunWithContext(ctx, n, cur.Chart, vals)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cur, next, nil
+}
+
 // Describe returns the chart notes.
 func (h *HelmChart) Describe(path string) (string, error) {
 	ns, n := client.Namespaced(path)
@@ -122,6 +192,9 @@ func (h *HelmChart) Delete(_ context.Context, path string, _ *metav1.DeletionPro
 
 // Uninstall uninstalls a HelmChart.
 func (h *HelmChart) Uninstall(path string, keepHist bool) error {
+	if err := ensureWritable("uninstall", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	flags := h.Client().Config().Flags()
 	flags.Namespace = &ns
@@ -143,6 +216,20 @@ func (h *HelmChart) Uninstall(path string, keepHist bool) error {
 	return nil
 }
 
+func unifiedDiff(name, a, b string) (string, error) {
+	if a == b {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSuffix(a, "\n")),
+		B:        difflib.SplitLines(strings.TrimSuffix(b, "\n")),
+		FromFile: name + " (current)",
+		ToFile:   name + " (upgrade)",
+		Context:  3,
+	})
+}
+
 // ensureHelmConfig return a new configuration.
 func ensureHelmConfig(flags *genericclioptions.ConfigFlags, ns string) (*action.Configuration, error) {
 	cfg := new(action.Configuration)
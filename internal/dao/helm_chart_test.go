@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	uu := map[string]struct {
+		a, b string
+		e    string
+	}{
+		"same": {
+			a: "a: 1\n",
+			b: "a: 1\n",
+		},
+		"changed": {
+			a: "a: 1\nb: 2\n",
+			b: "a: 1\nb: 3\n",
+			e: "--- values (current)\n+++ values (upgrade)\n@@ -1,2 +1,2 @@\n a: 1\n-b: 2\n+b: 3\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := unifiedDiff("values", u.a, u.b)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
@@ -133,6 +133,9 @@ func (h *HelmHistory) GetValues(path string, allValues bool) ([]byte, error) {
 }
 
 func (h *HelmHistory) Rollback(_ context.Context, path, rev string) error {
+	if err := ensureWritable("rollback", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	cfg, err := ensureHelmConfig(h.Client().Config().Flags(), ns)
 	if err != nil {
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	rsGVR  = "apps/v1/replicasets"
+	dpGVR  = "apps/v1/deployments"
+	dsGVR  = "apps/v1/daemonsets"
+	stsGVR = "apps/v1/statefulsets"
+)
+
+var _ Accessor = (*Image)(nil)
+
+// Image represents the images in use by pods.
+type Image struct {
+	NonResource
+}
+
+// List returns the images in use in a given namespace.
+func (i *Image) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := i.getFactory().List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var pod v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pod); err != nil {
+			return nil, err
+		}
+		pp = append(pp, &pod)
+	}
+
+	ii := ImagesInUse(pp, i.podOwner)
+	res := make([]runtime.Object, 0, len(ii))
+	for _, img := range ii {
+		res = append(res, img)
+	}
+
+	return res, nil
+}
+
+// Get returns a given image.
+func (i *Image) Get(ctx context.Context, path string) (runtime.Object, error) {
+	ns, _ := ctx.Value(internal.KeyNamespace).(string)
+	oo, err := i.List(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		if img, ok := o.(render.ImageRes); ok && img.Image == path {
+			return img, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no image found for %q", path)
+}
+
+// podOwner returns the restartable workload controlling a pod if any.
+func (i *Image) podOwner(pod *v1.Pod) (render.ImageConsumer, bool) {
+	for _, ref := range pod.OwnerReferences {
+		if ref.Controller == nil || !*ref.Controller {
+			continue
+		}
+		fqn := client.FQN(pod.Namespace, ref.Name)
+		switch ref.Kind {
+		case "DaemonSet":
+			return render.ImageConsumer{GVR: dsGVR, FQN: fqn}, true
+		case "StatefulSet":
+			return render.ImageConsumer{GVR: stsGVR, FQN: fqn}, true
+		case "ReplicaSet":
+			o, err := i.getFactory().Get(rsGVR, fqn, true, labels.Everything())
+			if err != nil {
+				return render.ImageConsumer{}, false
+			}
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return render.ImageConsumer{}, false
+			}
+			var rs appsv1.ReplicaSet
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &rs); err != nil {
+				return render.ImageConsumer{}, false
+			}
+			name, kind, _, err := controllerInfo(&rs)
+			if err != nil || kind != "Deployment" {
+				return render.ImageConsumer{}, false
+			}
+			return render.ImageConsumer{GVR: dpGVR, FQN: client.FQN(pod.Namespace, name)}, true
+		}
+	}
+
+	return render.ImageConsumer{}, false
+}
+
+// OwnerFn returns the workload controlling a given pod.
+type OwnerFn func(*v1.Pod) (render.ImageConsumer, bool)
+
+// ImagesInUse aggregates the images run by the given pods along with their
+// pull policies, resolved digests and consumers. Images are sorted by name.
+func ImagesInUse(pp []*v1.Pod, owner OwnerFn) []render.ImageRes {
+	index := make(map[string]*render.ImageRes)
+	for _, pod := range pp {
+		w, hasOwner := owner(pod)
+		started := pod.CreationTimestamp.Time
+		if pod.Status.StartTime != nil {
+			started = pod.Status.StartTime.Time
+		}
+		for _, c := range append(slices.Clone(pod.Spec.InitContainers), pod.Spec.Containers...) {
+			img, ok := index[c.Image]
+			if !ok {
+				img = &render.ImageRes{Image: c.Image, Digests: make(map[string]time.Time)}
+				index[c.Image] = img
+			}
+			if p := string(c.ImagePullPolicy); p != "" && !slices.Contains(img.Policies, p) {
+				img.Policies = append(img.Policies, p)
+			}
+			digest := containerDigest(pod, c.Name)
+			if digest != "" && started.After(img.Digests[digest]) {
+				img.Digests[digest] = started
+			}
+			pc := render.ImageConsumer{GVR: "v1/pods", FQN: client.FQN(pod.Namespace, pod.Name), Digest: digest}
+			if !slices.Contains(img.Pods, pc) {
+				img.Pods = append(img.Pods, pc)
+			}
+			if !hasOwner {
+				continue
+			}
+			w.Digest = digest
+			if !slices.Contains(img.Workloads, w) {
+				img.Workloads = append(img.Workloads, w)
+			}
+		}
+	}
+
+	ii := make([]render.ImageRes, 0, len(index))
+	for _, img := range index {
+		sort.Strings(img.Policies)
+		ii = append(ii, *img)
+	}
+	sort.Slice(ii, func(a, b int) bool {
+		return ii[a].Image < ii[b].Image
+	})
+
+	return ii
+}
+
+func containerDigest(pod *v1.Pod, co string) string {
+	for _, s := range append(slices.Clone(pod.Status.InitContainerStatuses), pod.Status.ContainerStatuses...) {
+		if s.Name == co {
+			return imageDigest(s.ImageID)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestImagesInUse(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	pp := []*v1.Pod{
+		makeImgPod("p1", "dp1", "nginx:1.25", v1.PullAlways, "sha256:old", t0),
+		makeImgPod("p2", "dp2", "nginx:1.25", v1.PullIfNotPresent, "sha256:new", t0.Add(time.Hour)),
+		makeImgPod("p3", "", "busybox", v1.PullIfNotPresent, "sha256:bb", t0),
+	}
+	owner := func(pod *v1.Pod) (render.ImageConsumer, bool) {
+		if n, ok := pod.Labels["owner"]; ok {
+			return render.ImageConsumer{GVR: "apps/v1/deployments", FQN: "default/" + n}, true
+		}
+		return render.ImageConsumer{}, false
+	}
+
+	ii := dao.ImagesInUse(pp, owner)
+	assert.Equal(t, 2, len(ii))
+
+	assert.Equal(t, "busybox", ii[0].Image)
+	assert.Equal(t, []string{"IfNotPresent"}, ii[0].Policies)
+	assert.False(t, ii[0].Drifted())
+	assert.Empty(t, ii[0].Workloads)
+
+	img := ii[1]
+	assert.Equal(t, "nginx:1.25", img.Image)
+	assert.Equal(t, []string{"Always", "IfNotPresent"}, img.Policies)
+	assert.Equal(t, 2, len(img.Pods))
+	assert.True(t, img.Drifted())
+	assert.Equal(t, "sha256:new", img.CurrentDigest())
+	assert.Equal(t, []render.ImageConsumer{{GVR: "apps/v1/deployments", FQN: "default/dp1"}}, img.StaleWorkloads())
+}
+
+func makeImgPod(n, owner, img string, policy v1.PullPolicy, digest string, started time.Time) *v1.Pod {
+	st := metav1.NewTime(started)
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      n,
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "c1", Image: img, ImagePullPolicy: policy},
+			},
+		},
+		Status: v1.PodStatus{
+			StartTime: &st,
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", ImageID: "docker.io/library/" + img + "@" + digest},
+			},
+		},
+	}
+	if owner != "" {
+		pod.Labels = map[string]string{"owner": owner}
+	}
+
+	return &pod
+}
@@ -6,11 +6,13 @@ package dao
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/vul"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -38,6 +40,9 @@ func (is *ImageScan) listImages(ctx context.Context, gvr client.GVR, path string
 
 // List returns a collection of scans.
 func (is *ImageScan) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	if ss, ok := ctx.Value(internal.KeyImageScans).([]*vul.Scan); ok {
+		return scanRows(ss), nil
+	}
 	fqn, ok := ctx.Value(internal.KeyPath).(string)
 	if !ok {
 		return nil, fmt.Errorf("no context path for %q", is.gvr)
@@ -65,3 +70,63 @@ func (is *ImageScan) List(ctx context.Context, _ string) ([]runtime.Object, erro
 
 	return res, nil
 }
+
+// ImageRef represents a container image and its resolved digest.
+type ImageRef struct {
+	Image  string
+	Digest string
+}
+
+// PodImageRefs returns the images of a pod containers. When a container name is
+// specified only this container image is returned.
+func PodImageRefs(pod *v1.Pod, co string) []ImageRef {
+	ss := make([]v1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	ss = append(ss, pod.Status.InitContainerStatuses...)
+	ss = append(ss, pod.Status.ContainerStatuses...)
+
+	digests := make(map[string]string, len(ss))
+	for _, s := range ss {
+		digests[s.Name] = imageDigest(s.ImageID)
+	}
+
+	cc := make([]v1.Container, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	cc = append(cc, pod.Spec.InitContainers...)
+	cc = append(cc, pod.Spec.Containers...)
+	rr, seen := make([]ImageRef, 0, len(cc)), make(map[string]struct{}, len(cc))
+	for _, c := range cc {
+		if co != "" && c.Name != co {
+			continue
+		}
+		if _, ok := seen[c.Image]; ok {
+			continue
+		}
+		seen[c.Image] = struct{}{}
+		rr = append(rr, ImageRef{Image: c.Image, Digest: digests[c.Name]})
+	}
+
+	return rr
+}
+
+// imageDigest extracts the digest of a container image id ie
+// docker.io/library/nginx@sha256:abc -> sha256:abc.
+func imageDigest(id string) string {
+	if i := strings.LastIndex(id, "@"); i >= 0 {
+		return id[i+1:]
+	}
+	if strings.HasPrefix(id, "sha256:") {
+		return id
+	}
+
+	return ""
+}
+
+func scanRows(ss []*vul.Scan) []runtime.Object {
+	oo := make([]runtime.Object, 0, len(ss))
+	for _, s := range ss {
+		for _, r := range s.Table.Rows {
+			oo = append(oo, render.ImageScanRes{Image: s.ID, Row: r})
+		}
+	}
+
+	return oo
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestPodImageRefs(t *testing.T) {
+	pod := v1.Pod{
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{
+				{Name: "i1", Image: "busybox:1.36"},
+			},
+			Containers: []v1.Container{
+				{Name: "c1", Image: "nginx:1.25"},
+				{Name: "c2", Image: "fred:latest"},
+				{Name: "c3", Image: "nginx:1.25"},
+			},
+		},
+		Status: v1.PodStatus{
+			InitContainerStatuses: []v1.ContainerStatus{
+				{Name: "i1", ImageID: "sha256:i1"},
+			},
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", ImageID: "docker.io/library/nginx@sha256:c1"},
+				{Name: "c2"},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		co string
+		e  []dao.ImageRef
+	}{
+		"all": {
+			e: []dao.ImageRef{
+				{Image: "busybox:1.36", Digest: "sha256:i1"},
+				{Image: "nginx:1.25", Digest: "sha256:c1"},
+				{Image: "fred:latest"},
+			},
+		},
+		"container": {
+			co: "c2",
+			e:  []dao.ImageRef{{Image: "fred:latest"}},
+		},
+		"none": {
+			co: "c4",
+			e:  []dao.ImageRef{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.PodImageRefs(&pod, u.co))
+		})
+	}
+}
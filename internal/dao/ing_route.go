@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	ingGVR = "networking.k8s.io/v1/ingresses"
+
+	anyHost = "*"
+)
+
+var (
+	_ Accessor   = (*IngressRoute)(nil)
+	_ Controller = (*IngressRoute)(nil)
+)
+
+// IngressRoute resolves ingress rules to their backends.
+type IngressRoute struct {
+	NonResource
+}
+
+// List returns the resolved routes for the ingress specified in the context.
+func (i *IngressRoute) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, errors.New("no ingress path specified")
+	}
+	o, err := i.getFactory().Get(ingGVR, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var ing netv1.Ingress
+	if err := fromUnstructured(o, &ing); err != nil {
+		return nil, err
+	}
+
+	rr := ResolveIngress(&ing)
+	days := make(map[string]*int)
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		if r.Service != "" {
+			r.Ready, r.Total, r.Issue = i.backendHealth(r.Service)
+		}
+		if r.TLSSecret != "" {
+			d, ok := days[r.TLSSecret]
+			if !ok {
+				d = i.tlsDaysLeft(client.FQN(ing.Namespace, r.TLSSecret))
+				days[r.TLSSecret] = d
+			}
+			r.TLSDaysLeft = d
+		}
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// Get returns a given route.
+func (i *IngressRoute) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, fmt.Errorf("nyi")
+}
+
+// Pod returns a pod backing a given route.
+func (i *IngressRoute) Pod(path string) (string, error) {
+	svc, _, _ := strings.Cut(path, "@")
+	if svc == "" {
+		return "", fmt.Errorf("no service backend for route %q", path)
+	}
+
+	var s Service
+	s.Init(i.getFactory(), client.NewGVR("v1/services"))
+
+	return s.Pod(svc)
+}
+
+func (i *IngressRoute) backendHealth(fqn string) (int, int, string) {
+	var s Service
+	s.Init(i.getFactory(), client.NewGVR("v1/services"))
+	svc, err := s.GetInstance(fqn)
+	if err != nil {
+		return 0, 0, fmt.Sprintf("service %s not found", fqn)
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return 0, 0, ""
+	}
+	oo, err := i.getFactory().List("v1/pods", svc.Namespace, true, labels.SelectorFromSet(svc.Spec.Selector))
+	if err != nil {
+		return 0, 0, err.Error()
+	}
+	if len(oo) == 0 {
+		return 0, 0, fmt.Sprintf("service %s selects no pods", fqn)
+	}
+	sel := labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: svc.Name})
+	ee, err := i.getFactory().List(epsGVR, svc.Namespace, true, sel)
+	if err != nil {
+		return 0, len(oo), err.Error()
+	}
+	slices := make([]discoveryv1.EndpointSlice, 0, len(ee))
+	for _, o := range ee {
+		var eps discoveryv1.EndpointSlice
+		if err := fromUnstructured(o, &eps); err != nil {
+			return 0, len(oo), err.Error()
+		}
+		slices = append(slices, eps)
+	}
+
+	return readyEndpoints(endpointsByPod(slices)), len(oo), ""
+}
+
+func (i *IngressRoute) tlsDaysLeft(fqn string) *int {
+	o, err := i.getFactory().Get("v1/secrets", fqn, true, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to fetch tls secret %s", fqn)
+		return nil
+	}
+	var sec v1.Secret
+	if err := fromUnstructured(o, &sec); err != nil {
+		log.Debug().Err(err).Msgf("Unable to convert tls secret %s", fqn)
+		return nil
+	}
+	t, err := render.CertNotAfter(sec.Data[v1.TLSCertKey])
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to parse tls secret %s", fqn)
+		return nil
+	}
+	d := render.DaysLeft(t)
+
+	return &d
+}
+
+// ResolveIngress lists an ingress routes along with their service backends
+// and tls secrets.
+func ResolveIngress(ing *netv1.Ingress) []render.IngressRouteRes {
+	var rr []render.IngressRouteRes
+	if b := ing.Spec.DefaultBackend; b != nil {
+		rr = append(rr, newIngressRoute(ing, anyHost, anyHost, *b))
+	}
+	for _, rule := range ing.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = anyHost
+		}
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, p := range rule.HTTP.Paths {
+			path := p.Path
+			if path == "" {
+				path = "/"
+			}
+			r := newIngressRoute(ing, host, path, p.Backend)
+			r.TLSSecret = tlsSecretFor(ing.Spec.TLS, rule.Host)
+			rr = append(rr, r)
+		}
+	}
+	for i := range rr {
+		rr[i].Index = i
+	}
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newIngressRoute(ing *netv1.Ingress, host, path string, b netv1.IngressBackend) render.IngressRouteRes {
+	r := render.IngressRouteRes{Host: host, Path: path}
+	if b.Service == nil {
+		r.Port = render.NAValue
+		if b.Resource != nil {
+			r.Issue = fmt.Sprintf("resource backend %s/%s is not resolved", b.Resource.Kind, b.Resource.Name)
+		}
+		return r
+	}
+	r.Service = client.FQN(ing.Namespace, b.Service.Name)
+	r.Port = b.Service.Port.Name
+	if r.Port == "" {
+		r.Port = strconv.Itoa(int(b.Service.Port.Number))
+	}
+
+	return r
+}
+
+func tlsSecretFor(tt []netv1.IngressTLS, host string) string {
+	for _, t := range tt {
+		for _, h := range t.Hosts {
+			if h == host || wildcardMatch(h, host) {
+				return t.SecretName
+			}
+		}
+	}
+
+	return ""
+}
+
+func wildcardMatch(pattern, host string) bool {
+	suffix, ok := strings.CutPrefix(pattern, "*.")
+	if !ok {
+		return false
+	}
+	_, rest, ok := strings.Cut(host, ".")
+
+	return ok && rest == suffix
+}
+
+func readyEndpoints(mm map[string]podEndpoint) int {
+	var count int
+	for _, ep := range mm {
+		if ep.state == EndpointReady {
+			count++
+		}
+	}
+
+	return count
+}
+
+func fromUnstructured(o runtime.Object, v interface{}) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, v)
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResolveIngress(t *testing.T) {
+	svcBackend := func(n string, port int32, name string) netv1.IngressBackend {
+		return netv1.IngressBackend{
+			Service: &netv1.IngressServiceBackend{
+				Name: n,
+				Port: netv1.ServiceBackendPort{Name: name, Number: port},
+			},
+		}
+	}
+	rule := func(host string, pp ...netv1.HTTPIngressPath) netv1.IngressRule {
+		return netv1.IngressRule{
+			Host: host,
+			IngressRuleValue: netv1.IngressRuleValue{
+				HTTP: &netv1.HTTPIngressRuleValue{Paths: pp},
+			},
+		}
+	}
+
+	uu := map[string]struct {
+		spec netv1.IngressSpec
+		e    []render.IngressRouteRes
+	}{
+		"empty": {},
+		"default": {
+			spec: netv1.IngressSpec{DefaultBackend: &netv1.IngressBackend{
+				Resource: &v1.TypedLocalObjectReference{Kind: "Bucket", Name: "b1"},
+			}},
+			e: []render.IngressRouteRes{
+				{Host: "*", Path: "*", Port: render.NAValue, Issue: "resource backend Bucket/b1 is not resolved"},
+			},
+		},
+		"rules": {
+			spec: netv1.IngressSpec{
+				DefaultBackend: &netv1.IngressBackend{Service: svcBackend("dflt", 80, "").Service},
+				TLS: []netv1.IngressTLS{
+					{Hosts: []string{"*.example.com"}, SecretName: "wild-tls"},
+				},
+				Rules: []netv1.IngressRule{
+					rule("api.example.com",
+						netv1.HTTPIngressPath{Path: "/v1", Backend: svcBackend("api", 0, "http")},
+						netv1.HTTPIngressPath{Backend: svcBackend("web", 8080, "")},
+					),
+					rule("",
+						netv1.HTTPIngressPath{Path: "/", Backend: svcBackend("web", 8080, "")},
+					),
+					{Host: "nohttp.com"},
+				},
+			},
+			e: []render.IngressRouteRes{
+				{Index: 0, Host: "*", Path: "*", Service: "ns1/dflt", Port: "80"},
+				{Index: 1, Host: "api.example.com", Path: "/v1", Service: "ns1/api", Port: "http", TLSSecret: "wild-tls"},
+				{Index: 2, Host: "api.example.com", Path: "/", Service: "ns1/web", Port: "8080", TLSSecret: "wild-tls"},
+				{Index: 3, Host: "*", Path: "/", Service: "ns1/web", Port: "8080"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ing := netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ing1"},
+				Spec:       u.spec,
+			}
+			assert.Equal(t, u.e, dao.ResolveIngress(&ing))
+		})
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
-	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -149,25 +148,28 @@ func (j *Job) Scan(ctx context.Context, gvr client.GVR, fqn string, wait bool) (
 		}
 		switch gvr {
 		case CmGVR:
-			if !hasConfigMap(&job.Spec.Template.Spec, n) {
+			usage := configMapUsage(&job.Spec.Template.Spec, n)
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: j.GVR(),
-				FQN: client.FQN(job.Namespace, job.Name),
+				GVR:   j.GVR(),
+				FQN:   client.FQN(job.Namespace, job.Name),
+				Usage: usage,
 			})
 		case SecGVR:
-			found, err := hasSecret(j.Factory, &job.Spec.Template.Spec, job.Namespace, n, wait)
+			usage, err := secretUsage(j.Factory, &job.Spec.Template.Spec, job.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("locate secret %q", fqn)
 				continue
 			}
-			if !found {
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: j.GVR(),
-				FQN: client.FQN(job.Namespace, job.Name),
+				GVR:   j.GVR(),
+				FQN:   client.FQN(job.Namespace, job.Name),
+				Usage: usage,
 			})
 		case PcGVR:
 			if !hasPC(&job.Spec.Template.Spec, n) {
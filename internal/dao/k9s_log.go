@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/logring"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*K9sLog)(nil)
+
+// K9sLog represents k9s own log entries.
+type K9sLog struct {
+	NonResource
+}
+
+// List returns the recent k9s log entries at or above the level found in context.
+func (l *K9sLog) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	level, ok := ctx.Value(internal.KeyLogLevel).(zerolog.Level)
+	if !ok {
+		level = zerolog.TraceLevel
+	}
+	ee := logring.Default.Entries(level)
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, render.K9sLogRes{Entry: e})
+	}
+
+	return oo, nil
+}
+
+// Get returns a given log entry.
+func (l *K9sLog) Get(_ context.Context, path string) (runtime.Object, error) {
+	seq, err := strconv.ParseUint(path, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log entry id %q", path)
+	}
+	for _, e := range logring.Default.Entries(zerolog.TraceLevel) {
+		if e.Seq == seq {
+			return render.K9sLogRes{Entry: e}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no log entry found for %q", path)
+}
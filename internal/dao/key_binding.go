@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*KeyBinding)(nil)
+)
+
+// KeyBinding represents the key bindings of a view.
+type KeyBinding struct {
+	NonResource
+}
+
+// List returns a collection of key bindings.
+func (k *KeyBinding) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	kk, ok := ctx.Value(internal.KeyBindings).([]render.KeyBindingRes)
+	if !ok {
+		return nil, fmt.Errorf("no key bindings for %q", k.gvrStr())
+	}
+
+	oo := make([]runtime.Object, 0, len(kk))
+	for _, kb := range kk {
+		oo = append(oo, kb)
+	}
+
+	return oo, nil
+}
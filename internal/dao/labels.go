@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// LabelsField represents resource labels.
+	LabelsField = "labels"
+
+	// AnnotationsField represents resource annotations.
+	AnnotationsField = "annotations"
+)
+
+// FetchMeta returns a resource labels or annotations.
+func FetchMeta(f Factory, gvr client.GVR, path, field string) (map[string]string, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	if o == nil {
+		return nil, fmt.Errorf("unable to locate resource %q", path)
+	}
+
+	return metaFor(o, field)
+}
+
+// MetaKeys returns the sorted labels or annotations keys in use for a given
+// resource type as seen by the informer cache.
+func MetaKeys(f Factory, gvr client.GVR, ns, field string) ([]string, error) {
+	oo, err := f.List(gvr.String(), ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]struct{})
+	for _, o := range oo {
+		mm, err := metaFor(o, field)
+		if err != nil {
+			return nil, err
+		}
+		for k := range mm {
+			set[k] = struct{}{}
+		}
+	}
+	kk := make([]string, 0, len(set))
+	for k := range set {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk, nil
+}
+
+func metaFor(o runtime.Object, field string) (map[string]string, error) {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return nil, err
+	}
+	switch field {
+	case LabelsField:
+		return m.GetLabels(), nil
+	case AnnotationsField:
+		return m.GetAnnotations(), nil
+	default:
+		return nil, fmt.Errorf("unsupported metadata field %q", field)
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestFetchMeta(t *testing.T) {
+	uu := map[string]struct {
+		field string
+		e     map[string]string
+		fail  bool
+	}{
+		"labels": {
+			field: dao.LabelsField,
+			e:     map[string]string{"app": "nginx", "pod-template-hash": "7fb78fb6d8"},
+		},
+		"annotations": {
+			field: dao.AnnotationsField,
+			e:     map[string]string{"kubectl.kubernetes.io/restartedAt": "2019-12-31T12:26:47-07:00"},
+		},
+		"toast": {
+			field: "blee",
+			fail:  true,
+		},
+	}
+
+	f := podsFactory()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mm, err := dao.FetchMeta(f, client.NewGVR("v1/pods"), "default/nginx-7fb78fb6d8-2w75j", u.field)
+			if u.fail {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, mm)
+		})
+	}
+}
+
+func TestMetaKeys(t *testing.T) {
+	kk, err := dao.MetaKeys(podsFactory(), client.NewGVR("v1/pods"), "default", dao.LabelsField)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"app", "pod-template-hash"}, kk)
+}
+
+// Helpers...
+
+func podsFactory() dao.Factory {
+	return &testFactory{
+		inventory: map[string]map[string][]runtime.Object{
+			"default": {
+				"v1/pods": {
+					load("p1"),
+				},
+			},
+		},
+	}
+}
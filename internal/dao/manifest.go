@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	kyaml "sigs.k8s.io/yaml"
+)
+
+var _ Accessor = (*Manifest)(nil)
+
+// Manifest represents the documents of a manifest about to be applied.
+type Manifest struct {
+	NonResource
+}
+
+// List returns the manifest documents.
+func (m *Manifest) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	dd, ok := ctx.Value(internal.KeyManifests).([]render.ManifestRes)
+	if !ok {
+		return nil, fmt.Errorf("no manifest documents found in context")
+	}
+	oo := make([]runtime.Object, 0, len(dd))
+	for _, d := range dd {
+		oo = append(oo, d)
+	}
+
+	return oo, nil
+}
+
+// Get returns a given manifest document.
+func (m *Manifest) Get(ctx context.Context, path string) (runtime.Object, error) {
+	oo, err := m.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		if d, ok := o.(render.ManifestRes); ok && d.ID() == path {
+			return d, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no manifest document found for %q", path)
+}
+
+// SplitManifest splits a multi-document yaml or json manifest into its documents.
+// Empty documents are skipped.
+func SplitManifest(raw []byte) ([]render.ManifestRes, error) {
+	r := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(raw)))
+	dd := make([]render.ManifestRes, 0, 10)
+	for {
+		doc, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var u unstructured.Unstructured
+		if err := kyaml.Unmarshal(doc, &u.Object); err != nil {
+			return nil, fmt.Errorf("invalid manifest document #%d: %w", len(dd)+1, err)
+		}
+		if len(u.Object) == 0 {
+			continue
+		}
+		if u.GetKind() == "" {
+			return nil, fmt.Errorf("manifest document #%d has no kind", len(dd)+1)
+		}
+		dd = append(dd, render.ManifestRes{
+			Index:     len(dd),
+			Kind:      u.GetKind(),
+			Namespace: u.GetNamespace(),
+			Name:      u.GetName(),
+			Raw:       doc,
+		})
+	}
+	if len(dd) == 0 {
+		return nil, errors.New("no resources found in manifest")
+	}
+
+	return dd, nil
+}
+
+// DiffStats returns the number of added and deleted lines of a unified diff.
+func DiffStats(diff string) (int, int) {
+	var adds, dels int
+	for _, l := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(l, "+++"), strings.HasPrefix(l, "---"):
+		case strings.HasPrefix(l, "+"):
+			adds++
+		case strings.HasPrefix(l, "-"):
+			dels++
+		}
+	}
+
+	return adds, dels
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitManifest(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		e   [][3]string
+		err bool
+	}{
+		"single": {
+			raw: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm1\n  namespace: ns1\n",
+			e:   [][3]string{{"ConfigMap", "ns1", "cm1"}},
+		},
+		"multi": {
+			raw: "---\n# leading comment\n---\napiVersion: v1\nkind: Namespace\nmetadata:\n  name: ns1\n---\napiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: d1\n  namespace: ns1\n",
+			e:   [][3]string{{"Namespace", "", "ns1"}, {"Deployment", "ns1", "d1"}},
+		},
+		"json": {
+			raw: `{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "s1"}}`,
+			e:   [][3]string{{"Secret", "", "s1"}},
+		},
+		"empty": {
+			raw: "---\n",
+			err: true,
+		},
+		"no-kind": {
+			raw: "apiVersion: v1\nmetadata:\n  name: cm1\n",
+			err: true,
+		},
+		"bad-yaml": {
+			raw: "kind: [\n",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dd, err := dao.SplitManifest([]byte(u.raw))
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, len(u.e), len(dd))
+			for i, d := range dd {
+				assert.Equal(t, i, d.Index)
+				assert.Equal(t, u.e[i], [3]string{d.Kind, d.Namespace, d.Name})
+				assert.NotEmpty(t, d.Raw)
+			}
+		})
+	}
+}
+
+func TestDiffStats(t *testing.T) {
+	diff := `diff -u -N /tmp/LIVE/apps.v1.Deployment.ns1.d1 /tmp/MERGED/apps.v1.Deployment.ns1.d1
+--- /tmp/LIVE/apps.v1.Deployment.ns1.d1
++++ /tmp/MERGED/apps.v1.Deployment.ns1.d1
+@@ -6,7 +6,7 @@
+-  replicas: 1
++  replicas: 3
++  paused: false
+   selector:`
+
+	adds, dels := dao.DiffStats(diff)
+	assert.Equal(t, 2, adds)
+	assert.Equal(t, 1, dels)
+}
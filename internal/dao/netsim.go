@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	npGVR = "networking.k8s.io/v1/networkpolicies"
+
+	allPeers = "*"
+	denyAll  = "<deny all>"
+)
+
+var _ Accessor = (*NetSim)(nil)
+
+// NetSim evaluates network policies effects on pods.
+type NetSim struct {
+	NonResource
+}
+
+// List returns the allowed ingress/egress flows for pods in a namespace or
+// for a given pod when a path is present in the context.
+func (n *NetSim) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	pods, err := n.pods(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	pols := make(map[string][]netv1.NetworkPolicy)
+	oo := make([]runtime.Object, 0, len(pods))
+	for _, pod := range pods {
+		pp, ok := pols[pod.Namespace]
+		if !ok {
+			if pp, err = n.policies(pod.Namespace); err != nil {
+				return nil, err
+			}
+			pols[pod.Namespace] = pp
+		}
+		for _, r := range EvalNetPols(pod, pp) {
+			oo = append(oo, r)
+		}
+	}
+
+	return oo, nil
+}
+
+// Get returns a given flow.
+func (n *NetSim) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, fmt.Errorf("nyi")
+}
+
+func (n *NetSim) pods(ctx context.Context, ns string) ([]*v1.Pod, error) {
+	if path, ok := ctx.Value(internal.KeyPath).(string); ok && path != "" {
+		o, err := n.getFactory().Get("v1/pods", path, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		pod, err := toPod(o)
+		if err != nil {
+			return nil, err
+		}
+		return []*v1.Pod{pod}, nil
+	}
+
+	oo, err := n.getFactory().List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		pod, err := toPod(o)
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
+
+func (n *NetSim) policies(ns string) ([]netv1.NetworkPolicy, error) {
+	oo, err := n.getFactory().List(npGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]netv1.NetworkPolicy, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var np netv1.NetworkPolicy
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &np); err != nil {
+			return nil, err
+		}
+		pp = append(pp, np)
+	}
+
+	return pp, nil
+}
+
+// EvalNetPols computes the allowed ingress/egress flows for a given pod.
+// Directions not covered by any policy are flagged as such.
+func EvalNetPols(pod *v1.Pod, pols []netv1.NetworkPolicy) []render.NetSimRes {
+	var (
+		rr                []render.NetSimRes
+		ingress, egress   bool
+		podFQN, podLabels = client.FQN(pod.Namespace, pod.Name), labels.Set(pod.Labels)
+	)
+	for _, np := range pols {
+		if np.Namespace != pod.Namespace {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(&np.Spec.PodSelector)
+		if err != nil || !sel.Matches(podLabels) {
+			continue
+		}
+		in, out := policyTypes(&np)
+		if in {
+			ingress = true
+			if len(np.Spec.Ingress) == 0 {
+				rr = append(rr, newNetSimRes(pod, netv1.PolicyTypeIngress, np.Name, denyAll, ""))
+			}
+			for _, r := range np.Spec.Ingress {
+				rr = append(rr, newNetSimRes(pod, netv1.PolicyTypeIngress, np.Name, peersStr(r.From), netPortsStr(r.Ports)))
+			}
+		}
+		if out {
+			egress = true
+			if len(np.Spec.Egress) == 0 {
+				rr = append(rr, newNetSimRes(pod, netv1.PolicyTypeEgress, np.Name, denyAll, ""))
+			}
+			for _, r := range np.Spec.Egress {
+				rr = append(rr, newNetSimRes(pod, netv1.PolicyTypeEgress, np.Name, peersStr(r.To), netPortsStr(r.Ports)))
+			}
+		}
+	}
+	if !ingress {
+		rr = append(rr, render.NetSimRes{Pod: podFQN, Direction: string(netv1.PolicyTypeIngress), Peer: allPeers, Ports: allPeers})
+	}
+	if !egress {
+		rr = append(rr, render.NetSimRes{Pod: podFQN, Direction: string(netv1.PolicyTypeEgress), Peer: allPeers, Ports: allPeers})
+	}
+	for i := range rr {
+		rr[i].Index = i
+	}
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newNetSimRes(pod *v1.Pod, dir netv1.PolicyType, pol, peer, ports string) render.NetSimRes {
+	if ports == "" {
+		ports = allPeers
+	}
+
+	return render.NetSimRes{
+		Pod:       client.FQN(pod.Namespace, pod.Name),
+		Direction: string(dir),
+		Policy:    pol,
+		Peer:      peer,
+		Ports:     ports,
+		Covered:   true,
+	}
+}
+
+func toPod(o runtime.Object) (*v1.Pod, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	var pod v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pod); err != nil {
+		return nil, err
+	}
+
+	return &pod, nil
+}
+
+// policyTypes returns whether a policy applies to ingress and/or egress.
+func policyTypes(np *netv1.NetworkPolicy) (bool, bool) {
+	if len(np.Spec.PolicyTypes) == 0 {
+		return true, len(np.Spec.Egress) > 0
+	}
+	var in, out bool
+	for _, t := range np.Spec.PolicyTypes {
+		switch t {
+		case netv1.PolicyTypeIngress:
+			in = true
+		case netv1.PolicyTypeEgress:
+			out = true
+		}
+	}
+
+	return in, out
+}
+
+func peersStr(pp []netv1.NetworkPolicyPeer) string {
+	if len(pp) == 0 {
+		return allPeers
+	}
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		ss = append(ss, peerStr(p))
+	}
+
+	return strings.Join(ss, " ")
+}
+
+func peerStr(p netv1.NetworkPolicyPeer) string {
+	if b := p.IPBlock; b != nil {
+		if len(b.Except) == 0 {
+			return "cidr:" + b.CIDR
+		}
+		return "cidr:" + b.CIDR + "[!" + strings.Join(b.Except, ",") + "]"
+	}
+
+	var ss []string
+	if p.NamespaceSelector != nil {
+		ss = append(ss, "ns:"+selectorStr(p.NamespaceSelector))
+	}
+	if p.PodSelector != nil {
+		ss = append(ss, "po:"+selectorStr(p.PodSelector))
+	}
+
+	return strings.Join(ss, "/")
+}
+
+func selectorStr(sel *metav1.LabelSelector) string {
+	if len(sel.MatchLabels) == 0 && len(sel.MatchExpressions) == 0 {
+		return allPeers
+	}
+
+	return metav1.FormatLabelSelector(sel)
+}
+
+func netPortsStr(pp []netv1.NetworkPolicyPort) string {
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		proto := string(v1.ProtocolTCP)
+		if p.Protocol != nil {
+			proto = string(*p.Protocol)
+		}
+		port := allPeers
+		if p.Port != nil {
+			port = p.Port.String()
+			if p.EndPort != nil {
+				port += fmt.Sprintf("-%d", *p.EndPort)
+			}
+		}
+		ss = append(ss, proto+":"+port)
+	}
+
+	return strings.Join(ss, ",")
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestEvalNetPols(t *testing.T) {
+	port, tcp := intstr.FromInt(80), v1.ProtocolTCP
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "p1",
+			Labels:    map[string]string{"app": "fred"},
+		},
+	}
+
+	uu := map[string]struct {
+		pols []netv1.NetworkPolicy
+		e    []render.NetSimRes
+	}{
+		"uncovered": {
+			e: []render.NetSimRes{
+				{Pod: "ns1/p1", Index: 0, Direction: "Ingress", Peer: "*", Ports: "*"},
+				{Pod: "ns1/p1", Index: 1, Direction: "Egress", Peer: "*", Ports: "*"},
+			},
+		},
+		"no-match": {
+			pols: []netv1.NetworkPolicy{
+				makeNetPol("ns1", "np1", map[string]string{"app": "blee"}),
+				makeNetPol("ns2", "np2", nil),
+			},
+			e: []render.NetSimRes{
+				{Pod: "ns1/p1", Index: 0, Direction: "Ingress", Peer: "*", Ports: "*"},
+				{Pod: "ns1/p1", Index: 1, Direction: "Egress", Peer: "*", Ports: "*"},
+			},
+		},
+		"deny-ingress": {
+			pols: []netv1.NetworkPolicy{
+				makeNetPol("ns1", "np1", nil),
+			},
+			e: []render.NetSimRes{
+				{Pod: "ns1/p1", Index: 0, Direction: "Ingress", Policy: "np1", Peer: "<deny all>", Ports: "*", Covered: true},
+				{Pod: "ns1/p1", Index: 1, Direction: "Egress", Peer: "*", Ports: "*"},
+			},
+		},
+		"rules": {
+			pols: []netv1.NetworkPolicy{
+				func() netv1.NetworkPolicy {
+					np := makeNetPol("ns1", "np1", map[string]string{"app": "fred"})
+					np.Spec.PolicyTypes = []netv1.PolicyType{netv1.PolicyTypeIngress, netv1.PolicyTypeEgress}
+					np.Spec.Ingress = []netv1.NetworkPolicyIngressRule{
+						{
+							From: []netv1.NetworkPolicyPeer{
+								{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "blee"}}},
+								{NamespaceSelector: &metav1.LabelSelector{}},
+							},
+							Ports: []netv1.NetworkPolicyPort{{Protocol: &tcp, Port: &port}},
+						},
+					}
+					np.Spec.Egress = []netv1.NetworkPolicyEgressRule{
+						{
+							To: []netv1.NetworkPolicyPeer{
+								{IPBlock: &netv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}}},
+							},
+						},
+					}
+					return np
+				}(),
+			},
+			e: []render.NetSimRes{
+				{Pod: "ns1/p1", Index: 0, Direction: "Ingress", Policy: "np1", Peer: "po:app=blee ns:*", Ports: "TCP:80", Covered: true},
+				{Pod: "ns1/p1", Index: 1, Direction: "Egress", Policy: "np1", Peer: "cidr:10.0.0.0/8[!10.1.0.0/16]", Ports: "*", Covered: true},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.EvalNetPols(&pod, u.pols))
+		})
+	}
+}
+
+// Helpers...
+
+func makeNetPol(ns, n string, sel map[string]string) netv1.NetworkPolicy {
+	return netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+		Spec: netv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: sel},
+		},
+	}
+}
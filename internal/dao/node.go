@@ -12,7 +12,6 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
-	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -38,6 +37,9 @@ type Node struct {
 
 // ToggleCordon toggles cordon/uncordon a node.
 func (n *Node) ToggleCordon(path string, cordon bool) error {
+	if err := ensureWritable("cordon", path); err != nil {
+		return err
+	}
 	log.Debug().Msgf("CORDON %q::%t -- %q", path, cordon, n.gvr.GVK())
 	o, err := FetchNode(context.Background(), n.Factory, path)
 	if err != nil {
@@ -87,6 +89,9 @@ func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.H
 
 // Drain drains a node.
 func (n *Node) Drain(path string, opts DrainOptions, w io.Writer) error {
+	if err := ensureWritable("drain", path); err != nil {
+		return err
+	}
 	cordoned, err := n.ensureCordoned(path)
 	if err != nil {
 		return err
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultPageSize tracks the default number of resources per page.
+const DefaultPageSize = 500
+
+// Pager tracks a chunked list position. Resources are listed directly off the
+// api server a page at a time rather than cached by an informer.
+type Pager struct {
+	limit     int64
+	scope     string
+	tokens    []string
+	page      int
+	remaining *int64
+	mx        sync.RWMutex
+}
+
+// NewPager returns a new pager.
+func NewPager(limit int64) *Pager {
+	return &Pager{
+		limit:  limit,
+		tokens: []string{""},
+	}
+}
+
+// Limit returns the page size.
+func (p *Pager) Limit() int64 {
+	return p.limit
+}
+
+// Page returns the current page index.
+func (p *Pager) Page() int {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.page
+}
+
+// Continue returns the continue token for the current page.
+func (p *Pager) Continue() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.tokens[p.page]
+}
+
+// HasNext checks if more resources are available.
+func (p *Pager) HasNext() bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return len(p.tokens) > p.page+1
+}
+
+// Next moves to the next page if any.
+func (p *Pager) Next() bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if len(p.tokens) <= p.page+1 {
+		return false
+	}
+	p.page++
+
+	return true
+}
+
+// Prev moves to the previous page if any.
+func (p *Pager) Prev() bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.page == 0 {
+		return false
+	}
+	p.page--
+
+	return true
+}
+
+// Reset moves back to the first page.
+func (p *Pager) Reset() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.tokens, p.page, p.remaining = []string{""}, 0, nil
+}
+
+// sync restarts from the first page when the listed namespace or selectors changed.
+func (p *Pager) sync(scope string) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.scope == scope {
+		return
+	}
+	p.scope, p.tokens, p.page, p.remaining = scope, []string{""}, 0, nil
+}
+
+// Update records the current page list response.
+func (p *Pager) Update(next string, remaining *int64) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.tokens, p.remaining = p.tokens[:p.page+1], remaining
+	if next != "" {
+		p.tokens = append(p.tokens, next)
+	}
+}
+
+// String returns the pager position ie p2 +1,234 when the number of
+// remaining resources is known or p2 + when more pages are available.
+func (p *Pager) String() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	s := fmt.Sprintf("p%d", p.page+1)
+	if len(p.tokens) <= p.page+1 {
+		return s
+	}
+	if p.remaining != nil {
+		return s + " +" + render.AsThousands(*p.remaining)
+	}
+
+	return s + " +"
+}
+
+// pagedList issues a chunked list call when paging is on. Expired continue
+// tokens restart the listing from the first page.
+func pagedList[T metav1.ListInterface](ctx context.Context, ns string, opts metav1.ListOptions, list func(metav1.ListOptions) (T, error)) (T, error) {
+	p, ok := ctx.Value(internal.KeyPager).(*Pager)
+	if !ok || p == nil {
+		return list(opts)
+	}
+	p.sync(ns + "|" + opts.LabelSelector + "|" + opts.FieldSelector)
+
+	opts.Limit, opts.Continue = p.Limit(), p.Continue()
+	l, err := list(opts)
+	if kerrors.IsResourceExpired(err) {
+		log.Debug().Msgf("Continue token expired. Listing from first page")
+		p.Reset()
+		opts.Continue = ""
+		l, err = list(opts)
+	}
+	if err != nil {
+		return l, err
+	}
+	p.Update(l.GetContinue(), l.GetRemainingItemCount())
+
+	return l, nil
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPagerNav(t *testing.T) {
+	p := NewPager(10)
+	assert.Equal(t, "p1", p.String())
+	assert.False(t, p.Next())
+	assert.False(t, p.Prev())
+
+	rem := int64(1234)
+	p.Update("t1", &rem)
+	assert.True(t, p.HasNext())
+	assert.Equal(t, "p1 +1,234", p.String())
+	assert.True(t, p.Next())
+	assert.Equal(t, "t1", p.Continue())
+
+	p.Update("t2", nil)
+	assert.Equal(t, "p2 +", p.String())
+	assert.True(t, p.Prev())
+	assert.Equal(t, "", p.Continue())
+	assert.True(t, p.Next())
+	assert.True(t, p.Next())
+	assert.Equal(t, "t2", p.Continue())
+
+	p.Update("", nil)
+	assert.Equal(t, "p3", p.String())
+	assert.False(t, p.Next())
+
+	p.Reset()
+	assert.Equal(t, 0, p.Page())
+	assert.False(t, p.HasNext())
+}
+
+func TestPagedList(t *testing.T) {
+	var calls []metav1.ListOptions
+	list := func(next string, err error) func(metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+		return func(opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+			calls = append(calls, opts)
+			if err != nil && opts.Continue != "" {
+				return nil, err
+			}
+			var l unstructured.UnstructuredList
+			l.SetContinue(next)
+			return &l, nil
+		}
+	}
+
+	_, err := pagedList(context.Background(), "ns1", metav1.ListOptions{}, list("t1", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, metav1.ListOptions{}, calls[0])
+
+	p := NewPager(10)
+	ctx := context.WithValue(context.Background(), internal.KeyPager, p)
+	calls = nil
+	_, err = pagedList(ctx, "ns1", metav1.ListOptions{}, list("t1", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, metav1.ListOptions{Limit: 10}, calls[0])
+	assert.True(t, p.Next())
+
+	calls = nil
+	expired := kerrors.NewResourceExpired("too old")
+	_, err = pagedList(ctx, "ns1", metav1.ListOptions{}, list("t2", expired))
+	assert.NoError(t, err)
+	assert.Equal(t, []metav1.ListOptions{{Limit: 10, Continue: "t1"}, {Limit: 10}}, calls)
+	assert.Equal(t, 0, p.Page())
+	assert.True(t, p.Next())
+
+	calls = nil
+	_, err = pagedList(ctx, "ns2", metav1.ListOptions{}, list("", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, metav1.ListOptions{Limit: 10}, calls[0])
+	assert.False(t, p.HasNext())
+
+	_, err = pagedList(ctx, "ns2", metav1.ListOptions{}, func(metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+		return nil, errors.New("boom")
+	})
+	assert.Error(t, err)
+}
@@ -5,6 +5,10 @@ package dao
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // ImageSpec represents a container image.
@@ -87,3 +91,61 @@ func extractElements(imageSpecs ImageSpecs) (initElementsOrders []Element, initE
 	}
 	return initElementsOrders, initElements, elementsOrders, elements
 }
+
+// MetaPatch tracks resource metadata updates.
+type MetaPatch struct {
+	Metadata MetaFields `json:"metadata"`
+}
+
+// MetaFields represents labels and annotations updates. A nil value
+// removes the given key.
+type MetaFields struct {
+	Labels      map[string]*string `json:"labels,omitempty"`
+	Annotations map[string]*string `json:"annotations,omitempty"`
+}
+
+// GetMetaPatch builds a merge patch to update labels and annotations.
+func GetMetaPatch(labels, annotations map[string]*string) ([]byte, error) {
+	return json.Marshal(MetaPatch{
+		Metadata: MetaFields{
+			Labels:      labels,
+			Annotations: annotations,
+		},
+	})
+}
+
+// ParseMetaEdits parses kubectl style metadata edits ie k1=v1,k2- where a
+// trailing dash removes the key.
+func ParseMetaEdits(s string) (map[string]*string, error) {
+	edits := make(map[string]*string)
+	for _, tok := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if k, ok := strings.CutSuffix(tok, "-"); ok && !strings.Contains(tok, "=") {
+			if err := validateMetaKey(k); err != nil {
+				return nil, err
+			}
+			edits[k] = nil
+			continue
+		}
+		k, v, ok := strings.Cut(tok, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid edit %q. Expecting key=value or key-", tok)
+		}
+		if err := validateMetaKey(k); err != nil {
+			return nil, err
+		}
+		edits[k] = &v
+	}
+	if len(edits) == 0 {
+		return nil, fmt.Errorf("no edits specified")
+	}
+
+	return edits, nil
+}
+
+func validateMetaKey(k string) error {
+	if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+		return fmt.Errorf("invalid key %q: %s", k, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
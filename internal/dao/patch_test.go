@@ -94,3 +94,56 @@ func TestGetJsonPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMetaEdits(t *testing.T) {
+	v1, v2 := "v1", ""
+	uu := map[string]struct {
+		s    string
+		e    map[string]*string
+		fail bool
+	}{
+		"add": {
+			s: "a=v1,b=",
+			e: map[string]*string{"a": &v1, "b": &v2},
+		},
+		"remove": {
+			s: "a=v1 b-",
+			e: map[string]*string{"a": &v1, "b": nil},
+		},
+		"prefixed": {
+			s: "app.kubernetes.io/name-",
+			e: map[string]*string{"app.kubernetes.io/name": nil},
+		},
+		"empty": {
+			fail: true,
+		},
+		"no-value": {
+			s:    "a",
+			fail: true,
+		},
+		"bad-key": {
+			s:    "-a=v1",
+			fail: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ee, err := ParseMetaEdits(u.s)
+			if u.fail {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, u.e, ee)
+		})
+	}
+}
+
+func TestGetMetaPatch(t *testing.T) {
+	v := "v1"
+	bb, err := GetMetaPatch(map[string]*string{"a": &v, "b": nil}, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"metadata":{"labels":{"a":"v1","b":null}}}`, string(bb))
+}
@@ -0,0 +1,313 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// JobRunning tracks a running plugin job.
+	JobRunning = "Running"
+
+	// JobCompleted tracks a successful plugin job.
+	JobCompleted = "Completed"
+
+	// JobFailed tracks a failed plugin job.
+	JobFailed = "Failed"
+
+	// JobKilled tracks a plugin job killed by the user.
+	JobKilled = "Killed"
+
+	// maxJobOutput caps the output retained per job.
+	maxJobOutput = 1 << 20
+)
+
+var (
+	_ Accessor = (*PluginJob)(nil)
+	_ Nuker    = (*PluginJob)(nil)
+)
+
+// PluginJob represents a plugin job dao.
+type PluginJob struct {
+	NonResource
+}
+
+// Delete kills a running job or discards a finished one.
+func (p *PluginJob) Delete(ctx context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
+	jj, ok := ctx.Value(internal.KeyPluginJobs).(*BackgroundJobs)
+	if !ok {
+		return fmt.Errorf("no plugin jobs found in context")
+	}
+
+	return jj.Delete(path)
+}
+
+// List returns a collection of plugin jobs.
+func (p *PluginJob) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	jj, ok := ctx.Value(internal.KeyPluginJobs).(*BackgroundJobs)
+	if !ok {
+		return nil, fmt.Errorf("no plugin jobs found in context")
+	}
+
+	ll := jj.List()
+	oo := make([]runtime.Object, 0, len(ll))
+	for _, j := range ll {
+		oo = append(oo, render.PluginJobRes{PluginJobber: j})
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// BackgroundJob represents a plugin command running detached.
+type BackgroundJob struct {
+	id        string
+	name      string
+	cmd       *exec.Cmd
+	out       bytes.Buffer
+	status    string
+	err       error
+	startTime time.Time
+	endTime   time.Time
+	killed    bool
+	mx        sync.RWMutex
+}
+
+// ID returns the job id.
+func (j *BackgroundJob) ID() string {
+	return j.id
+}
+
+// Name returns the plugin name.
+func (j *BackgroundJob) Name() string {
+	return j.name
+}
+
+// Command returns the job command line.
+func (j *BackgroundJob) Command() string {
+	return strings.Join(j.cmd.Args, " ")
+}
+
+// Status returns the job status.
+func (j *BackgroundJob) Status() string {
+	j.mx.RLock()
+	defer j.mx.RUnlock()
+
+	return j.status
+}
+
+// Err returns the job error if any.
+func (j *BackgroundJob) Err() error {
+	j.mx.RLock()
+	defer j.mx.RUnlock()
+
+	return j.err
+}
+
+// Started returns the job start time.
+func (j *BackgroundJob) Started() time.Time {
+	return j.startTime
+}
+
+// Duration returns how long the job ran for.
+func (j *BackgroundJob) Duration() time.Duration {
+	j.mx.RLock()
+	defer j.mx.RUnlock()
+
+	if j.endTime.IsZero() {
+		return time.Since(j.startTime)
+	}
+
+	return j.endTime.Sub(j.startTime)
+}
+
+// IsRunning returns true if the job is still running.
+func (j *BackgroundJob) IsRunning() bool {
+	return j.Status() == JobRunning
+}
+
+// Output returns the job stdout and stderr.
+func (j *BackgroundJob) Output() string {
+	j.mx.RLock()
+	defer j.mx.RUnlock()
+
+	return j.out.String()
+}
+
+// Write captures the job output.
+func (j *BackgroundJob) Write(bb []byte) (int, error) {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	n, err := j.out.Write(bb)
+	if over := j.out.Len() - maxJobOutput; over > 0 {
+		j.out.Next(over)
+	}
+
+	return n, err
+}
+
+func (j *BackgroundJob) kill() error {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	if j.status != JobRunning || j.cmd.Process == nil {
+		return nil
+	}
+	j.killed = true
+
+	return j.cmd.Process.Kill()
+}
+
+func (j *BackgroundJob) wait() {
+	err := j.cmd.Wait()
+
+	j.mx.Lock()
+	defer j.mx.Unlock()
+	j.endTime, j.err = time.Now(), err
+	switch {
+	case j.killed:
+		j.status = JobKilled
+	case err != nil:
+		j.status = JobFailed
+	default:
+		j.status = JobCompleted
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// JobDoneFunc is called once a background job exits.
+type JobDoneFunc func(*BackgroundJob)
+
+// BackgroundJobs tracks plugin jobs running detached.
+type BackgroundJobs struct {
+	jobs map[string]*BackgroundJob
+	seq  int
+	mx   sync.RWMutex
+}
+
+// NewBackgroundJobs returns a new job manager.
+func NewBackgroundJobs() *BackgroundJobs {
+	return &BackgroundJobs{
+		jobs: make(map[string]*BackgroundJob),
+	}
+}
+
+// Start launches a detached job capturing its output.
+func (b *BackgroundJobs) Start(name, bin string, args []string, input []byte, done JobDoneFunc) (*BackgroundJob, error) {
+	b.mx.Lock()
+	b.seq++
+	id := strconv.Itoa(b.seq)
+	b.mx.Unlock()
+
+	j := BackgroundJob{
+		id:     id,
+		name:   name,
+		cmd:    exec.Command(bin, args...),
+		status: JobRunning,
+	}
+	j.cmd.Stdout, j.cmd.Stderr = &j, &j
+	if input != nil {
+		j.cmd.Stdin = bytes.NewReader(input)
+	}
+	log.Debug().Msgf("Starting job %s> %s", id, j.cmd)
+	j.startTime = time.Now()
+	if err := j.cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	b.mx.Lock()
+	b.jobs[id] = &j
+	b.mx.Unlock()
+
+	go func() {
+		j.wait()
+		log.Debug().Msgf("Job %s exited: %s", id, j.Status())
+		if done != nil {
+			done(&j)
+		}
+	}()
+
+	return &j, nil
+}
+
+// Get returns a job by id.
+func (b *BackgroundJobs) Get(id string) (*BackgroundJob, bool) {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+	j, ok := b.jobs[id]
+
+	return j, ok
+}
+
+// List returns all jobs ordered by start time.
+func (b *BackgroundJobs) List() []*BackgroundJob {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	jj := make([]*BackgroundJob, 0, len(b.jobs))
+	for _, j := range b.jobs {
+		jj = append(jj, j)
+	}
+	sort.Slice(jj, func(i, k int) bool {
+		return jj[i].startTime.Before(jj[k].startTime)
+	})
+
+	return jj
+}
+
+// Kill kills a running job.
+func (b *BackgroundJobs) Kill(id string) error {
+	j, ok := b.Get(id)
+	if !ok {
+		return fmt.Errorf("no job found with id %q", id)
+	}
+
+	return j.kill()
+}
+
+// Delete kills a running job or discards a finished one.
+func (b *BackgroundJobs) Delete(id string) error {
+	j, ok := b.Get(id)
+	if !ok {
+		return fmt.Errorf("no job found with id %q", id)
+	}
+	if j.IsRunning() {
+		return j.kill()
+	}
+
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	delete(b.jobs, id)
+
+	return nil
+}
+
+// KillAll kills all running jobs.
+func (b *BackgroundJobs) KillAll() {
+	var errs error
+	for _, j := range b.List() {
+		errs = errors.Join(errs, j.kill())
+	}
+	if errs != nil {
+		log.Error().Err(errs).Msg("Killing plugin jobs")
+	}
+}
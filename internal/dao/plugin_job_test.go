@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackgroundJobsStart(t *testing.T) {
+	uu := map[string]struct {
+		args   []string
+		input  []byte
+		status string
+		out    string
+	}{
+		"completed": {
+			args:   []string{"-c", "echo fred"},
+			status: dao.JobCompleted,
+			out:    "fred\n",
+		},
+		"stdin": {
+			args:   []string{"-c", "cat"},
+			input:  []byte("blee"),
+			status: dao.JobCompleted,
+			out:    "blee",
+		},
+		"failed": {
+			args:   []string{"-c", "echo oops >&2; exit 1"},
+			status: dao.JobFailed,
+			out:    "oops\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			jj := dao.NewBackgroundJobs()
+			done := make(chan *dao.BackgroundJob, 1)
+			j, err := jj.Start("fred", "sh", u.args, u.input, func(j *dao.BackgroundJob) {
+				done <- j
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "1", j.ID())
+
+			<-done
+			assert.Equal(t, u.status, j.Status())
+			assert.Equal(t, u.out, j.Output())
+			assert.False(t, j.IsRunning())
+		})
+	}
+}
+
+func TestBackgroundJobsKill(t *testing.T) {
+	jj := dao.NewBackgroundJobs()
+	done := make(chan *dao.BackgroundJob, 1)
+	j, err := jj.Start("fred", "sleep", []string{"10"}, nil, func(j *dao.BackgroundJob) {
+		done <- j
+	})
+	assert.NoError(t, err)
+	assert.True(t, j.IsRunning())
+
+	assert.NoError(t, jj.Kill(j.ID()))
+	<-done
+	assert.Equal(t, dao.JobKilled, j.Status())
+	assert.Error(t, jj.Kill("zorg"))
+}
+
+func TestPluginJobList(t *testing.T) {
+	jj := dao.NewBackgroundJobs()
+	done := make(chan *dao.BackgroundJob, 2)
+	for i := 0; i < 2; i++ {
+		_, err := jj.Start("fred", "true", nil, nil, func(j *dao.BackgroundJob) {
+			done <- j
+		})
+		assert.NoError(t, err)
+	}
+	<-done
+	<-done
+
+	var p dao.PluginJob
+	ctx := context.WithValue(context.Background(), internal.KeyPluginJobs, jj)
+	oo, err := p.List(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(oo))
+
+	assert.NoError(t, p.Delete(ctx, "1", nil, dao.DefaultGrace))
+	oo, err = p.List(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(oo))
+
+	_, err = p.List(context.Background(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*PluginRows)(nil)
+)
+
+// PluginRows represents rows returned by a plugin.
+type PluginRows struct {
+	NonResource
+}
+
+// List returns the plugin rows as a table.
+func (p *PluginRows) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	t, ok := ctx.Value(internal.KeyPluginRows).(*metav1.Table)
+	if !ok {
+		return nil, fmt.Errorf("no plugin rows for %q", p.gvrStr())
+	}
+
+	return []runtime.Object{t}, nil
+}
@@ -16,7 +16,6 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/watch"
-	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -276,25 +275,28 @@ func (p *Pod) Scan(ctx context.Context, gvr client.GVR, fqn string, wait bool) (
 		}
 		switch gvr {
 		case CmGVR:
-			if !hasConfigMap(&pod.Spec, n) {
+			usage := configMapUsage(&pod.Spec, n)
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: p.GVR(),
-				FQN: client.FQN(pod.Namespace, pod.Name),
+				GVR:   p.GVR(),
+				FQN:   client.FQN(pod.Namespace, pod.Name),
+				Usage: usage,
 			})
 		case SecGVR:
-			found, err := hasSecret(p.Factory, &pod.Spec, pod.Namespace, n, wait)
+			usage, err := secretUsage(p.Factory, &pod.Spec, pod.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("locate secret %q", fqn)
 				continue
 			}
-			if !found {
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: p.GVR(),
-				FQN: client.FQN(pod.Namespace, pod.Name),
+				GVR:   p.GVR(),
+				FQN:   client.FQN(pod.Namespace, pod.Name),
+				Usage: usage,
 			})
 		case PvcGVR:
 			if !hasPVC(&pod.Spec, n) {
@@ -425,6 +427,9 @@ func (p *Pod) GetPodSpec(path string) (*v1.PodSpec, error) {
 
 // SetImages sets container images.
 func (p *Pod) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) error {
+	if err := ensureWritable("set image", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	auth, err := p.Client().CanI(ns, "v1/pod", n, client.PatchAccess)
 	if err != nil {
@@ -471,6 +476,9 @@ func (p *Pod) isControlled(path string) (string, bool, error) {
 }
 
 func (p *Pod) Sanitize(ctx context.Context, ns string) (int, error) {
+	if err := ensureWritable("sanitize", ns); err != nil {
+		return 0, err
+	}
 	oo, err := p.Resource.List(ctx, ns)
 	if err != nil {
 		return 0, err
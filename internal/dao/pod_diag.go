@@ -0,0 +1,324 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	evGVR  = "v1/events"
+	pvcGVR = "v1/persistentvolumeclaims"
+
+	diagVolumes    = "Volumes"
+	diagScheduling = "Scheduling"
+	diagImages     = "Images"
+	diagCrashes    = "Crashes"
+	diagProbes     = "Probes"
+)
+
+var (
+	_ Accessor = (*PodDiag)(nil)
+
+	imagePullReasons = map[string]struct{}{
+		"ErrImagePull":        {},
+		"ImagePullBackOff":    {},
+		"InvalidImageName":    {},
+		"ErrImageNeverPull":   {},
+		"RegistryUnavailable": {},
+	}
+	probeKinds = []string{"Startup", "Liveness", "Readiness"}
+)
+
+// PodDiag troubleshoots why a pod is not running.
+type PodDiag struct {
+	NonResource
+}
+
+// List returns the troubleshooting findings for a given pod.
+func (p *PodDiag) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, errors.New("no pod path specified")
+	}
+
+	var po Pod
+	po.Init(p.getFactory(), client.NewGVR("v1/pods"))
+	pod, err := po.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+
+	oo, err := p.getFactory().List(evGVR, pod.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ee := make([]v1.Event, 0, 10)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var ev v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ev); err != nil {
+			return nil, err
+		}
+		if ev.InvolvedObject.Kind == "Pod" && ev.InvolvedObject.Name == pod.Name {
+			ee = append(ee, ev)
+		}
+	}
+
+	pvcs := make(map[string]*v1.PersistentVolumeClaim)
+	for _, v := range pod.Spec.Volumes {
+		if v.PersistentVolumeClaim == nil {
+			continue
+		}
+		o, err := p.getFactory().Get(pvcGVR, client.FQN(pod.Namespace, v.PersistentVolumeClaim.ClaimName), true, labels.Everything())
+		if err != nil {
+			continue
+		}
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var pvc v1.PersistentVolumeClaim
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pvc); err != nil {
+			return nil, err
+		}
+		pvcs[pvc.Name] = &pvc
+	}
+
+	rr := DiagnosePod(pod, ee, pvcs)
+	res := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// Get returns a given pod finding.
+func (p *PodDiag) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, fmt.Errorf("nyi")
+}
+
+// DiagnosePod walks a decision tree to find out why a pod is not running.
+// Volume claims and scheduling are checked first. Container images, crashes
+// and probes are only checked once the pod is scheduled.
+func DiagnosePod(pod *v1.Pod, ee []v1.Event, pvcs map[string]*v1.PersistentVolumeClaim) []render.PodDiagRes {
+	d := podDiagnosis{
+		fqn:    client.FQN(pod.Namespace, pod.Name),
+		pod:    pod,
+		events: ee,
+	}
+	d.checkVolumes(pvcs)
+	if !d.checkScheduling() {
+		return d.rr
+	}
+	d.checkImages()
+	d.checkCrashes()
+	d.checkProbes()
+
+	return d.rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type podDiagnosis struct {
+	fqn    string
+	pod    *v1.Pod
+	events []v1.Event
+	rr     []render.PodDiagRes
+}
+
+func (d *podDiagnosis) add(check, result, finding, next string) {
+	d.rr = append(d.rr, render.PodDiagRes{
+		Pod:     d.fqn,
+		Index:   len(d.rr),
+		Check:   check,
+		Result:  result,
+		Finding: finding,
+		Next:    next,
+	})
+}
+
+func (d *podDiagnosis) eventsCmd(n string) string {
+	return ":events /" + n
+}
+
+func (d *podDiagnosis) checkVolumes(pvcs map[string]*v1.PersistentVolumeClaim) {
+	var claims, issues int
+	for _, v := range d.pod.Spec.Volumes {
+		if v.PersistentVolumeClaim == nil {
+			continue
+		}
+		claims++
+		n := v.PersistentVolumeClaim.ClaimName
+		pvc, ok := pvcs[n]
+		switch {
+		case !ok:
+			issues++
+			d.add(diagVolumes, render.DiagFail, fmt.Sprintf("claim %q not found", n), ":pvc")
+		case pvc.Status.Phase != v1.ClaimBound:
+			issues++
+			d.add(diagVolumes, render.DiagFail, fmt.Sprintf("claim %q is %s", n, pvc.Status.Phase), d.eventsCmd(n))
+		}
+	}
+	if claims > 0 && issues == 0 {
+		d.add(diagVolumes, render.DiagOK, fmt.Sprintf("%d claim(s) bound", claims), "")
+	}
+}
+
+func (d *podDiagnosis) checkScheduling() bool {
+	if d.pod.Spec.NodeName != "" {
+		d.add(diagScheduling, render.DiagOK, fmt.Sprintf("scheduled on %s", d.pod.Spec.NodeName), "")
+		return true
+	}
+
+	finding := "pod is awaiting scheduling"
+	for _, c := range d.pod.Status.Conditions {
+		if c.Type == v1.PodScheduled && c.Status == v1.ConditionFalse && c.Message != "" {
+			finding = c.Message
+		}
+	}
+	if ev, ok := d.lastEvent("FailedScheduling", ""); ok {
+		finding = ev.Message
+	}
+	d.add(diagScheduling, render.DiagFail, finding, d.eventsCmd(d.pod.Name))
+
+	return false
+}
+
+func (d *podDiagnosis) checkImages() {
+	var issues int
+	for _, cs := range d.containerStatuses() {
+		w := cs.State.Waiting
+		if w == nil {
+			continue
+		}
+		if _, ok := imagePullReasons[w.Reason]; !ok {
+			continue
+		}
+		issues++
+		next := d.eventsCmd(d.pod.Name)
+		if len(d.pod.Spec.ImagePullSecrets) == 0 && w.Reason != "InvalidImageName" {
+			next = ":secrets"
+		}
+		d.add(diagImages, render.DiagFail, fmt.Sprintf("container %s: %s %s %s", cs.Name, w.Reason, cs.Image, w.Message), next)
+	}
+	if issues == 0 {
+		d.add(diagImages, render.DiagOK, "all images pulled", "")
+	}
+}
+
+func (d *podDiagnosis) checkCrashes() {
+	var issues int
+	for _, cs := range d.containerStatuses() {
+		t := cs.LastTerminationState.Terminated
+		if cs.State.Terminated != nil {
+			t = cs.State.Terminated
+		}
+		switch {
+		case t != nil && t.Reason == "OOMKilled":
+			issues++
+			finding := fmt.Sprintf("container %s was OOMKilled", cs.Name)
+			if l, ok := d.memLimit(cs.Name); ok {
+				finding += " (memory limit " + l + ")"
+			}
+			d.add(diagCrashes, render.DiagFail, finding, "<p> previous logs")
+		case cs.State.Waiting != nil && cs.State.Waiting.Reason == "CrashLoopBackOff":
+			issues++
+			finding := fmt.Sprintf("container %s is crash looping", cs.Name)
+			if t != nil {
+				finding += fmt.Sprintf(" (exit code %d %s)", t.ExitCode, t.Reason)
+			}
+			d.add(diagCrashes, render.DiagFail, finding, "<p> previous logs")
+		case cs.RestartCount > 0:
+			issues++
+			d.add(diagCrashes, render.DiagWarn, fmt.Sprintf("container %s restarted %d time(s)", cs.Name, cs.RestartCount), "<p> previous logs")
+		}
+	}
+	if issues == 0 {
+		d.add(diagCrashes, render.DiagOK, "no container crashes", "")
+	}
+}
+
+func (d *podDiagnosis) checkProbes() {
+	var issues int
+	for _, k := range probeKinds {
+		ev, ok := d.lastEvent("Unhealthy", k+" probe failed")
+		if !ok {
+			continue
+		}
+		issues++
+		result := render.DiagWarn
+		if k == "Liveness" {
+			result = render.DiagFail
+		}
+		finding := ev.Message
+		if ev.Count > 1 {
+			finding += fmt.Sprintf(" (x%d)", ev.Count)
+		}
+		d.add(diagProbes, result, finding, d.eventsCmd(d.pod.Name))
+	}
+	for _, cs := range d.pod.Status.ContainerStatuses {
+		if cs.State.Running != nil && !cs.Ready {
+			issues++
+			d.add(diagProbes, render.DiagWarn, fmt.Sprintf("container %s is running but not ready", cs.Name), d.eventsCmd(d.pod.Name))
+		}
+	}
+	if issues == 0 {
+		d.add(diagProbes, render.DiagOK, "probes passing", "")
+	}
+}
+
+// lastEvent returns the most recent pod event matching a reason and message prefix.
+func (d *podDiagnosis) lastEvent(reason, prefix string) (v1.Event, bool) {
+	var (
+		last v1.Event
+		ok   bool
+	)
+	for _, ev := range d.events {
+		if ev.Reason != reason || !strings.HasPrefix(ev.Message, prefix) {
+			continue
+		}
+		if !ok || last.LastTimestamp.Before(&ev.LastTimestamp) {
+			last, ok = ev, true
+		}
+	}
+
+	return last, ok
+}
+
+func (d *podDiagnosis) containerStatuses() []v1.ContainerStatus {
+	ss := make([]v1.ContainerStatus, 0, len(d.pod.Status.InitContainerStatuses)+len(d.pod.Status.ContainerStatuses))
+	ss = append(ss, d.pod.Status.InitContainerStatuses...)
+
+	return append(ss, d.pod.Status.ContainerStatuses...)
+}
+
+func (d *podDiagnosis) memLimit(co string) (string, bool) {
+	for _, c := range d.pod.Spec.Containers {
+		if c.Name != co {
+			continue
+		}
+		if l, ok := c.Resources.Limits[v1.ResourceMemory]; ok {
+			return l.String(), true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiagnosePod(t *testing.T) {
+	pod := func(node string, cs ...v1.ContainerStatus) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+			Spec: v1.PodSpec{
+				NodeName: node,
+				Containers: []v1.Container{{
+					Name: "c1",
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("64Mi")},
+					},
+				}},
+			},
+			Status: v1.PodStatus{ContainerStatuses: cs},
+		}
+	}
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+
+	uu := map[string]struct {
+		pod  *v1.Pod
+		ee   []v1.Event
+		pvcs map[string]*v1.PersistentVolumeClaim
+		e    [][3]string
+	}{
+		"healthy": {
+			pod: pod("n1", v1.ContainerStatus{Name: "c1", State: running, Ready: true}),
+			e: [][3]string{
+				{"Scheduling", "OK", "scheduled on n1"},
+				{"Images", "OK", "all images pulled"},
+				{"Crashes", "OK", "no container crashes"},
+				{"Probes", "OK", "probes passing"},
+			},
+		},
+		"unscheduled": {
+			pod: func() *v1.Pod {
+				p := pod("")
+				p.Spec.Volumes = []v1.Volume{{
+					Name:         "v1",
+					VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "c1"}},
+				}}
+				return p
+			}(),
+			ee: []v1.Event{{Reason: "FailedScheduling", Message: "0/3 nodes are available"}},
+			pvcs: map[string]*v1.PersistentVolumeClaim{
+				"c1": {Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending}},
+			},
+			e: [][3]string{
+				{"Volumes", "FAIL", `claim "c1" is Pending`},
+				{"Scheduling", "FAIL", "0/3 nodes are available"},
+			},
+		},
+		"image-pull": {
+			pod: pod("n1", v1.ContainerStatus{
+				Name:  "c1",
+				Image: "fred:0.0.1",
+				State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "not found"}},
+			}),
+			e: [][3]string{
+				{"Scheduling", "OK", "scheduled on n1"},
+				{"Images", "FAIL", "container c1: ImagePullBackOff fred:0.0.1 not found"},
+				{"Crashes", "OK", "no container crashes"},
+				{"Probes", "OK", "probes passing"},
+			},
+		},
+		"oom": {
+			pod: pod("n1", v1.ContainerStatus{
+				Name:                 "c1",
+				State:                running,
+				Ready:                true,
+				RestartCount:         2,
+				LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+			}),
+			e: [][3]string{
+				{"Scheduling", "OK", "scheduled on n1"},
+				{"Images", "OK", "all images pulled"},
+				{"Crashes", "FAIL", "container c1 was OOMKilled (memory limit 64Mi)"},
+				{"Probes", "OK", "probes passing"},
+			},
+		},
+		"probes": {
+			pod: pod("n1", v1.ContainerStatus{Name: "c1", State: running}),
+			ee: []v1.Event{
+				{Reason: "Unhealthy", Message: "Readiness probe failed: connection refused", Count: 4},
+				{Reason: "Pulled", Message: "Pulled image"},
+			},
+			e: [][3]string{
+				{"Scheduling", "OK", "scheduled on n1"},
+				{"Images", "OK", "all images pulled"},
+				{"Crashes", "OK", "no container crashes"},
+				{"Probes", "WARN", "Readiness probe failed: connection refused (x4)"},
+				{"Probes", "WARN", "container c1 is running but not ready"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr := dao.DiagnosePod(u.pod, u.ee, u.pvcs)
+			ff := make([][3]string, 0, len(rr))
+			for i, r := range rr {
+				assert.Equal(t, "ns1/p1", r.Pod)
+				assert.Equal(t, i, r.Index)
+				ff = append(ff, [3]string{r.Check, r.Result, r.Finding})
+			}
+			assert.Equal(t, u.e, ff)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/popeye/pkg"
+	pcfg "github.com/derailed/popeye/pkg/config"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+var _ Accessor = (*PopeyeScore)(nil)
+
+// PopeyeScore tracks popeye scheduled scans scores.
+type PopeyeScore struct {
+	NonResource
+}
+
+// List returns the scores history, most recent first.
+func (p *PopeyeScore) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no popeye scores file found in context")
+	}
+	ss, err := config.LoadPopeyeScores(path)
+	if err != nil {
+		return nil, err
+	}
+	rr := PopeyeTrend(ss.Scores, render.PopeyeTrendWindow)
+	oo := make([]runtime.Object, 0, len(rr))
+	for i := len(rr) - 1; i >= 0; i-- {
+		oo = append(oo, rr[i])
+	}
+
+	return oo, nil
+}
+
+// Get returns a given score.
+func (p *PopeyeScore) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, fmt.Errorf("nyi")
+}
+
+// PopeyeTrend computes deltas and trends for a chronological scores history.
+func PopeyeTrend(ss []config.PopeyeScore, window int) []render.PopeyeScoreRes {
+	rr := make([]render.PopeyeScoreRes, 0, len(ss))
+	for i, s := range ss {
+		r := render.PopeyeScoreRes{
+			Index:  i,
+			Time:   s.Time,
+			Score:  s.Score,
+			Errors: s.Errors,
+		}
+		if i > 0 {
+			r.Delta = s.Score - ss[i-1].Score
+		}
+		start := i - window + 1
+		if start < 0 {
+			start = 0
+		}
+		r.Trend = make([]int, 0, i-start+1)
+		for _, t := range ss[start : i+1] {
+			r.Trend = append(r.Trend, t.Score)
+		}
+		rr = append(rr, r)
+	}
+
+	return rr
+}
+
+// ScanPopeye runs a popeye scan against the cluster and returns the error count and score.
+func ScanPopeye(flags *genericclioptions.ConfigFlags) (int, int, error) {
+	ff, out, all := pcfg.NewFlags(), "score", true
+	ff.ConfigFlags = flags
+	ff.Output = &out
+	ff.AllNamespaces = &all
+
+	p, err := pkg.NewPopeye(ff, log.Zerolog())
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := p.Init(); err != nil {
+		return 0, 0, err
+	}
+	p.SetOutputTarget(pkg.NopWriter(bytes.NewBufferString("")))
+
+	return p.Sanitize()
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopeyeTrend(t *testing.T) {
+	ss := []config.PopeyeScore{
+		{Score: 80, Errors: 3},
+		{Score: 90, Errors: 1},
+		{Score: 85, Errors: 2},
+	}
+	uu := map[string]struct {
+		window int
+		deltas []int
+		trends [][]int
+	}{
+		"full": {
+			window: 10,
+			deltas: []int{0, 10, -5},
+			trends: [][]int{{80}, {80, 90}, {80, 90, 85}},
+		},
+		"windowed": {
+			window: 2,
+			deltas: []int{0, 10, -5},
+			trends: [][]int{{80}, {80, 90}, {90, 85}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr := PopeyeTrend(ss, u.window)
+			assert.Equal(t, len(ss), len(rr))
+			for i, r := range rr {
+				assert.Equal(t, i, r.Index)
+				assert.Equal(t, ss[i].Errors, r.Errors)
+				assert.Equal(t, u.deltas[i], r.Delta)
+				assert.Equal(t, u.trends[i], r.Trend)
+			}
+		})
+	}
+}
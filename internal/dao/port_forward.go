@@ -13,7 +13,6 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
-	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -59,6 +58,9 @@ func (p *PortForward) List(ctx context.Context, _ string) ([]runtime.Object, err
 			N: config.Benchmarks.Defaults.N,
 		}
 		if cust, ok := cc[PodToKey(k)]; ok {
+			if cust, err = config.Benchmarks.Resolve(cust); err != nil {
+				log.Warn().Err(err).Msgf("Benchmark config for %q", k)
+			}
 			cfg.C, cfg.N = cust.C, cust.N
 			cfg.Host, cfg.Path = cust.HTTP.Host, cust.HTTP.Path
 		}
@@ -88,17 +90,17 @@ func PodToKey(path string) string {
 }
 
 // BenchConfigFor returns a custom bench spec if defined otherwise returns the default one.
-func BenchConfigFor(benchFile, path string) config.BenchConfig {
+func BenchConfigFor(benchFile, path string) (config.BenchConfig, error) {
 	def := config.DefaultBenchSpec()
 	cust, err := config.NewBench(benchFile)
 	if err != nil {
 		log.Debug().Msgf("No custom benchmark config file found. Using default: %q", benchFile)
-		return def
+		return def, nil
 	}
 	if b, ok := cust.Benchmarks.Containers[PodToKey(path)]; ok {
-		return b
+		return cust.Benchmarks.Resolve(b)
 	}
 
 	def.C, def.N = cust.Benchmarks.Defaults.C, cust.Benchmarks.Defaults.N
-	return def
+	return def, nil
 }
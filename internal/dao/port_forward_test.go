@@ -4,6 +4,7 @@
 package dao_test
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
@@ -17,7 +18,7 @@ func TestBenchForConfig(t *testing.T) {
 		spec      config.BenchConfig
 	}{
 		"no_file": {file: "", key: "", spec: config.DefaultBenchSpec()},
-		"spec": {file: "testdata/benchspec.yaml", key: "default/nginx-123-456|nginx", spec: config.BenchConfig{
+		"spec": {file: "testdata/benchspec.yaml", key: "default/nginx-6b866d578b-c6tcn|nginx", spec: config.BenchConfig{
 			C: 2,
 			N: 3000,
 			HTTP: config.HTTP{
@@ -25,12 +26,34 @@ func TestBenchForConfig(t *testing.T) {
 				Path:   "/",
 			},
 		}},
+		"defaults": {file: "testdata/benchspec.yaml", key: "default/fred-6b866d578b-c6tcn|fred", spec: config.BenchConfig{
+			C: 2,
+			N: 500,
+			HTTP: config.HTTP{
+				Method: "GET",
+				Path:   "/",
+			},
+		}},
+		"profile": {file: "testdata/benchspec.yaml", key: "default/blee-6b866d578b-c6tcn|blee", spec: config.BenchConfig{
+			Profile: "h2",
+			C:       5,
+			N:       100,
+			HTTP: config.HTTP{
+				Method:  "POST",
+				Scheme:  "https",
+				Path:    "/api",
+				HTTP2:   true,
+				Headers: http.Header{"Accept": {"application/json"}},
+			},
+		}},
 	}
 
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			assert.NotNil(t, u.spec, dao.BenchConfigFor(u.file, u.key))
+			cfg, err := dao.BenchConfigFor(u.file, u.key)
+			assert.NoError(t, err)
+			assert.Equal(t, u.spec, cfg)
 		})
 	}
 }
@@ -12,9 +12,9 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/port"
-	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -36,6 +36,8 @@ type PortForwarder struct {
 	path                string
 	tunnel              port.PortTunnel
 	age                 time.Time
+	meter               *port.Meter
+	selector            labels.Selector
 }
 
 // NewPortForwarder returns a new port forward streamer.
@@ -44,6 +46,7 @@ func NewPortForwarder(f Factory) *PortForwarder {
 		Factory:   f,
 		stopChan:  make(chan struct{}),
 		readyChan: make(chan struct{}),
+		meter:     port.NewMeter(),
 	}
 }
 
@@ -67,6 +70,37 @@ func (p *PortForwarder) SetActive(b bool) {
 	p.active = b
 }
 
+// Stats returns the port forward traffic stats.
+func (p *PortForwarder) Stats() port.TunnelStats {
+	return p.meter.Stats()
+}
+
+// SetSelector backs the port forward by any healthy pod matching a selector.
+func (p *PortForwarder) SetSelector(sel labels.Selector) {
+	p.selector = sel
+}
+
+// Retargetable checks if the port forward can move to another backing pod.
+func (p *PortForwarder) Retargetable() bool {
+	return p.selector != nil
+}
+
+// Repick restarts the port forward on another healthy pod matching its
+// selector.
+func (p *PortForwarder) Repick() (*portforward.PortForwarder, error) {
+	if p.selector == nil {
+		return nil, fmt.Errorf("port-forward %s is not backed by a selector", p.ID())
+	}
+	ns, _ := client.Namespaced(p.path)
+	path, err := HealthyPod(p.Factory, ns, p.selector, p.path)
+	if err != nil {
+		return nil, err
+	}
+	p.stopChan, p.readyChan = make(chan struct{}), make(chan struct{})
+
+	return p.Start(path, p.tunnel)
+}
+
 // Port returns the port mapping.
 func (p *PortForwarder) Port() string {
 	return p.tunnel.PortMap()
@@ -174,7 +208,7 @@ func (p *PortForwarder) forwardPorts(method string, url *url.URL, addr, portMap
 	if err != nil {
 		return nil, err
 	}
-	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport, Timeout: defaultTimeout}, method, url)
+	dialer := p.meter.Dialer(spdy.NewDialer(upgrader, &http.Client{Transport: transport, Timeout: defaultTimeout}, method, url))
 
 	return portforward.NewOnAddresses(dialer, []string{addr}, []string{portMap}, p.stopChan, p.readyChan, p.Out, p.ErrOut)
 }
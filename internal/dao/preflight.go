@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const metricsGroup = "metrics.k8s.io"
+
+// PreflightCheck represents a context preflight check outcome.
+type PreflightCheck struct {
+	Name   string
+	Detail string
+	Err    error
+}
+
+// String returns the check outcome as a string.
+func (p PreflightCheck) String() string {
+	if p.Err != nil {
+		return fmt.Sprintf("✗ %s: %s", p.Name, p.Err)
+	}
+
+	return fmt.Sprintf("✓ %s: %s", p.Name, p.Detail)
+}
+
+// PreflightChecks represents a collection of preflight checks.
+type PreflightChecks []PreflightCheck
+
+// Passed checks if all the checks passed.
+func (pp PreflightChecks) Passed() bool {
+	for _, p := range pp {
+		if p.Err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Preflight checks a given context is reachable, authenticates and serves
+// metrics without switching to it.
+func Preflight(ctx context.Context, cfg *client.Config, name string) PreflightChecks {
+	dial, err := fleetDial(cfg, name)
+	if err != nil {
+		return PreflightChecks{{Name: "Config", Err: err}}
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.CallTimeout())
+	defer cancel()
+
+	return preflight(ctx, dial)
+}
+
+func preflight(ctx context.Context, dial kubernetes.Interface) PreflightChecks {
+	rev, err := dial.Discovery().ServerVersion()
+	if err != nil {
+		return PreflightChecks{{Name: "Reachable", Err: err}}
+	}
+	pp := PreflightChecks{{Name: "Reachable", Detail: "server " + rev.GitVersion}}
+
+	auth := PreflightCheck{Name: "Auth"}
+	sar := client.MakeSAR(client.BlankNamespace, "v1/namespaces", "")
+	sar.Spec.ResourceAttributes.Verb = client.ListVerb
+	sar, err = dial.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	switch {
+	case apierrors.IsUnauthorized(err):
+		auth.Err = errors.New("credentials rejected")
+	case err != nil:
+		auth.Err = err
+	case sar.Status.Allowed:
+		auth.Detail = "authenticated. Namespaces listing allowed"
+	default:
+		auth.Detail = "authenticated. Namespaces listing denied"
+	}
+	pp = append(pp, auth)
+
+	mx := PreflightCheck{Name: "Metrics", Detail: "not available"}
+	if gg, err := dial.Discovery().ServerGroups(); err == nil {
+		for _, g := range gg.Groups {
+			if g.Name == metricsGroup {
+				mx.Detail = "available"
+				break
+			}
+		}
+	}
+
+	return append(pp, mx)
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestPreflight(t *testing.T) {
+	uu := map[string]struct {
+		metrics bool
+		authErr error
+		e       []string
+		passed  bool
+	}{
+		"plain": {
+			e: []string{
+				"✓ Reachable: server v1.29.1",
+				"✓ Auth: authenticated. Namespaces listing denied",
+				"✓ Metrics: not available",
+			},
+			passed: true,
+		},
+		"metrics": {
+			metrics: true,
+			e: []string{
+				"✓ Reachable: server v1.29.1",
+				"✓ Auth: authenticated. Namespaces listing denied",
+				"✓ Metrics: available",
+			},
+			passed: true,
+		},
+		"unauthorized": {
+			authErr: apierrors.NewUnauthorized("bad token"),
+			e: []string{
+				"✓ Reachable: server v1.29.1",
+				"✗ Auth: credentials rejected",
+				"✓ Metrics: not available",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dial := fake.NewSimpleClientset()
+			dial.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.29.1"}
+			if u.metrics {
+				dial.Resources = []*metav1.APIResourceList{{GroupVersion: "metrics.k8s.io/v1beta1"}}
+			}
+			if u.authErr != nil {
+				dial.PrependReactor("create", "selfsubjectaccessreviews", func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, u.authErr
+				})
+			}
+			pp := preflight(context.Background(), dial)
+			ss := make([]string, 0, len(pp))
+			for _, p := range pp {
+				ss = append(ss, p.String())
+			}
+			assert.Equal(t, u.e, ss)
+			assert.Equal(t, u.passed, pp.Passed())
+		})
+	}
+}
+
+func TestPreflightUnreachable(t *testing.T) {
+	dial := fake.NewSimpleClientset()
+	dial.PrependReactor("get", "version", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("dial tcp: i/o timeout")
+	})
+
+	pp := preflight(context.Background(), dial)
+	assert.Len(t, pp, 1)
+	assert.Equal(t, "✗ Reachable: dial tcp: i/o timeout", pp[0].String())
+	assert.False(t, pp.Passed())
+}
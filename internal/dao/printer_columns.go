@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const dateColumnType = "date"
+
+// PrinterColumns returns a custom resource additional printer columns if any.
+func PrinterColumns(f Factory, gvr client.GVR) ([]apiextv1.CustomResourceColumnDefinition, error) {
+	v, err := crdVersion(f, gvr)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	return v.AdditionalPrinterColumns, nil
+}
+
+// AugmentTable evaluates printer columns missing from a server table against the
+// rows objects.
+func AugmentTable(t *metav1.Table, cc []apiextv1.CustomResourceColumnDefinition) error {
+	missing := missingColumns(t, cc)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	pp := make([]*jsonpath.JSONPath, 0, len(missing))
+	for _, c := range missing {
+		jp := jsonpath.New(c.Name).AllowMissingKeys(true)
+		if err := jp.Parse(fmt.Sprintf("{%s}", c.JSONPath)); err != nil {
+			return fmt.Errorf("invalid jsonpath for printer column %q: %w", c.Name, err)
+		}
+		pp = append(pp, jp)
+		t.ColumnDefinitions = append(t.ColumnDefinitions, metav1.TableColumnDefinition{
+			Name:        c.Name,
+			Type:        c.Type,
+			Format:      c.Format,
+			Description: c.Description,
+			Priority:    c.Priority,
+		})
+	}
+
+	for i := range t.Rows {
+		var obj interface{}
+		if err := json.Unmarshal(t.Rows[i].Object.Raw, &obj); err != nil {
+			return err
+		}
+		for j, jp := range pp {
+			t.Rows[i].Cells = append(t.Rows[i].Cells, columnValue(jp, obj, missing[j].Type))
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func missingColumns(t *metav1.Table, cc []apiextv1.CustomResourceColumnDefinition) []apiextv1.CustomResourceColumnDefinition {
+	missing := make([]apiextv1.CustomResourceColumnDefinition, 0, len(cc))
+	for _, c := range cc {
+		if !hasColumn(t, c.Name) {
+			missing = append(missing, c)
+		}
+	}
+
+	return missing
+}
+
+func hasColumn(t *metav1.Table, name string) bool {
+	for _, c := range t.ColumnDefinitions {
+		if strings.EqualFold(c.Name, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func columnValue(jp *jsonpath.JSONPath, obj interface{}, kind string) interface{} {
+	rr, err := jp.FindResults(obj)
+	if err != nil {
+		return nil
+	}
+	ss := make([]string, 0, 1)
+	for _, r := range rr {
+		for _, v := range r {
+			ss = append(ss, cellValue(v, kind))
+		}
+	}
+	if len(ss) == 0 {
+		return nil
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func cellValue(v reflect.Value, kind string) string {
+	i := v.Interface()
+	if s, ok := i.(string); ok && kind == dateColumnType {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return render.ToAge(metav1.NewTime(t))
+		}
+	}
+	if f, ok := i.(float64); ok && f == float64(int64(f)) {
+		return fmt.Sprintf("%d", int64(f))
+	}
+
+	return fmt.Sprintf("%v", i)
+}
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/rest"
+)
+
+func TestIncludeObjects(t *testing.T) {
+	gv := schema.GroupVersion{Group: "fred.io", Version: "v1"}
+	s := runtime.NewScheme()
+	metav1.AddToGroupVersion(s, gv)
+	s.AddKnownTypes(gv, &metav1.Table{}, &metav1.TableOptions{})
+	p := runtime.NewParameterCodec(s)
+
+	u, _ := url.Parse("https://blee.io")
+	cfg := rest.ClientContentConfig{
+		GroupVersion: gv,
+		Negotiator:   runtime.NewClientNegotiator(serializer.NewCodecFactory(s).WithoutConversion(), gv),
+	}
+	req := rest.NewRequestWithClient(u, "/apis", cfg, nil).Resource("freds")
+
+	assert.Empty(t, req.URL().Query().Get("includeObject"))
+	assert.Equal(t, "Object", includeObjects(req, p).URL().Query().Get("includeObject"))
+}
+
+func TestTableNeedsObjects(t *testing.T) {
+	cc := []apiextv1.CustomResourceColumnDefinition{
+		{Name: "Replicas", Type: "integer", JSONPath: ".spec.replicas"},
+	}
+	uu := map[string]struct {
+		gvr  string
+		cols []string
+		e    bool
+	}{
+		"rendered": {
+			gvr:  "fred.io/v1/rendered",
+			cols: []string{"Name", "Replicas", "Age"},
+		},
+		"missing": {
+			gvr:  "fred.io/v1/missing",
+			cols: []string{"Name", "Age"},
+			e:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var tb Table
+			tb.Init(connFactory{}, client.NewGVR(u.gvr))
+			var probes int
+			probe := func() (*metav1.Table, error) {
+				probes++
+				var tt metav1.Table
+				for _, c := range u.cols {
+					tt.ColumnDefinitions = append(tt.ColumnDefinitions, metav1.TableColumnDefinition{Name: c})
+				}
+				return &tt, nil
+			}
+
+			assert.Equal(t, u.e, tb.needsObjects(cc, probe))
+			assert.Equal(t, u.e, tb.needsObjects(cc, probe))
+			assert.Equal(t, 1, probes)
+		})
+	}
+}
+
+func TestAugmentTableFullObject(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "fred.io/v1",
+		"kind":       "Fred",
+		"metadata": map[string]interface{}{
+			"name":      "fred",
+			"namespace": "blee",
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+		},
+		"status": map[string]interface{}{
+			"phase": "Running",
+		},
+	}}
+	raw, err := json.Marshal(&o)
+	assert.NoError(t, err)
+
+	tt := metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name"}},
+		Rows: []metav1.TableRow{
+			{Cells: []interface{}{"fred"}, Object: runtime.RawExtension{Raw: raw}},
+		},
+	}
+	cc := []apiextv1.CustomResourceColumnDefinition{
+		{Name: "Replicas", Type: "integer", JSONPath: ".spec.replicas"},
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+	}
+
+	assert.NoError(t, AugmentTable(&tt, cc))
+	assert.Equal(t, []interface{}{"fred", "2", "Running"}, tt.Rows[0].Cells)
+}
+
+func TestAugmentTable(t *testing.T) {
+	uu := map[string]struct {
+		cc    []apiextv1.CustomResourceColumnDefinition
+		cols  []string
+		cells []interface{}
+		err   bool
+	}{
+		"none": {
+			cols:  []string{"Name", "Age"},
+			cells: []interface{}{"fred", "2d"},
+		},
+		"rendered-by-server": {
+			cc: []apiextv1.CustomResourceColumnDefinition{
+				{Name: "age", JSONPath: ".metadata.creationTimestamp"},
+			},
+			cols:  []string{"Name", "Age"},
+			cells: []interface{}{"fred", "2d"},
+		},
+		"missing": {
+			cc: []apiextv1.CustomResourceColumnDefinition{
+				{Name: "Replicas", Type: "integer", JSONPath: ".spec.replicas"},
+				{Name: "Ready", Type: "string", JSONPath: ".status.conditions[?(@.type==\"Ready\")].status"},
+				{Name: "Hosts", Type: "string", JSONPath: ".spec.hosts[*]"},
+				{Name: "Phase", Type: "string", JSONPath: ".status.phase", Priority: 1},
+			},
+			cols:  []string{"Name", "Age", "Replicas", "Ready", "Hosts", "Phase"},
+			cells: []interface{}{"fred", "2d", "3", "True", "a.com,b.com", nil},
+		},
+		"toast": {
+			cc: []apiextv1.CustomResourceColumnDefinition{
+				{Name: "Bad", JSONPath: ".spec[.replicas"},
+			},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tt := makeCRTable()
+			err := AugmentTable(tt, u.cc)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			cols := make([]string, 0, len(tt.ColumnDefinitions))
+			for _, c := range tt.ColumnDefinitions {
+				cols = append(cols, c.Name)
+			}
+			assert.Equal(t, u.cols, cols)
+			assert.Equal(t, u.cells, tt.Rows[0].Cells)
+		})
+	}
+}
+
+// Helpers...
+
+type connFactory struct {
+	Factory
+}
+
+func (connFactory) Client() client.Connection {
+	return ctxConn{}
+}
+
+type ctxConn struct {
+	client.Connection
+}
+
+func (ctxConn) ActiveContext() string {
+	return "ct1"
+}
+
+func makeCRTable() *metav1.Table {
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name"},
+			{Name: "Age"},
+		},
+		Rows: []metav1.TableRow{
+			{
+				Cells: []interface{}{"fred", "2d"},
+				Object: runtime.RawExtension{
+					Raw: []byte(`{
+  "apiVersion": "fred.io/v1",
+  "kind": "Fred",
+  "metadata": {"name": "fred", "creationTimestamp": "2024-01-01T00:00:00Z"},
+  "spec": {"replicas": 3, "hosts": ["a.com", "b.com"]},
+  "status": {"conditions": [{"type": "Ready", "status": "True"}]}
+}`),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,324 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	k9sProxy = "k9s-proxy"
+
+	// proxyHost tracks the proxies listening address. Proxies are never
+	// exposed beyond the local host.
+	proxyHost        = "127.0.0.1"
+	proxyRetryDelay  = 2 * time.Second
+	proxyNukeTimeout = 5 * time.Second
+)
+
+var (
+	_ Accessor = (*Proxy)(nil)
+	_ Nuker    = (*Proxy)(nil)
+)
+
+// clusterProxies tracks the active cluster proxies by local port.
+var clusterProxies = struct {
+	proxies map[string]*ClusterProxy
+	mx      sync.Mutex
+}{
+	proxies: make(map[string]*ClusterProxy),
+}
+
+// Proxy represents the local SOCKS5/HTTP proxies tunneled through the cluster.
+type Proxy struct {
+	NonResource
+}
+
+// List returns the active cluster proxies.
+func (p *Proxy) List(context.Context, string) ([]runtime.Object, error) {
+	clusterProxies.mx.Lock()
+	defer clusterProxies.mx.Unlock()
+
+	oo := make([]runtime.Object, 0, len(clusterProxies.proxies))
+	for _, cp := range clusterProxies.proxies {
+		oo = append(oo, cp.res())
+	}
+	sort.Slice(oo, func(i, j int) bool {
+		return oo[i].(render.ProxyRes).Port < oo[j].(render.ProxyRes).Port
+	})
+
+	return oo, nil
+}
+
+// Delete stops a cluster proxy.
+func (p *Proxy) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
+	return StopProxy(path)
+}
+
+// ClusterProxy represents a local SOCKS5/HTTP proxy relaying connections through
+// a throwaway cluster pod.
+type ClusterProxy struct {
+	Port    string
+	Pod     string
+	Started time.Time
+
+	socks  *port.SOCKSProxy
+	dial   kubernetes.Interface
+	ns     string
+	cancel context.CancelFunc
+}
+
+// Address returns the proxy url. Hosts are resolved on the proxy side.
+func (c *ClusterProxy) Address() string {
+	return "socks5h://" + c.socks.Addr()
+}
+
+func (c *ClusterProxy) res() render.ProxyRes {
+	return render.ProxyRes{
+		Port:    c.Port,
+		Address: c.Address(),
+		Pod:     c.ns + "/" + c.Pod,
+		Started: c.Started,
+		Stats:   c.socks.Stats(),
+	}
+}
+
+func (c *ClusterProxy) stop() error {
+	c.cancel()
+	_ = c.socks.Close()
+
+	return nukeProxyPod(c.dial, c.ns, c.Pod)
+}
+
+// StartProxy launches a proxy pod and serves a local SOCKS5/HTTP proxy on a
+// given port relaying connections from within the cluster. Hosts are resolved
+// by the cluster DNS.
+func StartProxy(ctx context.Context, f Factory, spo config.ShellPod, localPort string) (*ClusterProxy, error) {
+	clusterProxies.mx.Lock()
+	_, ok := clusterProxies.proxies[localPort]
+	clusterProxies.mx.Unlock()
+	if ok {
+		return nil, fmt.Errorf("a proxy is already listening on port %s", localPort)
+	}
+
+	name := fmt.Sprintf("%s-%d-%s", k9sProxy, os.Getpid(), localPort)
+	if err := ensureWritable("start proxy", spo.Namespace+"/"+name); err != nil {
+		return nil, err
+	}
+	conn := f.Client()
+	if conn == nil {
+		return nil, errors.New("no client connection")
+	}
+	dial, err := conn.Dial()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := conn.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	l, err := net.Listen("tcp", net.JoinHostPort(proxyHost, localPort))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := launchProxyPod(ctx, dial, name, spo); err != nil {
+		_ = l.Close()
+		if e := nukeProxyPod(dial, spo.Namespace, name); e != nil {
+			log.Error().Err(e).Msgf("Cleaning proxy pod %s failed", name)
+		}
+		return nil, err
+	}
+
+	pctx, cancel := context.WithCancel(context.Background())
+	cp := ClusterProxy{
+		Port:    localPort,
+		Pod:     name,
+		Started: time.Now(),
+		socks:   port.NewSOCKSProxy(l, execTunnel(cfg, dial, spo.Namespace, name)),
+		dial:    dial,
+		ns:      spo.Namespace,
+		cancel:  cancel,
+	}
+	go func() {
+		if err := cp.socks.Serve(pctx); err != nil {
+			log.Error().Err(err).Msgf("Proxy on port %s failed", localPort)
+		}
+	}()
+
+	clusterProxies.mx.Lock()
+	clusterProxies.proxies[localPort] = &cp
+	clusterProxies.mx.Unlock()
+
+	return &cp, nil
+}
+
+// StopProxy stops the proxy listening on a given port and deletes its pod.
+func StopProxy(localPort string) error {
+	clusterProxies.mx.Lock()
+	cp, ok := clusterProxies.proxies[localPort]
+	delete(clusterProxies.proxies, localPort)
+	clusterProxies.mx.Unlock()
+	if !ok {
+		return fmt.Errorf("no proxy listening on port %s", localPort)
+	}
+
+	return cp.stop()
+}
+
+// StopProxies stops all proxies and deletes their pods.
+func StopProxies() {
+	clusterProxies.mx.Lock()
+	pp := clusterProxies.proxies
+	clusterProxies.proxies = make(map[string]*ClusterProxy)
+	clusterProxies.mx.Unlock()
+
+	for k, cp := range pp {
+		if err := cp.stop(); err != nil {
+			log.Error().Err(err).Msgf("Stopping proxy on port %s failed", k)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func launchProxyPod(ctx context.Context, dial kubernetes.Interface, name string, spo config.ShellPod) error {
+	pods := dial.CoreV1().Pods(spo.Namespace)
+	if _, err := pods.Create(ctx, proxyPod(name, spo), metav1.CreateOptions{}); err != nil {
+		return err
+	}
+
+	for {
+		po, err := pods.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		switch po.Status.Phase {
+		case v1.PodRunning:
+			return nil
+		case v1.PodFailed, v1.PodSucceeded:
+			return fmt.Errorf("proxy pod %s exited: %s", name, po.Status.Phase)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(proxyRetryDelay):
+		}
+	}
+}
+
+func nukeProxyPod(dial kubernetes.Interface, ns, name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), proxyNukeTimeout)
+	defer cancel()
+
+	var grace int64
+	err := dial.CoreV1().Pods(ns).Delete(ctx, name, metav1.DeleteOptions{GracePeriodSeconds: &grace})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func proxyPod(name string, spo config.ShellPod) *v1.Pod {
+	var grace int64
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: spo.Namespace,
+			Labels:    spo.Labels,
+		},
+		Spec: v1.PodSpec{
+			RestartPolicy:                 v1.RestartPolicyNever,
+			ImagePullSecrets:              spo.ImagePullSecrets,
+			TerminationGracePeriodSeconds: &grace,
+			Containers: []v1.Container{
+				{
+					Name:            k9sProxy,
+					Image:           spo.Image,
+					ImagePullPolicy: spo.ImagePullPolicy,
+					Command:         []string{"tail", "-f", "/dev/null"},
+					Resources:       proxyResources(spo.Limits),
+				},
+			},
+		},
+	}
+}
+
+func proxyResources(ll config.Limits) v1.ResourceRequirements {
+	rl := make(v1.ResourceList, len(ll))
+	for k, v := range ll {
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Invalid proxy pod %s limit %q", k, v)
+			continue
+		}
+		rl[k] = q
+	}
+
+	return v1.ResourceRequirements{Limits: rl}
+}
+
+// execTunnel relays a proxied connection through netcat in the proxy pod.
+func execTunnel(cfg *restclient.Config, dial kubernetes.Interface, ns, pod string) port.TunnelFunc {
+	return func(ctx context.Context, addr string, rw io.ReadWriter) error {
+		host, p, err := net.SplitHostPort(addr)
+		if err != nil {
+			return err
+		}
+		req := dial.CoreV1().RESTClient().Post().
+			Resource("pods").
+			Namespace(ns).
+			Name(pod).
+			SubResource("exec").
+			VersionedParams(&v1.PodExecOptions{
+				Container: k9sProxy,
+				Command:   []string{"nc", host, p},
+				Stdin:     true,
+				Stdout:    true,
+				Stderr:    true,
+			}, scheme.ParameterCodec)
+		exec, err := remotecommand.NewSPDYExecutor(cfg, "POST", req.URL())
+		if err != nil {
+			return err
+		}
+
+		var stderr bytes.Buffer
+		err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+			Stdin:  rw,
+			Stdout: rw,
+			Stderr: &stderr,
+		})
+		if msg := strings.TrimSpace(stderr.String()); err != nil && msg != "" {
+			return errors.New(msg)
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	_ Accessor   = (*PersistentVolumeClaim)(nil)
+	_ Expandable = (*PersistentVolumeClaim)(nil)
+)
+
+// PersistentVolumeClaim represents a pvc resource.
+type PersistentVolumeClaim struct {
+	Resource
+}
+
+// List returns a collection of pvcs along with their volume usage.
+func (p *PersistentVolumeClaim) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := p.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	mm := p.pvcsStats(ctx, ns)
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		res = append(res, &render.PVCWithStats{Raw: u, Stats: mm[extractFQN(o)]})
+	}
+
+	return res, nil
+}
+
+// Expand resizes a pvc storage request.
+func (p *PersistentVolumeClaim) Expand(ctx context.Context, path, size string) error {
+	if err := ensureWritable("expand", path); err != nil {
+		return err
+	}
+	q, err := resource.ParseQuantity(size)
+	if err != nil {
+		return fmt.Errorf("invalid size %q: %w", size, err)
+	}
+	pvc, err := p.load(path)
+	if err != nil {
+		return err
+	}
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return err
+	}
+	class := storageClassFor(pvc)
+	if class == "" {
+		return fmt.Errorf("no storage class found for pvc %q", path)
+	}
+	sc, err := dial.StorageV1().StorageClasses().Get(ctx, class, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if err := validateExpansion(pvc, sc, q); err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, p.gvrStr(), n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch pvc %s", path)
+	}
+	patch := fmt.Sprintf(`{"spec":{"resources":{"requests":{"storage":%q}}}}`, q.String())
+	_, err = dial.CoreV1().PersistentVolumeClaims(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
+
+func (p *PersistentVolumeClaim) load(path string) (*v1.PersistentVolumeClaim, error) {
+	o, err := p.getFactory().Get(p.gvrStr(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var pvc v1.PersistentVolumeClaim
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pvc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pvc, nil
+}
+
+// pvcsStats collects pvcs usage from the kubelets running pods with claims.
+func (p *PersistentVolumeClaim) pvcsStats(ctx context.Context, ns string) client.PVCsStatsMap {
+	pp, err := p.getFactory().List("v1/pods", ns, false, labels.Everything())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list pods for pvcs usage")
+		return nil
+	}
+	nodes := make(map[string]struct{})
+	for _, o := range pp {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var pod v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pod); err != nil {
+			continue
+		}
+		if pod.Spec.NodeName == "" || !hasClaim(&pod.Spec) {
+			continue
+		}
+		nodes[pod.Spec.NodeName] = struct{}{}
+	}
+	nn := make([]string, 0, len(nodes))
+	for n := range nodes {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	mm, err := client.DialMetrics(p.Client()).FetchPVCsStats(ctx, nn)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to fetch pvcs usage")
+	}
+
+	return mm
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hasClaim(spec *v1.PodSpec) bool {
+	for _, v := range spec.Volumes {
+		if v.PersistentVolumeClaim != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func storageClassFor(pvc *v1.PersistentVolumeClaim) string {
+	if class, ok := pvc.Annotations[v1.BetaStorageClassAnnotation]; ok {
+		return class
+	}
+	if pvc.Spec.StorageClassName != nil {
+		return *pvc.Spec.StorageClassName
+	}
+
+	return ""
+}
+
+func validateExpansion(pvc *v1.PersistentVolumeClaim, sc *storagev1.StorageClass, size resource.Quantity) error {
+	if sc.AllowVolumeExpansion == nil || !*sc.AllowVolumeExpansion {
+		return fmt.Errorf("storage class %q does not allow volume expansion", sc.Name)
+	}
+	current := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	if size.Cmp(current) <= 0 {
+		return fmt.Errorf("new size %s must be greater than current size %s", size.String(), current.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateExpansion(t *testing.T) {
+	yes, no := true, false
+	uu := map[string]struct {
+		allow *bool
+		size  string
+		err   string
+	}{
+		"happy": {
+			allow: &yes,
+			size:  "2Gi",
+		},
+		"no-expansion": {
+			allow: &no,
+			size:  "2Gi",
+			err:   `storage class "standard" does not allow volume expansion`,
+		},
+		"unset": {
+			size: "2Gi",
+			err:  `storage class "standard" does not allow volume expansion`,
+		},
+		"shrink": {
+			allow: &yes,
+			size:  "512Mi",
+			err:   "new size 512Mi must be greater than current size 1Gi",
+		},
+		"same": {
+			allow: &yes,
+			size:  "1Gi",
+			err:   "new size 1Gi must be greater than current size 1Gi",
+		},
+	}
+
+	pvc := v1.PersistentVolumeClaim{
+		Spec: v1.PersistentVolumeClaimSpec{
+			Resources: v1.VolumeResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sc := storagev1.StorageClass{
+				ObjectMeta:           metav1.ObjectMeta{Name: "standard"},
+				AllowVolumeExpansion: u.allow,
+			}
+			err := validateExpansion(&pvc, &sc, resource.MustParse(u.size))
+			if u.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestStorageClassFor(t *testing.T) {
+	class := "fast"
+	uu := map[string]struct {
+		pvc v1.PersistentVolumeClaim
+		e   string
+	}{
+		"none": {},
+		"spec": {
+			pvc: v1.PersistentVolumeClaim{
+				Spec: v1.PersistentVolumeClaimSpec{StorageClassName: &class},
+			},
+			e: "fast",
+		},
+		"annotation": {
+			pvc: v1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{v1.BetaStorageClassAnnotation: "slow"},
+				},
+				Spec: v1.PersistentVolumeClaimSpec{StorageClassName: &class},
+			},
+			e: "slow",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, storageClassFor(&u.pvc))
+		})
+	}
+}
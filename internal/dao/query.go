@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Query)(nil)
+
+// Query represents a jsonpath query evaluated against a set of resources.
+type Query struct {
+	NonResource
+}
+
+// List returns the query results for each queried resource.
+func (q *Query) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	expr, ok := ctx.Value(internal.KeyQuery).(string)
+	if !ok || expr == "" {
+		return nil, errors.New("no query in context")
+	}
+	gvr, ok := ctx.Value(internal.KeyQueryGVR).(client.GVR)
+	if !ok {
+		return nil, errors.New("no queried resource in context")
+	}
+	paths, _ := ctx.Value(internal.KeyQueryPaths).([]string)
+	jp, err := model1.ParseJSONPath("query", expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", expr, err)
+	}
+
+	oo := make([]runtime.Object, 0, len(paths))
+	for _, path := range paths {
+		ns, n := client.Namespaced(path)
+		res := render.QueryRes{Namespace: ns, Name: n}
+		o, err := q.getFactory().Get(gvr.String(), path, true, labels.Everything())
+		if err != nil {
+			res.Err = err
+			oo = append(oo, res)
+			continue
+		}
+		m, err := model1.ObjectMap(o)
+		if err != nil {
+			res.Err = err
+			oo = append(oo, res)
+			continue
+		}
+		res.Result, res.Err = model1.JSONPathValue(jp, m)
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// Get returns a query result for a given resource.
+func (q *Query) Get(ctx context.Context, path string) (runtime.Object, error) {
+	oo, err := q.List(context.WithValue(ctx, internal.KeyQueryPaths, []string{path}), "")
+	if err != nil {
+		return nil, err
+	}
+	if len(oo) == 0 {
+		return nil, fmt.Errorf("no query result found for %q", path)
+	}
+
+	return oo[0], nil
+}
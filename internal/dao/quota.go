@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	quotaGVR      = "v1/resourcequotas"
+	limitRangeGVR = "v1/limitranges"
+)
+
+// QuotaUsage represents the consumption of a quota resource and the impact
+// of a pending change.
+type QuotaUsage struct {
+	Quota    string
+	Resource v1.ResourceName
+	Used     resource.Quantity
+	Hard     resource.Quantity
+	Delta    resource.Quantity
+}
+
+// After returns the quota consumption once the change is applied.
+func (q QuotaUsage) After() resource.Quantity {
+	after := q.Used.DeepCopy()
+	after.Add(q.Delta)
+
+	return after
+}
+
+// Exceeds returns true if the change would exceed the quota.
+func (q QuotaUsage) Exceeds() bool {
+	if q.Delta.Sign() <= 0 {
+		return false
+	}
+	after := q.After()
+
+	return after.Cmp(q.Hard) > 0
+}
+
+// String returns the usage as text ie requests.cpu 500m/1 (+250m).
+func (q QuotaUsage) String() string {
+	s := fmt.Sprintf("%s %s/%s", q.Resource, q.Used.String(), q.Hard.String())
+	if !q.Delta.IsZero() {
+		sign := "+"
+		if q.Delta.Sign() < 0 {
+			sign = ""
+		}
+		s += fmt.Sprintf(" (%s%s)", sign, q.Delta.String())
+	}
+
+	return s
+}
+
+// NamespaceQuotas returns the resource quotas and limit ranges of a namespace.
+func NamespaceQuotas(f Factory, ns string) ([]v1.ResourceQuota, []v1.LimitRange, error) {
+	oo, err := f.List(quotaGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	qq := make([]v1.ResourceQuota, 0, len(oo))
+	for _, o := range oo {
+		var q v1.ResourceQuota
+		if err := fromUnstructured(o, &q); err != nil {
+			return nil, nil, err
+		}
+		qq = append(qq, q)
+	}
+
+	oo, err = f.List(limitRangeGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	ll := make([]v1.LimitRange, 0, len(oo))
+	for _, o := range oo {
+		var l v1.LimitRange
+		if err := fromUnstructured(o, &l); err != nil {
+			return nil, nil, err
+		}
+		ll = append(ll, l)
+	}
+
+	return qq, ll, nil
+}
+
+// PodQuotaUsage returns the quota resources consumed by a pod once limit
+// range defaults are applied to containers missing requests or limits.
+func PodQuotaUsage(spec *v1.PodSpec, ll []v1.LimitRange) v1.ResourceList {
+	defReqs, defLims := containerDefaults(ll)
+	reqs, lims := make(v1.ResourceList), make(v1.ResourceList)
+	for _, c := range spec.Containers {
+		r, l := effectiveResources(c, defReqs, defLims)
+		addList(reqs, r)
+		addList(lims, l)
+	}
+	// Init containers run sequentially, the pod needs the largest of them.
+	for _, c := range spec.InitContainers {
+		r, l := effectiveResources(c, defReqs, defLims)
+		maxList(reqs, r)
+		maxList(lims, l)
+	}
+
+	usage := v1.ResourceList{v1.ResourcePods: resource.MustParse("1")}
+	for n, q := range reqs {
+		usage[v1.ResourceName("requests."+string(n))] = q
+		usage[n] = q
+	}
+	for n, q := range lims {
+		usage[v1.ResourceName("limits."+string(n))] = q
+	}
+
+	return usage
+}
+
+// ScaleQuotaUsage returns the quota resources consumed when scaling pods by a
+// given number of replicas. The delta is negative when scaling down.
+func ScaleQuotaUsage(spec *v1.PodSpec, ll []v1.LimitRange, replicas int64) v1.ResourceList {
+	usage := PodQuotaUsage(spec, ll)
+	for n, q := range usage {
+		usage[n] = *resource.NewMilliQuantity(q.MilliValue()*replicas, q.Format)
+	}
+
+	return usage
+}
+
+// QuotaImpact returns the consumption of each hard quota resource and the impact
+// of the given delta. Usages are sorted by quota and resource names.
+func QuotaImpact(qq []v1.ResourceQuota, delta v1.ResourceList) []QuotaUsage {
+	uu := make([]QuotaUsage, 0, len(qq)*4)
+	for _, q := range qq {
+		for n, hard := range q.Status.Hard {
+			u := QuotaUsage{
+				Quota:    q.Name,
+				Resource: n,
+				Used:     q.Status.Used[n],
+				Hard:     hard,
+			}
+			if d, ok := delta[n]; ok {
+				u.Delta = d
+			}
+			uu = append(uu, u)
+		}
+	}
+	sort.Slice(uu, func(i, j int) bool {
+		if uu[i].Quota != uu[j].Quota {
+			return uu[i].Quota < uu[j].Quota
+		}
+		return uu[i].Resource < uu[j].Resource
+	})
+
+	return uu
+}
+
+// QuotaReport returns the quota usages as text, flagging quotas a change would exceed.
+func QuotaReport(uu []QuotaUsage) string {
+	var b strings.Builder
+	for _, u := range uu {
+		fmt.Fprintf(&b, "%s: %s", u.Quota, u)
+		if u.Exceeds() {
+			b.WriteString(" EXCEEDED")
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func containerDefaults(ll []v1.LimitRange) (v1.ResourceList, v1.ResourceList) {
+	reqs, lims := make(v1.ResourceList), make(v1.ResourceList)
+	for _, l := range ll {
+		for _, item := range l.Spec.Limits {
+			if item.Type != v1.LimitTypeContainer {
+				continue
+			}
+			for n, q := range item.DefaultRequest {
+				reqs[n] = q
+			}
+			for n, q := range item.Default {
+				lims[n] = q
+			}
+		}
+	}
+
+	return reqs, lims
+}
+
+// effectiveResources returns a container requests and limits as admitted by
+// the api server. Missing limits use the limit range defaults. Missing requests
+// use the limit range default request, falling back to the container limit.
+func effectiveResources(c v1.Container, defReqs, defLims v1.ResourceList) (v1.ResourceList, v1.ResourceList) {
+	reqs, lims := make(v1.ResourceList), make(v1.ResourceList)
+	for n, q := range c.Resources.Limits {
+		lims[n] = q
+	}
+	for n, q := range defLims {
+		if _, ok := lims[n]; !ok {
+			lims[n] = q
+		}
+	}
+	for n, q := range c.Resources.Requests {
+		reqs[n] = q
+	}
+	for _, n := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		if _, ok := reqs[n]; ok {
+			continue
+		}
+		if q, ok := defReqs[n]; ok {
+			reqs[n] = q
+		} else if q, ok := lims[n]; ok {
+			reqs[n] = q
+		}
+	}
+
+	return reqs, lims
+}
+
+func addList(acc, ll v1.ResourceList) {
+	for n, q := range ll {
+		v := acc[n]
+		v.Add(q)
+		acc[n] = v
+	}
+}
+
+func maxList(acc, ll v1.ResourceList) {
+	for n, q := range ll {
+		if v, ok := acc[n]; !ok || q.Cmp(v) > 0 {
+			acc[n] = q
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodQuotaUsage(t *testing.T) {
+	ll := []v1.LimitRange{
+		{
+			Spec: v1.LimitRangeSpec{
+				Limits: []v1.LimitRangeItem{
+					{
+						Type:           v1.LimitTypeContainer,
+						Default:        v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("256Mi")},
+						DefaultRequest: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")},
+					},
+				},
+			},
+		},
+	}
+	spec := v1.PodSpec{
+		InitContainers: []v1.Container{
+			{Name: "i1", Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}}},
+		},
+		Containers: []v1.Container{
+			{Name: "c1", Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("200m")}}},
+			{Name: "c2"},
+		},
+	}
+
+	uu := map[string]struct {
+		ll []v1.LimitRange
+		e  map[v1.ResourceName]string
+	}{
+		"limit-range": {
+			ll: ll,
+			e: map[v1.ResourceName]string{
+				"pods":            "1",
+				"cpu":             "1",
+				"requests.cpu":    "1",
+				"memory":          "512Mi",
+				"requests.memory": "512Mi",
+				"limits.cpu":      "1",
+				"limits.memory":   "512Mi",
+			},
+		},
+		"no-limit-range": {
+			e: map[v1.ResourceName]string{
+				"pods":         "1",
+				"cpu":          "1",
+				"requests.cpu": "1",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			usage := dao.PodQuotaUsage(&spec, u.ll)
+			assert.Equal(t, len(u.e), len(usage))
+			for n, e := range u.e {
+				q := usage[n]
+				assert.Equal(t, 0, q.Cmp(resource.MustParse(e)), "%s: %s vs %s", n, q.String(), e)
+			}
+		})
+	}
+}
+
+func TestQuotaImpact(t *testing.T) {
+	qq := []v1.ResourceQuota{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "compute"},
+			Status: v1.ResourceQuotaStatus{
+				Hard: v1.ResourceList{
+					"pods":         resource.MustParse("10"),
+					"requests.cpu": resource.MustParse("2"),
+				},
+				Used: v1.ResourceList{
+					"pods":         resource.MustParse("4"),
+					"requests.cpu": resource.MustParse("1500m"),
+				},
+			},
+		},
+	}
+	spec := v1.PodSpec{
+		Containers: []v1.Container{
+			{Name: "c1", Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("250m")}}},
+		},
+	}
+
+	uu := map[string]struct {
+		replicas int64
+		exceeds  []bool
+		e        string
+	}{
+		"none": {
+			exceeds: []bool{false, false},
+			e:       "compute: pods 4/10\ncompute: requests.cpu 1500m/2\n",
+		},
+		"fits": {
+			replicas: 2,
+			exceeds:  []bool{false, false},
+			e:        "compute: pods 4/10 (+2)\ncompute: requests.cpu 1500m/2 (+500m)\n",
+		},
+		"exceeds": {
+			replicas: 3,
+			exceeds:  []bool{false, true},
+			e:        "compute: pods 4/10 (+3)\ncompute: requests.cpu 1500m/2 (+750m) EXCEEDED\n",
+		},
+		"scale-down": {
+			replicas: -2,
+			exceeds:  []bool{false, false},
+			e:        "compute: pods 4/10 (-2)\ncompute: requests.cpu 1500m/2 (-500m)\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var delta v1.ResourceList
+			if u.replicas != 0 {
+				delta = dao.ScaleQuotaUsage(&spec, nil, u.replicas)
+			}
+			usages := dao.QuotaImpact(qq, delta)
+			assert.Equal(t, len(u.exceeds), len(usages))
+			for i, e := range u.exceeds {
+				assert.Equal(t, e, usages[i].Exceeds())
+			}
+			assert.Equal(t, u.e, dao.QuotaReport(usages))
+		})
+	}
+}
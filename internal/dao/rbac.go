@@ -10,11 +10,11 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
-	"github.com/rs/zerolog/log"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
@@ -34,6 +34,11 @@ type Rbac struct {
 	Resource
 }
 
+// AddChangeHandler opts out of change tracking as rows are derived from other resources.
+func (*Rbac) AddChangeHandler(string, cache.ResourceEventHandler) (func(), error) {
+	return nil, errUntracked
+}
+
 // List lists out rbac resources.
 func (r *Rbac) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	gvr, ok := ctx.Value(internal.KeyGVR).(client.GVR)
@@ -10,11 +10,11 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
-	"github.com/rs/zerolog/log"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -27,6 +27,11 @@ type Policy struct {
 	Resource
 }
 
+// AddChangeHandler opts out of change tracking as rows are derived from other resources.
+func (*Policy) AddChangeHandler(string, cache.ResourceEventHandler) (func(), error) {
+	return nil, errUntracked
+}
+
 // List returns available policies.
 func (p *Policy) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	kind, ok := ctx.Value(internal.KeySubjectKind).(string)
@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/render"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -22,6 +23,11 @@ type Subject struct {
 	Resource
 }
 
+// AddChangeHandler opts out of change tracking as rows are derived from other resources.
+func (*Subject) AddChangeHandler(string, cache.ResourceEventHandler) (func(), error) {
+	return nil, errUntracked
+}
+
 // List returns a collection of subjects.
 func (s *Subject) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	kind, ok := ctx.Value(internal.KeySubjectKind).(string)
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrReadOnly indicates a cluster mutation was attempted in read-only mode.
+var ErrReadOnly = errors.New("k9s is running in read-only mode")
+
+var readOnly = struct {
+	fn func() bool
+	mx sync.RWMutex
+}{}
+
+// SetReadOnlyFn registers the source of the read-only mode. The function is
+// consulted prior to any cluster mutation so context overrides apply as soon
+// as they change.
+func SetReadOnlyFn(f func() bool) {
+	readOnly.mx.Lock()
+	defer readOnly.mx.Unlock()
+
+	readOnly.fn = f
+}
+
+// IsReadOnly checks if cluster mutations are currently disabled.
+func IsReadOnly() bool {
+	readOnly.mx.RLock()
+	defer readOnly.mx.RUnlock()
+
+	return readOnly.fn != nil && readOnly.fn()
+}
+
+// ensureWritable returns an error if a mutation is attempted in read-only mode.
+func ensureWritable(op, path string) error {
+	if IsReadOnly() {
+		return fmt.Errorf("%s %s denied: %w", op, path, ErrReadOnly)
+	}
+
+	return nil
+}
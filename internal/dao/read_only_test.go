@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsReadOnly(t *testing.T) {
+	uu := map[string]struct {
+		fn func() bool
+		e  bool
+	}{
+		"unset": {},
+		"off": {
+			fn: func() bool { return false },
+		},
+		"on": {
+			fn: func() bool { return true },
+			e:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			SetReadOnlyFn(u.fn)
+			defer SetReadOnlyFn(nil)
+
+			assert.Equal(t, u.e, IsReadOnly())
+			assert.Equal(t, u.e, errors.Is(ensureWritable("delete", "fred/blee"), ErrReadOnly))
+		})
+	}
+}
+
+func TestReadOnlyMutations(t *testing.T) {
+	SetReadOnlyFn(func() bool { return true })
+	defer SetReadOnlyFn(nil)
+
+	ctx := context.Background()
+	uu := map[string]func() error{
+		"delete": func() error {
+			return new(Generic).Delete(ctx, "fred/blee", nil, DefaultGrace)
+		},
+		"patch": func() error {
+			return new(Generic).Patch(ctx, "fred/blee", nil)
+		},
+		"update": func() error {
+			return new(Generic).Update(ctx, "fred/blee", nil)
+		},
+		"workload-delete": func() error {
+			return new(Workload).Delete(ctx, "fred/blee", nil, DefaultGrace)
+		},
+		"scale": func() error {
+			return new(Deployment).Scale(ctx, "fred/blee", 1)
+		},
+		"restart": func() error {
+			return new(StatefulSet).Restart(ctx, "fred/blee")
+		},
+		"set-images": func() error {
+			return new(DaemonSet).SetImages(ctx, "fred/blee", nil)
+		},
+		"trigger": func() error {
+			return new(CronJob).Run("fred/blee")
+		},
+		"drain": func() error {
+			return new(Node).Drain("blee", DrainOptions{}, io.Discard)
+		},
+		"cordon": func() error {
+			return new(Node).ToggleCordon("blee", true)
+		},
+		"expand": func() error {
+			return new(PersistentVolumeClaim).Expand(ctx, "fred/blee", "1Gi")
+		},
+		"secret": func() error {
+			return new(Secret).UpdateData(ctx, "fred/blee", nil)
+		},
+		"rollback": func() error {
+			return new(ReplicaSet).Rollback("fred/blee")
+		},
+		"uninstall": func() error {
+			return new(HelmChart).Uninstall("fred/blee", false)
+		},
+		"sanitize": func() error {
+			_, err := new(Pod).Sanitize(ctx, "fred")
+			return err
+		},
+		"proxy": func() error {
+			_, err := StartProxy(ctx, nil, config.ShellPod{Namespace: "fred"}, "1080")
+			return err
+		},
+	}
+
+	for k := range uu {
+		f := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.ErrorIs(t, f(), ErrReadOnly)
+		})
+	}
+}
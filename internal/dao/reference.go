@@ -58,6 +58,7 @@ func (r *Reference) Scan(ctx context.Context) ([]runtime.Object, error) {
 			Namespace: ns,
 			Name:      n,
 			GVR:       ref.GVR,
+			Usage:     ref.Usage,
 		})
 	}
 
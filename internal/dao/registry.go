@@ -10,11 +10,11 @@ import (
 	"sync"
 
 	"github.com/derailed/k9s/internal/client"
-	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 const (
@@ -67,8 +67,13 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("contexts"):                                          &Context{},
 		client.NewGVR("containers"):                                        &Container{},
 		client.NewGVR("scans"):                                             &ImageScan{},
+		client.NewGVR("pluginrows"):                                        &PluginRows{},
+		client.NewGVR("pluginjobs"):                                        &PluginJob{},
+		client.NewGVR("keys"):                                              &KeyBinding{},
 		client.NewGVR("screendumps"):                                       &ScreenDump{},
 		client.NewGVR("benchmarks"):                                        &Benchmark{},
+		client.NewGVR("crashes"):                                           &Crash{},
+		client.NewGVR("k9slogs"):                                           &K9sLog{},
 		client.NewGVR("portforwards"):                                      &PortForward{},
 		client.NewGVR("dir"):                                               &Dir{},
 		client.NewGVR("v1/services"):                                       &Service{},
@@ -77,6 +82,7 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("v1/namespaces"):                                     &Namespace{},
 		client.NewGVR("v1/configmaps"):                                     &ConfigMap{},
 		client.NewGVR("v1/secrets"):                                        &Secret{},
+		client.NewGVR("v1/persistentvolumeclaims"):                         &PersistentVolumeClaim{},
 		client.NewGVR("apps/v1/deployments"):                               &Deployment{},
 		client.NewGVR("apps/v1/daemonsets"):                                &DaemonSet{},
 		client.NewGVR("apps/v1/statefulsets"):                              &StatefulSet{},
@@ -123,6 +129,24 @@ func (m *Meta) AllGVRs() client.GVRs {
 	return kk
 }
 
+// GVRForKind returns the resource gvr matching a given apiVersion and kind.
+func (m *Meta) GVRForKind(apiVersion, kind string) (client.GVR, bool) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return client.NoGVR, false
+	}
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	for gvr, meta := range m.resMetas {
+		if meta.Kind == kind && meta.Group == gv.Group && IsK8sMeta(meta) {
+			return gvr, true
+		}
+	}
+
+	return client.NoGVR, false
+}
+
 // IsCRD checks if resource represents a CRD
 func IsCRD(r metav1.APIResource) bool {
 	for _, c := range r.Categories {
@@ -224,6 +248,143 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{k9sCat},
 	}
+	m[client.NewGVR("netsim")] = metav1.APIResource{
+		Name:         "netsim",
+		Kind:         "NetSim",
+		SingularName: "netsim",
+		ShortNames:   []string{"nsim"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("svchealth")] = metav1.APIResource{
+		Name:         "svchealth",
+		Kind:         "ServiceHealth",
+		SingularName: "svchealth",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("poddiag")] = metav1.APIResource{
+		Name:         "poddiag",
+		Kind:         "PodDiag",
+		SingularName: "poddiag",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("images")] = metav1.APIResource{
+		Name:         "images",
+		Kind:         "Images",
+		SingularName: "image",
+		ShortNames:   []string{"img"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("deprecations")] = metav1.APIResource{
+		Name:         "deprecations",
+		Kind:         "Deprecations",
+		SingularName: "deprecation",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("find")] = metav1.APIResource{
+		Name:         "find",
+		Kind:         "Find",
+		SingularName: "find",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("queries")] = metav1.APIResource{
+		Name:         "queries",
+		Kind:         "Query",
+		SingularName: "query",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("accessreviews")] = metav1.APIResource{
+		Name:         "accessreviews",
+		Kind:         "AccessReview",
+		SingularName: "accessreview",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("whoami")] = metav1.APIResource{
+		Name:         "whoami",
+		Kind:         "WhoAmI",
+		SingularName: "whoami",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("fleet")] = metav1.APIResource{
+		Name:         "fleet",
+		Kind:         "Fleet",
+		SingularName: "fleet",
+		ShortNames:   []string{"fl"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("proxies")] = metav1.APIResource{
+		Name:         "proxies",
+		Kind:         "Proxies",
+		SingularName: "proxy",
+		ShortNames:   []string{"px"},
+		Verbs:        []string{"delete"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("manifests")] = metav1.APIResource{
+		Name:         "manifests",
+		Kind:         "Manifests",
+		SingularName: "manifest",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("ingroutes")] = metav1.APIResource{
+		Name:         "ingroutes",
+		Kind:         "IngressRoutes",
+		SingularName: "ingroute",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("certs")] = metav1.APIResource{
+		Name:         "certs",
+		Kind:         "Certs",
+		SingularName: "cert",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("scores")] = metav1.APIResource{
+		Name:         "scores",
+		Kind:         "PopeyeScores",
+		SingularName: "score",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("top-pods")] = metav1.APIResource{
+		Name:         "top-pods",
+		Kind:         "TopPods",
+		SingularName: "top-pod",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("top-namespaces")] = metav1.APIResource{
+		Name:         "top-namespaces",
+		Kind:         "TopNamespaces",
+		SingularName: "top-namespace",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("top-nodes")] = metav1.APIResource{
+		Name:         "top-nodes",
+		Kind:         "TopNodes",
+		SingularName: "top-node",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 	m[client.NewGVR("aliases")] = metav1.APIResource{
 		Name:         "aliases",
 		Kind:         "Aliases",
@@ -270,6 +431,20 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{"delete"},
 		Categories:   []string{k9sCat},
 	}
+	m[client.NewGVR("crashes")] = metav1.APIResource{
+		Name:         "crashes",
+		Kind:         "Crashes",
+		SingularName: "crash",
+		Verbs:        []string{"delete"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("k9slogs")] = metav1.APIResource{
+		Name:         "k9slogs",
+		Kind:         "K9sLogs",
+		SingularName: "k9slog",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 	m[client.NewGVR("portforwards")] = metav1.APIResource{
 		Name:         "portforwards",
 		Namespaced:   true,
@@ -293,6 +468,29 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{k9sCat},
 	}
+	m[client.NewGVR("pluginrows")] = metav1.APIResource{
+		Name:         "pluginrows",
+		Kind:         "PluginRows",
+		SingularName: "pluginrow",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("keys")] = metav1.APIResource{
+		Name:         "keys",
+		Kind:         "KeyBindings",
+		SingularName: "key",
+		ShortNames:   []string{"kb"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("pluginjobs")] = metav1.APIResource{
+		Name:         "pluginjobs",
+		Kind:         "PluginJobs",
+		SingularName: "pluginjob",
+		ShortNames:   []string{"pj"},
+		Verbs:        []string{"delete"},
+		Categories:   []string{k9sCat},
+	}
 }
 
 func loadHelm(m ResourceMetas) {
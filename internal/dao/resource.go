@@ -5,19 +5,26 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
 )
 
 var (
-	_ Accessor  = (*Resource)(nil)
-	_ Describer = (*Resource)(nil)
-	_ Nuker     = (*Resource)(nil)
+	_ Accessor      = (*Resource)(nil)
+	_ Describer     = (*Resource)(nil)
+	_ Nuker         = (*Resource)(nil)
+	_ ChangeWatcher = (*Resource)(nil)
 )
 
+// errUntracked signals resources whose changes can't be tracked.
+var errUntracked = errors.New("resource changes are not tracked")
+
 // Resource represents an informer based resource.
 type Resource struct {
 	Generic
@@ -25,6 +32,9 @@ type Resource struct {
 
 // List returns a collection of resources.
 func (r *Resource) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	if p, ok := ctx.Value(internal.KeyPager).(*Pager); ok && p != nil {
+		return r.Generic.List(ctx, ns)
+	}
 	strLabel, _ := ctx.Value(internal.KeyLabels).(string)
 	lsel := labels.Everything()
 	if strLabel != "" {
@@ -41,6 +51,27 @@ func (r *Resource) Get(_ context.Context, path string) (runtime.Object, error) {
 	return r.getFactory().Get(r.gvrStr(), path, true, labels.Everything())
 }
 
+// AddChangeHandler registers a handler on the resource informer.
+func (r *Resource) AddChangeHandler(ns string, h cache.ResourceEventHandler) (func(), error) {
+	inf, err := r.getFactory().CanForResource(ns, r.gvrStr(), client.ListAccess)
+	if err != nil {
+		return nil, err
+	}
+	if inf == nil {
+		return nil, fmt.Errorf("no informer for %q", r.gvrStr())
+	}
+	reg, err := inf.Informer().AddEventHandler(h)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		if err := inf.Informer().RemoveEventHandler(reg); err != nil {
+			log.Error().Err(err).Msgf("Unable to remove change handler for %q", r.gvrStr())
+		}
+	}, nil
+}
+
 // ToYAML returns a resource yaml.
 func (r *Resource) ToYAML(path string, showManaged bool) (string, error) {
 	o, err := r.Get(context.Background(), path)
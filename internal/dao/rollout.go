@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// RecycleTimeout tracks how long to wait on a pod replacement to be ready.
+	RecycleTimeout = 5 * time.Minute
+
+	recyclePoll = 2 * time.Second
+)
+
+// recyclePod deletes a pod and waits for a healthy replacement matching the
+// given selector and predicate and sharing the pod controller.
+func recyclePod(ctx context.Context, c client.Connection, pod *v1.Pod, sel *metav1.LabelSelector, match func(*v1.Pod) bool) error {
+	ctrl := metav1.GetControllerOf(pod)
+	if ctrl == nil {
+		return fmt.Errorf("pod %s has no controller", pod.Name)
+	}
+	ls, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return err
+	}
+	auth, err := c.CanI(pod.Namespace, "v1/pods", pod.Name, []string{client.DeleteVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to delete pod %s", pod.Name)
+	}
+	dial, err := c.Dial()
+	if err != nil {
+		return err
+	}
+	if err := dial.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, RecycleTimeout)
+	defer cancel()
+	for {
+		pp, err := dial.CoreV1().Pods(pod.Namespace).List(ctx, metav1.ListOptions{LabelSelector: ls.String()})
+		if err != nil {
+			log.Warn().Err(err).Msgf("Listing %s replacement pods", pod.Name)
+		} else {
+			for i := range pp.Items {
+				p := &pp.Items[i]
+				if p.UID != pod.UID && isControlledBy(p, ctrl.UID) && match(p) && isPodHealthy(p) {
+					return nil
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting on pod %s replacement: %w", pod.Name, ctx.Err())
+		case <-time.After(recyclePoll):
+		}
+	}
+}
+
+// ownedPods returns the pods matching a selector and controlled by a given owner.
+func ownedPods(f Factory, ns string, sel *metav1.LabelSelector, owner types.UID) ([]*v1.Pod, error) {
+	ls, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return nil, err
+	}
+	if ls.Empty() {
+		return nil, fmt.Errorf("no valid selector found in namespace %q", ns)
+	}
+	oo, err := f.List("v1/pods", ns, true, ls)
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var pod v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pod); err != nil {
+			return nil, err
+		}
+		if isControlledBy(&pod, owner) {
+			pp = append(pp, &pod)
+		}
+	}
+
+	return pp, nil
+}
+
+func isControlledBy(pod *v1.Pod, owner types.UID) bool {
+	ctrl := metav1.GetControllerOf(pod)
+
+	return ctrl != nil && ctrl.UID == owner
+}
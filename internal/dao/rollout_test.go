@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestPartition(t *testing.T) {
+	var sts appsv1.StatefulSet
+	assert.Equal(t, int32(0), Partition(&sts))
+
+	sts.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{Partition: int32Ptr(2)}
+	assert.Equal(t, int32(2), Partition(&sts))
+}
+
+func TestOrdinals(t *testing.T) {
+	uu := map[string]struct {
+		spec appsv1.StatefulSetSpec
+		e    []int
+	}{
+		"default": {
+			e: []int{0},
+		},
+		"replicas": {
+			spec: appsv1.StatefulSetSpec{Replicas: int32Ptr(3)},
+			e:    []int{0, 1, 2},
+		},
+		"start": {
+			spec: appsv1.StatefulSetSpec{
+				Replicas: int32Ptr(2),
+				Ordinals: &appsv1.StatefulSetOrdinals{Start: 5},
+			},
+			e: []int{5, 6},
+		},
+		"none": {
+			spec: appsv1.StatefulSetSpec{Replicas: int32Ptr(0)},
+			e:    []int{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, Ordinals(&appsv1.StatefulSet{Spec: u.spec}))
+		})
+	}
+}
+
+func TestMaxUnavailable(t *testing.T) {
+	var ds appsv1.DaemonSet
+	assert.Equal(t, "1", MaxUnavailable(&ds))
+
+	mu := intstr.FromString("25%")
+	ds.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateDaemonSet{MaxUnavailable: &mu}
+	assert.Equal(t, "25%", MaxUnavailable(&ds))
+}
+
+func TestOwnedPods(t *testing.T) {
+	f := rolloutFactory{pods: []*v1.Pod{
+		makeOwnedPod("fred-1", "web", "ds1"),
+		makeOwnedPod("fred-2", "web", "ds2"),
+		makeOwnedPod("fred-3", "db", "ds1"),
+		makeOwnedPod("fred-4", "web", ""),
+	}}
+
+	uu := map[string]struct {
+		sel *metav1.LabelSelector
+		e   []string
+		err bool
+	}{
+		"labels": {
+			sel: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			e:   []string{"fred-1"},
+		},
+		"expressions": {
+			sel: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"web"}},
+			}},
+			e: []string{"fred-1"},
+		},
+		"empty": {
+			sel: &metav1.LabelSelector{},
+			err: true,
+		},
+		"none": {
+			e: []string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pp, err := ownedPods(f, "ns1", u.sel, "ds1")
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			nn := make([]string, 0, len(pp))
+			for _, p := range pp {
+				nn = append(nn, p.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+// Helpers...
+
+type rolloutFactory struct {
+	Factory
+	pods []*v1.Pod
+}
+
+func (f rolloutFactory) List(_, _ string, _ bool, sel labels.Selector) ([]runtime.Object, error) {
+	oo := make([]runtime.Object, 0, len(f.pods))
+	for _, p := range f.pods {
+		if !sel.Matches(labels.Set(p.Labels)) {
+			continue
+		}
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(p)
+		if err != nil {
+			return nil, err
+		}
+		oo = append(oo, &unstructured.Unstructured{Object: m})
+	}
+
+	return oo, nil
+}
+
+func makeOwnedPod(n, app string, owner types.UID) *v1.Pod {
+	po := v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      n,
+		Namespace: "ns1",
+		Labels:    map[string]string{"app": app},
+	}}
+	if owner != "" {
+		ctrl := true
+		po.OwnerReferences = []metav1.OwnerReference{{UID: owner, Controller: &ctrl}}
+	}
+
+	return &po
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
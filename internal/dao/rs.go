@@ -85,6 +85,9 @@ func controllerInfo(rs *appsv1.ReplicaSet) (string, string, string, error) {
 
 // Rollback reverses the last deployment.
 func (r *ReplicaSet) Rollback(fqn string) error {
+	if err := ensureWritable("rollback", fqn); err != nil {
+		return err
+	}
 	rs, err := r.Load(r.Factory, fqn)
 	if err != nil {
 		return err
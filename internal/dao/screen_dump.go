@@ -7,8 +7,12 @@ import (
 	"context"
 	"errors"
 	"os"
+	"path/filepath"
+	"sort"
+	"time"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -49,3 +53,51 @@ func (d *ScreenDump) List(ctx context.Context, _ string) ([]runtime.Object, erro
 
 	return oo, nil
 }
+
+// PruneScreenDumps enforces the retention policies on a screen dumps
+// directory, deleting the oldest dumps first. The most recent dump is always
+// kept. It returns the number of deleted dumps.
+func PruneScreenDumps(dir string, p config.ScreenDumps) (int, error) {
+	if !p.IsSet() {
+		return 0, nil
+	}
+	ee, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	ff := make([]os.FileInfo, 0, len(ee))
+	var size int64
+	for _, e := range ee {
+		if e.IsDir() {
+			continue
+		}
+		if fi, err := e.Info(); err == nil {
+			ff = append(ff, fi)
+			size += fi.Size()
+		}
+	}
+	sort.Slice(ff, func(i, j int) bool {
+		return ff[i].ModTime().After(ff[j].ModTime())
+	})
+
+	maxAge, maxSize := p.MaxAgeDuration(), p.MaxSize()
+	var (
+		count int
+		errs  error
+	)
+	for i := len(ff) - 1; i > 0; i-- {
+		f := ff[i]
+		expired := maxAge > 0 && time.Since(f.ModTime()) > maxAge
+		if !expired && (p.MaxFiles <= 0 || i < p.MaxFiles) && (maxSize <= 0 || size <= maxSize) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, f.Name())); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		size -= f.Size()
+		count++
+	}
+
+	return count, errs
+}
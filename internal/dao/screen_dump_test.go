@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneScreenDumps(t *testing.T) {
+	uu := map[string]struct {
+		p     config.ScreenDumps
+		count int
+		e     []string
+	}{
+		"none": {
+			e: []string{"d0", "d1", "d2", "d3"},
+		},
+		"files": {
+			p:     config.ScreenDumps{MaxFiles: 2},
+			count: 2,
+			e:     []string{"d0", "d1"},
+		},
+		"age": {
+			p:     config.ScreenDumps{MaxAge: "90m"},
+			count: 2,
+			e:     []string{"d0", "d1"},
+		},
+		"size": {
+			p:     config.ScreenDumps{MaxSizeMB: 1},
+			count: 2,
+			e:     []string{"d0", "d1"},
+		},
+		"keep-newest": {
+			p:     config.ScreenDumps{MaxAge: "1m"},
+			count: 3,
+			e:     []string{"d0"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dir := t.TempDir()
+			// d0 is the newest dump, each older dump is an hour older.
+			for i := 0; i < 4; i++ {
+				path := filepath.Join(dir, fmt.Sprintf("d%d", i))
+				require.NoError(t, os.WriteFile(path, make([]byte, 400*1024), 0600))
+				at := time.Now().Add(-time.Duration(i) * time.Hour)
+				require.NoError(t, os.Chtimes(path, at, at))
+			}
+
+			n, err := dao.PruneScreenDumps(dir, u.p)
+			require.NoError(t, err)
+			assert.Equal(t, u.count, n)
+
+			ee, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			ff := make([]string, 0, len(ee))
+			for _, e := range ee {
+				ff = append(ff, e.Name())
+			}
+			sort.Strings(ff)
+			assert.Equal(t, u.e, ff)
+		})
+	}
+}
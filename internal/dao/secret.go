@@ -4,15 +4,21 @@
 package dao
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/derailed/k9s/internal/client"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// SecretMask masks a secret value.
+const SecretMask = "********"
+
 // Secret represents a secret K8s resource.
 type Secret struct {
 	Resource
@@ -100,3 +106,46 @@ func ExtractSecrets(o *unstructured.Unstructured) (map[string]string, error) {
 
 	return secretData, nil
 }
+
+// MaskSecrets returns a copy of the secret data with all values masked.
+func MaskSecrets(data map[string]string) map[string]string {
+	mm := make(map[string]string, len(data))
+	for k := range data {
+		mm[k] = SecretMask
+	}
+
+	return mm
+}
+
+// UpdateData replaces a secret data with the given decoded values. Values
+// are encoded transparently.
+func (s *Secret) UpdateData(ctx context.Context, path string, data map[string]string) error {
+	if err := ensureWritable("update", path); err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := s.Client().CanI(ns, s.gvrStr(), n, []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update secret %s", path)
+	}
+
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return err
+	}
+	sec, err := dial.CoreV1().Secrets(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	sec.StringData = nil
+	sec.Data = make(map[string][]byte, len(data))
+	for k, v := range data {
+		sec.Data[k] = []byte(v)
+	}
+	_, err = dial.CoreV1().Secrets(ns).Update(ctx, sec, metav1.UpdateOptions{})
+
+	return err
+}
@@ -42,3 +42,9 @@ token-secret:  24 bytes`
 	decodedDescription, _ := s.Decode(encodedString, "kube-system/bootstrap-token-abcdef")
 	assert.Equal(t, expected, decodedDescription)
 }
+
+func TestMaskSecrets(t *testing.T) {
+	mm := dao.MaskSecrets(map[string]string{"user": "fred", "pwd": "blee"})
+
+	assert.Equal(t, map[string]string{"user": dao.SecretMask, "pwd": dao.SecretMask}, mm)
+}
@@ -7,11 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
-	"github.com/rs/zerolog/log"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -52,6 +52,9 @@ func (s *StatefulSet) ListImages(ctx context.Context, fqn string) ([]string, err
 
 // Scale a StatefulSet.
 func (s *StatefulSet) Scale(ctx context.Context, path string, replicas int32) error {
+	if err := ensureWritable("scale", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	auth, err := s.Client().CanI(ns, "apps/v1/statefulsets:scale", n, []string{client.GetVerb, client.UpdateVerb})
 	if err != nil {
@@ -77,6 +80,9 @@ func (s *StatefulSet) Scale(ctx context.Context, path string, replicas int32) er
 
 // Restart a StatefulSet rollout.
 func (s *StatefulSet) Restart(ctx context.Context, path string) error {
+	if err := ensureWritable("restart", path); err != nil {
+		return err
+	}
 	sts, err := s.GetInstance(s.Factory, path)
 	if err != nil {
 		return err
@@ -129,6 +135,104 @@ func (s *StatefulSet) Restart(ctx context.Context, path string) error {
 
 }
 
+// Partition returns a StatefulSet rolling update partition.
+func Partition(sts *appsv1.StatefulSet) int32 {
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		return *ru.Partition
+	}
+
+	return 0
+}
+
+// SetPartition sets a StatefulSet rolling update partition. Only pods with an
+// ordinal greater or equal to the partition are updated on rollouts.
+func (s *StatefulSet) SetPartition(ctx context.Context, path string, partition int32) error {
+	if err := ensureWritable("set partition", path); err != nil {
+		return err
+	}
+	if partition < 0 {
+		return fmt.Errorf("invalid partition %d", partition)
+	}
+	sts, err := s.GetInstance(s.Factory, path)
+	if err != nil {
+		return err
+	}
+	if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return fmt.Errorf("statefulset %s uses an %s update strategy", path, sts.Spec.UpdateStrategy.Type)
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := s.Client().CanI(ns, "apps/v1/statefulsets", n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch a statefulset")
+	}
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return err
+	}
+	patch := fmt.Sprintf(`{"spec":{"updateStrategy":{"rollingUpdate":{"partition":%d}}}}`, partition)
+	_, err = dial.AppsV1().StatefulSets(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
+
+// Ordinals returns a StatefulSet pods ordinals.
+func Ordinals(sts *appsv1.StatefulSet) []int {
+	start, replicas := 0, 1
+	if sts.Spec.Ordinals != nil {
+		start = int(sts.Spec.Ordinals.Start)
+	}
+	if sts.Spec.Replicas != nil {
+		replicas = int(*sts.Spec.Replicas)
+	}
+	oo := make([]int, 0, replicas)
+	for i := start; i < start+replicas; i++ {
+		oo = append(oo, i)
+	}
+
+	return oo
+}
+
+// RestartOrdinal deletes the StatefulSet pod with the given ordinal and waits
+// for its replacement to be ready.
+func (s *StatefulSet) RestartOrdinal(ctx context.Context, path string, ordinal int) error {
+	if err := ensureWritable("restart", path); err != nil {
+		return err
+	}
+	sts, err := s.GetInstance(s.Factory, path)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(Ordinals(sts), ordinal) {
+		return fmt.Errorf("no ordinal %d on statefulset %s", ordinal, path)
+	}
+	name := fmt.Sprintf("%s-%d", sts.Name, ordinal)
+	o, err := s.Factory.Get("v1/pods", client.FQN(sts.Namespace, name), true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	var pod v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pod); err != nil {
+		return err
+	}
+	if !isControlledBy(&pod, sts.UID) {
+		return fmt.Errorf("pod %s is not controlled by statefulset %s", name, path)
+	}
+	s.Forwarders().Kill(client.FQN(pod.Namespace, pod.Name))
+
+	return recyclePod(ctx, s.Client(), &pod, sts.Spec.Selector, func(p *v1.Pod) bool {
+		return p.Name == name
+	})
+}
+
 // GetInstance returns a statefulset instance.
 func (*StatefulSet) GetInstance(f Factory, fqn string) (*appsv1.StatefulSet, error) {
 	o, err := f.Get("apps/v1/statefulsets", fqn, true, labels.Everything())
@@ -226,25 +330,28 @@ func (s *StatefulSet) Scan(ctx context.Context, gvr client.GVR, fqn string, wait
 		}
 		switch gvr {
 		case CmGVR:
-			if !hasConfigMap(&sts.Spec.Template.Spec, n) {
+			usage := configMapUsage(&sts.Spec.Template.Spec, n)
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: s.GVR(),
-				FQN: client.FQN(sts.Namespace, sts.Name),
+				GVR:   s.GVR(),
+				FQN:   client.FQN(sts.Namespace, sts.Name),
+				Usage: usage,
 			})
 		case SecGVR:
-			found, err := hasSecret(s.Factory, &sts.Spec.Template.Spec, sts.Namespace, n, wait)
+			usage, err := secretUsage(s.Factory, &sts.Spec.Template.Spec, sts.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("locate secret %q", fqn)
 				continue
 			}
-			if !found {
+			if len(usage) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: s.GVR(),
-				FQN: client.FQN(sts.Namespace, sts.Name),
+				GVR:   s.GVR(),
+				FQN:   client.FQN(sts.Namespace, sts.Name),
+				Usage: usage,
 			})
 		case PvcGVR:
 			for _, v := range sts.Spec.VolumeClaimTemplates {
@@ -290,6 +397,9 @@ func (s *StatefulSet) GetPodSpec(path string) (*v1.PodSpec, error) {
 
 // SetImages sets container images.
 func (s *StatefulSet) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) error {
+	if err := ensureWritable("set image", path); err != nil {
+		return err
+	}
 	ns, n := client.Namespaced(path)
 	auth, err := s.Client().CanI(ns, "apps/v1/statefulset", n, client.PatchAccess)
 	if err != nil {
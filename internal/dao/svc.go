@@ -16,9 +16,10 @@ import (
 )
 
 var (
-	_ Accessor   = (*Service)(nil)
-	_ Loggable   = (*Service)(nil)
-	_ Controller = (*Service)(nil)
+	_ Accessor    = (*Service)(nil)
+	_ Loggable    = (*Service)(nil)
+	_ Controller  = (*Service)(nil)
+	_ PodSelector = (*Service)(nil)
 )
 
 // Service represents a k8s service.
@@ -49,6 +50,19 @@ func (s *Service) Pod(fqn string) (string, error) {
 	return podFromSelector(s.Factory, svc.Namespace, svc.Spec.Selector)
 }
 
+// PodSelector returns the namespace and selector of the service backing pods.
+func (s *Service) PodSelector(fqn string) (string, labels.Selector, error) {
+	svc, err := s.GetInstance(fqn)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return "", nil, fmt.Errorf("no valid selector found on Service %s", fqn)
+	}
+
+	return svc.Namespace, labels.Set(svc.Spec.Selector).AsSelector(), nil
+}
+
 // GetInstance returns a service instance.
 func (s *Service) GetInstance(fqn string) (*v1.Service, error) {
 	o, err := s.getFactory().Get(s.gvrStr(), fqn, true, labels.Everything())
@@ -69,20 +83,53 @@ func (s *Service) GetInstance(fqn string) (*v1.Service, error) {
 // Helpers...
 
 func podFromSelector(f Factory, ns string, sel map[string]string) (string, error) {
-	oo, err := f.List("v1/pods", ns, true, labels.Set(sel).AsSelector())
+	return HealthyPod(f, ns, labels.Set(sel).AsSelector(), "")
+}
+
+// HealthyPod returns a running and ready pod matching a selector, skipping a
+// given pod if others are healthy.
+func HealthyPod(f Factory, ns string, sel labels.Selector, skip string) (string, error) {
+	oo, err := f.List("v1/pods", ns, true, sel)
 	if err != nil {
 		return "", err
 	}
-
 	if len(oo) == 0 {
 		return "", fmt.Errorf("no matching pods for %v", sel)
 	}
 
-	var pod v1.Pod
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(oo[0].(*unstructured.Unstructured).Object, &pod)
-	if err != nil {
-		return "", err
+	var fallback string
+	for _, o := range oo {
+		var pod v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pod)
+		if err != nil {
+			return "", err
+		}
+		if !isPodHealthy(&pod) {
+			continue
+		}
+		fqn := client.FQN(pod.Namespace, pod.Name)
+		if fqn != skip {
+			return fqn, nil
+		}
+		fallback = fqn
+	}
+	if fallback == "" {
+		return "", fmt.Errorf("no healthy pods matching %v", sel)
+	}
+
+	return fallback, nil
+}
+
+// isPodHealthy checks if a pod is running, ready and not terminating.
+func isPodHealthy(pod *v1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != v1.PodRunning {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
 	}
 
-	return client.FQN(pod.Namespace, pod.Name), nil
+	return false
 }
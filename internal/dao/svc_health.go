@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	epsGVR = "discovery.k8s.io/v1/endpointslices"
+
+	// EndpointReady tracks a ready endpoint.
+	EndpointReady = "Ready"
+	// EndpointNotReady tracks an endpoint that is not ready.
+	EndpointNotReady = "NotReady"
+	// EndpointTerminating tracks a terminating endpoint.
+	EndpointTerminating = "Terminating"
+	// EndpointMissing tracks a pod missing from the service endpoints.
+	EndpointMissing = "Missing"
+)
+
+var _ Accessor = (*ServiceHealth)(nil)
+
+// ServiceHealth diagnoses a service pods and endpoints.
+type ServiceHealth struct {
+	NonResource
+}
+
+// List returns the health of each matched pods ports for a given service.
+func (s *ServiceHealth) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, errors.New("no service path specified")
+	}
+
+	var svc Service
+	svc.Init(s.getFactory(), client.NewGVR("v1/services"))
+	sv, err := svc.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []*v1.Pod
+	if len(sv.Spec.Selector) > 0 {
+		oo, err := s.getFactory().List("v1/pods", sv.Namespace, true, labels.SelectorFromSet(sv.Spec.Selector))
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			pod, err := toPod(o)
+			if err != nil {
+				return nil, err
+			}
+			pods = append(pods, pod)
+		}
+	}
+
+	sel := labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: sv.Name})
+	oo, err := s.getFactory().List(epsGVR, sv.Namespace, true, sel)
+	if err != nil {
+		return nil, err
+	}
+	slices := make([]discoveryv1.EndpointSlice, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var eps discoveryv1.EndpointSlice
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &eps); err != nil {
+			return nil, err
+		}
+		slices = append(slices, eps)
+	}
+
+	rr := DiagnoseService(sv, pods, slices)
+	res := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// Get returns a given pod port health.
+func (s *ServiceHealth) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, fmt.Errorf("nyi")
+}
+
+// DiagnoseService checks matched pods, endpoints readiness and ports
+// resolution for a given service.
+func DiagnoseService(svc *v1.Service, pods []*v1.Pod, slices []discoveryv1.EndpointSlice) []render.ServiceHealthRes {
+	switch {
+	case svc.Spec.Type == v1.ServiceTypeExternalName:
+		return []render.ServiceHealthRes{{Issue: fmt.Sprintf("external service pointing to %s", svc.Spec.ExternalName)}}
+	case len(svc.Spec.Selector) == 0:
+		return []render.ServiceHealthRes{{Issue: "service does not define a selector"}}
+	case len(pods) == 0:
+		return []render.ServiceHealthRes{{
+			Issue: fmt.Sprintf("selector %s matches no pods", labels.Set(svc.Spec.Selector)),
+		}}
+	}
+
+	eps := endpointsByPod(slices)
+	rr := make([]render.ServiceHealthRes, 0, len(pods)*len(svc.Spec.Ports))
+	for _, pod := range pods {
+		fqn := client.FQN(pod.Namespace, pod.Name)
+		ep, ok := eps[fqn]
+		for _, p := range svc.Spec.Ports {
+			r := render.ServiceHealthRes{
+				Pod:      fqn,
+				Index:    len(rr),
+				Port:     svcPortStr(p),
+				Endpoint: EndpointMissing,
+			}
+			if ok {
+				r.Endpoint = ep.state
+			}
+			target, err := resolveTargetPort(pod, p)
+			switch {
+			case err != nil:
+				r.Target, r.Issue = render.NAValue, err.Error()
+			case !ok:
+				r.Target, r.Issue = target, "pod is not part of the service endpoints"
+			case r.Endpoint != EndpointReady:
+				r.Target, r.Issue = target, fmt.Sprintf("endpoint is %s", r.Endpoint)
+			case !ep.hasPort(p.Name):
+				r.Target, r.Issue = target, fmt.Sprintf("port %q is not published by the endpoints", p.Name)
+			default:
+				r.Target, r.Reachable = target, true
+			}
+			rr = append(rr, r)
+		}
+	}
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type podEndpoint struct {
+	state string
+	ports map[string]struct{}
+}
+
+func (p podEndpoint) hasPort(n string) bool {
+	_, ok := p.ports[n]
+	return ok
+}
+
+func endpointsByPod(slices []discoveryv1.EndpointSlice) map[string]podEndpoint {
+	mm := make(map[string]podEndpoint)
+	for _, s := range slices {
+		ports := make(map[string]struct{}, len(s.Ports))
+		for _, p := range s.Ports {
+			if p.Name != nil {
+				ports[*p.Name] = struct{}{}
+			}
+		}
+		for _, e := range s.Endpoints {
+			if e.TargetRef == nil || e.TargetRef.Kind != "Pod" {
+				continue
+			}
+			ns := e.TargetRef.Namespace
+			if ns == "" {
+				ns = s.Namespace
+			}
+			mm[client.FQN(ns, e.TargetRef.Name)] = podEndpoint{
+				state: endpointState(e.Conditions),
+				ports: ports,
+			}
+		}
+	}
+
+	return mm
+}
+
+// endpointState returns an endpoint state. Unknown readiness is deemed ready.
+func endpointState(c discoveryv1.EndpointConditions) string {
+	switch {
+	case c.Terminating != nil && *c.Terminating:
+		return EndpointTerminating
+	case c.Ready != nil && !*c.Ready:
+		return EndpointNotReady
+	default:
+		return EndpointReady
+	}
+}
+
+func svcPortStr(p v1.ServicePort) string {
+	s := strconv.Itoa(int(p.Port)) + "/" + string(p.Protocol)
+	if p.Name != "" {
+		s = p.Name + ":" + s
+	}
+
+	return s
+}
+
+// resolveTargetPort resolves a service port target on a given pod.
+func resolveTargetPort(pod *v1.Pod, p v1.ServicePort) (string, error) {
+	t := p.TargetPort
+	if t.Type == intstr.Int {
+		if t.IntValue() == 0 {
+			return strconv.Itoa(int(p.Port)), nil
+		}
+		return t.String(), nil
+	}
+
+	proto := p.Protocol
+	if proto == "" {
+		proto = v1.ProtocolTCP
+	}
+	for _, co := range pod.Spec.Containers {
+		for _, cp := range co.Ports {
+			pp := cp.Protocol
+			if pp == "" {
+				pp = v1.ProtocolTCP
+			}
+			if cp.Name == t.StrVal && pp == proto {
+				return strconv.Itoa(int(cp.ContainerPort)), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("named port %q not found on pod", t.StrVal)
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestDiagnoseService(t *testing.T) {
+	yes, no := true, false
+	http := "http"
+
+	svc := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "svc1"},
+		Spec: v1.ServiceSpec{
+			Selector: map[string]string{"app": "fred"},
+			Ports: []v1.ServicePort{
+				{Name: "http", Port: 80, Protocol: v1.ProtocolTCP, TargetPort: intstr.FromString("web")},
+			},
+		},
+	}
+	pod := func(n string, named bool) *v1.Pod {
+		var pp []v1.ContainerPort
+		if named {
+			pp = append(pp, v1.ContainerPort{Name: "web", ContainerPort: 8080})
+		}
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: n},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "c1", Ports: pp}},
+			},
+		}
+	}
+	slice := func(ready *bool, pods ...string) discoveryv1.EndpointSlice {
+		s := discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "svc1-abc"},
+			Ports:      []discoveryv1.EndpointPort{{Name: &http}},
+		}
+		for _, p := range pods {
+			s.Endpoints = append(s.Endpoints, discoveryv1.Endpoint{
+				Conditions: discoveryv1.EndpointConditions{Ready: ready},
+				TargetRef:  &v1.ObjectReference{Kind: "Pod", Name: p},
+			})
+		}
+		return s
+	}
+
+	uu := map[string]struct {
+		svc    func() *v1.Service
+		pods   []*v1.Pod
+		slices []discoveryv1.EndpointSlice
+		e      []render.ServiceHealthRes
+	}{
+		"external": {
+			svc: func() *v1.Service {
+				s := svc.DeepCopy()
+				s.Spec.Type, s.Spec.ExternalName = v1.ServiceTypeExternalName, "blee.com"
+				return s
+			},
+			e: []render.ServiceHealthRes{{Issue: "external service pointing to blee.com"}},
+		},
+		"no-selector": {
+			svc: func() *v1.Service {
+				s := svc.DeepCopy()
+				s.Spec.Selector = nil
+				return s
+			},
+			e: []render.ServiceHealthRes{{Issue: "service does not define a selector"}},
+		},
+		"no-pods": {
+			e: []render.ServiceHealthRes{{Issue: "selector app=fred matches no pods"}},
+		},
+		"healthy": {
+			pods:   []*v1.Pod{pod("p1", true)},
+			slices: []discoveryv1.EndpointSlice{slice(&yes, "p1")},
+			e: []render.ServiceHealthRes{
+				{Pod: "ns1/p1", Port: "http:80/TCP", Target: "8080", Endpoint: dao.EndpointReady, Reachable: true},
+			},
+		},
+		"unknown-ready": {
+			pods:   []*v1.Pod{pod("p1", true)},
+			slices: []discoveryv1.EndpointSlice{slice(nil, "p1")},
+			e: []render.ServiceHealthRes{
+				{Pod: "ns1/p1", Port: "http:80/TCP", Target: "8080", Endpoint: dao.EndpointReady, Reachable: true},
+			},
+		},
+		"not-ready": {
+			pods:   []*v1.Pod{pod("p1", true)},
+			slices: []discoveryv1.EndpointSlice{slice(&no, "p1")},
+			e: []render.ServiceHealthRes{
+				{Pod: "ns1/p1", Port: "http:80/TCP", Target: "8080", Endpoint: dao.EndpointNotReady, Issue: "endpoint is NotReady"},
+			},
+		},
+		"missing": {
+			pods:   []*v1.Pod{pod("p1", true), pod("p2", true)},
+			slices: []discoveryv1.EndpointSlice{slice(&yes, "p1")},
+			e: []render.ServiceHealthRes{
+				{Pod: "ns1/p1", Port: "http:80/TCP", Target: "8080", Endpoint: dao.EndpointReady, Reachable: true},
+				{Pod: "ns1/p2", Index: 1, Port: "http:80/TCP", Target: "8080", Endpoint: dao.EndpointMissing, Issue: "pod is not part of the service endpoints"},
+			},
+		},
+		"bad-target": {
+			pods:   []*v1.Pod{pod("p1", false)},
+			slices: []discoveryv1.EndpointSlice{slice(&yes, "p1")},
+			e: []render.ServiceHealthRes{
+				{Pod: "ns1/p1", Port: "http:80/TCP", Target: render.NAValue, Endpoint: dao.EndpointReady, Issue: `named port "web" not found on pod`},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := &svc
+			if u.svc != nil {
+				s = u.svc()
+			}
+			assert.Equal(t, u.e, dao.DiagnoseService(s, u.pods, u.slices))
+		})
+	}
+}
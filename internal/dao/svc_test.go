@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestHealthyPod(t *testing.T) {
+	uu := map[string]struct {
+		pods []runtime.Object
+		skip string
+		e    string
+		err  bool
+	}{
+		"none": {
+			err: true,
+		},
+		"unhealthy": {
+			pods: []runtime.Object{makePod("p1", v1.PodPending, false)},
+			err:  true,
+		},
+		"not-ready": {
+			pods: []runtime.Object{makePod("p1", v1.PodRunning, false), makePod("p2", v1.PodRunning, true)},
+			e:    "default/p2",
+		},
+		"skip": {
+			pods: []runtime.Object{makePod("p1", v1.PodRunning, true), makePod("p2", v1.PodRunning, true)},
+			skip: "default/p1",
+			e:    "default/p2",
+		},
+		"skip-only": {
+			pods: []runtime.Object{makePod("p1", v1.PodRunning, true)},
+			skip: "default/p1",
+			e:    "default/p1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := &testFactory{inventory: map[string]map[string][]runtime.Object{
+				"default": {"v1/pods": u.pods},
+			}}
+			path, err := dao.HealthyPod(f, "default", labels.Everything(), u.skip)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, path)
+		})
+	}
+}
+
+// Helpers...
+
+func makePod(n string, phase v1.PodPhase, ready bool) runtime.Object {
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "default"},
+		Status: v1.PodStatus{
+			Phase:      phase,
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: status}},
+		},
+	}
+	o, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(&po)
+
+	return &unstructured.Unstructured{Object: o}
+}
@@ -6,9 +6,11 @@ package dao
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -18,7 +20,13 @@ import (
 
 const gvFmt = "application/json;as=Table;v=%s;g=%s, application/json"
 
-var genScheme = runtime.NewScheme()
+var (
+	genScheme = runtime.NewScheme()
+
+	// objectColumns tracks resources whose printer columns must be rendered
+	// from full objects.
+	objectColumns sync.Map
+)
 
 // Table retrieves K8s resources as tabular data.
 type Table struct {
@@ -58,27 +66,100 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		return nil, err
 	}
 	a := fmt.Sprintf(gvFmt, metav1.SchemeGroupVersion.Version, metav1.GroupName)
-	o, err := c.Get().
-		SetHeader("Accept", a).
-		Namespace(ns).
-		Resource(t.gvr.R()).
-		VersionedParams(&metav1.ListOptions{
-			LabelSelector:        labelSel,
-			FieldSelector:        fieldSel,
-			ResourceVersion:      "0",
-			ResourceVersionMatch: v1.ResourceVersionMatchNotOlderThan,
-		}, p).
-		Do(ctx).Get()
+	opts := metav1.ListOptions{
+		LabelSelector:        labelSel,
+		FieldSelector:        fieldSel,
+		ResourceVersion:      "0",
+		ResourceVersionMatch: v1.ResourceVersionMatchNotOlderThan,
+	}
+	// Chunked lists must be served by etcd rather than the watch cache.
+	if pg, ok := ctx.Value(internal.KeyPager).(*Pager); ok && pg != nil {
+		opts.ResourceVersion, opts.ResourceVersionMatch = "", ""
+	}
+	list := func(opts metav1.ListOptions, objects bool) (*metav1.Table, error) {
+		req := c.Get().
+			SetHeader("Accept", a).
+			Namespace(ns).
+			Resource(t.gvr.R()).
+			VersionedParams(&opts, p)
+		if objects {
+			req = includeObjects(req, p)
+		}
+		o, err := req.Do(ctx).Get()
+		if err != nil {
+			return nil, err
+		}
+		tt, ok := o.(*metav1.Table)
+		if !ok {
+			return nil, fmt.Errorf("expecting a table but got %T", o)
+		}
+		return tt, nil
+	}
+	cc := t.printerColumns()
+	var objects bool
+	if len(cc) > 0 {
+		objects = t.needsObjects(cc, func() (*metav1.Table, error) {
+			return list(metav1.ListOptions{LabelSelector: labelSel, FieldSelector: fieldSel, Limit: 1}, false)
+		})
+	}
+	tt, err := pagedList(ctx, ns, opts, func(opts metav1.ListOptions) (*metav1.Table, error) {
+		return list(opts, objects)
+	})
 	if err != nil {
 		return nil, err
 	}
+	if objects {
+		if err := AugmentTable(tt, cc); err != nil {
+			log.Warn().Err(err).Msgf("Unable to render printer columns for %s", t.gvr)
+		}
+	}
 
-	return []runtime.Object{o}, nil
+	return []runtime.Object{tt}, nil
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// printerColumns returns custom resources printer columns if any.
+func (t *Table) printerColumns() []apiextv1.CustomResourceColumnDefinition {
+	meta, err := MetaAccess.MetaFor(t.gvr)
+	if err != nil || !IsCRD(meta) {
+		return nil
+	}
+	cc, err := PrinterColumns(t.Factory, t.gvr)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to load printer columns for %s", t.gvr)
+		return nil
+	}
+
+	return cc
+}
+
+// needsObjects checks if the server table lacks some printer columns, in which
+// case rows must carry full objects to render them. The outcome is cached per
+// context and resource.
+func (t *Table) needsObjects(cc []apiextv1.CustomResourceColumnDefinition, probe func() (*metav1.Table, error)) bool {
+	key := t.Client().ActiveContext() + "|" + t.gvr.String()
+	if v, ok := objectColumns.Load(key); ok {
+		return v.(bool)
+	}
+	tt, err := probe()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to probe printer columns for %s", t.gvr)
+		return false
+	}
+	ok := len(missingColumns(tt, cc)) > 0
+	objectColumns.Store(key, ok)
+
+	return ok
+}
+
+// includeObjects requests full objects on table rows. By default the server only
+// sends objects metadata, leaving spec and status printer columns blank.
+func includeObjects(req *rest.Request, p runtime.ParameterCodec) *rest.Request {
+	return req.VersionedParams(&metav1.TableOptions{IncludeObject: v1.IncludeObject}, p)
+}
+
 func (t *Table) getClient(f serializer.CodecFactory) (*rest.RESTClient, error) {
 	cfg, err := t.Client().RestConfig()
 	if err != nil {
@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*TopPod)(nil)
+	_ Accessor = (*TopNamespace)(nil)
+	_ Accessor = (*TopNode)(nil)
+)
+
+// TopPod tracks pods resource usage.
+type TopPod struct {
+	NonResource
+}
+
+// List returns pods resource usage.
+func (t *TopPod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	uu, err := podsUsage(ctx, t.getFactory(), ns)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := usageTrackerFrom(ctx)
+	oo := make([]runtime.Object, 0, len(uu))
+	for _, u := range uu {
+		u.res.DeltaCPU, u.res.DeltaMEM = tracker.Track(client.FQN(u.res.Namespace, u.res.Name), u.at, u.res.CPU, u.res.MEM)
+		oo = append(oo, u.res)
+	}
+
+	return oo, nil
+}
+
+// TopNamespace tracks pods resource usage aggregated by namespace.
+type TopNamespace struct {
+	NonResource
+}
+
+// List returns namespaces resource usage.
+func (t *TopNamespace) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	uu, err := podsUsage(ctx, t.getFactory(), client.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := usageTrackerFrom(ctx)
+	oo := make([]runtime.Object, 0, len(uu))
+	for _, u := range aggregateUsage(uu) {
+		u.res.DeltaCPU, u.res.DeltaMEM = tracker.Track(u.res.Name, u.at, u.res.CPU, u.res.MEM)
+		oo = append(oo, u.res)
+	}
+
+	return oo, nil
+}
+
+// TopNode tracks nodes resource usage.
+type TopNode struct {
+	NonResource
+}
+
+// List returns nodes resource usage.
+func (t *TopNode) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	f := t.getFactory()
+	mx, err := client.DialMetrics(f.Client()).FetchNodesMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	oo, err := f.List("v1/nodes", client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	allocs := make(map[string]v1.ResourceList, len(oo))
+	for _, o := range oo {
+		var no v1.Node
+		if err := fromUnstructured(o, &no); err != nil {
+			return nil, err
+		}
+		allocs[no.Name] = no.Status.Allocatable
+	}
+
+	tracker := usageTrackerFrom(ctx)
+	res := make([]runtime.Object, 0, len(mx.Items))
+	for _, m := range mx.Items {
+		r := render.TopRes{
+			Name: m.Name,
+			CPU:  m.Usage.Cpu().MilliValue(),
+			MEM:  m.Usage.Memory().Value(),
+		}
+		if a, ok := allocs[m.Name]; ok {
+			r.CPUCap, r.MEMCap = a.Cpu().MilliValue(), a.Memory().Value()
+		}
+		r.DeltaCPU, r.DeltaMEM = tracker.Track(m.Name, m.Timestamp.Time, r.CPU, r.MEM)
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// usageSample represents a resource usage at a given time.
+type usageSample struct {
+	res render.TopRes
+	at  time.Time
+}
+
+// aggregateUsage aggregates pods usage by namespace. The sample time is the most
+// recent pod sample in the namespace.
+func aggregateUsage(uu []usageSample) []usageSample {
+	index := make(map[string]int)
+	aa := make([]usageSample, 0)
+	for _, u := range uu {
+		i, ok := index[u.res.Namespace]
+		if !ok {
+			i = len(aa)
+			index[u.res.Namespace] = i
+			aa = append(aa, usageSample{res: render.TopRes{Name: u.res.Namespace}})
+		}
+		a := &aa[i]
+		a.res.Count++
+		a.res.CPU += u.res.CPU
+		a.res.MEM += u.res.MEM
+		a.res.CPUCap += u.res.CPUCap
+		a.res.MEMCap += u.res.MEMCap
+		if u.at.After(a.at) {
+			a.at = u.at
+		}
+	}
+
+	return aa
+}
+
+func podsUsage(ctx context.Context, f Factory, ns string) ([]usageSample, error) {
+	mx, err := client.DialMetrics(f.Client()).FetchPodsMetrics(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	oo, err := f.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	reqs := make(map[string][2]int64, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		cpu, mem := render.PodRequests(&po)
+		reqs[client.FQN(po.Namespace, po.Name)] = [2]int64{cpu, mem}
+	}
+
+	uu := make([]usageSample, 0, len(mx.Items))
+	for _, m := range mx.Items {
+		u := usageSample{
+			res: render.TopRes{
+				Namespace: m.Namespace,
+				Name:      m.Name,
+				Count:     len(m.Containers),
+			},
+			at: m.Timestamp.Time,
+		}
+		for _, c := range m.Containers {
+			u.res.CPU += c.Usage.Cpu().MilliValue()
+			u.res.MEM += c.Usage.Memory().Value()
+		}
+		if r, ok := reqs[client.FQN(m.Namespace, m.Name)]; ok {
+			u.res.CPUCap, u.res.MEMCap = r[0], r[1]
+		}
+		uu = append(uu, u)
+	}
+
+	return uu, nil
+}
+
+// UsageTracker tracks resources usage samples to compute deltas between refreshes.
+type UsageTracker struct {
+	samples map[string]usage
+	mx      sync.Mutex
+}
+
+type usage struct {
+	at                 time.Time
+	cpu, mem           int64
+	deltaCPU, deltaMEM int64
+}
+
+// NewUsageTracker returns a new tracker.
+func NewUsageTracker() *UsageTracker {
+	return &UsageTracker{samples: make(map[string]usage)}
+}
+
+// Track records a usage sample and returns the cpu and memory deltas since the
+// previous sample. Resubmitting the same sample yields the last known deltas.
+func (t *UsageTracker) Track(id string, at time.Time, cpu, mem int64) (int64, int64) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	prev, ok := t.samples[id]
+	if ok && prev.at.Equal(at) {
+		return prev.deltaCPU, prev.deltaMEM
+	}
+	u := usage{at: at, cpu: cpu, mem: mem}
+	if ok {
+		u.deltaCPU, u.deltaMEM = cpu-prev.cpu, mem-prev.mem
+	}
+	t.samples[id] = u
+
+	return u.deltaCPU, u.deltaMEM
+}
+
+func usageTrackerFrom(ctx context.Context) *UsageTracker {
+	if t, ok := ctx.Value(internal.KeyUsageTracker).(*UsageTracker); ok {
+		return t
+	}
+
+	return NewUsageTracker()
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageTrackerTrack(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tr := NewUsageTracker()
+
+	dcpu, dmem := tr.Track("ns1/p1", t0, 100, 1000)
+	assert.Equal(t, int64(0), dcpu)
+	assert.Equal(t, int64(0), dmem)
+
+	dcpu, dmem = tr.Track("ns1/p1", t0.Add(time.Minute), 80, 1500)
+	assert.Equal(t, int64(-20), dcpu)
+	assert.Equal(t, int64(500), dmem)
+
+	dcpu, dmem = tr.Track("ns1/p1", t0.Add(time.Minute), 80, 1500)
+	assert.Equal(t, int64(-20), dcpu)
+	assert.Equal(t, int64(500), dmem)
+
+	dcpu, dmem = tr.Track("ns1/p2", t0, 10, 10)
+	assert.Equal(t, int64(0), dcpu)
+	assert.Equal(t, int64(0), dmem)
+}
+
+func TestAggregateUsage(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uu := []usageSample{
+		{res: render.TopRes{Namespace: "ns1", Name: "p1", CPU: 10, MEM: 100, CPUCap: 20}, at: t0},
+		{res: render.TopRes{Namespace: "ns2", Name: "p2", CPU: 5, MEM: 50}, at: t0},
+		{res: render.TopRes{Namespace: "ns1", Name: "p3", CPU: 30, MEM: 300, CPUCap: 40}, at: t0.Add(time.Second)},
+	}
+
+	aa := aggregateUsage(uu)
+	assert.Equal(t, 2, len(aa))
+	assert.Equal(t, render.TopRes{Name: "ns1", Count: 2, CPU: 40, MEM: 400, CPUCap: 60}, aa[0].res)
+	assert.Equal(t, t0.Add(time.Second), aa[0].at)
+	assert.Equal(t, render.TopRes{Name: "ns2", Count: 1, CPU: 5, MEM: 50}, aa[1].res)
+}
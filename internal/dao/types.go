@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 )
 
 // ResourceMetas represents a collection of resource metadata.
@@ -69,6 +70,13 @@ type Lister interface {
 	List(ctx context.Context, ns string) ([]runtime.Object, error)
 }
 
+// ChangeWatcher represents a resource that notifies of changes.
+type ChangeWatcher interface {
+	// AddChangeHandler registers a resource change handler and returns
+	// a func to unregister it.
+	AddChangeHandler(ns string, h cache.ResourceEventHandler) (func(), error)
+}
+
 // Accessor represents an accessible k8s resource.
 type Accessor interface {
 	Lister
@@ -120,12 +128,30 @@ type Scalable interface {
 	Scale(ctx context.Context, path string, replicas int32) error
 }
 
+// Expandable represents a resource that can be resized.
+type Expandable interface {
+	// Expand resizes a resource storage.
+	Expand(ctx context.Context, path, size string) error
+}
+
+// Patcher represents a resource that can be patched.
+type Patcher interface {
+	// Patch applies a merge patch to a resource.
+	Patch(ctx context.Context, path string, data []byte) error
+}
+
 // Controller represents a pod controller.
 type Controller interface {
 	// Pod returns a pod instance matching the selector.
 	Pod(path string) (string, error)
 }
 
+// PodSelector represents a resource backed by pods matching a selector.
+type PodSelector interface {
+	// PodSelector returns the namespace and selector of the backing pods.
+	PodSelector(path string) (string, labels.Selector, error)
+}
+
 // Nuker represents a resource deleter.
 type Nuker interface {
 	// Delete removes a resource from the api server.
@@ -0,0 +1,328 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	authv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	restclient "k8s.io/client-go/rest"
+)
+
+const (
+	whoamiSrcConfig = "kubeconfig"
+	whoamiSrcToken  = "token"
+	whoamiSrcCert   = "cert"
+	whoamiSrcReview = "review"
+
+	saClaim = "kubernetes.io"
+)
+
+var _ Accessor = (*WhoAmI)(nil)
+
+// WhoAmI inspects the current user credentials and effective identity.
+type WhoAmI struct {
+	NonResource
+}
+
+// List returns the current user credentials attributes.
+func (w *WhoAmI) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	conn := w.getFactory().Client()
+	if conn == nil {
+		return nil, errors.New("no client connection")
+	}
+	rr := configAttrs(conn.Config())
+
+	cfg, err := conn.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	rr = append(rr, credsAttrs(cfg)...)
+	rr = append(rr, w.reviewAttrs(ctx, conn)...)
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// Get returns a given identity attribute.
+func (w *WhoAmI) Get(ctx context.Context, path string) (runtime.Object, error) {
+	oo, err := w.List(ctx, client.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		if r, ok := o.(render.WhoAmIRes); ok && r.ID() == path {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no identity attribute found for %q", path)
+}
+
+// reviewAttrs asks the api server who it thinks we are.
+func (*WhoAmI) reviewAttrs(ctx context.Context, conn client.Connection) []render.WhoAmIRes {
+	dial, err := conn.Dial()
+	if err != nil {
+		return []render.WhoAmIRes{{Source: whoamiSrcReview, Key: "username", Err: err}}
+	}
+	res, err := dial.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return []render.WhoAmIRes{{Source: whoamiSrcReview, Key: "username", Err: err}}
+	}
+
+	return reviewRes(res.Status.UserInfo)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func configAttrs(cfg *client.Config) []render.WhoAmIRes {
+	var rr []render.WhoAmIRes
+	add := func(k string, v string, err error) {
+		if err != nil {
+			return
+		}
+		rr = append(rr, render.WhoAmIRes{Source: whoamiSrcConfig, Key: k, Value: v})
+	}
+	ctx, err := cfg.CurrentContextName()
+	add("context", ctx, err)
+	cl, err := cfg.CurrentClusterName()
+	add("cluster", cl, err)
+	usr, err := cfg.CurrentUserName()
+	add("user", usr, err)
+	if cfg.IsImpersonating() {
+		u, err := cfg.ImpersonateUser()
+		add("impersonate-user", u, err)
+		gg, err := cfg.ImpersonateGroups()
+		add("impersonate-groups", gg, err)
+	}
+
+	return rr
+}
+
+// credsAttrs decodes the current user credentials.
+func credsAttrs(cfg *restclient.Config) []render.WhoAmIRes {
+	rr := []render.WhoAmIRes{{Source: whoamiSrcConfig, Key: "auth", Value: authMethod(cfg)}}
+
+	token := cfg.BearerToken
+	if token == "" && cfg.BearerTokenFile != "" {
+		bb, err := os.ReadFile(cfg.BearerTokenFile)
+		if err != nil {
+			return append(rr, render.WhoAmIRes{Source: whoamiSrcToken, Key: "file", Value: cfg.BearerTokenFile, Err: err})
+		}
+		token = strings.TrimSpace(string(bb))
+	}
+	if token != "" {
+		rr = append(rr, tokenRes(token)...)
+	}
+
+	certData := cfg.CertData
+	if len(certData) == 0 && cfg.CertFile != "" {
+		bb, err := os.ReadFile(cfg.CertFile)
+		if err != nil {
+			return append(rr, render.WhoAmIRes{Source: whoamiSrcCert, Key: "file", Value: cfg.CertFile, Err: err})
+		}
+		certData = bb
+	}
+	if len(certData) > 0 {
+		rr = append(rr, certRes(certData)...)
+	}
+
+	return rr
+}
+
+func authMethod(cfg *restclient.Config) string {
+	switch {
+	case cfg.ExecProvider != nil:
+		return "exec:" + cfg.ExecProvider.Command
+	case cfg.AuthProvider != nil:
+		return "auth-provider:" + cfg.AuthProvider.Name
+	case cfg.BearerToken != "":
+		return "token"
+	case cfg.BearerTokenFile != "":
+		return "token-file:" + cfg.BearerTokenFile
+	case len(cfg.CertData) > 0 || cfg.CertFile != "":
+		return "client-cert"
+	case cfg.Username != "":
+		return "basic"
+	default:
+		return "none"
+	}
+}
+
+// DecodeJWTClaims returns the claims of a JWT token. The token signature is not verified.
+func DecodeJWTClaims(token string) (map[string]interface{}, error) {
+	tt := strings.Split(token, ".")
+	if len(tt) != 3 {
+		return nil, errors.New("token is not a JWT")
+	}
+	bb, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(tt[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT payload: %w", err)
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(bb, &claims); err != nil {
+		return nil, fmt.Errorf("invalid JWT claims: %w", err)
+	}
+
+	return claims, nil
+}
+
+func tokenRes(token string) []render.WhoAmIRes {
+	claims, err := DecodeJWTClaims(token)
+	if err != nil {
+		return []render.WhoAmIRes{{Source: whoamiSrcToken, Key: "type", Value: "opaque", Err: err}}
+	}
+
+	rr := []render.WhoAmIRes{{Source: whoamiSrcToken, Key: "type", Value: "jwt"}}
+	for _, k := range sortedClaims(claims) {
+		v := claims[k]
+		switch k {
+		case "exp", "iat", "nbf":
+			f, ok := v.(float64)
+			if !ok {
+				break
+			}
+			t := time.Unix(int64(f), 0)
+			r := render.WhoAmIRes{Source: whoamiSrcToken, Key: k, Value: t.UTC().Format(time.RFC3339)}
+			if k == "exp" {
+				r.Value += " (" + expiresIn(t) + ")"
+				if time.Now().After(t) {
+					r.Err = errors.New("token expired")
+				}
+			}
+			rr = append(rr, r)
+			continue
+		case saClaim:
+			rr = append(rr, saClaims(v)...)
+			continue
+		}
+		rr = append(rr, render.WhoAmIRes{Source: whoamiSrcToken, Key: k, Value: claimValue(v)})
+	}
+
+	return rr
+}
+
+// saClaims extracts the service account attributes from a bound token.
+func saClaims(v interface{}) []render.WhoAmIRes {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var rr []render.WhoAmIRes
+	if ns, ok := m["namespace"].(string); ok {
+		rr = append(rr, render.WhoAmIRes{Source: whoamiSrcToken, Key: "serviceaccount.namespace", Value: ns})
+	}
+	for _, k := range []string{"serviceaccount", "pod", "node"} {
+		o, ok := m[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if n, ok := o["name"].(string); ok {
+			rr = append(rr, render.WhoAmIRes{Source: whoamiSrcToken, Key: k + ".name", Value: n})
+		}
+	}
+
+	return rr
+}
+
+func certRes(bb []byte) []render.WhoAmIRes {
+	b, _ := pem.Decode(bb)
+	if b == nil {
+		return []render.WhoAmIRes{{Source: whoamiSrcCert, Key: "subject", Err: errors.New("no certificate found")}}
+	}
+	cert, err := x509.ParseCertificate(b.Bytes)
+	if err != nil {
+		return []render.WhoAmIRes{{Source: whoamiSrcCert, Key: "subject", Err: err}}
+	}
+	exp := render.WhoAmIRes{
+		Source: whoamiSrcCert,
+		Key:    "notAfter",
+		Value:  cert.NotAfter.UTC().Format(time.RFC3339) + " (" + expiresIn(cert.NotAfter) + ")",
+	}
+	if time.Now().After(cert.NotAfter) {
+		exp.Err = errors.New("certificate expired")
+	}
+
+	return []render.WhoAmIRes{
+		{Source: whoamiSrcCert, Key: "user", Value: cert.Subject.CommonName},
+		{Source: whoamiSrcCert, Key: "groups", Value: strings.Join(cert.Subject.Organization, ",")},
+		{Source: whoamiSrcCert, Key: "issuer", Value: cert.Issuer.CommonName},
+		exp,
+	}
+}
+
+func reviewRes(u authv1.UserInfo) []render.WhoAmIRes {
+	rr := []render.WhoAmIRes{
+		{Source: whoamiSrcReview, Key: "username", Value: u.Username},
+		{Source: whoamiSrcReview, Key: "uid", Value: u.UID},
+		{Source: whoamiSrcReview, Key: "groups", Value: strings.Join(u.Groups, ",")},
+	}
+	kk := make([]string, 0, len(u.Extra))
+	for k := range u.Extra {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		rr = append(rr, render.WhoAmIRes{Source: whoamiSrcReview, Key: "extra." + k, Value: strings.Join(u.Extra[k], ",")})
+	}
+
+	return rr
+}
+
+func sortedClaims(m map[string]interface{}) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
+
+func claimValue(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []interface{}:
+		ss := make([]string, 0, len(t))
+		for _, s := range t {
+			ss = append(ss, claimValue(s))
+		}
+		return strings.Join(ss, ",")
+	default:
+		bb, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprintf("%v", t)
+		}
+		return string(bb)
+	}
+}
+
+func expiresIn(t time.Time) string {
+	d := time.Until(t).Truncate(time.Second)
+	if d < 0 {
+		return "expired " + (-d).String() + " ago"
+	}
+
+	return "expires in " + d.String()
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	authv1 "k8s.io/api/authentication/v1"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func makeJWT(claims string) string {
+	enc := base64.RawURLEncoding.EncodeToString
+
+	return enc([]byte(`{"alg":"none"}`)) + "." + enc([]byte(claims)) + ".sig"
+}
+
+func TestDecodeJWTClaims(t *testing.T) {
+	uu := map[string]struct {
+		token string
+		e     map[string]interface{}
+		err   bool
+	}{
+		"happy": {
+			token: makeJWT(`{"sub":"fred","aud":["k8s"]}`),
+			e:     map[string]interface{}{"sub": "fred", "aud": []interface{}{"k8s"}},
+		},
+		"opaque": {
+			token: "abc123",
+			err:   true,
+		},
+		"toast": {
+			token: "a.!!.c",
+			err:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			claims, err := DecodeJWTClaims(u.token)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, claims)
+		})
+	}
+}
+
+func TestTokenRes(t *testing.T) {
+	rr := tokenRes(makeJWT(`{"sub":"system:serviceaccount:ns1:sa1","exp":1,"kubernetes.io":{"namespace":"ns1","serviceaccount":{"name":"sa1"}}}`))
+
+	assert.Equal(t, []render.WhoAmIRes{
+		{Source: whoamiSrcToken, Key: "type", Value: "jwt"},
+		{Source: whoamiSrcToken, Key: "exp", Value: rr[1].Value, Err: rr[1].Err},
+		{Source: whoamiSrcToken, Key: "serviceaccount.namespace", Value: "ns1"},
+		{Source: whoamiSrcToken, Key: "serviceaccount.name", Value: "sa1"},
+		{Source: whoamiSrcToken, Key: "sub", Value: "system:serviceaccount:ns1:sa1"},
+	}, rr)
+	assert.Error(t, rr[1].Err)
+	assert.Contains(t, rr[1].Value, "1970-01-01T00:00:01Z (expired")
+}
+
+func TestAuthMethod(t *testing.T) {
+	uu := map[string]struct {
+		cfg restclient.Config
+		e   string
+	}{
+		"none": {
+			e: "none",
+		},
+		"token": {
+			cfg: restclient.Config{BearerToken: "fred"},
+			e:   "token",
+		},
+		"exec": {
+			cfg: restclient.Config{ExecProvider: &api.ExecConfig{Command: "aws"}},
+			e:   "exec:aws",
+		},
+		"cert": {
+			cfg: restclient.Config{TLSClientConfig: restclient.TLSClientConfig{CertFile: "/tmp/crt"}},
+			e:   "client-cert",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, authMethod(&u.cfg))
+		})
+	}
+}
+
+func TestReviewRes(t *testing.T) {
+	rr := reviewRes(authv1.UserInfo{
+		Username: "fred",
+		UID:      "1",
+		Groups:   []string{"system:authenticated", "devs"},
+		Extra:    map[string]authv1.ExtraValue{"scopes": {"a", "b"}},
+	})
+
+	assert.Equal(t, []render.WhoAmIRes{
+		{Source: whoamiSrcReview, Key: "username", Value: "fred"},
+		{Source: whoamiSrcReview, Key: "uid", Value: "1"},
+		{Source: whoamiSrcReview, Key: "groups", Value: "system:authenticated,devs"},
+		{Source: whoamiSrcReview, Key: "extra.scopes", Value: "a,b"},
+	}, rr)
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
-	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -46,6 +45,9 @@ type Workload struct {
 }
 
 func (w *Workload) Delete(ctx context.Context, path string, propagation *metav1.DeletionPropagation, grace Grace) error {
+	if err := ensureWritable("delete", path); err != nil {
+		return err
+	}
 	gvr, _ := ctx.Value(internal.KeyGVR).(client.GVR)
 	ns, n := client.Namespaced(path)
 	auth, err := w.Client().CanI(ns, gvr.String(), n, []string{client.DeleteVerb})
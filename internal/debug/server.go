@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"sync"
+
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+)
+
+// StatsFunc returns the informers factory state.
+type StatsFunc func() watch.FactoryStats
+
+// Report represents the k9s runtime state.
+type Report struct {
+	Goroutines int                `json:"goroutines"`
+	Memory     MemStats           `json:"memory"`
+	Factory    watch.FactoryStats `json:"factory"`
+}
+
+// MemStats represents the k9s memory usage.
+type MemStats struct {
+	Alloc      uint64 `json:"alloc"`
+	TotalAlloc uint64 `json:"totalAlloc"`
+	Sys        uint64 `json:"sys"`
+	HeapInUse  uint64 `json:"heapInUse"`
+	HeapObjs   uint64 `json:"heapObjects"`
+	NumGC      uint32 `json:"numGC"`
+}
+
+// Server serves pprof profiles and informers stats on a local address.
+type Server struct {
+	addr     string
+	statsFn  StatsFunc
+	listener net.Listener
+	srv      *http.Server
+	once     sync.Once
+}
+
+// NewServer returns a new debug server.
+func NewServer(addr string, f StatsFunc) *Server {
+	return &Server{
+		addr:    addr,
+		statsFn: f,
+	}
+}
+
+// Start starts serving on the server address. Only loopback addresses are allowed.
+func (s *Server) Start() error {
+	addr, err := localAddr(s.addr)
+	if err != nil {
+		return err
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	s.listener, s.srv = l, &http.Server{Handler: s.routes()}
+	go func() {
+		if err := s.srv.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msg("Debug server failed")
+		}
+	}()
+	log.Info().Msgf("Debug server listening on %s", l.Addr())
+
+	return nil
+}
+
+// Stop stops the server.
+func (s *Server) Stop() {
+	s.once.Do(func() {
+		if s.srv != nil {
+			_ = s.srv.Close()
+		}
+	})
+}
+
+// Addr returns the server listening address.
+func (s *Server) Addr() string {
+	if s.listener == nil {
+		return ""
+	}
+
+	return s.listener.Addr().String()
+}
+
+func (s *Server) routes() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/informers", s.informers)
+
+	return mux
+}
+
+func (s *Server) informers(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(s.report()); err != nil {
+		log.Error().Err(err).Msg("Debug informers report failed")
+	}
+}
+
+func (s *Server) report() Report {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	r := Report{
+		Goroutines: runtime.NumGoroutine(),
+		Memory: MemStats{
+			Alloc:      m.Alloc,
+			TotalAlloc: m.TotalAlloc,
+			Sys:        m.Sys,
+			HeapInUse:  m.HeapInuse,
+			HeapObjs:   m.HeapObjects,
+			NumGC:      m.NumGC,
+		},
+	}
+	if s.statsFn != nil {
+		r.Factory = s.statsFn()
+	}
+
+	return r
+}
+
+// Helpers...
+
+// localAddr ensures the given address is bound to a loopback interface.
+// A bare port binds to localhost.
+func localAddr(addr string) (string, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", fmt.Errorf("invalid debug address %q: %w", addr, err)
+	}
+	if host == "" {
+		return net.JoinHostPort("127.0.0.1", port), nil
+	}
+	if host == "localhost" {
+		return addr, nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return "", fmt.Errorf("debug address %q must be a loopback address", addr)
+	}
+
+	return addr, nil
+}
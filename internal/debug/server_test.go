@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package debug
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalAddr(t *testing.T) {
+	uu := map[string]struct {
+		addr, e string
+		err     bool
+	}{
+		"port": {
+			addr: ":6060",
+			e:    "127.0.0.1:6060",
+		},
+		"localhost": {
+			addr: "localhost:6060",
+			e:    "localhost:6060",
+		},
+		"loopback": {
+			addr: "[::1]:6060",
+			e:    "[::1]:6060",
+		},
+		"remote": {
+			addr: "0.0.0.0:6060",
+			err:  true,
+		},
+		"host": {
+			addr: "fred:6060",
+			err:  true,
+		},
+		"no-port": {
+			addr: "localhost",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			addr, err := localAddr(u.addr)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, addr)
+		})
+	}
+}
+
+func TestServerInformers(t *testing.T) {
+	s := NewServer("127.0.0.1:0", func() watch.FactoryStats {
+		return watch.FactoryStats{
+			Informers: []watch.InformerStats{
+				{Namespace: "default", GVR: "v1/pods", Synced: true, Objects: 3, Leases: 1},
+			},
+		}
+	})
+	assert.NoError(t, s.Start())
+	defer s.Stop()
+
+	resp, err := http.Get("http://" + s.Addr() + "/debug/informers")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var r Report
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&r))
+	assert.Positive(t, r.Goroutines)
+	assert.Positive(t, r.Memory.Sys)
+	assert.Equal(t, []watch.InformerStats{{Namespace: "default", GVR: "v1/pods", Synced: true, Objects: 3, Leases: 1}}, r.Factory.Informers)
+
+	resp, err = http.Get("http://" + s.Addr() + "/debug/pprof/")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -14,6 +14,7 @@ var (
 	inverseRx = regexp.MustCompile(`\A\!`)
 	fuzzyRx   = regexp.MustCompile(`\A-f\s?([\w-]+)\b`)
 	labelRx   = regexp.MustCompile(`\A\-l`)
+	exprRx    = regexp.MustCompile(`\A-e\s?(.+)`)
 )
 
 // Helpers...
@@ -31,6 +32,9 @@ func IsLabelSelector(s string) bool {
 	if labelRx.MatchString(s) {
 		return true
 	}
+	if exprRx.MatchString(s) {
+		return false
+	}
 
 	return !strings.Contains(s, " ") && cmd.ToLabels(s) != nil
 }
@@ -44,3 +48,13 @@ func IsFuzzySelector(s string) (string, bool) {
 
 	return mm[1], true
 }
+
+// IsExprSelector checks if query is a filter expression.
+func IsExprSelector(s string) (string, bool) {
+	mm := exprRx.FindStringSubmatch(s)
+	if len(mm) != 2 {
+		return "", false
+	}
+
+	return mm[1], true
+}
@@ -22,6 +22,7 @@ func TestIsLabelSelector(t *testing.T) {
 		"wrong-flag":  {s: "-f app=fred,env=blee"},
 		"missing-key": {s: "=fred"},
 		"missing-val": {s: "fred="},
+		"expr":        {s: "-estatus=Running"},
 	}
 
 	for k := range uu {
@@ -31,3 +32,25 @@ func TestIsLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestIsExprSelector(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+		ok   bool
+	}{
+		"empty":    {s: ""},
+		"cool":     {s: "-e status=Running && restarts>3", e: "status=Running && restarts>3", ok: true},
+		"no-space": {s: "-estatus=Running", e: "status=Running", ok: true},
+		"label":    {s: "-l app=fred"},
+		"regex":    {s: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e, ok := internal.IsExprSelector(u.s)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, e)
+		})
+	}
+}
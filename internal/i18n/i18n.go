@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package i18n
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultLanguage represents the language of the source messages.
+const DefaultLanguage = "en"
+
+var (
+	//go:embed catalogs/*.yaml
+	catalogs embed.FS
+
+	language = DefaultLanguage
+	catalog  map[string]string
+	mx       sync.RWMutex
+)
+
+// Catalog represents a collection of translated messages keyed by their
+// english source.
+type Catalog struct {
+	Messages map[string]string `yaml:"messages"`
+}
+
+// Languages returns all available languages.
+func Languages() []string {
+	ee, _ := catalogs.ReadDir("catalogs")
+	ll := make([]string, 0, len(ee)+1)
+	ll = append(ll, DefaultLanguage)
+	for _, e := range ee {
+		ll = append(ll, strings.TrimSuffix(e.Name(), path.Ext(e.Name())))
+	}
+	sort.Strings(ll)
+
+	return ll
+}
+
+// Language returns the current language.
+func Language() string {
+	mx.RLock()
+	defer mx.RUnlock()
+
+	return language
+}
+
+// SetLanguage loads the message catalog for the given language ie fr or
+// fr_FR.UTF-8. Blank or unknown languages fall back to english.
+func SetLanguage(lang string) error {
+	lang = normalize(lang)
+	if lang == "" || lang == DefaultLanguage {
+		setCatalog(DefaultLanguage, nil)
+		return nil
+	}
+	c, err := Load(lang)
+	if err != nil {
+		setCatalog(DefaultLanguage, nil)
+		return err
+	}
+	setCatalog(lang, c.Messages)
+
+	return nil
+}
+
+// Load loads the embedded catalog of a given language.
+func Load(lang string) (*Catalog, error) {
+	bb, err := catalogs.ReadFile(path.Join("catalogs", lang+".yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("no translations for language %q", lang)
+	}
+	var c Catalog
+	if err := yaml.Unmarshal(bb, &c); err != nil {
+		return nil, fmt.Errorf("invalid %q catalog: %w", lang, err)
+	}
+
+	return &c, nil
+}
+
+// T returns the translation of a message or the message itself if none.
+func T(msg string) string {
+	mx.RLock()
+	defer mx.RUnlock()
+
+	if t, ok := catalog[msg]; ok && t != "" {
+		return t
+	}
+
+	return msg
+}
+
+// Tf returns a formatted translated message.
+func Tf(fmat string, args ...interface{}) string {
+	return fmt.Sprintf(T(fmat), args...)
+}
+
+func setCatalog(lang string, c map[string]string) {
+	mx.Lock()
+	defer mx.Unlock()
+
+	language, catalog = lang, c
+}
+
+func normalize(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "_.-@"); i != -1 {
+		lang = lang[:i]
+	}
+	if lang == "c" || lang == "posix" {
+		return ""
+	}
+
+	return lang
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package i18n_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+var verbRX = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+func TestLanguages(t *testing.T) {
+	assert.Equal(t, []string{"de", "en", "es", "fr"}, i18n.Languages())
+}
+
+func TestCatalogs(t *testing.T) {
+	for _, l := range i18n.Languages() {
+		if l == i18n.DefaultLanguage {
+			continue
+		}
+		t.Run(l, func(t *testing.T) {
+			c, err := i18n.Load(l)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, c.Messages)
+			for k, v := range c.Messages {
+				assert.Equal(t, verbRX.FindAllString(k, -1), verbRX.FindAllString(v, -1), k)
+			}
+		})
+	}
+}
+
+func TestSetLanguage(t *testing.T) {
+	defer func() { _ = i18n.SetLanguage("") }()
+
+	uu := map[string]struct {
+		lang, e, msg string
+		err          string
+	}{
+		"default": {
+			e:   i18n.DefaultLanguage,
+			msg: "Delete",
+		},
+		"fr": {
+			lang: "fr",
+			e:    "fr",
+			msg:  "Supprimer",
+		},
+		"locale": {
+			lang: "de_DE.UTF-8",
+			e:    "de",
+			msg:  "Löschen",
+		},
+		"posix": {
+			lang: "C",
+			e:    i18n.DefaultLanguage,
+			msg:  "Delete",
+		},
+		"unknown": {
+			lang: "tlh",
+			e:    i18n.DefaultLanguage,
+			msg:  "Delete",
+			err:  `no translations for language "tlh"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := i18n.SetLanguage(u.lang)
+			if err != nil {
+				assert.Equal(t, u.err, err.Error())
+			}
+			assert.Equal(t, u.e, i18n.Language())
+			assert.Equal(t, u.msg, i18n.T("Delete"))
+			assert.Equal(t, "Blee", i18n.T("Blee"))
+		})
+	}
+}
+
+func TestTf(t *testing.T) {
+	defer func() { _ = i18n.SetLanguage("") }()
+
+	assert.Equal(t, "Viewing pods...", i18n.Tf("Viewing %s...", "pods"))
+	assert.NoError(t, i18n.SetLanguage("es"))
+	assert.Equal(t, "Viendo pods...", i18n.Tf("Viewing %s...", "pods"))
+}
@@ -36,4 +36,18 @@ const (
 	KeyWait          ContextKey = "wait"
 	KeyPodCounting   ContextKey = "podCounting"
 	KeyEnableImgScan ContextKey = "vulScan"
+	KeyUsageTracker  ContextKey = "usageTracker"
+	KeyPluginRows    ContextKey = "pluginRows"
+	KeyPluginJobs    ContextKey = "pluginJobs"
+	KeyBindings      ContextKey = "keyBindings"
+	KeyManifests     ContextKey = "manifests"
+	KeyImageScans    ContextKey = "imageScans"
+	KeyQuery         ContextKey = "query"
+	KeyListUncached  ContextKey = "listUncached"
+	KeyQueryGVR      ContextKey = "queryGVR"
+	KeyQueryPaths    ContextKey = "queryPaths"
+	KeyAccessDenied  ContextKey = "accessDenied"
+	KeyPager         ContextKey = "pager"
+	KeyFleet         ContextKey = "fleet"
+	KeyLogLevel      ContextKey = "logLevel"
 )
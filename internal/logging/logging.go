@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package logging
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Subsystems loggers names.
+const (
+	Watch = "watch"
+	UI    = "ui"
+	DAO   = "dao"
+)
+
+// SubsystemField tracks the log field naming the emitting subsystem.
+const SubsystemField = "subsystem"
+
+var (
+	base      = log.Logger
+	mainLevel atomic.Int32
+	loggers   = make(map[string]*Logger)
+	mx        sync.RWMutex
+)
+
+func init() {
+	mainLevel.Store(int32(zerolog.InfoLevel))
+}
+
+// Init configures the main logger output and level. Subsystem loggers share
+// the same output.
+func Init(w io.Writer, level zerolog.Level) {
+	base = zerolog.New(w).With().Timestamp().Logger()
+	log.Logger = base.Hook(zerolog.HookFunc(mainHook))
+	SetLevel(level)
+}
+
+func mainHook(e *zerolog.Event, level zerolog.Level, _ string) {
+	if level < Level() {
+		e.Discard()
+	}
+}
+
+// ParseLevel converts a level name to a log level.
+func ParseLevel(s string) (zerolog.Level, error) {
+	switch strings.ToLower(s) {
+	case "trace":
+		return zerolog.TraceLevel, nil
+	case "debug":
+		return zerolog.DebugLevel, nil
+	case "info":
+		return zerolog.InfoLevel, nil
+	case "warn":
+		return zerolog.WarnLevel, nil
+	case "error":
+		return zerolog.ErrorLevel, nil
+	case "fatal":
+		return zerolog.FatalLevel, nil
+	default:
+		return zerolog.NoLevel, fmt.Errorf("invalid log level %q", s)
+	}
+}
+
+// Level returns the main log level.
+func Level() zerolog.Level {
+	return zerolog.Level(mainLevel.Load())
+}
+
+// SetLevel sets the main log level. Subsystems without a level of their own
+// follow it.
+func SetLevel(l zerolog.Level) {
+	mainLevel.Store(int32(l))
+	syncGlobalLevel()
+}
+
+// SetSubsystemLevel sets a subsystem log level. NoLevel reverts to the main level.
+func SetSubsystemLevel(name string, l zerolog.Level) error {
+	lg, ok := lookup(name)
+	if !ok {
+		return fmt.Errorf("unknown log subsystem %q. Expecting one of %s", name, strings.Join(Subsystems(), "|"))
+	}
+	lg.level.Store(int32(l))
+	syncGlobalLevel()
+
+	return nil
+}
+
+// Subsystems returns the registered subsystems names.
+func Subsystems() []string {
+	mx.RLock()
+	defer mx.RUnlock()
+
+	nn := make([]string, 0, len(loggers))
+	for n := range loggers {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// For returns the named subsystem logger.
+func For(name string) *Logger {
+	mx.Lock()
+	defer mx.Unlock()
+
+	if l, ok := loggers[name]; ok {
+		return l
+	}
+	l := Logger{name: name}
+	l.level.Store(int32(zerolog.NoLevel))
+	loggers[name] = &l
+
+	return &l
+}
+
+func lookup(name string) (*Logger, bool) {
+	mx.RLock()
+	defer mx.RUnlock()
+	l, ok := loggers[name]
+
+	return l, ok
+}
+
+// syncGlobalLevel lowers zerolog global level to the most verbose level in use.
+func syncGlobalLevel() {
+	min := Level()
+	mx.RLock()
+	for _, l := range loggers {
+		if lvl, ok := l.ownLevel(); ok && lvl < min {
+			min = lvl
+		}
+	}
+	mx.RUnlock()
+	zerolog.SetGlobalLevel(min)
+}
+
+// Logger represents a subsystem logger whose level can be changed at runtime.
+type Logger struct {
+	name  string
+	level atomic.Int32
+}
+
+// Name returns the subsystem name.
+func (l *Logger) Name() string {
+	return l.name
+}
+
+// Level returns the subsystem effective log level.
+func (l *Logger) Level() zerolog.Level {
+	if lvl, ok := l.ownLevel(); ok {
+		return lvl
+	}
+
+	return Level()
+}
+
+func (l *Logger) ownLevel() (zerolog.Level, bool) {
+	lvl := zerolog.Level(l.level.Load())
+
+	return lvl, lvl != zerolog.NoLevel
+}
+
+// Trace starts a trace message.
+func (l *Logger) Trace() *zerolog.Event {
+	return l.event(zerolog.TraceLevel)
+}
+
+// Debug starts a debug message.
+func (l *Logger) Debug() *zerolog.Event {
+	return l.event(zerolog.DebugLevel)
+}
+
+// Info starts an info message.
+func (l *Logger) Info() *zerolog.Event {
+	return l.event(zerolog.InfoLevel)
+}
+
+// Warn starts a warning message.
+func (l *Logger) Warn() *zerolog.Event {
+	return l.event(zerolog.WarnLevel)
+}
+
+// Error starts an error message.
+func (l *Logger) Error() *zerolog.Event {
+	return l.event(zerolog.ErrorLevel)
+}
+
+// Err starts an error message if err is set or an info message otherwise.
+func (l *Logger) Err(err error) *zerolog.Event {
+	if err != nil {
+		return l.Error().Err(err)
+	}
+
+	return l.Info()
+}
+
+// Fatal starts a fatal message. The process exits once the message is sent.
+func (l *Logger) Fatal() *zerolog.Event {
+	return base.Fatal().Str(SubsystemField, l.name)
+}
+
+// Zerolog returns a zerolog logger for the subsystem at its current level.
+func (l *Logger) Zerolog() *zerolog.Logger {
+	zl := base.With().Str(SubsystemField, l.name).Logger().Level(l.Level())
+
+	return &zl
+}
+
+func (l *Logger) event(lvl zerolog.Level) *zerolog.Event {
+	if lvl < l.Level() {
+		return nil
+	}
+
+	return base.WithLevel(lvl).Str(SubsystemField, l.name)
+}
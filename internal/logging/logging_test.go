@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package logging_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/logging"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLevel(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   zerolog.Level
+		err bool
+	}{
+		"debug": {s: "debug", e: zerolog.DebugLevel},
+		"upper": {s: "WARN", e: zerolog.WarnLevel},
+		"error": {s: "error", e: zerolog.ErrorLevel},
+		"toast": {s: "blee", e: zerolog.NoLevel, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, err := logging.ParseLevel(u.s)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, l)
+		})
+	}
+}
+
+func TestSubsystemLevels(t *testing.T) {
+	defer zerolog.SetGlobalLevel(zerolog.FatalLevel)
+
+	var buff bytes.Buffer
+	logging.Init(&buff, zerolog.InfoLevel)
+	fred := logging.For("fred")
+	assert.Same(t, fred, logging.For("fred"))
+	assert.Contains(t, logging.Subsystems(), "fred")
+
+	fred.Debug().Msg("fred-debug")
+	log.Debug().Msg("main-debug")
+	assert.Empty(t, buff.String())
+
+	require.NoError(t, logging.SetSubsystemLevel("fred", zerolog.DebugLevel))
+	assert.Equal(t, zerolog.DebugLevel, zerolog.GlobalLevel())
+	fred.Debug().Msg("fred-debug")
+	log.Debug().Msg("main-debug")
+	assert.Contains(t, buff.String(), `"subsystem":"fred"`)
+	assert.Contains(t, buff.String(), "fred-debug")
+	assert.NotContains(t, buff.String(), "main-debug")
+
+	buff.Reset()
+	require.NoError(t, logging.SetSubsystemLevel("fred", zerolog.NoLevel))
+	logging.SetLevel(zerolog.ErrorLevel)
+	assert.Equal(t, zerolog.ErrorLevel, fred.Level())
+	fred.Warn().Msg("fred-warn")
+	log.Error().Msg("main-error")
+	assert.NotContains(t, buff.String(), "fred-warn")
+	assert.Contains(t, buff.String(), "main-error")
+
+	assert.Error(t, logging.SetSubsystemLevel("blee", zerolog.DebugLevel))
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package logring
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// DefaultSize tracks the number of log entries retained in memory.
+const DefaultSize = 1_000
+
+// Default tracks k9s own log entries.
+var Default = New(DefaultSize)
+
+// Entry represents a structured log entry.
+type Entry struct {
+	Seq     uint64
+	Time    time.Time
+	Level   zerolog.Level
+	Message string
+	Fields  map[string]interface{}
+}
+
+// String returns a single line representation of the entry.
+func (e Entry) String() string {
+	var b strings.Builder
+	b.WriteString(e.Time.Format(time.RFC3339))
+	b.WriteString(" ")
+	b.WriteString(strings.ToUpper(e.Level.String()))
+	b.WriteString(" ")
+	b.WriteString(e.Message)
+	if ff := e.FieldsString(); ff != "" {
+		b.WriteString(" ")
+		b.WriteString(ff)
+	}
+
+	return b.String()
+}
+
+// FieldsString returns the entry fields as sorted key=value pairs.
+func (e Entry) FieldsString() string {
+	kk := make([]string, 0, len(e.Fields))
+	for k := range e.Fields {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	ff := make([]string, 0, len(kk))
+	for _, k := range kk {
+		ff = append(ff, fmt.Sprintf("%s=%v", k, e.Fields[k]))
+	}
+
+	return strings.Join(ff, " ")
+}
+
+// Ring retains the last structured log entries written to it. It expects
+// zerolog json events, one per write.
+type Ring struct {
+	entries []Entry
+	size    int
+	next    int
+	full    bool
+	seq     uint64
+	mx      sync.RWMutex
+}
+
+// New returns a new ring retaining up to size entries.
+func New(size int) *Ring {
+	return &Ring{
+		entries: make([]Entry, size),
+		size:    size,
+	}
+}
+
+// Write records a log event.
+func (r *Ring) Write(p []byte) (int, error) {
+	if r.size <= 0 {
+		return len(p), nil
+	}
+	e, err := parse(p)
+	if err != nil {
+		return 0, err
+	}
+	r.add(e)
+
+	return len(p), nil
+}
+
+func (r *Ring) add(e Entry) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.seq++
+	e.Seq = r.seq
+	r.entries[r.next] = e
+	r.next = (r.next + 1) % r.size
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Entries returns the entries at or above the given level, oldest first.
+func (r *Ring) Entries(level zerolog.Level) []Entry {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	ee := make([]Entry, 0, r.size)
+	if r.full {
+		ee = append(ee, r.entries[r.next:]...)
+	}
+	ee = append(ee, r.entries[:r.next]...)
+	if level <= zerolog.TraceLevel {
+		return ee
+	}
+	fee := ee[:0]
+	for _, e := range ee {
+		if e.Level >= level {
+			fee = append(fee, e)
+		}
+	}
+
+	return fee
+}
+
+// Lines returns all entries as text lines, oldest first.
+func (r *Ring) Lines() []string {
+	ee := r.Entries(zerolog.TraceLevel)
+	ll := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ll = append(ll, e.String())
+	}
+
+	return ll
+}
+
+func parse(p []byte) (Entry, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(p, &m); err != nil {
+		return Entry{}, fmt.Errorf("invalid log event: %w", err)
+	}
+	e := Entry{Level: zerolog.NoLevel}
+	if s, ok := m[zerolog.LevelFieldName].(string); ok {
+		if l, err := zerolog.ParseLevel(s); err == nil {
+			e.Level = l
+		}
+	}
+	if s, ok := m[zerolog.TimestampFieldName].(string); ok {
+		if t, err := time.Parse(zerolog.TimeFieldFormat, s); err == nil {
+			e.Time = t
+		}
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	if s, ok := m[zerolog.MessageFieldName].(string); ok {
+		e.Message = s
+	}
+	delete(m, zerolog.LevelFieldName)
+	delete(m, zerolog.TimestampFieldName)
+	delete(m, zerolog.MessageFieldName)
+	if len(m) > 0 {
+		e.Fields = m
+	}
+
+	return e, nil
+}
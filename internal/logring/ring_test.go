@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package logring_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/logring"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRingEntries(t *testing.T) {
+	uu := map[string]struct {
+		size  int
+		level zerolog.Level
+		e     []string
+	}{
+		"all": {
+			size:  10,
+			level: zerolog.TraceLevel,
+			e:     []string{"m1", "m2", "m3", "m4"},
+		},
+		"warn": {
+			size:  10,
+			level: zerolog.WarnLevel,
+			e:     []string{"m2", "m4"},
+		},
+		"wrap": {
+			size:  3,
+			level: zerolog.TraceLevel,
+			e:     []string{"m2", "m3", "m4"},
+		},
+		"wrap-error": {
+			size:  2,
+			level: zerolog.ErrorLevel,
+			e:     []string{"m4"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := logring.New(u.size)
+			l := zerolog.New(r).With().Timestamp().Logger()
+			l.Debug().Msg("m1")
+			l.Warn().Str("fred", "blee").Msg("m2")
+			l.Info().Msg("m3")
+			l.Error().Msg("m4")
+
+			ee := r.Entries(u.level)
+			mm := make([]string, 0, len(ee))
+			for _, e := range ee {
+				mm = append(mm, e.Message)
+			}
+			assert.Equal(t, u.e, mm)
+		})
+	}
+}
+
+func TestRingParse(t *testing.T) {
+	r := logring.New(5)
+	l := zerolog.New(r).With().Timestamp().Logger()
+	l.Warn().Str("fred", "blee").Int("count", 2).Msg("Boom")
+
+	ee := r.Entries(zerolog.TraceLevel)
+	require.Len(t, ee, 1)
+	e := ee[0]
+	assert.Equal(t, uint64(1), e.Seq)
+	assert.Equal(t, zerolog.WarnLevel, e.Level)
+	assert.Equal(t, "Boom", e.Message)
+	assert.False(t, e.Time.IsZero())
+	assert.Equal(t, "count=2 fred=blee", e.FieldsString())
+	assert.Contains(t, e.String(), "WARN Boom count=2 fred=blee")
+}
+
+func TestRingInvalid(t *testing.T) {
+	r := logring.New(5)
+	_, err := r.Write([]byte("not json"))
+	assert.Error(t, err)
+
+	var buff bytes.Buffer
+	l := zerolog.New(zerolog.MultiLevelWriter(&buff, r))
+	l.Info().Msg("hello")
+	assert.Len(t, r.Lines(), 1)
+	assert.Contains(t, buff.String(), "hello")
+}
@@ -92,6 +92,16 @@ func (c *Cluster) UserName() string {
 	return n
 }
 
+// IsImpersonating checks if the user is impersonated.
+func (c *Cluster) IsImpersonating() bool {
+	return c.factory.Client().Config().IsImpersonating()
+}
+
+// Throttle returns the client rate and whether the api server is throttling requests.
+func (c *Cluster) Throttle() (float32, bool) {
+	return c.factory.Client().Config().Governor().IsThrottled()
+}
+
 // Metrics gathers node level metrics and compute utilization percentages.
 func (c *Cluster) Metrics(ctx context.Context, mx *client.ClusterMetrics) error {
 	var (
@@ -40,9 +40,13 @@ type ClusterInfoListener interface {
 type ClusterMeta struct {
 	Context, Cluster    string
 	User                string
+	Impersonating       bool
 	K9sVer, K9sLatest   string
 	K8sVer              string
 	Cpu, Mem, Ephemeral int
+	Throttled           bool
+	QPS                 float32
+	K9sMem              MemStats
 }
 
 // NewClusterMeta returns a new instance.
@@ -68,6 +72,9 @@ func (c ClusterMeta) Deltas(n ClusterMeta) bool {
 	return c.Context != n.Context ||
 		c.Cluster != n.Cluster ||
 		c.User != n.User ||
+		c.Impersonating != n.Impersonating ||
+		c.Throttled != n.Throttled ||
+		c.QPS != n.QPS ||
 		c.K8sVer != n.K8sVer ||
 		c.K9sVer != n.K9sVer ||
 		c.K9sLatest != n.K9sLatest
@@ -80,6 +87,7 @@ type ClusterInfo struct {
 	data      ClusterMeta
 	version   string
 	cfg       *config.K9s
+	mem       *MemMonitor
 	listeners []ClusterInfoListener
 	cache     *cache.LRUExpireCache
 	mx        sync.RWMutex
@@ -115,6 +123,11 @@ func (c *ClusterInfo) fetchK9sLatestRev() string {
 	return latestRev
 }
 
+// SetMemMonitor registers a monitor reporting k9s memory usage.
+func (c *ClusterInfo) SetMemMonitor(m *MemMonitor) {
+	c.mem = m
+}
+
 // Reset resets context and reload.
 func (c *ClusterInfo) Reset(f dao.Factory) {
 	if f == nil {
@@ -137,7 +150,9 @@ func (c *ClusterInfo) Refresh() {
 		data.Context = c.cluster.ContextName()
 		data.Cluster = c.cluster.ClusterName()
 		data.User = c.cluster.UserName()
+		data.Impersonating = c.cluster.IsImpersonating()
 		data.K8sVer = c.cluster.Version()
+		data.QPS, data.Throttled = c.cluster.Throttle()
 		ctx, cancel := context.WithTimeout(context.Background(), c.cluster.factory.Client().Config().CallTimeout())
 		defer cancel()
 		var mx client.ClusterMetrics
@@ -146,6 +161,9 @@ func (c *ClusterInfo) Refresh() {
 		}
 	}
 	data.K9sVer = c.version
+	if c.mem != nil {
+		data.K9sMem = c.mem.Last()
+	}
 	v1 := NewSemVer(data.K9sVer)
 
 	var latestRev string
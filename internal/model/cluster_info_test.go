@@ -33,6 +33,11 @@ func TestClusterMetaDelta(t *testing.T) {
 			n: makeClusterMeta("freddie"),
 			e: true,
 		},
+		"throttled": {
+			o: makeClusterMeta("fred"),
+			n: makeThrottledClusterMeta("fred", 25),
+			e: true,
+		},
 	}
 
 	for k := range uu {
@@ -52,3 +57,10 @@ func makeClusterMeta(cluster string) model.ClusterMeta {
 
 	return m
 }
+
+func makeThrottledClusterMeta(cluster string, qps float32) model.ClusterMeta {
+	m := makeClusterMeta(cluster)
+	m.Throttled, m.QPS = true, qps
+
+	return m
+}
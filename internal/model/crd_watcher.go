@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	crdGVR = "apiextensions.k8s.io/v1/customresourcedefinitions"
+
+	// crdSettleDelay batches CRDs changes as operators often install several at once.
+	crdSettleDelay = 2 * time.Second
+)
+
+type crdEvent int
+
+const (
+	crdAdded crdEvent = iota
+	crdUpdated
+	crdRemoved
+)
+
+// CRDChanges tracks CRDs installed, updated or removed since the last reload.
+type CRDChanges struct {
+	Added, Updated, Removed []string
+}
+
+// IsEmpty checks if no CRDs changed.
+func (c CRDChanges) IsEmpty() bool {
+	return len(c.Added)+len(c.Updated)+len(c.Removed) == 0
+}
+
+// String returns a changes summary.
+func (c CRDChanges) String() string {
+	var ss []string
+	if n := len(c.Added); n > 0 {
+		ss = append(ss, fmt.Sprintf("%d added", n))
+	}
+	if n := len(c.Updated); n > 0 {
+		ss = append(ss, fmt.Sprintf("%d updated", n))
+	}
+	if n := len(c.Removed); n > 0 {
+		ss = append(ss, fmt.Sprintf("%d removed", n))
+	}
+
+	return "CRDs " + strings.Join(ss, ", ")
+}
+
+// CRDChangedFunc represents a CRDs changes callback.
+type CRDChangedFunc func(CRDChanges)
+
+// CRDWatcher watches CRDs so their resources can be registered as they
+// come and go.
+type CRDWatcher struct {
+	factory dao.Factory
+	fn      CRDChangedFunc
+	delay   time.Duration
+	inf     cache.SharedIndexInformer
+	reg     cache.ResourceEventHandlerRegistration
+	lease   *watch.Lease
+	added   map[string]struct{}
+	updated map[string]struct{}
+	removed map[string]struct{}
+	timer   *time.Timer
+	mx      sync.Mutex
+}
+
+// NewCRDWatcher returns a new CRDs watcher.
+func NewCRDWatcher(f dao.Factory, fn CRDChangedFunc) *CRDWatcher {
+	w := CRDWatcher{
+		factory: f,
+		fn:      fn,
+		delay:   crdSettleDelay,
+	}
+	w.reset()
+
+	return &w
+}
+
+// Start registers the CRDs informer event handler.
+func (w *CRDWatcher) Start() error {
+	gi, err := w.factory.CanForResource(client.ClusterScope, crdGVR, client.MonitorAccess)
+	if err != nil {
+		return err
+	}
+	if gi == nil {
+		return fmt.Errorf("no informer found for %s", crdGVR)
+	}
+	inf := gi.Informer()
+	reg, err := inf.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(o interface{}, initial bool) {
+			if !initial {
+				w.track(crdAdded, o)
+			}
+		},
+		UpdateFunc: func(o1, o2 interface{}) {
+			if crdGeneration(o1) != crdGeneration(o2) {
+				w.track(crdUpdated, o2)
+			}
+		},
+		DeleteFunc: func(o interface{}) {
+			if d, ok := o.(cache.DeletedFinalStateUnknown); ok {
+				o = d.Obj
+			}
+			w.track(crdRemoved, o)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	w.mx.Lock()
+	w.inf, w.reg = inf, reg
+	w.lease = leaseInformer(w.factory, client.ClusterScope, crdGVR)
+	w.mx.Unlock()
+
+	return nil
+}
+
+// Stop unregisters the CRDs informer event handler.
+func (w *CRDWatcher) Stop() {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	if w.inf == nil {
+		return
+	}
+	if err := w.inf.RemoveEventHandler(w.reg); err != nil {
+		log.Warn().Err(err).Msg("Unable to remove CRDs handler")
+	}
+	w.lease.Release()
+	w.inf, w.reg, w.lease = nil, nil, nil
+}
+
+func (w *CRDWatcher) track(evt crdEvent, o interface{}) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	w.mx.Lock()
+	defer w.mx.Unlock()
+	switch evt {
+	case crdAdded:
+		w.added[u.GetName()] = struct{}{}
+	case crdUpdated:
+		w.updated[u.GetName()] = struct{}{}
+	case crdRemoved:
+		w.removed[u.GetName()] = struct{}{}
+	}
+	if w.timer == nil {
+		w.timer = time.AfterFunc(w.delay, w.flush)
+	}
+}
+
+func (w *CRDWatcher) flush() {
+	w.mx.Lock()
+	cc := CRDChanges{
+		Added:   sortedNames(w.added),
+		Updated: sortedNames(w.updated),
+		Removed: sortedNames(w.removed),
+	}
+	w.reset()
+	w.timer = nil
+	w.mx.Unlock()
+
+	if !cc.IsEmpty() {
+		w.fn(cc)
+	}
+}
+
+func (w *CRDWatcher) reset() {
+	w.added = make(map[string]struct{})
+	w.updated = make(map[string]struct{})
+	w.removed = make(map[string]struct{})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func crdGeneration(o interface{}) int64 {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return 0
+	}
+
+	return u.GetGeneration()
+}
+
+func sortedNames(m map[string]struct{}) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	ss := make([]string, 0, len(m))
+	for k := range m {
+		ss = append(ss, k)
+	}
+	sort.Strings(ss)
+
+	return ss
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCRDWatcherFlush(t *testing.T) {
+	changes := make(chan CRDChanges, 1)
+	w := NewCRDWatcher(nil, func(cc CRDChanges) { changes <- cc })
+	w.delay = time.Millisecond
+
+	w.track(crdAdded, makeCRD("b.fred.io", 1))
+	w.track(crdAdded, makeCRD("a.fred.io", 1))
+	w.track(crdRemoved, makeCRD("c.fred.io", 1))
+	w.track(crdUpdated, "toast")
+
+	select {
+	case cc := <-changes:
+		assert.Equal(t, CRDChanges{
+			Added:   []string{"a.fred.io", "b.fred.io"},
+			Removed: []string{"c.fred.io"},
+		}, cc)
+		assert.Equal(t, "CRDs 2 added, 1 removed", cc.String())
+	case <-time.After(time.Second):
+		assert.Fail(t, "expecting CRDs changes")
+	}
+
+	w.flush()
+	select {
+	case cc := <-changes:
+		assert.Fail(t, "expecting no changes", cc)
+	default:
+	}
+}
+
+func TestCRDGeneration(t *testing.T) {
+	assert.Equal(t, int64(2), crdGeneration(makeCRD("a.fred.io", 2)))
+	assert.Equal(t, int64(0), crdGeneration("toast"))
+}
+
+func makeCRD(n string, gen int64) *unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{}}
+	u.SetName(n)
+	u.SetGeneration(gen)
+
+	return &u
+}
@@ -5,9 +5,12 @@ package model
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"sync"
 	"time"
 
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/rs/zerolog/log"
 )
 
@@ -21,6 +24,9 @@ const (
 	FlashWarn
 	// FlashErr represents an error message.
 	FlashErr
+
+	// AccessReviewHint tells users how to review an access denial.
+	AccessReviewHint = " (<alt-a> to review access)"
 )
 
 // LevelMessage tracks an message and severity.
@@ -59,6 +65,8 @@ type Flash struct {
 	cancel  context.CancelFunc
 	delay   time.Duration
 	msgChan chan LevelMessage
+	denied  *client.AccessDeniedError
+	mx      sync.RWMutex
 }
 
 // NewFlash returns a new instance.
@@ -76,29 +84,30 @@ func (f *Flash) Channel() FlashChan {
 
 // Info displays an info flash message.
 func (f *Flash) Info(msg string) {
-	f.SetMessage(FlashInfo, msg)
+	f.SetMessage(FlashInfo, i18n.T(msg))
 }
 
 // Infof displays a formatted info flash message.
 func (f *Flash) Infof(fmat string, args ...interface{}) {
-	f.Info(fmt.Sprintf(fmat, args...))
+	f.SetMessage(FlashInfo, i18n.Tf(fmat, args...))
 }
 
 // Warn displays a warning flash message.
 func (f *Flash) Warn(msg string) {
 	log.Warn().Msg(msg)
-	f.SetMessage(FlashWarn, msg)
+	f.SetMessage(FlashWarn, i18n.T(msg))
 }
 
 // Warnf displays a formatted warning flash message.
 func (f *Flash) Warnf(fmat string, args ...interface{}) {
-	f.Warn(fmt.Sprintf(fmat, args...))
+	log.Warn().Msgf(fmat, args...)
+	f.SetMessage(FlashWarn, i18n.Tf(fmat, args...))
 }
 
 // Err displays an error flash message.
 func (f *Flash) Err(err error) {
 	log.Error().Msg(err.Error())
-	f.SetMessage(FlashErr, err.Error())
+	f.SetMessage(FlashErr, err.Error()+f.trackDenied(err))
 }
 
 // Errf displays a formatted error flash message.
@@ -111,7 +120,28 @@ func (f *Flash) Errf(fmat string, args ...interface{}) {
 		}
 	}
 	log.Error().Err(err).Msgf(fmat, args...)
-	f.SetMessage(FlashErr, fmt.Sprintf(fmat, args...))
+	f.SetMessage(FlashErr, i18n.Tf(fmat, args...)+f.trackDenied(err))
+}
+
+// LastDenied returns the last flashed access denial if any.
+func (f *Flash) LastDenied() *client.AccessDeniedError {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	return f.denied
+}
+
+// trackDenied records access denials and returns a review hint.
+func (f *Flash) trackDenied(err error) string {
+	var denied *client.AccessDeniedError
+	if !errors.As(err, &denied) {
+		return ""
+	}
+	f.mx.Lock()
+	f.denied = denied
+	f.mx.Unlock()
+
+	return AccessReviewHint
 }
 
 // Clear clears the flash message.
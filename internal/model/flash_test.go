@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/stretchr/testify/assert"
 )
@@ -70,6 +72,43 @@ func TestFlashBurst(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("test-%d", count), m)
 }
 
+func TestFlashTranslated(t *testing.T) {
+	const delay = 1 * time.Millisecond
+
+	assert.NoError(t, i18n.SetLanguage("fr"))
+	defer func() { _ = i18n.SetLanguage("") }()
+
+	f := model.NewFlash(delay)
+	v := newFlash()
+	go v.listen(f.Channel())
+
+	f.Infof("Viewing %s...", "pods")
+
+	time.Sleep(5 * delay)
+	_, _, m := v.getMetrics()
+	assert.Equal(t, "Affichage de pods...", m)
+}
+
+func TestFlashAccessDenied(t *testing.T) {
+	const delay = 1 * time.Millisecond
+
+	f := model.NewFlash(delay)
+	v := newFlash()
+	go v.listen(f.Channel())
+	assert.Nil(t, f.LastDenied())
+
+	denied := &client.AccessDeniedError{Verb: "list", Namespace: "ns1", GVR: "v1/pods"}
+	f.Err(fmt.Errorf("init failed: %w", denied))
+
+	time.Sleep(5 * delay)
+	_, _, m := v.getMetrics()
+	assert.Equal(t, "init failed: `list access denied for user on \"ns1\":v1/pods"+model.AccessReviewHint, m)
+	assert.Equal(t, denied, f.LastDenied())
+
+	f.Err(errors.New("blee"))
+	assert.Equal(t, denied, f.LastDenied())
+}
+
 type flash struct {
 	set, clear int
 	level      model.FlashLevel
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/watch"
 	"github.com/sahilm/fuzzy"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -54,3 +56,19 @@ func rxFilter(q string, lines []string) fuzzy.Matches {
 
 	return matches
 }
+
+// informerLeaser represents a factory handing out informer leases.
+type informerLeaser interface {
+	Lease(ns, gvr string) *watch.Lease
+}
+
+// leaseInformer holds a given informer so it won't be evicted while watched.
+// It returns a nil lease when the factory does not support leases.
+func leaseInformer(f dao.Factory, ns, gvr string) *watch.Lease {
+	l, ok := f.(informerLeaser)
+	if !ok {
+		return nil
+	}
+
+	return l.Lease(ns, gvr)
+}
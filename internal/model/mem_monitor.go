@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// memPressure tracks the ceiling ratio past which idle informers are evicted.
+	memPressure = 0.9
+
+	// memTarget tracks the ceiling ratio evictions aim to get back under.
+	memTarget = 0.75
+
+	// memIdle tracks how long an informer must go unused before it can be evicted.
+	memIdle = time.Minute
+
+	statmFile = "/proc/self/statm"
+)
+
+// MemStats represents k9s own memory usage.
+type MemStats struct {
+	RSS, Heap, Cache, Ceiling int64
+}
+
+// Usage returns the process resident memory or the heap size when unknown.
+func (m MemStats) Usage() int64 {
+	if m.RSS > 0 {
+		return m.RSS
+	}
+
+	return m.Heap
+}
+
+// Pressured checks if the memory usage approaches the configured ceiling.
+func (m MemStats) Pressured() bool {
+	return m.Ceiling > 0 && float64(m.Usage()) >= float64(m.Ceiling)*memPressure
+}
+
+// String returns the memory usage as a string.
+func (m MemStats) String() string {
+	s := strconv.Itoa(int(client.ToMB(m.Usage()))) + "Mi"
+	if m.Ceiling > 0 {
+		s += "/" + strconv.Itoa(int(client.ToMB(m.Ceiling))) + "Mi"
+	}
+
+	return fmt.Sprintf("%s cache~%dMi", s, client.ToMB(m.Cache))
+}
+
+// memEvicter represents a factory evicting its idle informers.
+type memEvicter interface {
+	Stats() watch.FactoryStats
+	Evict(n int64, idle time.Duration) []watch.InformerStats
+}
+
+// MemMonitor tracks k9s memory usage and evicts idle informers as the
+// memory ceiling is approached.
+type MemMonitor struct {
+	factory memEvicter
+	ceiling int64
+	readFn  func() MemStats
+	last    MemStats
+	mx      sync.RWMutex
+}
+
+// NewMemMonitor returns a new monitor given a ceiling in MiB. A zero ceiling
+// only tracks the memory usage.
+func NewMemMonitor(f memEvicter, ceiling int) *MemMonitor {
+	return &MemMonitor{
+		factory: f,
+		ceiling: int64(ceiling) * client.MegaByte,
+		readFn:  readMemStats,
+	}
+}
+
+// SetCeiling sets the memory ceiling in MiB.
+func (m *MemMonitor) SetCeiling(ceiling int) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.ceiling = int64(ceiling) * client.MegaByte
+}
+
+// Last returns the last memory usage sample.
+func (m *MemMonitor) Last() MemStats {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	return m.last
+}
+
+// Check samples the memory usage and evicts the largest idle informers when
+// under pressure. It returns the evicted informers if any.
+func (m *MemMonitor) Check() (MemStats, []watch.InformerStats) {
+	st := m.sample()
+	if !st.Pressured() {
+		return st, nil
+	}
+
+	n := st.Usage() - int64(float64(st.Ceiling)*memTarget)
+	evicted := m.factory.Evict(n, memIdle)
+	if len(evicted) > 0 {
+		log.Warn().Msgf("Memory pressure %s. Evicted %d informers", st, len(evicted))
+		runtime.GC()
+		debug.FreeOSMemory()
+		st = m.sample()
+	}
+
+	return st, evicted
+}
+
+func (m *MemMonitor) sample() MemStats {
+	st := m.readFn()
+	for _, i := range m.factory.Stats().Informers {
+		st.Cache += i.Bytes
+	}
+
+	m.mx.Lock()
+	st.Ceiling = m.ceiling
+	m.last = st
+	m.mx.Unlock()
+
+	return st
+}
+
+// Helpers...
+
+func readMemStats() MemStats {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+
+	return MemStats{
+		RSS:  readRSS(),
+		Heap: int64(ms.HeapAlloc),
+	}
+}
+
+// readRSS returns the process resident memory if the platform reports it.
+func readRSS() int64 {
+	bb, err := os.ReadFile(statmFile)
+	if err != nil {
+		return 0
+	}
+	ff := strings.Fields(string(bb))
+	if len(ff) < 2 {
+		return 0
+	}
+	pages, err := strconv.ParseInt(ff[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return pages * int64(os.Getpagesize())
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemStats(t *testing.T) {
+	uu := map[string]struct {
+		m         MemStats
+		e         string
+		pressured bool
+	}{
+		"no-ceiling": {
+			m: MemStats{RSS: 200 * client.MegaByte, Heap: 100 * client.MegaByte, Cache: 20 * client.MegaByte},
+			e: "200Mi cache~20Mi",
+		},
+		"heap": {
+			m: MemStats{Heap: 100 * client.MegaByte, Ceiling: 1024 * client.MegaByte},
+			e: "100Mi/1024Mi cache~0Mi",
+		},
+		"pressured": {
+			m:         MemStats{RSS: 950 * client.MegaByte, Ceiling: 1024 * client.MegaByte},
+			e:         "950Mi/1024Mi cache~0Mi",
+			pressured: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.m.String())
+			assert.Equal(t, u.pressured, u.m.Pressured())
+		})
+	}
+}
+
+func TestMemMonitorCheck(t *testing.T) {
+	uu := map[string]struct {
+		rss, ceiling int
+		evict        int64
+		evicted      int
+	}{
+		"no-ceiling": {
+			rss: 2048,
+		},
+		"under": {
+			rss:     512,
+			ceiling: 1024,
+		},
+		"pressured": {
+			rss:     1000,
+			ceiling: 1000,
+			evict:   250 * client.MegaByte,
+			evicted: 1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := memFactory{}
+			m := NewMemMonitor(&f, u.ceiling)
+			m.readFn = func() MemStats {
+				return MemStats{RSS: int64(u.rss) * client.MegaByte}
+			}
+			st, ii := m.Check()
+			assert.Len(t, ii, u.evicted)
+			assert.Equal(t, u.evict, f.evict)
+			assert.Equal(t, int64(30), st.Cache)
+			assert.Equal(t, st, m.Last())
+		})
+	}
+}
+
+// Helpers...
+
+type memFactory struct {
+	evict int64
+}
+
+func (*memFactory) Stats() watch.FactoryStats {
+	return watch.FactoryStats{
+		Informers: []watch.InformerStats{{GVR: "v1/pods", Bytes: 10}, {GVR: "v1/secrets", Bytes: 20}},
+	}
+}
+
+func (f *memFactory) Evict(n int64, _ time.Duration) []watch.InformerStats {
+	f.evict = n
+	return []watch.InformerStats{{GVR: "v1/secrets", Bytes: 20}}
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"strings"
+)
+
+const (
+	// MaxNavigation tracks max navigation entries.
+	MaxNavigation = 50
+
+	// MaxRecentResources tracks max recent resources per namespace.
+	MaxRecentResources = 10
+)
+
+// NavEntry represents a visited location.
+type NavEntry struct {
+	// Command tracks the command that led to the view.
+	Command string
+
+	// FQN tracks the resource the view is scoped to if any.
+	FQN string
+
+	// Namespace tracks the active namespace.
+	Namespace string
+
+	// Filter tracks the view filter.
+	Filter string
+
+	// GVR tracks the viewed resource if any.
+	GVR string
+}
+
+// String returns a human readable location.
+func (e NavEntry) String() string {
+	ss := []string{e.Command}
+	if e.FQN != "" {
+		ss = append(ss, e.FQN)
+	}
+	if e.Namespace != "" {
+		ss = append(ss, "("+e.Namespace+")")
+	}
+	if e.Filter != "" {
+		ss = append(ss, "/"+e.Filter)
+	}
+
+	return strings.Join(ss, " ")
+}
+
+func (e NavEntry) sameView(e1 NavEntry) bool {
+	return e.Command == e1.Command && e.FQN == e1.FQN && e.Namespace == e1.Namespace
+}
+
+// Navigation tracks visited locations with browser style back and forward.
+type Navigation struct {
+	entries []NavEntry
+	cursor  int
+	limit   int
+}
+
+// NewNavigation returns a new instance.
+func NewNavigation(limit int) *Navigation {
+	return &Navigation{
+		cursor: -1,
+		limit:  limit,
+	}
+}
+
+// Push records a new location. Forward locations are discarded.
+func (n *Navigation) Push(e NavEntry) {
+	if e.Command == "" {
+		return
+	}
+	if c, ok := n.Current(); ok && c.sameView(e) {
+		n.entries[n.cursor] = e
+		return
+	}
+	n.entries = append(n.entries[:n.cursor+1], e)
+	if len(n.entries) > n.limit {
+		n.entries = n.entries[len(n.entries)-n.limit:]
+	}
+	n.cursor = len(n.entries) - 1
+}
+
+// SetFilter updates the filter of the current location.
+func (n *Navigation) SetFilter(f string) {
+	if n.cursor < 0 {
+		return
+	}
+	n.entries[n.cursor].Filter = f
+}
+
+// Current returns the current location.
+func (n *Navigation) Current() (NavEntry, bool) {
+	if n.cursor < 0 {
+		return NavEntry{}, false
+	}
+
+	return n.entries[n.cursor], true
+}
+
+// Back moves to the previous location.
+func (n *Navigation) Back() (NavEntry, bool) {
+	if n.cursor <= 0 {
+		return NavEntry{}, false
+	}
+	n.cursor--
+
+	return n.entries[n.cursor], true
+}
+
+// Forward moves to the next location.
+func (n *Navigation) Forward() (NavEntry, bool) {
+	if n.cursor+1 >= len(n.entries) {
+		return NavEntry{}, false
+	}
+	n.cursor++
+
+	return n.entries[n.cursor], true
+}
+
+// Recent returns distinct locations, most recent first.
+func (n *Navigation) Recent() []NavEntry {
+	ee := make([]NavEntry, 0, len(n.entries))
+	for i := len(n.entries) - 1; i >= 0; i-- {
+		var seen bool
+		for _, e := range ee {
+			if e == n.entries[i] {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			ee = append(ee, n.entries[i])
+		}
+	}
+
+	return ee
+}
+
+// RecentResources returns the distinct resources recently visited in a given
+// namespace, most recent first.
+func (n *Navigation) RecentResources(ns string) []NavEntry {
+	ee := make([]NavEntry, 0, MaxRecentResources)
+	for _, e := range n.Recent() {
+		if len(ee) == MaxRecentResources {
+			break
+		}
+		if e.GVR == "" || e.Namespace != ns || hasGVR(ee, e.GVR) {
+			continue
+		}
+		ee = append(ee, e)
+	}
+
+	return ee
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hasGVR(ee []NavEntry, gvr string) bool {
+	for _, e := range ee {
+		if e.GVR == gvr {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNavigationBackForward(t *testing.T) {
+	n := model.NewNavigation(10)
+	_, ok := n.Back()
+	assert.False(t, ok)
+
+	n.Push(model.NavEntry{Command: "po", Namespace: "default"})
+	n.SetFilter("fred")
+	n.Push(model.NavEntry{Command: "svc", Namespace: "default"})
+	n.Push(model.NavEntry{Command: "dp", Namespace: "kube-system"})
+
+	e, ok := n.Back()
+	assert.True(t, ok)
+	assert.Equal(t, "svc", e.Command)
+	e, ok = n.Back()
+	assert.True(t, ok)
+	assert.Equal(t, model.NavEntry{Command: "po", Namespace: "default", Filter: "fred"}, e)
+	_, ok = n.Back()
+	assert.False(t, ok)
+
+	e, ok = n.Forward()
+	assert.True(t, ok)
+	assert.Equal(t, "svc", e.Command)
+
+	n.Push(model.NavEntry{Command: "cm", Namespace: "default"})
+	_, ok = n.Forward()
+	assert.False(t, ok)
+	e, _ = n.Current()
+	assert.Equal(t, "cm", e.Command)
+}
+
+func TestNavigationPush(t *testing.T) {
+	n := model.NewNavigation(2)
+	n.Push(model.NavEntry{})
+	_, ok := n.Current()
+	assert.False(t, ok)
+
+	n.Push(model.NavEntry{Command: "po"})
+	n.Push(model.NavEntry{Command: "po", Filter: "fred"})
+	n.Push(model.NavEntry{Command: "svc"})
+	n.Push(model.NavEntry{Command: "dp"})
+
+	e, ok := n.Back()
+	assert.True(t, ok)
+	assert.Equal(t, "svc", e.Command)
+	_, ok = n.Back()
+	assert.False(t, ok)
+}
+
+func TestNavigationRecent(t *testing.T) {
+	n := model.NewNavigation(10)
+	for _, c := range []string{"po", "svc", "po", "dp"} {
+		n.Push(model.NavEntry{Command: c})
+	}
+
+	ee := n.Recent()
+	assert.Equal(t, 3, len(ee))
+	assert.Equal(t, "dp", ee[0].Command)
+	assert.Equal(t, "po", ee[1].Command)
+	assert.Equal(t, "svc", ee[2].Command)
+}
+
+func TestNavEntryString(t *testing.T) {
+	e := model.NavEntry{Command: "po", FQN: "default/fred", Namespace: "default", Filter: "blee"}
+
+	assert.Equal(t, "po default/fred (default) /blee", e.String())
+}
+
+func TestNavigationRecentResources(t *testing.T) {
+	n := model.NewNavigation(model.MaxNavigation)
+	assert.Empty(t, n.RecentResources("default"))
+
+	n.Push(model.NavEntry{Command: "po app=web", Namespace: "default", GVR: "v1/pods"})
+	n.Push(model.NavEntry{Command: "svc", Namespace: "default", GVR: "v1/services"})
+	n.Push(model.NavEntry{Command: "dp", Namespace: "prod", GVR: "apps/v1/deployments"})
+	n.Push(model.NavEntry{Command: "pods", Namespace: "default", GVR: "v1/pods"})
+	n.Push(model.NavEntry{Command: "recent", Namespace: "default"})
+
+	assert.Equal(t, []model.NavEntry{
+		{Command: "pods", Namespace: "default", GVR: "v1/pods"},
+		{Command: "svc", Namespace: "default", GVR: "v1/services"},
+	}, n.RecentResources("default"))
+	assert.Equal(t, []model.NavEntry{
+		{Command: "dp", Namespace: "prod", GVR: "apps/v1/deployments"},
+	}, n.RecentResources("prod"))
+
+	for i := 0; i < model.MaxRecentResources+5; i++ {
+		n.Push(model.NavEntry{Command: fmt.Sprintf("r%d", i), Namespace: "blee", GVR: fmt.Sprintf("v1/r%d", i)})
+	}
+	ee := n.RecentResources("blee")
+	assert.Len(t, ee, model.MaxRecentResources)
+	assert.Equal(t, fmt.Sprintf("v1/r%d", model.MaxRecentResources+4), ee[0].GVR)
+}
@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Notification represents a resource notification.
+type Notification struct {
+	Title   string `json:"title"`
+	GVR     string `json:"gvr"`
+	Path    string `json:"path"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message"`
+}
+
+// String returns the notification as a string.
+func (n Notification) String() string {
+	return fmt.Sprintf("[%s] %s", n.Title, n.Message)
+}
+
+// NewRowWatchNotification returns a notification for a watched row event.
+func NewRowWatchNotification(evt RowWatchEvent) Notification {
+	return Notification{
+		Title:   "Watch",
+		GVR:     evt.GVR.String(),
+		Path:    evt.Path,
+		Status:  evt.To,
+		Message: evt.String(),
+	}
+}
+
+type notifyReg struct {
+	inf   cache.SharedIndexInformer
+	reg   cache.ResourceEventHandlerRegistration
+	lease *watch.Lease
+}
+
+// Notifier evaluates notification rules against informer events and
+// dispatches matches to the configured sinks.
+type Notifier struct {
+	factory dao.Factory
+	cfg     *config.Notifications
+	favsFn  func() []string
+	sinks   []NotifySink
+	regs    []notifyReg
+	hits    map[string]struct{}
+	mx      sync.RWMutex
+}
+
+// NewNotifier returns a new notifier.
+func NewNotifier(f dao.Factory, cfg *config.Notifications, favsFn func() []string) *Notifier {
+	n := Notifier{
+		factory: f,
+		cfg:     cfg,
+		favsFn:  favsFn,
+		hits:    make(map[string]struct{}),
+	}
+	for _, s := range cfg.Sinks {
+		sink, err := NewNotifySink(s)
+		if err != nil {
+			log.Warn().Err(err).Msg("Skipping notification sink")
+			continue
+		}
+		n.sinks = append(n.sinks, sink)
+	}
+
+	return &n
+}
+
+// AddSink registers a new notification sink.
+func (n *Notifier) AddSink(s NotifySink) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	n.sinks = append(n.sinks, s)
+}
+
+// Start registers the rules informer event handlers.
+func (n *Notifier) Start() {
+	for i, r := range n.cfg.Rules {
+		for _, ns := range n.ruleScopes(r) {
+			if err := n.register(i, r, ns); err != nil {
+				log.Warn().Err(err).Msgf("Notification rule %q disabled in namespace %q", r.Title(), ns)
+				continue
+			}
+		}
+	}
+}
+
+// Stop unregisters all informer event handlers.
+func (n *Notifier) Stop() {
+	n.mx.Lock()
+	rr := n.regs
+	n.regs, n.hits = nil, make(map[string]struct{})
+	n.mx.Unlock()
+
+	for _, r := range rr {
+		if err := r.inf.RemoveEventHandler(r.reg); err != nil {
+			log.Warn().Err(err).Msg("Unable to remove notification handler")
+		}
+		r.lease.Release()
+	}
+}
+
+// Notify dispatches a notification to all sinks.
+func (n *Notifier) Notify(no Notification) {
+	n.mx.RLock()
+	ss := make([]NotifySink, len(n.sinks))
+	copy(ss, n.sinks)
+	n.mx.RUnlock()
+
+	for _, s := range ss {
+		go func(s NotifySink) {
+			ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+			defer cancel()
+			if err := s.Notify(ctx, no); err != nil {
+				log.Warn().Err(err).Msgf("Notification delivery failed")
+			}
+		}(s)
+	}
+}
+
+func (n *Notifier) ruleScopes(r config.NotifyRule) []string {
+	if len(r.Namespaces) == 0 && !r.Favorites {
+		return []string{client.NamespaceAll}
+	}
+	if auth, err := n.factory.Client().CanI(client.NamespaceAll, r.GVR, "", client.MonitorAccess); err == nil && auth {
+		return []string{client.NamespaceAll}
+	}
+
+	nss := make([]string, 0, len(r.Namespaces))
+	nss = append(nss, r.Namespaces...)
+	if r.Favorites {
+		nss = append(nss, n.favsFn()...)
+	}
+
+	return nss
+}
+
+func (n *Notifier) register(i int, r config.NotifyRule, ns string) error {
+	gi, err := n.factory.CanForResource(ns, r.GVR, client.MonitorAccess)
+	if err != nil {
+		return err
+	}
+	if gi == nil {
+		return fmt.Errorf("no informer found for %s", r.GVR)
+	}
+	inf := gi.Informer()
+	reg, err := inf.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc:    func(o interface{}, initial bool) { n.evaluate(i, r, o, initial) },
+		UpdateFunc: func(_, o interface{}) { n.evaluate(i, r, o, false) },
+		DeleteFunc: func(o interface{}) { n.forget(i, o) },
+	})
+	if err != nil {
+		return err
+	}
+
+	n.mx.Lock()
+	n.regs = append(n.regs, notifyReg{inf: inf, reg: reg, lease: leaseInformer(n.factory, ns, r.GVR)})
+	n.mx.Unlock()
+
+	return nil
+}
+
+func (n *Notifier) evaluate(i int, r config.NotifyRule, o interface{}, initial bool) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok || !r.InNamespace(u.GetNamespace(), n.favsFn()) {
+		return
+	}
+
+	status, key := rowStatus(u), hitKey(i, u)
+	match := r.Matches(status)
+	n.mx.Lock()
+	_, hit := n.hits[key]
+	if match {
+		n.hits[key] = struct{}{}
+	} else {
+		delete(n.hits, key)
+	}
+	n.mx.Unlock()
+
+	// Only notify on transitions and skip the informer initial listing.
+	if !match || hit || initial {
+		return
+	}
+	path := watchPath(u)
+	n.Notify(Notification{
+		Title:   r.Title(),
+		GVR:     r.GVR,
+		Path:    path,
+		Status:  status,
+		Message: fmt.Sprintf("%s %s is %s", u.GetKind(), path, status),
+	})
+}
+
+func (n *Notifier) forget(i int, o interface{}) {
+	if d, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = d.Obj
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	delete(n.hits, hitKey(i, u))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hitKey(i int, u *unstructured.Unstructured) string {
+	return strconv.Itoa(i) + ":" + watchPath(u)
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNotifierEvaluate(t *testing.T) {
+	r := config.NotifyRule{Name: "Crash", GVR: "v1/pods", Status: "Failed", Favorites: true}
+	n := NewNotifier(nil, &config.Notifications{Rules: []config.NotifyRule{r}}, func() []string {
+		return []string{"default"}
+	})
+	s := make(chanSink, 10)
+	n.AddSink(s)
+
+	// Initial listing does not notify.
+	n.evaluate(0, r, makeWatchObj("Pod", map[string]interface{}{"phase": "Failed"}, nil), true)
+	assert.Nil(t, s.next())
+
+	// Still failing.
+	n.evaluate(0, r, makeWatchObj("Pod", map[string]interface{}{"phase": "Failed"}, nil), false)
+	assert.Nil(t, s.next())
+
+	// Recovered then failing again.
+	n.evaluate(0, r, makeWatchObj("Pod", map[string]interface{}{"phase": "Running"}, nil), false)
+	n.evaluate(0, r, makeWatchObj("Pod", map[string]interface{}{"phase": "Failed"}, nil), false)
+	no := s.next()
+	assert.NotNil(t, no)
+	assert.Equal(t, "[Crash] Pod default/fred is Failed", no.String())
+
+	// Deleted then recreated failing.
+	n.forget(0, makeWatchObj("Pod", nil, nil))
+	n.evaluate(0, r, makeWatchObj("Pod", map[string]interface{}{"phase": "Failed"}, nil), false)
+	assert.NotNil(t, s.next())
+
+	// Non favorite namespace.
+	o := makeWatchObj("Pod", map[string]interface{}{"phase": "Failed"}, nil)
+	o.SetNamespace("blee")
+	n.evaluate(0, r, o, false)
+	assert.Nil(t, s.next())
+
+	// Not an unstructured.
+	n.evaluate(0, r, &unstructured.UnstructuredList{}, false)
+	assert.Nil(t, s.next())
+}
+
+// Helpers...
+
+type chanSink chan Notification
+
+func (c chanSink) Notify(_ context.Context, n Notification) error {
+	c <- n
+	return nil
+}
+
+func (c chanSink) next() *Notification {
+	select {
+	case n := <-c:
+		return &n
+	case <-time.After(50 * time.Millisecond):
+		return nil
+	}
+}
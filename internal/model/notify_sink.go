@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const webhookTimeout = 5 * time.Second
+
+// NotifySink represents a notification destination.
+type NotifySink interface {
+	// Notify delivers a notification.
+	Notify(ctx context.Context, n Notification) error
+}
+
+// NewNotifySink returns a sink for a given configuration.
+func NewNotifySink(cfg config.NotifySink) (NotifySink, error) {
+	switch cfg.Type {
+	case config.SinkBell:
+		return NewBellSink(os.Stdout), nil
+	case config.SinkOSC:
+		return NewOSCSink(os.Stdout), nil
+	case config.SinkWebhook, config.SinkSlack:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("%s notification sink requires an url", cfg.Type)
+		}
+		return NewWebhookSink(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown notification sink type %q", cfg.Type)
+	}
+}
+
+// BellSink rings the terminal bell.
+type BellSink struct {
+	w io.Writer
+}
+
+// NewBellSink returns a new terminal bell sink.
+func NewBellSink(w io.Writer) *BellSink {
+	return &BellSink{w: w}
+}
+
+// Notify delivers a notification.
+func (b *BellSink) Notify(context.Context, Notification) error {
+	_, err := io.WriteString(b.w, "\a")
+
+	return err
+}
+
+// OSCSink issues desktop notifications via the OSC 9 terminal escape sequence.
+type OSCSink struct {
+	w io.Writer
+}
+
+// NewOSCSink returns a new desktop notification sink.
+func NewOSCSink(w io.Writer) *OSCSink {
+	return &OSCSink{w: w}
+}
+
+// Notify delivers a notification.
+func (o *OSCSink) Notify(_ context.Context, n Notification) error {
+	_, err := fmt.Fprintf(o.w, "\x1b]9;%s\a", oscEscape(n.String()))
+
+	return err
+}
+
+// WebhookSink posts notifications to an http endpoint.
+type WebhookSink struct {
+	cfg    config.NotifySink
+	client *http.Client
+}
+
+// NewWebhookSink returns a new webhook sink.
+func NewWebhookSink(cfg config.NotifySink) *WebhookSink {
+	return &WebhookSink{
+		cfg:    cfg,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Notify delivers a notification.
+func (w *WebhookSink) Notify(ctx context.Context, n Notification) error {
+	var payload interface{} = n
+	if w.cfg.Type == config.SinkSlack {
+		payload = map[string]string{"text": n.String()}
+	}
+	bb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL, bytes.NewReader(bb))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s notification failed with status %s", w.cfg.Type, resp.Status)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// oscEscape strips control chars that would terminate the escape sequence.
+func oscEscape(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNotifySink(t *testing.T) {
+	uu := map[string]struct {
+		cfg config.NotifySink
+		err string
+	}{
+		"bell": {
+			cfg: config.NotifySink{Type: config.SinkBell},
+		},
+		"osc": {
+			cfg: config.NotifySink{Type: config.SinkOSC},
+		},
+		"slack": {
+			cfg: config.NotifySink{Type: config.SinkSlack, URL: "http://fred"},
+		},
+		"no-url": {
+			cfg: config.NotifySink{Type: config.SinkWebhook},
+			err: "webhook notification sink requires an url",
+		},
+		"toast": {
+			cfg: config.NotifySink{Type: "pigeon"},
+			err: `unknown notification sink type "pigeon"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := model.NewNotifySink(u.cfg)
+			if u.err != "" {
+				assert.Equal(t, u.err, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, s)
+		})
+	}
+}
+
+func TestTermSinks(t *testing.T) {
+	n := model.Notification{Title: "Crash", Message: "Pod default/fred\nis CrashLoopBackOff"}
+
+	var bell bytes.Buffer
+	assert.NoError(t, model.NewBellSink(&bell).Notify(context.Background(), n))
+	assert.Equal(t, "\a", bell.String())
+
+	var osc bytes.Buffer
+	assert.NoError(t, model.NewOSCSink(&osc).Notify(context.Background(), n))
+	assert.Equal(t, "\x1b]9;[Crash] Pod default/fredis CrashLoopBackOff\a", osc.String())
+}
+
+func TestWebhookSink(t *testing.T) {
+	uu := map[string]struct {
+		kind   string
+		status int
+		e      string
+		err    string
+	}{
+		"webhook": {
+			kind:   config.SinkWebhook,
+			status: http.StatusOK,
+			e:      `{"title":"Crash","gvr":"v1/pods","path":"default/fred","status":"CrashLoopBackOff","message":"boom"}`,
+		},
+		"slack": {
+			kind:   config.SinkSlack,
+			status: http.StatusOK,
+			e:      `{"text":"[Crash] boom"}`,
+		},
+		"toast": {
+			kind:   config.SinkWebhook,
+			status: http.StatusForbidden,
+			e:      `{"title":"Crash","gvr":"v1/pods","path":"default/fred","status":"CrashLoopBackOff","message":"boom"}`,
+			err:    "webhook notification failed with status 403 Forbidden",
+		},
+	}
+
+	n := model.Notification{
+		Title:   "Crash",
+		GVR:     "v1/pods",
+		Path:    "default/fred",
+		Status:  "CrashLoopBackOff",
+		Message: "boom",
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var body, auth string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bb, _ := io.ReadAll(r.Body)
+				body, auth = string(bb), r.Header.Get("Authorization")
+				w.WriteHeader(u.status)
+			}))
+			defer srv.Close()
+
+			s := model.NewWebhookSink(config.NotifySink{
+				Type:    u.kind,
+				URL:     srv.URL,
+				Headers: map[string]string{"Authorization": "Bearer blee"},
+			})
+			err := s.Notify(context.Background(), n)
+			if u.err != "" {
+				assert.Equal(t, u.err, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.True(t, json.Valid([]byte(body)))
+			assert.Equal(t, u.e, body)
+			assert.Equal(t, "Bearer blee", auth)
+		})
+	}
+}
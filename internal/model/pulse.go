@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/health"
 	"github.com/rs/zerolog/log"
@@ -35,6 +36,7 @@ type Pulse struct {
 	listeners   []PulseListener
 	refreshRate time.Duration
 	health      *PulseHealth
+	panels      []config.PulsePanel
 	data        health.Checks
 }
 
@@ -95,7 +97,7 @@ func (p *Pulse) list(ctx context.Context) ([]runtime.Object, error) {
 		return nil, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
 	}
 	if p.health == nil {
-		p.health = NewPulseHealth(f)
+		p.health = NewPulseHealth(f, p.panels)
 	}
 	ctx = context.WithValue(ctx, internal.KeyFields, "")
 	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
@@ -120,6 +122,12 @@ func (p *Pulse) reconcile(ctx context.Context) error {
 	return nil
 }
 
+// SetPanels sets up the pulses panels to track.
+func (p *Pulse) SetPanels(pp []config.PulsePanel) {
+	p.panels = pp
+	p.health = nil
+}
+
 // GetNamespace returns the model namespace.
 func (p *Pulse) GetNamespace() string {
 	return p.namespace
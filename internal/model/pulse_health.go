@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/health"
 	"github.com/derailed/k9s/internal/model1"
@@ -20,31 +22,30 @@ import (
 // PulseHealth tracks resources health.
 type PulseHealth struct {
 	factory dao.Factory
+	panels  []config.PulsePanel
 }
 
 // NewPulseHealth returns a new instance.
-func NewPulseHealth(f dao.Factory) *PulseHealth {
+func NewPulseHealth(f dao.Factory, pp []config.PulsePanel) *PulseHealth {
+	if len(pp) == 0 {
+		pp = config.NewPulses().Panels
+	}
+
 	return &PulseHealth{
 		factory: f,
+		panels:  pp,
 	}
 }
 
-// List returns a canned collection of resources health.
+// List returns the configured panels resources health.
 func (h *PulseHealth) List(ctx context.Context, ns string) ([]runtime.Object, error) {
-	gvrs := []string{
-		"v1/pods",
-		"v1/events",
-		"apps/v1/replicasets",
-		"apps/v1/deployments",
-		"apps/v1/statefulsets",
-		"apps/v1/daemonsets",
-		"batch/v1/jobs",
-		"v1/persistentvolumes",
-	}
-
-	hh := make([]runtime.Object, 0, 10)
-	for _, gvr := range gvrs {
-		c, err := h.check(ctx, ns, gvr)
+	hh := make([]runtime.Object, 0, len(h.panels)+2)
+	for _, p := range h.panels {
+		pns := ns
+		if p.Namespace != "" {
+			pns = p.Namespace
+		}
+		c, err := h.check(context.WithValue(ctx, internal.KeyLabels, p.Selector), pns, p)
 		if err != nil {
 			return nil, err
 		}
@@ -100,7 +101,8 @@ func (h *PulseHealth) checkMetrics(ctx context.Context) (health.Checks, error) {
 	return health.Checks{c1, c2}, nil
 }
 
-func (h *PulseHealth) check(ctx context.Context, ns, gvr string) (*health.Check, error) {
+func (h *PulseHealth) check(ctx context.Context, ns string, p config.PulsePanel) (*health.Check, error) {
+	gvr := p.GVR
 	meta, ok := Registry[gvr]
 	if !ok {
 		meta = ResourceMeta{
@@ -117,9 +119,12 @@ func (h *PulseHealth) check(ctx context.Context, ns, gvr string) (*health.Check,
 	if err != nil {
 		return nil, err
 	}
-	c := health.NewCheck(gvr)
+	c := health.NewCheck(p.ID())
 
 	if meta.Renderer.IsGeneric() {
+		if len(oo) == 0 {
+			return c, nil
+		}
 		table, ok := oo[0].(*metav1.Table)
 		if !ok {
 			return nil, fmt.Errorf("expecting a meta table but got %T", oo[0])
@@ -131,7 +136,7 @@ func (h *PulseHealth) check(ctx context.Context, ns, gvr string) (*health.Check,
 			if err := re.Render(row, ns, &rows[i]); err != nil {
 				return nil, err
 			}
-			if !model1.IsValid(ns, re.Header(ns), rows[i]) {
+			if !isHealthy(ns, p, re.Header(ns), rows[i]) {
 				c.Inc(health.S2)
 				continue
 			}
@@ -146,7 +151,7 @@ func (h *PulseHealth) check(ctx context.Context, ns, gvr string) (*health.Check,
 		if err := re.Render(o, ns, &rr[i]); err != nil {
 			return nil, err
 		}
-		if !model1.IsValid(ns, re.Header(ns), rr[i]) {
+		if !isHealthy(ns, p, re.Header(ns), rr[i]) {
 			c.Inc(health.S2)
 			continue
 		}
@@ -155,3 +160,20 @@ func (h *PulseHealth) check(ctx context.Context, ns, gvr string) (*health.Check,
 
 	return c, nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// isHealthy checks a row health using either the panel health column or
+// the resource validity.
+func isHealthy(ns string, p config.PulsePanel, h model1.Header, r model1.Row) bool {
+	if p.HealthColumn == "" {
+		return model1.IsValid(ns, h, r)
+	}
+	idx, ok := h.IndexOf(p.HealthColumn, true)
+	if !ok || idx >= len(r.Fields) {
+		return model1.IsValid(ns, h, r)
+	}
+
+	return p.IsHealthy(r.Fields[idx])
+}
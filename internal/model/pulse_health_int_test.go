@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPulseIsHealthy(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "ROBUSTNESS"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+	uu := map[string]struct {
+		panel config.PulsePanel
+		row   model1.Row
+		e     bool
+	}{
+		"valid": {
+			row: model1.Row{Fields: model1.Fields{"v1", "degraded", ""}},
+			e:   true,
+		},
+		"invalid": {
+			row: model1.Row{Fields: model1.Fields{"v1", "healthy", "boom"}},
+		},
+		"column-healthy": {
+			panel: config.PulsePanel{HealthColumn: "ROBUSTNESS", HealthyValues: []string{"healthy"}},
+			row:   model1.Row{Fields: model1.Fields{"v1", "healthy", "boom"}},
+			e:     true,
+		},
+		"column-unhealthy": {
+			panel: config.PulsePanel{HealthColumn: "ROBUSTNESS", HealthyValues: []string{"healthy"}},
+			row:   model1.Row{Fields: model1.Fields{"v1", "degraded", ""}},
+		},
+		"column-missing": {
+			panel: config.PulsePanel{HealthColumn: "BOZO", HealthyValues: []string{"healthy"}},
+			row:   model1.Row{Fields: model1.Fields{"v1", "degraded", ""}},
+			e:     true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isHealthy("", u.panel, h, u.row))
+		})
+	}
+}
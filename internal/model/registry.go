@@ -22,6 +22,78 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Reference{},
 		Renderer: &render.Reference{},
 	},
+	"netsim": {
+		DAO:      &dao.NetSim{},
+		Renderer: &render.NetSim{},
+	},
+	"svchealth": {
+		DAO:      &dao.ServiceHealth{},
+		Renderer: &render.ServiceHealth{},
+	},
+	"poddiag": {
+		DAO:      &dao.PodDiag{},
+		Renderer: &render.PodDiag{},
+	},
+	"images": {
+		DAO:      &dao.Image{},
+		Renderer: &render.Image{},
+	},
+	"deprecations": {
+		DAO:      &dao.Deprecation{},
+		Renderer: &render.Deprecation{},
+	},
+	"find": {
+		DAO:      &dao.Find{},
+		Renderer: &render.Find{},
+	},
+	"queries": {
+		DAO:      &dao.Query{},
+		Renderer: &render.Query{},
+	},
+	"manifests": {
+		DAO:      &dao.Manifest{},
+		Renderer: &render.Manifest{},
+	},
+	"ingroutes": {
+		DAO:      &dao.IngressRoute{},
+		Renderer: &render.IngressRoute{},
+	},
+	"accessreviews": {
+		DAO:      &dao.AccessReview{},
+		Renderer: &render.AccessReview{},
+	},
+	"whoami": {
+		DAO:      &dao.WhoAmI{},
+		Renderer: &render.WhoAmI{},
+	},
+	"fleet": {
+		DAO:      &dao.Fleet{},
+		Renderer: &render.Fleet{},
+	},
+	"proxies": {
+		DAO:      &dao.Proxy{},
+		Renderer: &render.Proxy{},
+	},
+	"certs": {
+		DAO:      &dao.Cert{},
+		Renderer: &render.Cert{},
+	},
+	"scores": {
+		DAO:      &dao.PopeyeScore{},
+		Renderer: &render.PopeyeScore{},
+	},
+	"top-pods": {
+		DAO:      &dao.TopPod{},
+		Renderer: &render.TopPod{},
+	},
+	"top-namespaces": {
+		DAO:      &dao.TopNamespace{},
+		Renderer: &render.TopNamespace{},
+	},
+	"top-nodes": {
+		DAO:      &dao.TopNode{},
+		Renderer: &render.TopNode{},
+	},
 	"dir": {
 		DAO:      &dao.Dir{},
 		Renderer: &render.Dir{},
@@ -46,6 +118,18 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.ImageScan{},
 		Renderer: &render.ImageScan{},
 	},
+	"pluginrows": {
+		DAO:      &dao.PluginRows{},
+		Renderer: &render.PluginRows{},
+	},
+	"keys": {
+		DAO:      &dao.KeyBinding{},
+		Renderer: &render.KeyBinding{},
+	},
+	"pluginjobs": {
+		DAO:      &dao.PluginJob{},
+		Renderer: &render.PluginJob{},
+	},
 	"contexts": {
 		DAO:      &dao.Context{},
 		Renderer: &render.Context{},
@@ -78,6 +162,14 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Benchmark{},
 		Renderer: &render.Benchmark{},
 	},
+	"crashes": {
+		DAO:      &dao.Crash{},
+		Renderer: &render.Crash{},
+	},
+	"k9slogs": {
+		DAO:      &dao.K9sLog{},
+		Renderer: &render.K9sLog{},
+	},
 	"aliases": {
 		DAO:      &dao.Alias{},
 		Renderer: &render.Alias{},
@@ -133,6 +225,7 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.PersistentVolume{},
 	},
 	"v1/persistentvolumeclaims": {
+		DAO:      &dao.PersistentVolumeClaim{},
 		Renderer: &render.PersistentVolumeClaim{},
 	},
 
@@ -178,12 +271,20 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.CustomResourceDefinition{},
 	},
 
+	// Cert-Manager...
+	dao.CertificateGVR: {
+		Renderer: &render.Certificate{},
+	},
+
 	// Storage...
 	"storage.k8s.io/v1/storageclasses": {
 		Renderer: &render.StorageClass{},
 	},
 
 	// Policy...
+	"policy/v1/poddisruptionbudgets": {
+		Renderer: &render.PodDisruptionBudget{},
+	},
 	"policy/v1beta1/poddisruptionbudgets": {
 		Renderer: &render.PodDisruptionBudget{},
 	},
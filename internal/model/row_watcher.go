@@ -0,0 +1,309 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	statusReady       = "Ready"
+	statusNotReady    = "NotReady"
+	statusRunning     = "Running"
+	statusUnscheduled = "SchedulingDisabled"
+)
+
+// RowWatchEvent represents a watched row status change.
+type RowWatchEvent struct {
+	GVR      client.GVR
+	Path     string
+	From, To string
+	Deleted  bool
+}
+
+// String returns the event as a string.
+func (e RowWatchEvent) String() string {
+	if e.Deleted {
+		return fmt.Sprintf("%s %s was deleted", e.GVR.R(), e.Path)
+	}
+
+	return fmt.Sprintf("%s %s status changed %s -> %s", e.GVR.R(), e.Path, e.From, e.To)
+}
+
+// RowWatchListener represents a watched rows listener.
+type RowWatchListener interface {
+	// RowWatchChanged notifies a watched row changed.
+	RowWatchChanged(RowWatchEvent)
+}
+
+type rowWatch struct {
+	gvr    client.GVR
+	path   string
+	status string
+	inf    cache.SharedIndexInformer
+	reg    cache.ResourceEventHandlerRegistration
+	lease  *watch.Lease
+}
+
+// RowWatcher tracks status changes of specific resources via informers.
+type RowWatcher struct {
+	factory   dao.Factory
+	watches   map[string]*rowWatch
+	listeners []RowWatchListener
+	mx        sync.RWMutex
+}
+
+// NewRowWatcher returns a new row watcher.
+func NewRowWatcher(f dao.Factory) *RowWatcher {
+	return &RowWatcher{
+		factory: f,
+		watches: make(map[string]*rowWatch),
+	}
+}
+
+// AddListener registers a new listener.
+func (w *RowWatcher) AddListener(l RowWatchListener) {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	w.listeners = append(w.listeners, l)
+}
+
+// RemoveListener unregisters a listener.
+func (w *RowWatcher) RemoveListener(l RowWatchListener) {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	victim := -1
+	for i, lis := range w.listeners {
+		if lis == l {
+			victim = i
+			break
+		}
+	}
+	if victim >= 0 {
+		w.listeners = append(w.listeners[:victim], w.listeners[victim+1:]...)
+	}
+}
+
+// IsWatched checks if a given resource is being watched.
+func (w *RowWatcher) IsWatched(gvr client.GVR, path string) bool {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+
+	_, ok := w.watches[watchKey(gvr, path)]
+
+	return ok
+}
+
+// Watch starts watching a given resource for status changes.
+func (w *RowWatcher) Watch(gvr client.GVR, path string) error {
+	if w.IsWatched(gvr, path) {
+		return nil
+	}
+	o, err := w.factory.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	ns, _ := client.Namespaced(path)
+	gi, err := w.factory.ForResource(ns, gvr.String())
+	if err != nil {
+		return err
+	}
+	if gi == nil {
+		return fmt.Errorf("no informer found for %s", gvr)
+	}
+
+	key := watchKey(gvr, path)
+	rw := rowWatch{
+		gvr:    gvr,
+		path:   path,
+		status: rowStatus(u),
+		inf:    gi.Informer(),
+	}
+	rw.reg, err = rw.inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, o interface{}) { w.updated(key, path, o) },
+		DeleteFunc: func(o interface{}) { w.deleted(key, path, o) },
+	})
+	if err != nil {
+		return err
+	}
+	rw.lease = leaseInformer(w.factory, ns, gvr.String())
+
+	w.mx.Lock()
+	w.watches[key] = &rw
+	w.mx.Unlock()
+
+	return nil
+}
+
+// Unwatch stops watching a given resource.
+func (w *RowWatcher) Unwatch(gvr client.GVR, path string) {
+	w.mx.Lock()
+	rw, ok := w.watches[watchKey(gvr, path)]
+	delete(w.watches, watchKey(gvr, path))
+	w.mx.Unlock()
+
+	if ok {
+		rw.stop()
+	}
+}
+
+// Clear stops all watches.
+func (w *RowWatcher) Clear() {
+	w.mx.Lock()
+	ww := w.watches
+	w.watches = make(map[string]*rowWatch)
+	w.mx.Unlock()
+
+	for _, rw := range ww {
+		rw.stop()
+	}
+}
+
+func (w *RowWatcher) updated(key, path string, o interface{}) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok || watchPath(u) != path {
+		return
+	}
+
+	w.mx.Lock()
+	rw, ok := w.watches[key]
+	if !ok {
+		w.mx.Unlock()
+		return
+	}
+	from, to := rw.status, rowStatus(u)
+	rw.status = to
+	w.mx.Unlock()
+
+	if from == to {
+		return
+	}
+	w.fireChanged(RowWatchEvent{GVR: rw.gvr, Path: rw.path, From: from, To: to})
+}
+
+func (w *RowWatcher) deleted(key, path string, o interface{}) {
+	if d, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = d.Obj
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok || watchPath(u) != path {
+		return
+	}
+
+	w.mx.Lock()
+	rw, ok := w.watches[key]
+	delete(w.watches, key)
+	w.mx.Unlock()
+	if !ok {
+		return
+	}
+	// Handlers can't be removed from within their own callback.
+	go rw.stop()
+
+	w.fireChanged(RowWatchEvent{GVR: rw.gvr, Path: rw.path, From: rw.status, Deleted: true})
+}
+
+func (w *RowWatcher) fireChanged(evt RowWatchEvent) {
+	w.mx.RLock()
+	ll := make([]RowWatchListener, len(w.listeners))
+	copy(ll, w.listeners)
+	w.mx.RUnlock()
+
+	for _, l := range ll {
+		l.RowWatchChanged(evt)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (rw *rowWatch) stop() {
+	rw.lease.Release()
+	if rw.inf == nil || rw.reg == nil {
+		return
+	}
+	if err := rw.inf.RemoveEventHandler(rw.reg); err != nil {
+		log.Warn().Err(err).Msgf("Unable to remove watch handler for %s", rw.path)
+	}
+}
+
+func watchKey(gvr client.GVR, path string) string {
+	return gvr.String() + ":" + path
+}
+
+func watchPath(u *unstructured.Unstructured) string {
+	return client.FQN(u.GetNamespace(), u.GetName())
+}
+
+func rowStatus(u *unstructured.Unstructured) string {
+	switch u.GetKind() {
+	case "Pod":
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return ""
+		}
+		return render.PodStatus(&po)
+	case "Node":
+		s := statusNotReady
+		if conditionStatus(u, "Ready") == string(v1.ConditionTrue) {
+			s = statusReady
+		}
+		if unschedulable, _, _ := unstructured.NestedBool(u.Object, "spec", "unschedulable"); unschedulable {
+			s += "," + statusUnscheduled
+		}
+		return s
+	case "Job":
+		for _, c := range []string{"Complete", "Failed", "Suspended"} {
+			if conditionStatus(u, c) == string(v1.ConditionTrue) {
+				return c
+			}
+		}
+		return statusRunning
+	}
+
+	if phase, ok, _ := unstructured.NestedString(u.Object, "status", "phase"); ok {
+		return phase
+	}
+	switch conditionStatus(u, "Ready") {
+	case string(v1.ConditionTrue):
+		return statusReady
+	case "":
+		return ""
+	default:
+		return statusNotReady
+	}
+}
+
+func conditionStatus(u *unstructured.Unstructured, kind string) string {
+	cc, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok || m["type"] != kind {
+			continue
+		}
+		if s, ok := m["status"].(string); ok {
+			return s
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestRowStatus(t *testing.T) {
+	uu := map[string]struct {
+		o *unstructured.Unstructured
+		e string
+	}{
+		"pod": {
+			o: makeWatchObj("Pod", map[string]interface{}{"phase": "Pending"}, nil),
+			e: "Pending",
+		},
+		"node-ready": {
+			o: makeWatchObj("Node", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True"},
+				},
+			}, nil),
+			e: "Ready",
+		},
+		"node-cordoned": {
+			o: makeWatchObj("Node", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "Unknown"},
+				},
+			}, map[string]interface{}{"unschedulable": true}),
+			e: "NotReady,SchedulingDisabled",
+		},
+		"job-running": {
+			o: makeWatchObj("Job", map[string]interface{}{"active": int64(1)}, nil),
+			e: "Running",
+		},
+		"job-failed": {
+			o: makeWatchObj("Job", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Complete", "status": "False"},
+					map[string]interface{}{"type": "Failed", "status": "True"},
+				},
+			}, nil),
+			e: "Failed",
+		},
+		"phase": {
+			o: makeWatchObj("PersistentVolumeClaim", map[string]interface{}{"phase": "Bound"}, nil),
+			e: "Bound",
+		},
+		"ready": {
+			o: makeWatchObj("Fred", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False"},
+				},
+			}, nil),
+			e: "NotReady",
+		},
+		"none": {
+			o: makeWatchObj("ConfigMap", nil, nil),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowStatus(u.o))
+		})
+	}
+}
+
+func TestRowWatcherEvents(t *testing.T) {
+	gvr, path := client.NewGVR("v1/pods"), "default/fred"
+	w := NewRowWatcher(nil)
+	l := rowWatchListener{}
+	w.AddListener(&l)
+	key := watchKey(gvr, path)
+	w.watches[key] = &rowWatch{gvr: gvr, path: path, status: "Pending"}
+
+	w.updated(key, path, makeWatchObj("Pod", map[string]interface{}{"phase": "Pending"}, nil))
+	assert.Empty(t, l.events)
+
+	o := makeWatchObj("Pod", map[string]interface{}{"phase": "Running"}, nil)
+	o.SetName("blee")
+	w.updated(key, path, o)
+	assert.Empty(t, l.events)
+
+	w.updated(key, path, makeWatchObj("Pod", map[string]interface{}{"phase": "Failed"}, nil))
+	assert.Equal(t, []string{"pods default/fred status changed Pending -> Failed"}, l.events)
+
+	w.deleted(key, path, cache.DeletedFinalStateUnknown{
+		Key: path,
+		Obj: makeWatchObj("Pod", nil, nil),
+	})
+	assert.Equal(t, "pods default/fred was deleted", l.events[1])
+	assert.False(t, w.IsWatched(gvr, path))
+
+	w.RemoveListener(&l)
+	w.watches[key] = &rowWatch{gvr: gvr, path: path, status: "Pending"}
+	w.updated(key, path, makeWatchObj("Pod", map[string]interface{}{"phase": "Failed"}, nil))
+	assert.Equal(t, 2, len(l.events))
+}
+
+// Helpers...
+
+type rowWatchListener struct {
+	events []string
+}
+
+func (l *rowWatchListener) RowWatchChanged(evt RowWatchEvent) {
+	l.events = append(l.events, evt.String())
+}
+
+func makeWatchObj(kind string, status, spec map[string]interface{}) *unstructured.Unstructured {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":      "fred",
+			"namespace": "default",
+		},
+	}}
+	if status != nil {
+		o.Object["status"] = status
+	}
+	if spec != nil {
+		o.Object["spec"] = spec
+	}
+
+	return &o
+}
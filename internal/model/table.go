@@ -15,12 +15,19 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/tracing"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-const initRefreshRate = 300 * time.Millisecond
+const (
+	initRefreshRate = 300 * time.Millisecond
+
+	// fullSyncInterval tracks how often all rows are rendered again when
+	// only tracking resource changes.
+	fullSyncInterval = 10 * time.Second
+)
 
 // TableListener represents a table model listener.
 type TableListener interface {
@@ -40,6 +47,7 @@ type Table struct {
 	refreshRate time.Duration
 	instance    string
 	labelFilter string
+	changes     *changeTracker
 	mx          sync.RWMutex
 }
 
@@ -49,6 +57,7 @@ func NewTable(gvr client.GVR) *Table {
 		gvr:         gvr,
 		data:        model1.NewTableData(gvr),
 		refreshRate: 2 * time.Second,
+		changes:     newChangeTracker(),
 	}
 }
 
@@ -182,6 +191,8 @@ func (t *Table) Peek() *model1.TableData {
 }
 
 func (t *Table) updater(ctx context.Context) {
+	defer t.changes.unwatch()
+
 	bf := backoff.NewExponentialBackOff()
 	bf.InitialInterval, bf.MaxElapsedTime = initRefreshRate, maxReaderRetryInterval
 	rate := initRefreshRate
@@ -192,7 +203,7 @@ func (t *Table) updater(ctx context.Context) {
 		case <-time.After(rate):
 			rate = t.refreshRate
 			err := backoff.Retry(func() error {
-				return t.refresh(ctx)
+				return t.refreshChanges(ctx)
 			}, backoff.WithContext(bf, ctx))
 			if err != nil {
 				log.Warn().Err(err).Msgf("reconciler exited")
@@ -214,6 +225,7 @@ func (t *Table) refresh(ctx context.Context) error {
 	}
 	defer atomic.StoreInt32(&t.inUpdate, 0)
 
+	t.changes.reset()
 	if err := t.reconcile(ctx); err != nil {
 		return err
 	}
@@ -222,6 +234,64 @@ func (t *Table) refresh(ctx context.Context) error {
 	return nil
 }
 
+// refreshChanges only renders the resources that changed since the last
+// refresh. It falls back to a full refresh when changes aren't tracked,
+// resources are paged or rows are due for a full sync.
+func (t *Table) refreshChanges(ctx context.Context) error {
+	if t.instance != "" || isPaged(ctx) || !t.watchChanges(ctx) || t.changes.syncDue() {
+		return t.refresh(ctx)
+	}
+
+	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
+		log.Debug().Msgf("Dropping update...")
+		return nil
+	}
+	defer atomic.StoreInt32(&t.inUpdate, 0)
+
+	dirty := t.changes.take()
+	if len(dirty) == 0 {
+		return nil
+	}
+	meta := resourceMeta(t.gvr)
+	ctx = context.WithValue(ctx, internal.KeyLabels, t.labelFilter)
+	oo, err := t.list(ctx, meta.DAO)
+	if err == nil {
+		err = t.data.Patch(ctx, meta.Renderer, oo, dirty)
+	}
+	if err != nil {
+		t.changes.expire()
+		return err
+	}
+	t.fireTableChanged(t.Peek())
+
+	return nil
+}
+
+// watchChanges tracks the model resource changes in the current namespace.
+// It returns false if the resource changes can't be tracked.
+func (t *Table) watchChanges(ctx context.Context) bool {
+	ns := client.CleanseNamespace(t.data.GetNamespace())
+	if client.IsClusterScoped(ns) {
+		ns = client.BlankNamespace
+	}
+	if t.changes.watching(ns) {
+		return true
+	}
+
+	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return false
+	}
+	a := resourceMeta(t.gvr).DAO
+	w, ok := a.(dao.ChangeWatcher)
+	if !ok {
+		return false
+	}
+	a.Init(factory, t.gvr)
+
+	return t.changes.watch(ns, w)
+}
+
 func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, error) {
 	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
 	if !ok {
@@ -238,7 +308,15 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 		ns = client.BlankNamespace
 	}
 
-	return a.List(ctx, ns)
+	ctx, span := tracing.Start(ctx, "dao.list",
+		tracing.GVRKey.String(t.gvr.String()),
+		tracing.NamespaceKey.String(ns),
+	)
+	oo, err := a.List(ctx, ns)
+	span.SetAttributes(tracing.CountKey.Int(len(oo)))
+	tracing.End(span, err)
+
+	return oo, err
 }
 
 func (t *Table) reconcile(ctx context.Context) error {
@@ -282,3 +360,10 @@ func (t *Table) fireTableLoadFailed(err error) {
 		l.TableLoadFailed(err)
 	}
 }
+
+// isPaged checks if resources are listed a page at a time.
+func isPaged(ctx context.Context) bool {
+	p, ok := ctx.Value(internal.KeyPager).(*dao.Pager)
+
+	return ok && p != nil
+}
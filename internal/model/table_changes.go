@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/tools/cache"
+)
+
+// changeTracker tracks resources changed since the last table refresh.
+type changeTracker struct {
+	dirty    map[string]struct{}
+	ns       string
+	remove   func()
+	syncedAt time.Time
+	mx       sync.Mutex
+}
+
+func newChangeTracker() *changeTracker {
+	return &changeTracker{dirty: make(map[string]struct{})}
+}
+
+// watching checks if changes are tracked in the given namespace.
+func (c *changeTracker) watching(ns string) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.remove != nil && c.ns == ns
+}
+
+// watch tracks resource changes in the given namespace.
+func (c *changeTracker) watch(ns string, w dao.ChangeWatcher) bool {
+	c.unwatch()
+	remove, err := w.AddChangeHandler(ns, cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.changed,
+		UpdateFunc: func(_, o interface{}) { c.changed(o) },
+		DeleteFunc: c.changed,
+	})
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to track changes in %q", ns)
+		return false
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.ns, c.remove, c.syncedAt = ns, remove, time.Time{}
+
+	return true
+}
+
+// unwatch stops tracking resource changes.
+func (c *changeTracker) unwatch() {
+	c.mx.Lock()
+	remove := c.remove
+	c.ns, c.remove = "", nil
+	c.mx.Unlock()
+
+	if remove != nil {
+		remove()
+	}
+}
+
+func (c *changeTracker) changed(o interface{}) {
+	if tomb, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = tomb.Obj
+	}
+	fqn, ok := model1.ObjectFQN(o)
+	if !ok {
+		return
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.dirty[fqn] = struct{}{}
+}
+
+// take returns the changed resources and clears them out.
+func (c *changeTracker) take() map[string]struct{} {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	dd := c.dirty
+	c.dirty = make(map[string]struct{}, len(dd))
+
+	return dd
+}
+
+// reset clears out all changes on full sync.
+func (c *changeTracker) reset() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.dirty, c.syncedAt = make(map[string]struct{}), time.Now()
+}
+
+// expire forces a full sync on the next refresh.
+func (c *changeTracker) expire() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.syncedAt = time.Time{}
+}
+
+// syncDue checks if all rows must be rendered again.
+func (c *changeTracker) syncDue() bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return time.Since(c.syncedAt) >= fullSyncInterval
+}
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 )
 
 func TestTableReconcile(t *testing.T) {
@@ -98,9 +99,44 @@ func TestTableMeta(t *testing.T) {
 	}
 }
 
+func TestChangeTracker(t *testing.T) {
+	c := newChangeTracker()
+	assert.True(t, c.syncDue())
+
+	var w watcher
+	assert.True(t, c.watch("ns1", &w))
+	assert.True(t, c.watching("ns1"))
+	assert.False(t, c.watching("ns2"))
+
+	c.reset()
+	assert.False(t, c.syncDue())
+	w.h.OnAdd(mustLoad("p1"), false)
+	w.h.OnDelete(cache.DeletedFinalStateUnknown{Obj: mustLoad("p1")})
+	w.h.OnUpdate(nil, &render.PodWithMetrics{Raw: mustLoad("p1")})
+	assert.Equal(t, map[string]struct{}{"default/nginx-7fb78fb6d8-2w75j": {}}, c.take())
+	assert.Empty(t, c.take())
+
+	c.expire()
+	assert.True(t, c.syncDue())
+
+	c.unwatch()
+	assert.True(t, w.removed)
+	assert.False(t, c.watching("ns1"))
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+type watcher struct {
+	h       cache.ResourceEventHandler
+	removed bool
+}
+
+func (w *watcher) AddChangeHandler(_ string, h cache.ResourceEventHandler) (func(), error) {
+	w.h = h
+	return func() { w.removed = true }, nil
+}
+
 func mustLoad(n string) *unstructured.Unstructured {
 	raw, err := os.ReadFile(fmt.Sprintf("testdata/%s.json", n))
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -41,6 +42,8 @@ type Tree struct {
 	inUpdate    int32
 	refreshRate time.Duration
 	query       string
+	instance    string
+	renderer    TreeRenderer
 }
 
 // NewTree returns a new model.
@@ -61,6 +64,21 @@ func (t *Tree) SetFilter(q string) {
 	t.query = q
 }
 
+// SetInstance scopes the model to a single resource instance.
+func (t *Tree) SetInstance(path string) {
+	t.instance = path
+}
+
+// GetInstance returns the resource instance if any.
+func (t *Tree) GetInstance() string {
+	return t.instance
+}
+
+// SetTreeRenderer overrides the registered tree renderer.
+func (t *Tree) SetTreeRenderer(r TreeRenderer) {
+	t.renderer = r
+}
+
 // AddListener adds a listener.
 func (t *Tree) AddListener(l TreeListener) {
 	t.listeners = append(t.listeners, l)
@@ -196,6 +214,13 @@ func (t *Tree) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, erro
 	if !ok {
 		return nil, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
 	}
+	if t.instance != "" {
+		o, err := factory.Get(t.gvr.String(), t.instance, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		return []runtime.Object{o}, nil
+	}
 	a.Init(factory, t.gvr)
 
 	return a.List(ctx, client.CleanseNamespace(t.namespace))
@@ -203,6 +228,9 @@ func (t *Tree) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, erro
 
 func (t *Tree) reconcile(ctx context.Context) error {
 	meta := t.resourceMeta()
+	if t.renderer != nil {
+		meta.TreeRenderer = t.renderer
+	}
 	oo, err := t.list(ctx, meta.DAO)
 	if err != nil {
 		return err
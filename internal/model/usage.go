@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import "sync"
+
+// UsageSample represents a resource cpu and memory usage.
+type UsageSample struct {
+	CPU, MEM int
+}
+
+// UsageHistory tracks the last usage samples of resources keyed by fqn.
+type UsageHistory struct {
+	limit   int
+	samples map[string][]UsageSample
+	mx      sync.RWMutex
+}
+
+// NewUsageHistory returns a new instance.
+func NewUsageHistory(limit int) *UsageHistory {
+	return &UsageHistory{
+		limit:   limit,
+		samples: make(map[string][]UsageSample),
+	}
+}
+
+// Add records a new sample, evicting the oldest one once full.
+func (u *UsageHistory) Add(fqn string, s UsageSample) {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	ss := append(u.samples[fqn], s)
+	if len(ss) > u.limit {
+		ss = ss[len(ss)-u.limit:]
+	}
+	u.samples[fqn] = ss
+}
+
+// Samples returns the samples of a resource, oldest first.
+func (u *UsageHistory) Samples(fqn string) []UsageSample {
+	u.mx.RLock()
+	defer u.mx.RUnlock()
+
+	ss := make([]UsageSample, len(u.samples[fqn]))
+	copy(ss, u.samples[fqn])
+
+	return ss
+}
+
+// Retain drops the samples of resources no longer present.
+func (u *UsageHistory) Retain(fqns map[string]struct{}) {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	for k := range u.samples {
+		if _, ok := fqns[k]; !ok {
+			delete(u.samples, k)
+		}
+	}
+}
+
+// Len returns the number of tracked resources.
+func (u *UsageHistory) Len() int {
+	u.mx.RLock()
+	defer u.mx.RUnlock()
+
+	return len(u.samples)
+}
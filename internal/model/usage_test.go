@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageHistory(t *testing.T) {
+	u := model.NewUsageHistory(3)
+	for i := 1; i <= 5; i++ {
+		u.Add("default/p1", model.UsageSample{CPU: i, MEM: i * 10})
+	}
+	u.Add("default/p2", model.UsageSample{CPU: 1, MEM: 1})
+
+	assert.Equal(t, 2, u.Len())
+	assert.Equal(t, []model.UsageSample{{CPU: 3, MEM: 30}, {CPU: 4, MEM: 40}, {CPU: 5, MEM: 50}}, u.Samples("default/p1"))
+	assert.Empty(t, u.Samples("default/p3"))
+
+	u.Retain(map[string]struct{}{"default/p2": {}})
+	assert.Equal(t, 1, u.Len())
+	assert.Empty(t, u.Samples("default/p1"))
+}
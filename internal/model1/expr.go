@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	exprOr  = "||"
+	exprAnd = "&&"
+)
+
+var exprTermRx = regexp.MustCompile(`\A\s*([^\s=!<>~]+)\s*(==|!=|>=|<=|=~|!~|=|>|<)\s*(.*?)\s*\z`)
+
+// FilterExpr represents a filter expression evaluated against rendered
+// columns ie status=Running && restarts>3 && age<1h. Terms are OR'ed
+// groups of AND'ed column comparisons.
+type FilterExpr [][]exprTerm
+
+type exprTerm struct {
+	col, op, val string
+	rx           *regexp.Regexp
+}
+
+// ParseFilterExpr parses a filter expression.
+func ParseFilterExpr(s string) (FilterExpr, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, errors.New("empty filter expression")
+	}
+
+	var e FilterExpr
+	for _, or := range strings.Split(s, exprOr) {
+		var tt []exprTerm
+		for _, and := range strings.Split(or, exprAnd) {
+			t, err := parseExprTerm(and)
+			if err != nil {
+				return nil, err
+			}
+			tt = append(tt, t)
+		}
+		e = append(e, tt)
+	}
+
+	return e, nil
+}
+
+func parseExprTerm(s string) (exprTerm, error) {
+	mm := exprTermRx.FindStringSubmatch(s)
+	if len(mm) != 4 || mm[3] == "" {
+		return exprTerm{}, fmt.Errorf("invalid filter term %q", strings.TrimSpace(s))
+	}
+	t := exprTerm{
+		col: ExprColName(mm[1]),
+		op:  mm[2],
+		val: strings.Trim(mm[3], `"'`),
+	}
+	if t.op == "=~" || t.op == "!~" {
+		rx, err := regexp.Compile(`(?i)` + t.val)
+		if err != nil {
+			return exprTerm{}, fmt.Errorf("invalid filter term %q: %w", strings.TrimSpace(s), err)
+		}
+		t.rx = rx
+	}
+
+	return t, nil
+}
+
+// ExprColName returns the header column name of an expression column.
+// Spaces in column names are spelled as underscores.
+func ExprColName(s string) string {
+	return strings.ToUpper(strings.ReplaceAll(s, "_", " "))
+}
+
+// Matcher returns a row matcher for the given header. It errors when the
+// expression refers to unknown columns.
+func (e FilterExpr) Matcher(h Header) (func(Fields) bool, error) {
+	idx := make(map[string]int)
+	for _, tt := range e {
+		for _, t := range tt {
+			i, ok := h.IndexOf(t.col, true)
+			if !ok {
+				return nil, fmt.Errorf("unknown filter column %q", t.col)
+			}
+			idx[t.col] = i
+		}
+	}
+
+	return func(ff Fields) bool {
+		for _, tt := range e {
+			if matchAll(tt, h, idx, ff) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func matchAll(tt []exprTerm, h Header, idx map[string]int, ff Fields) bool {
+	for _, t := range tt {
+		i := idx[t.col]
+		if i >= len(ff) || !t.match(h[i], ff[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t exprTerm) match(col HeaderColumn, v string) bool {
+	v = strings.TrimSpace(v)
+	switch t.op {
+	case "=", "==":
+		return strings.EqualFold(v, t.val)
+	case "!=":
+		return !strings.EqualFold(v, t.val)
+	case "=~":
+		return t.rx.MatchString(v)
+	case "!~":
+		return !t.rx.MatchString(v)
+	}
+
+	c, ok := compareValues(col, v, t.val)
+	if !ok {
+		return false
+	}
+	switch t.op {
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	default:
+		return false
+	}
+}
+
+// compareValues compares a column value with an expression value based on
+// the column kind. It returns false if the values can't be compared.
+func compareValues(col HeaderColumn, v1, v2 string) (int, bool) {
+	switch {
+	case col.Time:
+		if v1 == NAValue {
+			return 0, false
+		}
+		return cmp.Compare(DurationToSeconds(v1), DurationToSeconds(v2)), true
+	case col.Capacity:
+		q1, err := resource.ParseQuantity(v1)
+		if err != nil {
+			return 0, false
+		}
+		q2, err := resource.ParseQuantity(v2)
+		if err != nil {
+			return 0, false
+		}
+		return q1.Cmp(q2), true
+	}
+
+	f1, err := strconv.ParseFloat(strings.ReplaceAll(v1, ",", ""), 64)
+	if err != nil {
+		return 0, false
+	}
+	f2, err := strconv.ParseFloat(strings.ReplaceAll(v2, ",", ""), 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return cmp.Compare(f1, f2), true
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFilterExpr(t *testing.T) {
+	uu := map[string]struct {
+		s     string
+		terms []int
+		err   bool
+	}{
+		"single": {
+			s:     "status=Running",
+			terms: []int{1},
+		},
+		"and": {
+			s:     "status=Running && restarts>3 && age<1h",
+			terms: []int{3},
+		},
+		"or": {
+			s:     "status=Running && restarts>3 || status=~crash",
+			terms: []int{2, 1},
+		},
+		"empty": {
+			err: true,
+		},
+		"no-op": {
+			s:   "status",
+			err: true,
+		},
+		"no-val": {
+			s:   "status=",
+			err: true,
+		},
+		"bad-rx": {
+			s:   "name=~fred(",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e, err := ParseFilterExpr(u.s)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, len(u.terms), len(e))
+			for i, n := range u.terms {
+				assert.Equal(t, n, len(e[i]))
+			}
+		})
+	}
+}
+
+func TestTableDataExprFilter(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+			HeaderColumn{Name: "RESTARTS"},
+			HeaderColumn{Name: "MEMORY", Capacity: true},
+			HeaderColumn{Name: "NOMINATED NODE", Wide: true},
+			HeaderColumn{Name: "AGE", Time: true},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "Running", "5", "1Gi", "n1", "30m"}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "Running", "0", "512Mi", "n2", "2d"}}},
+			RowEvent{Row: Row{ID: "zorg", Fields: Fields{"zorg", "CrashLoopBackOff", "12", "128Mi", "n1", "5m"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		q   string
+		e   []string
+		err bool
+	}{
+		"eq": {
+			q: "status=running",
+			e: []string{"fred", "blee"},
+		},
+		"neq": {
+			q: "status!=Running",
+			e: []string{"zorg"},
+		},
+		"and": {
+			q: "status=Running && restarts>3 && age<1h",
+			e: []string{"fred"},
+		},
+		"or": {
+			q: "restarts>=12 || age>1d",
+			e: []string{"blee", "zorg"},
+		},
+		"rx": {
+			q: "status=~crash",
+			e: []string{"zorg"},
+		},
+		"not-rx": {
+			q: "name!~^(fred|blee)$",
+			e: []string{"zorg"},
+		},
+		"capacity": {
+			q: "memory<=512Mi",
+			e: []string{"blee", "zorg"},
+		},
+		"spaced-col": {
+			q: "nominated_node=n1",
+			e: []string{"fred", "zorg"},
+		},
+		"unknown-col": {
+			q:   "fred=1",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr, err := td.exprFilter(u.q)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			ids := make([]string, 0, rr.Len())
+			rr.Range(func(_ int, re RowEvent) bool {
+				ids = append(ids, re.Row.ID)
+				return true
+			})
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/fvbommel/sortorder"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -40,6 +43,24 @@ func GenericHydrate(ns string, table *metav1.Table, rr Rows, re Renderer) error
 	return nil
 }
 
+// ObjectFQN returns the fully qualified name of a resource if it has any
+// object metadata. Wrapped resources are keyed by their raw object.
+func ObjectFQN(o interface{}) (string, bool) {
+	if r, ok := o.(RawObjecter); ok && r.RawObject() != nil {
+		o = r.RawObject()
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return "", false
+	}
+	ns := m.GetNamespace()
+	if ns == "" {
+		ns = client.ClusterScope
+	}
+
+	return client.FQN(ns, m.GetName()), true
+}
+
 // IsValid returns true if resource is valid, false otherwise.
 func IsValid(ns string, h Header, r Row) bool {
 	if len(r.Fields) == 0 {
@@ -79,7 +100,8 @@ func labelize(labels string) map[string]string {
 	return data
 }
 
-func durationToSeconds(duration string) int64 {
+// DurationToSeconds converts a humanized duration ie 2d3h to seconds.
+func DurationToSeconds(duration string) int64 {
 	if len(duration) == 0 {
 		return 0
 	}
@@ -149,7 +171,7 @@ func Less(isNumber, isDuration, isCapacity bool, id1, id2, v1, v2 string) bool {
 }
 
 func lessDuration(s1, s2 string) bool {
-	d1, d2 := durationToSeconds(s1), durationToSeconds(s2)
+	d1, d2 := DurationToSeconds(s1), DurationToSeconds(s2)
 	return d1 <= d2
 }
 
@@ -161,6 +183,11 @@ func lessCapacity(s1, s2 string) bool {
 
 func lessNumber(s1, s2 string) bool {
 	v1, v2 := strings.Replace(s1, ",", "", -1), strings.Replace(s2, ",", "", -1)
+	if f1, err := strconv.ParseFloat(v1, 64); err == nil {
+		if f2, err := strconv.ParseFloat(v2, 64); err == nil {
+			return f1 < f2
+		}
+	}
 
 	return sortorder.NaturalLess(v1, v2)
 }
@@ -73,7 +73,7 @@ func TestDurationToSecond(t *testing.T) {
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			assert.Equal(t, u.e, durationToSeconds(u.s))
+			assert.Equal(t, u.e, DurationToSeconds(u.s))
 		})
 	}
 }
@@ -84,6 +84,6 @@ func BenchmarkDurationToSecond(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		durationToSeconds(t)
+		DurationToSeconds(t)
 	}
 }
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// RawObjecter represents a resource wrapping a raw kubernetes object.
+type RawObjecter interface {
+	// RawObject returns the wrapped object.
+	RawObject() *unstructured.Unstructured
+}
+
+// ParseJSONPath parses a JSONPath or a yq like expression ie .spec.containers[].image.
+func ParseJSONPath(name, expr string) (*jsonpath.JSONPath, error) {
+	jp := jsonpath.New(name).AllowMissingKeys(true)
+	if err := jp.Parse(normalizeJSONPath(expr)); err != nil {
+		return nil, err
+	}
+
+	return jp, nil
+}
+
+// JSONPathValue evaluates a jsonpath against an object. Multiple results are
+// comma separated.
+func JSONPathValue(jp *jsonpath.JSONPath, obj interface{}) (string, error) {
+	rr, err := jp.FindResults(obj)
+	if err != nil {
+		return "", err
+	}
+	ss := make([]string, 0, 1)
+	for _, r := range rr {
+		for _, v := range r {
+			ss = append(ss, jsonValue(v))
+		}
+	}
+
+	return strings.Join(ss, ","), nil
+}
+
+// ObjectMap returns a generic representation of an object suitable for
+// jsonpath evaluations.
+func ObjectMap(o runtime.Object) (map[string]interface{}, error) {
+	switch t := o.(type) {
+	case *unstructured.Unstructured:
+		return t.Object, nil
+	case RawObjecter:
+		if raw := t.RawObject(); raw != nil {
+			return raw.Object, nil
+		}
+		return nil, fmt.Errorf("no raw object found for %T", o)
+	default:
+		return runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func normalizeJSONPath(expr string) string {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "{") {
+		return expr
+	}
+	expr = strings.ReplaceAll(expr, "[]", "[*]")
+	if !strings.HasPrefix(expr, ".") && !strings.HasPrefix(expr, "$") {
+		expr = "." + expr
+	}
+
+	return "{" + expr + "}"
+}
+
+func jsonValue(v reflect.Value) string {
+	switch i := v.Interface().(type) {
+	case string:
+		return i
+	case float64:
+		if i == float64(int64(i)) {
+			return fmt.Sprintf("%d", int64(i))
+		}
+		return fmt.Sprintf("%v", i)
+	case map[string]interface{}, []interface{}:
+		bb, err := json.Marshal(i)
+		if err != nil {
+			return fmt.Sprintf("%v", i)
+		}
+		return string(bb)
+	default:
+		return fmt.Sprintf("%v", i)
+	}
+}
+
+// jsonPathColumns returns the custom view columns evaluated from jsonpath
+// expressions for a given resource.
+func jsonPathColumns(ctx context.Context, gvr string) []config.JSONPathColumn {
+	cfg, ok := ctx.Value(internal.KeyViewConfig).(*config.CustomView)
+	if !ok || cfg == nil {
+		return nil
+	}
+	vs, ok := cfg.ViewSettingFor(gvr)
+	if !ok {
+		return nil
+	}
+
+	return vs.JSONPathColumns()
+}
+
+// augmentRows appends the jsonpath columns values to the rows.
+func augmentRows(cc []config.JSONPathColumn, rows Rows, objs []map[string]interface{}) Header {
+	hh := make(Header, 0, len(cc))
+	pp := make([]*jsonpath.JSONPath, 0, len(cc))
+	for _, c := range cc {
+		jp, err := ParseJSONPath(c.Name, c.Path)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Invalid jsonpath for custom column %q", c.Name)
+			continue
+		}
+		pp = append(pp, jp)
+		hh = append(hh, HeaderColumn{Name: c.Name})
+	}
+	for i := range rows {
+		for _, jp := range pp {
+			var v string
+			if i < len(objs) && objs[i] != nil {
+				v, _ = JSONPathValue(jp, objs[i])
+			}
+			rows[i].Fields = append(rows[i].Fields, v)
+		}
+	}
+
+	return hh
+}
+
+// objectsFor returns the objects backing the rows of a resource list.
+func objectsFor(oo []runtime.Object, generic bool) []map[string]interface{} {
+	if !generic {
+		mm := make([]map[string]interface{}, 0, len(oo))
+		for _, o := range oo {
+			m, err := ObjectMap(o)
+			if err != nil {
+				log.Debug().Err(err).Msg("Custom column object conversion failed")
+			}
+			mm = append(mm, m)
+		}
+		return mm
+	}
+	if len(oo) == 0 {
+		return nil
+	}
+	table, ok := oo[0].(*metav1.Table)
+	if !ok {
+		return nil
+	}
+	mm := make([]map[string]interface{}, 0, len(table.Rows))
+	for _, r := range table.Rows {
+		var m map[string]interface{}
+		if len(r.Object.Raw) > 0 {
+			if err := json.Unmarshal(r.Object.Raw, &m); err != nil {
+				log.Debug().Err(err).Msg("Custom column object decode failed")
+			}
+		}
+		mm = append(mm, m)
+	}
+
+	return mm
+}
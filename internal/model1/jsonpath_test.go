@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestJSONPathValue(t *testing.T) {
+	obj := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"nodeName": "n1",
+			"replicas": int64(3),
+			"containers": []interface{}{
+				map[string]interface{}{"name": "c1", "image": "nginx"},
+				map[string]interface{}{"name": "c2", "image": "envoy"},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		expr, e string
+		err     bool
+	}{
+		"jsonpath": {
+			expr: "{.spec.nodeName}",
+			e:    "n1",
+		},
+		"yq": {
+			expr: ".spec.nodeName",
+			e:    "n1",
+		},
+		"no-dot": {
+			expr: "spec.replicas",
+			e:    "3",
+		},
+		"array": {
+			expr: ".spec.containers[].image",
+			e:    "nginx,envoy",
+		},
+		"object": {
+			expr: ".spec.containers[0]",
+			e:    `{"image":"nginx","name":"c1"}`,
+		},
+		"missing": {
+			expr: ".spec.fred",
+		},
+		"toast": {
+			expr: ".spec.containers[",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			jp, err := ParseJSONPath(k, u.expr)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			v, err := JSONPathValue(jp, obj)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
+
+func TestTableDataReconcileJSONPathColumns(t *testing.T) {
+	cv := config.NewCustomView()
+	cv.AddColumn("test", []string{"NAME"}, "RV:.metadata.resourceVersion")
+	ctx := context.WithValue(context.Background(), internal.KeyViewConfig, cv)
+
+	r := countRenderer{renders: make(map[string]int)}
+	oo := []runtime.Object{makeMetaObj("ns1", "p1", "v1")}
+	td := NewTableData(client.NewGVR("test"))
+	assert.NoError(t, td.Reconcile(ctx, &r, oo))
+
+	assert.Equal(t, []string{"NAME", "VERSION", "RV"}, td.Header().ColumnNames(true))
+	re, ok := td.FindRow("ns1/p1")
+	assert.True(t, ok)
+	assert.Equal(t, Fields{"p1", "v1", "v1"}, re.Row.Fields)
+}
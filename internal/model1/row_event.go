@@ -18,6 +18,8 @@ type RowEvent struct {
 	Kind   ResEvent
 	Row    Row
 	Deltas DeltaRow
+	// Stale tracks the number of consecutive refreshes the row remained unchanged.
+	Stale int
 }
 
 // NewRowEvent returns a new row event.
@@ -43,6 +45,7 @@ func (r RowEvent) Clone() RowEvent {
 		Kind:   r.Kind,
 		Row:    r.Row.Clone(),
 		Deltas: r.Deltas.Clone(),
+		Stale:  r.Stale,
 	}
 }
 
@@ -58,6 +61,7 @@ func (r RowEvent) Customize(cols []int) RowEvent {
 		Kind:   r.Kind,
 		Deltas: delta,
 		Row:    r.Row.Customize(cols),
+		Stale:  r.Stale,
 	}
 }
 
@@ -73,6 +77,7 @@ func (r RowEvent) Labelize(cols []int, labelCol int, labels []string) RowEvent {
 		Kind:   r.Kind,
 		Deltas: r.Deltas.Labelize(cols, labelCol),
 		Row:    r.Row.Labelize(cols, labelCol, labels),
+		Stale:  r.Stale,
 	}
 }
 
@@ -254,7 +259,7 @@ func (r *RowEvents) FindIndex(id string) (int, bool) {
 }
 
 // Sort rows based on column index and order.
-func (r *RowEvents) Sort(ns string, sortCol int, isDuration, numCol, isCapacity, asc bool) {
+func (r *RowEvents) Sort(ns string, sortCol int, isDuration, numCol, isCapacity, asc bool, kk ...SortKey) {
 	if sortCol == -1 {
 		return
 	}
@@ -267,6 +272,7 @@ func (r *RowEvents) Sort(ns string, sortCol int, isDuration, numCol, isCapacity,
 		IsNumber:   numCol,
 		IsDuration: isDuration,
 		IsCapacity: isCapacity,
+		Keys:       kk,
 	}
 	sort.Sort(t)
 	r.reindex()
@@ -274,6 +280,15 @@ func (r *RowEvents) Sort(ns string, sortCol int, isDuration, numCol, isCapacity,
 
 // ----------------------------------------------------------------------------
 
+// SortKey represents a secondary sort column.
+type SortKey struct {
+	Index      int
+	IsNumber   bool
+	IsDuration bool
+	IsCapacity bool
+	Asc        bool
+}
+
 // RowEventSorter sorts row events by a given colon.
 type RowEventSorter struct {
 	Events     *RowEvents
@@ -283,6 +298,7 @@ type RowEventSorter struct {
 	IsDuration bool
 	IsCapacity bool
 	Asc        bool
+	Keys       []SortKey
 }
 
 func (r RowEventSorter) Len() int {
@@ -296,6 +312,13 @@ func (r RowEventSorter) Swap(i, j int) {
 func (r RowEventSorter) Less(i, j int) bool {
 	f1, f2 := r.Events.events[i].Row.Fields, r.Events.events[j].Row.Fields
 	id1, id2 := r.Events.events[i].Row.ID, r.Events.events[j].Row.ID
+	if f1[r.Index] == f2[r.Index] {
+		for _, k := range r.Keys {
+			if v1, v2 := f1[k.Index], f2[k.Index]; v1 != v2 {
+				return k.Asc == Less(k.IsNumber, k.IsDuration, k.IsCapacity, id1, id2, v1, v2)
+			}
+		}
+	}
 	less := Less(r.IsNumber, r.IsDuration, r.IsCapacity, id1, id2, f1[r.Index], f2[r.Index])
 	if r.Asc {
 		return less
@@ -418,7 +418,42 @@ func TestRowEventsSort(t *testing.T) {
 		col                int
 		duration, num, asc bool
 		capacity           bool
+		keys               []model1.SortKey
 	}{
+		"natural": {
+			re: model1.NewRowEventsWithEvts(
+				model1.RowEvent{Row: model1.Row{ID: "A", Fields: model1.Fields{"pod-10", "2"}}},
+				model1.RowEvent{Row: model1.Row{ID: "B", Fields: model1.Fields{"pod-2", "2"}}},
+				model1.RowEvent{Row: model1.Row{ID: "C", Fields: model1.Fields{"pod-1", "2"}}},
+			),
+			col: 0,
+			asc: true,
+			e: model1.NewRowEventsWithEvts(
+				model1.RowEvent{Row: model1.Row{ID: "C", Fields: model1.Fields{"pod-1", "2"}}},
+				model1.RowEvent{Row: model1.Row{ID: "B", Fields: model1.Fields{"pod-2", "2"}}},
+				model1.RowEvent{Row: model1.Row{ID: "A", Fields: model1.Fields{"pod-10", "2"}}},
+			),
+		},
+		"multi-keys": {
+			re: model1.NewRowEventsWithEvts(
+				model1.RowEvent{Row: model1.Row{ID: "A", Fields: model1.Fields{"Running", "pod-10", "1"}}},
+				model1.RowEvent{Row: model1.Row{ID: "B", Fields: model1.Fields{"Pending", "pod-2", "3"}}},
+				model1.RowEvent{Row: model1.Row{ID: "C", Fields: model1.Fields{"Running", "pod-2", "1"}}},
+				model1.RowEvent{Row: model1.Row{ID: "D", Fields: model1.Fields{"Running", "pod-3", "5"}}},
+			),
+			col: 0,
+			asc: true,
+			keys: []model1.SortKey{
+				{Index: 2, IsNumber: true, Asc: false},
+				{Index: 1, Asc: true},
+			},
+			e: model1.NewRowEventsWithEvts(
+				model1.RowEvent{Row: model1.Row{ID: "B", Fields: model1.Fields{"Pending", "pod-2", "3"}}},
+				model1.RowEvent{Row: model1.Row{ID: "D", Fields: model1.Fields{"Running", "pod-3", "5"}}},
+				model1.RowEvent{Row: model1.Row{ID: "C", Fields: model1.Fields{"Running", "pod-2", "1"}}},
+				model1.RowEvent{Row: model1.Row{ID: "A", Fields: model1.Fields{"Running", "pod-10", "1"}}},
+			),
+		},
 		"age_time": {
 			re: model1.NewRowEventsWithEvts(
 				model1.RowEvent{Row: model1.Row{ID: "A", Fields: model1.Fields{"1", "2", testTime().Add(20 * time.Second).String()}}},
@@ -494,7 +529,7 @@ func TestRowEventsSort(t *testing.T) {
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			u.re.Sort("", u.col, u.duration, u.num, u.capacity, u.asc)
+			u.re.Sort("", u.col, u.duration, u.num, u.capacity, u.asc, u.keys...)
 			assert.Equal(t, u.e, u.re)
 		})
 	}
@@ -509,6 +509,21 @@ func TestLess(t *testing.T) {
 			v2:         "1Ti",
 			e:          true,
 		},
+		"signed": {
+			isNumber: true,
+			id1:      "id1",
+			id2:      "id2",
+			v1:       "-12",
+			v2:       "+3",
+			e:        true,
+		},
+		"thousands": {
+			isNumber: true,
+			id1:      "id1",
+			id2:      "id2",
+			v1:       "1,200",
+			v2:       "300",
+		},
 	}
 
 	for k := range uu {
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	ascSort  = "asc"
+	descSort = "desc"
+)
+
+// String returns the sort spec ie STATUS:asc,NAME:desc.
+func (s SortColumn) String() string {
+	if s.Name == "" {
+		return ""
+	}
+	ss := make([]string, 0, len(s.Secondary)+1)
+	for _, c := range append([]SortColumn{{Name: s.Name, ASC: s.ASC}}, s.Secondary...) {
+		order := descSort
+		if c.ASC {
+			order = ascSort
+		}
+		ss = append(ss, c.Name+":"+order)
+	}
+
+	return strings.Join(ss, ",")
+}
+
+// Then returns a sort column breaking ties with the given column. A column
+// already sorted on only has its order updated.
+func (s SortColumn) Then(name string, asc bool) SortColumn {
+	if name == s.Name {
+		return s
+	}
+	ss := make([]SortColumn, 0, len(s.Secondary)+1)
+	for _, c := range s.Secondary {
+		if c.Name != name {
+			ss = append(ss, c)
+		}
+	}
+	s.Secondary = append(ss, SortColumn{Name: name, ASC: asc})
+
+	return s
+}
+
+// ParseSortColumn parses a sort spec ie STATUS:asc,NAME:desc.
+func ParseSortColumn(spec string) (SortColumn, error) {
+	var sc SortColumn
+	for i, s := range strings.Split(spec, ",") {
+		tt := strings.Split(strings.TrimSpace(s), ":")
+		if len(tt) != 2 || tt[0] == "" || (tt[1] != ascSort && tt[1] != descSort) {
+			return SortColumn{}, fmt.Errorf("invalid sort spec %q. must be col-name:asc|desc[,...]", spec)
+		}
+		c := SortColumn{Name: tt[0], ASC: tt[1] == ascSort}
+		if i == 0 {
+			sc = c
+			continue
+		}
+		sc.Secondary = append(sc.Secondary, c)
+	}
+
+	return sc, nil
+}
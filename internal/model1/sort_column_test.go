@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSortColumn(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    model1.SortColumn
+		err  bool
+	}{
+		"single": {
+			spec: "NAME:asc",
+			e:    model1.SortColumn{Name: "NAME", ASC: true},
+		},
+		"multi": {
+			spec: "STATUS:desc, NAME:asc",
+			e: model1.SortColumn{
+				Name:      "STATUS",
+				Secondary: []model1.SortColumn{{Name: "NAME", ASC: true}},
+			},
+		},
+		"no-order": {
+			spec: "NAME",
+			err:  true,
+		},
+		"bad-order": {
+			spec: "NAME:up",
+			err:  true,
+		},
+		"empty": {
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sc, err := model1.ParseSortColumn(u.spec)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, sc)
+		})
+	}
+}
+
+func TestSortColumnThen(t *testing.T) {
+	sc := model1.SortColumn{Name: "STATUS", ASC: true}
+	assert.Equal(t, "STATUS:asc", sc.String())
+
+	sc = sc.Then("AGE", false).Then("NAME", true)
+	assert.Equal(t, "STATUS:asc,AGE:desc,NAME:asc", sc.String())
+
+	sc = sc.Then("AGE", true)
+	assert.Equal(t, "STATUS:asc,NAME:asc,AGE:asc", sc.String())
+
+	sc = sc.Then("STATUS", false)
+	assert.Equal(t, "STATUS:asc,NAME:asc,AGE:asc", sc.String())
+
+	assert.Equal(t, "", model1.SortColumn{}.String())
+}
@@ -28,6 +28,9 @@ type (
 	SortColumn struct {
 		Name string
 		ASC  bool
+
+		// Secondary tracks the columns breaking ties in order.
+		Secondary []SortColumn
 	}
 )
 
@@ -37,6 +40,8 @@ type FilterOpts struct {
 	Toast  bool
 	Filter string
 	Invert bool
+	// Changes hides rows that remained unchanged for the given number of refreshes.
+	Changes int
 }
 
 // TableData tracks a K8s resource for tabular display.
@@ -104,6 +109,20 @@ func (t *TableData) Sort(sc SortColumn) {
 	if idx < 0 {
 		return
 	}
+	kk := make([]SortKey, 0, len(sc.Secondary))
+	for _, s := range sc.Secondary {
+		c, i := t.HeadCol(s.Name, false)
+		if i < 0 {
+			continue
+		}
+		kk = append(kk, SortKey{
+			Index:      i,
+			IsNumber:   c.MX,
+			IsDuration: c.Time,
+			IsCapacity: c.Capacity,
+			Asc:        s.ASC,
+		})
+	}
 	t.rowEvents.Sort(
 		t.GetNamespace(),
 		idx,
@@ -111,6 +130,7 @@ func (t *TableData) Sort(sc SortColumn) {
 		col.MX,
 		col.Capacity,
 		sc.ASC,
+		kk...,
 	)
 }
 
@@ -136,6 +156,15 @@ func (t *TableData) HeadCol(n string, w bool) (HeaderColumn, int) {
 }
 
 func (t *TableData) Filter(f FilterOpts) *TableData {
+	td := t.filter(f)
+	if f.Changes > 0 {
+		td.rowEvents = filterChanges(td.rowEvents, f.Changes)
+	}
+
+	return td
+}
+
+func (t *TableData) filter(f FilterOpts) *TableData {
 	td := NewTableDataFromTable(t)
 
 	if f.Toast {
@@ -148,6 +177,15 @@ func (t *TableData) Filter(f FilterOpts) *TableData {
 		td.rowEvents = t.fuzzyFilter(f)
 		return td
 	}
+	if q, ok := internal.IsExprSelector(f.Filter); ok {
+		rr, err := t.exprFilter(q)
+		if err == nil {
+			td.rowEvents = rr
+		} else {
+			log.Error().Err(err).Msg("expr filter failed")
+		}
+		return td
+	}
 	rr, err := t.rxFilter(f.Filter, internal.IsInverseSelector(f.Filter))
 	if err == nil {
 		td.rowEvents = rr
@@ -186,6 +224,27 @@ func (t *TableData) rxFilter(q string, inverse bool) (*RowEvents, error) {
 	return rr, nil
 }
 
+func (t *TableData) exprFilter(q string) (*RowEvents, error) {
+	e, err := ParseFilterExpr(q)
+	if err != nil {
+		return nil, err
+	}
+	match, err := e.Matcher(t.header)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := NewRowEvents(t.RowCount() / 2)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if match(re.Row.Fields) {
+			rr.Add(re)
+		}
+		return true
+	})
+
+	return rr, nil
+}
+
 func (t *TableData) fuzzyFilter(q string) *RowEvents {
 	q = strings.TrimSpace(q)
 	ss := make([]string, 0, t.RowCount()/2)
@@ -225,6 +284,22 @@ func (t *TableData) filterToast() *RowEvents {
 	return rr
 }
 
+func filterChanges(rr *RowEvents, window int) *RowEvents {
+	if rr == nil {
+		return rr
+	}
+
+	out := NewRowEvents(10)
+	rr.Range(func(_ int, re RowEvent) bool {
+		if re.Stale < window {
+			out.Add(re)
+		}
+		return true
+	})
+
+	return out
+}
+
 func (t *TableData) GetNamespace() string {
 	t.mx.RLock()
 	defer t.mx.RUnlock()
@@ -262,8 +337,13 @@ func (t *TableData) Reconcile(ctx context.Context, r Renderer, oo []runtime.Obje
 		}
 	}
 
+	header := r.Header(t.namespace)
+	if cc := jsonPathColumns(ctx, t.gvr.String()); len(cc) > 0 {
+		header = append(header.Clone(), augmentRows(cc, rows, objectsFor(oo, r.IsGeneric()))...)
+	}
+
 	t.Update(rows)
-	t.SetHeader(t.namespace, r.Header(t.namespace))
+	t.SetHeader(t.namespace, header)
 	if t.HeaderCount() == 0 {
 		return fmt.Errorf("fail to list resource %s", t.gvr)
 	}
@@ -332,7 +412,7 @@ func (t *TableData) Customize(vs *config.ViewSetting, sc SortColumn, manual, wid
 		return t, sc
 	}
 
-	cols := vs.Columns
+	cols := vs.ColumnNames()
 	cdata := TableData{
 		gvr:       t.gvr,
 		namespace: t.namespace,
@@ -409,6 +489,37 @@ func (t *TableData) ColumnNames(w bool) []string {
 	return t.header.ColumnNames(w)
 }
 
+// Patch renders the dirty resources only and reuses the current rows of the
+// unchanged ones. Dirty resources are keyed by fully qualified names.
+func (t *TableData) Patch(ctx context.Context, r Renderer, oo []runtime.Object, dirty map[string]struct{}) error {
+	if r.IsGeneric() || len(jsonPathColumns(ctx, t.gvr.String())) > 0 {
+		return t.Reconcile(ctx, r, oo)
+	}
+
+	rows := make(Rows, len(oo))
+	for i, o := range oo {
+		if fqn, ok := ObjectFQN(o); ok {
+			if _, ok := dirty[fqn]; !ok {
+				if re, ok := t.FindRow(fqn); ok {
+					rows[i] = re.Row
+					continue
+				}
+			}
+		}
+		if err := r.Render(o, t.namespace, &rows[i]); err != nil {
+			return err
+		}
+	}
+
+	t.Update(rows)
+	t.SetHeader(t.namespace, r.Header(t.namespace))
+	if t.HeaderCount() == 0 {
+		return fmt.Errorf("fail to list resource %s", t.gvr)
+	}
+
+	return nil
+}
+
 // GetHeader returns table header.
 func (t *TableData) GetHeader() Header {
 	t.mx.RLock()
@@ -445,7 +556,7 @@ func (t *TableData) Update(rows Rows) {
 				}
 				delta := NewDeltaRow(ev.Row, row, t.header)
 				if delta.IsBlank() {
-					ev.Kind, ev.Deltas, ev.Row = EventUnchanged, blankDelta, row
+					ev.Kind, ev.Deltas, ev.Row, ev.Stale = EventUnchanged, blankDelta, row, ev.Stale+1
 					t.rowEvents.Set(index, ev)
 				} else {
 					t.rowEvents.Set(index, NewRowEventWithDeltas(row, delta))
@@ -4,12 +4,15 @@
 package model1
 
 import (
+	"context"
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func init() {
@@ -287,9 +290,9 @@ func TestTableDataUpdate(t *testing.T) {
 				Row{ID: "C", Fields: Fields{"10", "2", "3"}},
 			},
 			e: NewRowEventsWithEvts(
-				RowEvent{Kind: EventUnchanged, Row: Row{ID: "A", Fields: Fields{"1", "2", "3"}}},
-				RowEvent{Kind: EventUnchanged, Row: Row{ID: "B", Fields: Fields{"0", "2", "3"}}},
-				RowEvent{Kind: EventUnchanged, Row: Row{ID: "C", Fields: Fields{"10", "2", "3"}}},
+				RowEvent{Kind: EventUnchanged, Stale: 1, Row: Row{ID: "A", Fields: Fields{"1", "2", "3"}}},
+				RowEvent{Kind: EventUnchanged, Stale: 1, Row: Row{ID: "B", Fields: Fields{"0", "2", "3"}}},
+				RowEvent{Kind: EventUnchanged, Stale: 1, Row: Row{ID: "C", Fields: Fields{"10", "2", "3"}}},
 			),
 		},
 		"add": {
@@ -305,9 +308,9 @@ func TestTableDataUpdate(t *testing.T) {
 				Row{ID: "D", Fields: Fields{"10", "2", "3"}},
 			},
 			e: NewRowEventsWithEvts(
-				RowEvent{Kind: EventUnchanged, Row: Row{ID: "A", Fields: Fields{"1", "2", "3"}}},
-				RowEvent{Kind: EventUnchanged, Row: Row{ID: "B", Fields: Fields{"0", "2", "3"}}},
-				RowEvent{Kind: EventUnchanged, Row: Row{ID: "C", Fields: Fields{"10", "2", "3"}}},
+				RowEvent{Kind: EventUnchanged, Stale: 1, Row: Row{ID: "A", Fields: Fields{"1", "2", "3"}}},
+				RowEvent{Kind: EventUnchanged, Stale: 1, Row: Row{ID: "B", Fields: Fields{"0", "2", "3"}}},
+				RowEvent{Kind: EventUnchanged, Stale: 1, Row: Row{ID: "C", Fields: Fields{"10", "2", "3"}}},
 				RowEvent{Kind: EventAdd, Row: Row{ID: "D", Fields: Fields{"10", "2", "3"}}},
 			),
 		},
@@ -322,8 +325,8 @@ func TestTableDataUpdate(t *testing.T) {
 				Row{ID: "C", Fields: Fields{"10", "2", "3"}},
 			},
 			e: NewRowEventsWithEvts(
-				RowEvent{Kind: EventUnchanged, Row: Row{ID: "A", Fields: Fields{"1", "2", "3"}}},
-				RowEvent{Kind: EventUnchanged, Row: Row{ID: "C", Fields: Fields{"10", "2", "3"}}},
+				RowEvent{Kind: EventUnchanged, Stale: 1, Row: Row{ID: "A", Fields: Fields{"1", "2", "3"}}},
+				RowEvent{Kind: EventUnchanged, Stale: 1, Row: Row{ID: "C", Fields: Fields{"10", "2", "3"}}},
 			),
 		},
 		"update": {
@@ -343,8 +346,8 @@ func TestTableDataUpdate(t *testing.T) {
 					Row:    Row{ID: "A", Fields: Fields{"10", "2", "3"}},
 					Deltas: DeltaRow{"1", "", ""},
 				},
-				RowEvent{Kind: EventUnchanged, Row: Row{ID: "B", Fields: Fields{"0", "2", "3"}}},
-				RowEvent{Kind: EventUnchanged, Row: Row{ID: "C", Fields: Fields{"10", "2", "3"}}},
+				RowEvent{Kind: EventUnchanged, Stale: 1, Row: Row{ID: "B", Fields: Fields{"0", "2", "3"}}},
+				RowEvent{Kind: EventUnchanged, Stale: 1, Row: Row{ID: "C", Fields: Fields{"10", "2", "3"}}},
 			),
 		},
 	}
@@ -402,3 +405,122 @@ func TestTableDataDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestTableDataFilterChanges(t *testing.T) {
+	uu := map[string]struct {
+		re     *RowEvents
+		window int
+		e      []string
+	}{
+		"off": {
+			re: NewRowEventsWithEvts(
+				RowEvent{Stale: 5, Row: Row{ID: "A", Fields: Fields{"1"}}},
+				RowEvent{Row: Row{ID: "B", Fields: Fields{"2"}}},
+			),
+			e: []string{"A", "B"},
+		},
+		"window": {
+			re: NewRowEventsWithEvts(
+				RowEvent{Stale: 5, Row: Row{ID: "A", Fields: Fields{"1"}}},
+				RowEvent{Stale: 2, Row: Row{ID: "B", Fields: Fields{"2"}}},
+				RowEvent{Stale: 3, Row: Row{ID: "C", Fields: Fields{"3"}}},
+				RowEvent{Kind: EventUpdate, Row: Row{ID: "D", Fields: Fields{"4"}}},
+			),
+			window: 3,
+			e:      []string{"B", "D"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(client.NewGVR("test"), Header{HeaderColumn{Name: "A"}}, u.re)
+			ids := make([]string, 0, len(u.e))
+			td.Filter(FilterOpts{Changes: u.window}).RowsRange(func(_ int, re RowEvent) bool {
+				ids = append(ids, re.Row.ID)
+				return true
+			})
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
+
+func TestTableDataUpdateStale(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{HeaderColumn{Name: "A"}},
+		NewRowEventsWithEvts(RowEvent{Row: Row{ID: "A", Fields: Fields{"1"}}}),
+	)
+
+	td.Update(Rows{Row{ID: "A", Fields: Fields{"1"}}})
+	td.Update(Rows{Row{ID: "A", Fields: Fields{"1"}}})
+	re, ok := td.FindRow("A")
+	assert.True(t, ok)
+	assert.Equal(t, 2, re.Stale)
+
+	td.Update(Rows{Row{ID: "A", Fields: Fields{"2"}}})
+	re, ok = td.FindRow("A")
+	assert.True(t, ok)
+	assert.Equal(t, EventUpdate, re.Kind)
+	assert.Equal(t, 0, re.Stale)
+}
+
+func TestTableDataPatch(t *testing.T) {
+	r := countRenderer{renders: make(map[string]int)}
+	oo := []runtime.Object{
+		makeMetaObj("ns1", "p1", "v1"),
+		makeMetaObj("ns1", "p2", "v1"),
+		makeMetaObj("", "n1", "v1"),
+	}
+
+	td := NewTableData(client.NewGVR("test"))
+	assert.NoError(t, td.Reconcile(context.Background(), &r, oo))
+	assert.Equal(t, 3, td.RowCount())
+
+	oo[1] = makeMetaObj("ns1", "p2", "v2")
+	oo = append(oo[:2], makeMetaObj("ns1", "p3", "v1"))
+	dirty := map[string]struct{}{"ns1/p2": {}, "ns1/p3": {}, "-/n1": {}}
+	assert.NoError(t, td.Patch(context.Background(), &r, oo, dirty))
+
+	assert.Equal(t, map[string]int{"ns1/p1": 1, "ns1/p2": 2, "-/n1": 1, "ns1/p3": 1}, r.renders)
+	assert.Equal(t, 3, td.RowCount())
+	re, ok := td.FindRow("ns1/p2")
+	assert.True(t, ok)
+	assert.Equal(t, Fields{"p2", "v2"}, re.Row.Fields)
+	assert.Equal(t, EventUpdate, re.Kind)
+	re, ok = td.FindRow("ns1/p1")
+	assert.True(t, ok)
+	assert.Equal(t, EventUnchanged, re.Kind)
+	_, ok = td.FindRow("-/n1")
+	assert.False(t, ok)
+}
+
+// Helpers...
+
+type countRenderer struct {
+	renders map[string]int
+}
+
+func (*countRenderer) IsGeneric() bool          { return false }
+func (*countRenderer) ColorerFunc() ColorerFunc { return DefaultColorer }
+func (*countRenderer) Header(string) Header {
+	return Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "VERSION"}}
+}
+
+func (r *countRenderer) Render(o interface{}, _ string, row *Row) error {
+	u := o.(*unstructured.Unstructured)
+	row.ID, _ = ObjectFQN(u)
+	row.Fields = Fields{u.GetName(), u.GetResourceVersion()}
+	r.renders[row.ID]++
+
+	return nil
+}
+
+func makeMetaObj(ns, n, v string) *unstructured.Unstructured {
+	var u unstructured.Unstructured
+	u.SetNamespace(ns)
+	u.SetName(n)
+	u.SetResourceVersion(v)
+
+	return &u
+}
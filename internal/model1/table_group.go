@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// GroupRowPrefix tracks the id prefix of group header rows.
+	GroupRowPrefix = "group://"
+
+	groupNone      = "<none>"
+	groupExpanded  = "▾"
+	groupCollapsed = "▸"
+)
+
+// IsGroupRow returns true if the row id denotes a group header row.
+func IsGroupRow(id string) bool {
+	return strings.HasPrefix(id, GroupRowPrefix)
+}
+
+// GroupRowID returns the header row id for a given group.
+func GroupRowID(group string) string {
+	return GroupRowPrefix + group
+}
+
+// GroupFromID returns the group of a group header row id.
+func GroupFromID(id string) string {
+	return strings.TrimPrefix(id, GroupRowPrefix)
+}
+
+type rowGroup struct {
+	name   string
+	rows   []RowEvent
+	status map[string]int
+	states []string
+}
+
+func (g *rowGroup) add(re RowEvent, statusCol int) {
+	g.rows = append(g.rows, re)
+	if statusCol < 0 || statusCol >= len(re.Row.Fields) {
+		return
+	}
+	s := re.Row.Fields[statusCol]
+	if s == "" {
+		return
+	}
+	if _, ok := g.status[s]; !ok {
+		g.states = append(g.states, s)
+	}
+	g.status[s]++
+}
+
+// Status returns the aggregated status of the group members ie Running:3 Pending:1.
+func (g *rowGroup) Status() string {
+	ss := make([]string, 0, len(g.states))
+	for _, s := range g.states {
+		ss = append(ss, fmt.Sprintf("%s:%d", s, g.status[s]))
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// Group returns a table whose rows are grouped by the values of a given column.
+// Each group leads with a header row tracking the member count and aggregated
+// status. Members of collapsed groups are omitted. Groups and members retain
+// the current row order.
+func (t *TableData) Group(col string, collapsed map[string]struct{}) *TableData {
+	idx, ok := t.header.IndexOf(strings.ToUpper(col), true)
+	if !ok {
+		return t
+	}
+	statusCol, ok := t.header.IndexOf("STATUS", true)
+	if !ok {
+		statusCol = -1
+	}
+	labelCol, ok := t.header.IndexOf("NAME", true)
+	if !ok {
+		labelCol = 0
+	}
+
+	gg, index := make([]*rowGroup, 0, 10), make(map[string]*rowGroup)
+	t.RowsRange(func(_ int, re RowEvent) bool {
+		var v string
+		if idx < len(re.Row.Fields) {
+			v = strings.TrimSpace(re.Row.Fields[idx])
+		}
+		if v == "" {
+			v = groupNone
+		}
+		g, ok := index[v]
+		if !ok {
+			g = &rowGroup{name: v, status: make(map[string]int)}
+			index[v] = g
+			gg = append(gg, g)
+		}
+		g.add(re, statusCol)
+		return true
+	})
+
+	rr := NewRowEvents(t.RowCount() + len(gg))
+	for _, g := range gg {
+		_, folded := collapsed[g.name]
+		row := NewRow(len(t.header))
+		row.ID = GroupRowID(g.name)
+		marker := groupExpanded
+		if folded {
+			marker = groupCollapsed
+		}
+		row.Fields[labelCol] = fmt.Sprintf("%s %s (%d)", marker, g.name, len(g.rows))
+		if idx != labelCol {
+			row.Fields[idx] = g.name
+		}
+		if statusCol >= 0 {
+			row.Fields[statusCol] = g.Status()
+		}
+		rr.Add(NewRowEvent(EventUnchanged, row))
+		if folded {
+			continue
+		}
+		for _, re := range g.rows {
+			rr.Add(re)
+		}
+	}
+
+	return NewTableDataFull(t.gvr, t.GetNamespace(), t.header, rr)
+}
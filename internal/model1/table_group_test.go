@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableDataGroup(t *testing.T) {
+	h := Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "NODE"},
+	}
+	rr := NewRowEventsWithEvts(
+		RowEvent{Row: Row{ID: "p1", Fields: Fields{"p1", "Running", "n1"}}},
+		RowEvent{Row: Row{ID: "p2", Fields: Fields{"p2", "Pending", "n2"}}},
+		RowEvent{Row: Row{ID: "p3", Fields: Fields{"p3", "Running", "n1"}}},
+		RowEvent{Row: Row{ID: "p4", Fields: Fields{"p4", "Pending", ""}}},
+	)
+
+	uu := map[string]struct {
+		col       string
+		collapsed map[string]struct{}
+		ids       []string
+		rows      map[string]Fields
+	}{
+		"none": {
+			col: "BLEE",
+			ids: []string{"p1", "p2", "p3", "p4"},
+		},
+		"expanded": {
+			col: "node",
+			ids: []string{"group://n1", "p1", "p3", "group://n2", "p2", "group://<none>", "p4"},
+			rows: map[string]Fields{
+				"group://n1":     {"▾ n1 (2)", "Running:2", "n1"},
+				"group://<none>": {"▾ <none> (1)", "Pending:1", "<none>"},
+			},
+		},
+		"collapsed": {
+			col:       "NODE",
+			collapsed: map[string]struct{}{"n1": {}},
+			ids:       []string{"group://n1", "group://n2", "p2", "group://<none>", "p4"},
+			rows: map[string]Fields{
+				"group://n1": {"▸ n1 (2)", "Running:2", "n1"},
+			},
+		},
+		"status": {
+			col: "STATUS",
+			ids: []string{"group://Running", "p1", "p3", "group://Pending", "p2", "p4"},
+			rows: map[string]Fields{
+				"group://Pending": {"▾ Pending (2)", "Pending:2", ""},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(client.NewGVR("test"), h, rr).Group(u.col, u.collapsed)
+			ids := make([]string, 0, len(u.ids))
+			td.RowsRange(func(_ int, re RowEvent) bool {
+				ids = append(ids, re.Row.ID)
+				return true
+			})
+			assert.Equal(t, u.ids, ids)
+			for id, ff := range u.rows {
+				re, ok := td.FindRow(id)
+				assert.True(t, ok)
+				assert.True(t, IsGroupRow(re.Row.ID))
+				assert.Equal(t, ff, re.Row.Fields)
+			}
+		})
+	}
+}
@@ -55,6 +55,15 @@ func (b *Benchmark) init(base, version string) error {
 	if err != nil {
 		return err
 	}
+	switch req.URL.Scheme {
+	case "http":
+		if b.config.HTTP.HTTP2 {
+			log.Warn().Msgf("HTTP/2 requires the https scheme. Benchmarking %s using HTTP/1.1", base)
+		}
+	case "https":
+	default:
+		return fmt.Errorf("unsupported benchmark scheme %q", req.URL.Scheme)
+	}
 	if b.config.Auth.User != "" || b.config.Auth.Password != "" {
 		req.SetBasicAuth(b.config.Auth.User, b.config.Auth.Password)
 	}
@@ -103,6 +112,9 @@ func (b *Benchmark) Cancel() {
 
 // Canceled checks if the benchmark was canceled.
 func (b *Benchmark) Canceled() bool {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
 	return b.canceled
 }
 
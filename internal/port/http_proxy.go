@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package port
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+)
+
+const (
+	httpConnected  = "HTTP/1.1 200 Connection established\r\n\r\n"
+	httpBadRequest = "HTTP/1.1 400 Bad Request\r\nConnection: close\r\n\r\n"
+)
+
+// httpHandshake reads an HTTP proxy request and returns its target address
+// along with the stream to tunnel. CONNECT requests are acknowledged and
+// tunneled as is. Plain requests are rewritten in origin form and the
+// connection is closed once answered.
+func httpHandshake(r *bufio.Reader, w io.Writer) (string, io.ReadWriter, error) {
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		return "", nil, err
+	}
+	if req.Method == http.MethodConnect {
+		if _, _, err := net.SplitHostPort(req.Host); err != nil {
+			_, _ = io.WriteString(w, httpBadRequest)
+			return "", nil, err
+		}
+		if _, err := io.WriteString(w, httpConnected); err != nil {
+			return "", nil, err
+		}
+		return req.Host, &readWriter{r: r, w: w}, nil
+	}
+
+	if req.URL.Scheme != "http" || req.URL.Hostname() == "" {
+		_, _ = io.WriteString(w, httpBadRequest)
+		return "", nil, fmt.Errorf("unsupported proxy request %s %s", req.Method, req.URL)
+	}
+	p := req.URL.Port()
+	if p == "" {
+		p = "80"
+	}
+	req.Header.Del("Proxy-Connection")
+	req.Header.Del("Proxy-Authorization")
+	req.Close = true
+	var buff bytes.Buffer
+	if err := req.Write(&buff); err != nil {
+		return "", nil, err
+	}
+
+	return net.JoinHostPort(req.URL.Hostname(), p), &readWriter{r: io.MultiReader(&buff, r), w: w}, nil
+}
+
+// readWriter pairs a reader and a writer.
+type readWriter struct {
+	r io.Reader
+	w io.Writer
+}
+
+// Read reads from the underlying reader.
+func (rw *readWriter) Read(p []byte) (int, error) {
+	return rw.r.Read(p)
+}
+
+// Write writes to the underlying writer.
+func (rw *readWriter) Write(p []byte) (int, error) {
+	return rw.w.Write(p)
+}
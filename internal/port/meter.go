@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package port
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+// TunnelStats represents a port forward traffic snapshot.
+type TunnelStats struct {
+	BytesIn, BytesOut int64
+	Conns             int
+	LastErr           string
+}
+
+// Meter tracks a port forward traffic by instrumenting its streams.
+type Meter struct {
+	in, out int64
+	conns   int32
+	lastErr string
+	mx      sync.RWMutex
+}
+
+// NewMeter returns a new port forward meter.
+func NewMeter() *Meter {
+	return &Meter{}
+}
+
+// Stats returns the current traffic stats.
+func (m *Meter) Stats() TunnelStats {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	return TunnelStats{
+		BytesIn:  atomic.LoadInt64(&m.in),
+		BytesOut: atomic.LoadInt64(&m.out),
+		Conns:    int(atomic.LoadInt32(&m.conns)),
+		LastErr:  m.lastErr,
+	}
+}
+
+// SetErr records the last forwarding error.
+func (m *Meter) SetErr(err error) {
+	if err == nil {
+		return
+	}
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.lastErr = err.Error()
+}
+
+// Dialer returns a dialer metering the streams of the connections it dials.
+func (m *Meter) Dialer(d httpstream.Dialer) httpstream.Dialer {
+	return &meteredDialer{Dialer: d, meter: m}
+}
+
+type meteredDialer struct {
+	httpstream.Dialer
+	meter *Meter
+}
+
+// Dial dials the api server.
+func (d *meteredDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, proto, err := d.Dialer.Dial(protocols...)
+	if err != nil {
+		d.meter.SetErr(err)
+		return nil, proto, err
+	}
+
+	return &meteredConn{Connection: conn, meter: d.meter}, proto, nil
+}
+
+type meteredConn struct {
+	httpstream.Connection
+	meter *Meter
+}
+
+// CreateStream creates a new stream. Each data stream tracks a local
+// connection being forwarded.
+func (c *meteredConn) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	s, err := c.Connection.CreateStream(headers)
+	if err != nil {
+		c.meter.SetErr(err)
+		return nil, err
+	}
+	switch headers.Get(v1.StreamType) {
+	case v1.StreamTypeData:
+		atomic.AddInt32(&c.meter.conns, 1)
+		return &dataStream{Stream: s, meter: c.meter}, nil
+	case v1.StreamTypeError:
+		return &errorStream{Stream: s, meter: c.meter}, nil
+	default:
+		return s, nil
+	}
+}
+
+// RemoveStreams removes streams from the connection.
+func (c *meteredConn) RemoveStreams(streams ...httpstream.Stream) {
+	ss := make([]httpstream.Stream, 0, len(streams))
+	for _, s := range streams {
+		switch ms := s.(type) {
+		case *dataStream:
+			ms.done()
+			ss = append(ss, ms.Stream)
+		case *errorStream:
+			ss = append(ss, ms.Stream)
+		default:
+			ss = append(ss, s)
+		}
+	}
+	c.Connection.RemoveStreams(ss...)
+}
+
+// dataStream counts the bytes copied from and to a forwarded connection.
+type dataStream struct {
+	httpstream.Stream
+	meter  *Meter
+	closed int32
+}
+
+// Read reads bytes sent by the remote port.
+func (s *dataStream) Read(p []byte) (int, error) {
+	n, err := s.Stream.Read(p)
+	atomic.AddInt64(&s.meter.in, int64(n))
+	s.check(err)
+
+	return n, err
+}
+
+// Write writes bytes to the remote port.
+func (s *dataStream) Write(p []byte) (int, error) {
+	n, err := s.Stream.Write(p)
+	atomic.AddInt64(&s.meter.out, int64(n))
+	s.check(err)
+
+	return n, err
+}
+
+func (s *dataStream) check(err error) {
+	if err == nil || errors.Is(err, io.EOF) || strings.Contains(err.Error(), "use of closed network connection") {
+		return
+	}
+	s.meter.SetErr(err)
+}
+
+func (s *dataStream) done() {
+	if atomic.CompareAndSwapInt32(&s.closed, 0, 1) {
+		atomic.AddInt32(&s.meter.conns, -1)
+	}
+}
+
+// errorStream records the errors reported by the remote side.
+type errorStream struct {
+	httpstream.Stream
+	meter *Meter
+}
+
+// Read reads an error message sent by the remote side.
+func (s *errorStream) Read(p []byte) (int, error) {
+	n, err := s.Stream.Read(p)
+	if msg := strings.TrimSpace(string(p[:n])); msg != "" {
+		s.meter.SetErr(errors.New(msg))
+	}
+
+	return n, err
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package port_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/port"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+func TestMeter(t *testing.T) {
+	m := port.NewMeter()
+	c := newFakeConn()
+	conn, _, err := m.Dialer(&fakeDialer{conn: c}).Dial()
+	assert.NoError(t, err)
+
+	hh := http.Header{}
+	hh.Set(v1.StreamType, v1.StreamTypeError)
+	es, err := conn.CreateStream(hh)
+	assert.NoError(t, err)
+	hh.Set(v1.StreamType, v1.StreamTypeData)
+	ds, err := conn.CreateStream(hh)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, m.Stats().Conns)
+
+	n, err := ds.Write([]byte("hello"))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+	bb, err := io.ReadAll(ds)
+	assert.NoError(t, err)
+	assert.Equal(t, "fred", string(bb))
+	_, err = io.ReadAll(es)
+	assert.NoError(t, err)
+
+	conn.RemoveStreams(es, ds)
+	conn.RemoveStreams(ds)
+	assert.Equal(t, 3, c.removed)
+	assert.Equal(t, port.TunnelStats{
+		BytesIn:  4,
+		BytesOut: 5,
+		LastErr:  "pod not running",
+	}, m.Stats())
+}
+
+func TestMeterDialErr(t *testing.T) {
+	m := port.NewMeter()
+	_, _, err := m.Dialer(&fakeDialer{err: errors.New("upgrade failed")}).Dial()
+	assert.Error(t, err)
+	assert.Equal(t, "upgrade failed", m.Stats().LastErr)
+}
+
+// Helpers...
+
+type fakeDialer struct {
+	conn httpstream.Connection
+	err  error
+}
+
+func (d *fakeDialer) Dial(...string) (httpstream.Connection, string, error) {
+	return d.conn, "", d.err
+}
+
+type fakeConn struct {
+	removed int
+}
+
+func newFakeConn() *fakeConn {
+	return &fakeConn{}
+}
+
+func (c *fakeConn) CreateStream(hh http.Header) (httpstream.Stream, error) {
+	if hh.Get(v1.StreamType) == v1.StreamTypeError {
+		return &fakeStream{Reader: strings.NewReader("pod not running"), headers: hh.Clone()}, nil
+	}
+
+	return &fakeStream{Reader: strings.NewReader("fred"), headers: hh.Clone()}, nil
+}
+
+func (c *fakeConn) Close() error                          { return nil }
+func (c *fakeConn) CloseChan() <-chan bool                { return nil }
+func (c *fakeConn) SetIdleTimeout(time.Duration)          {}
+func (c *fakeConn) RemoveStreams(ss ...httpstream.Stream) { c.removed += len(ss) }
+
+type fakeStream struct {
+	io.Reader
+	headers http.Header
+}
+
+func (s *fakeStream) Write(p []byte) (int, error) { return len(p), nil }
+func (s *fakeStream) Close() error                { return nil }
+func (s *fakeStream) Reset() error                { return nil }
+func (s *fakeStream) Headers() http.Header        { return s.headers }
+func (s *fakeStream) Identifier() uint32          { return 0 }
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package port
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	socksVersion  = 0x05
+	socksNoAuth   = 0x00
+	socksNoMethod = 0xff
+	socksConnect  = 0x01
+
+	socksIPv4   = 0x01
+	socksDomain = 0x03
+	socksIPv6   = 0x04
+
+	socksSucceeded       = 0x00
+	socksCmdUnsupported  = 0x07
+	socksAddrUnsupported = 0x08
+)
+
+// TunnelFunc pipes a proxied connection to a given host:port address until
+// either side is done.
+type TunnelFunc func(ctx context.Context, addr string, rw io.ReadWriter) error
+
+// SOCKSProxy represents a local SOCKS5 proxy relaying connections through a
+// tunnel. HTTP proxy requests are served on the same port.
+type SOCKSProxy struct {
+	listener net.Listener
+	tunnel   TunnelFunc
+	in, out  int64
+	conns    int32
+	lastErr  string
+	mx       sync.RWMutex
+}
+
+// NewSOCKSProxy returns a new proxy serving a given listener.
+func NewSOCKSProxy(l net.Listener, t TunnelFunc) *SOCKSProxy {
+	return &SOCKSProxy{
+		listener: l,
+		tunnel:   t,
+	}
+}
+
+// Addr returns the proxy listening address.
+func (s *SOCKSProxy) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Stats returns the proxy traffic stats.
+func (s *SOCKSProxy) Stats() TunnelStats {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return TunnelStats{
+		BytesIn:  atomic.LoadInt64(&s.in),
+		BytesOut: atomic.LoadInt64(&s.out),
+		Conns:    int(atomic.LoadInt32(&s.conns)),
+		LastErr:  s.lastErr,
+	}
+}
+
+// Close stops accepting connections.
+func (s *SOCKSProxy) Close() error {
+	return s.listener.Close()
+}
+
+// Serve relays the proxied connections until the listener is closed or the
+// context is canceled.
+func (s *SOCKSProxy) Serve(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		_ = s.listener.Close()
+	}()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) || ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go s.handle(ctx, conn)
+	}
+}
+
+func (s *SOCKSProxy) handle(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	br := bufio.NewReader(conn)
+	b, err := br.Peek(1)
+	if err != nil {
+		s.setErr(err)
+		return
+	}
+	var (
+		addr string
+		crw  io.ReadWriter = &readWriter{r: br, w: conn}
+	)
+	if b[0] == socksVersion {
+		addr, err = handshake(crw)
+	} else {
+		addr, crw, err = httpHandshake(br, conn)
+	}
+	if err != nil {
+		s.setErr(err)
+		return
+	}
+	atomic.AddInt32(&s.conns, 1)
+	defer atomic.AddInt32(&s.conns, -1)
+
+	log.Debug().Msgf("Proxying %s", addr)
+	rw := &countingRW{rw: crw, in: &s.in, out: &s.out}
+	if err := s.tunnel(ctx, addr, rw); err != nil && ctx.Err() == nil {
+		s.setErr(fmt.Errorf("%s: %w", addr, err))
+	}
+}
+
+func (s *SOCKSProxy) setErr(err error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.lastErr = err.Error()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// handshake negotiates a no auth CONNECT request and returns its address.
+func handshake(rw io.ReadWriter) (string, error) {
+	hdr := make([]byte, 2)
+	if _, err := io.ReadFull(rw, hdr); err != nil {
+		return "", err
+	}
+	if hdr[0] != socksVersion {
+		return "", fmt.Errorf("unsupported socks version %d", hdr[0])
+	}
+	methods := make([]byte, hdr[1])
+	if _, err := io.ReadFull(rw, methods); err != nil {
+		return "", err
+	}
+	method := byte(socksNoMethod)
+	for _, m := range methods {
+		if m == socksNoAuth {
+			method = socksNoAuth
+			break
+		}
+	}
+	if _, err := rw.Write([]byte{socksVersion, method}); err != nil {
+		return "", err
+	}
+	if method == socksNoMethod {
+		return "", errors.New("socks client requires authentication")
+	}
+
+	req := make([]byte, 4)
+	if _, err := io.ReadFull(rw, req); err != nil {
+		return "", err
+	}
+	if req[1] != socksConnect {
+		_ = reply(rw, socksCmdUnsupported)
+		return "", fmt.Errorf("unsupported socks command %d", req[1])
+	}
+	var host string
+	switch req[3] {
+	case socksIPv4, socksIPv6:
+		ip := make([]byte, net.IPv4len)
+		if req[3] == socksIPv6 {
+			ip = make([]byte, net.IPv6len)
+		}
+		if _, err := io.ReadFull(rw, ip); err != nil {
+			return "", err
+		}
+		host = net.IP(ip).String()
+	case socksDomain:
+		n := make([]byte, 1)
+		if _, err := io.ReadFull(rw, n); err != nil {
+			return "", err
+		}
+		name := make([]byte, n[0])
+		if _, err := io.ReadFull(rw, name); err != nil {
+			return "", err
+		}
+		host = string(name)
+	default:
+		_ = reply(rw, socksAddrUnsupported)
+		return "", fmt.Errorf("unsupported socks address type %d", req[3])
+	}
+	p := make([]byte, 2)
+	if _, err := io.ReadFull(rw, p); err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(p)))), reply(rw, socksSucceeded)
+}
+
+func reply(w io.Writer, code byte) error {
+	_, err := w.Write([]byte{socksVersion, code, 0x00, socksIPv4, 0, 0, 0, 0, 0, 0})
+
+	return err
+}
+
+// countingRW counts the bytes relayed through a proxied connection.
+type countingRW struct {
+	rw      io.ReadWriter
+	in, out *int64
+}
+
+// Read reads bytes sent by the proxy client.
+func (c *countingRW) Read(p []byte) (int, error) {
+	n, err := c.rw.Read(p)
+	atomic.AddInt64(c.out, int64(n))
+
+	return n, err
+}
+
+// Write writes bytes back to the proxy client.
+func (c *countingRW) Write(p []byte) (int, error) {
+	n, err := c.rw.Write(p)
+	atomic.AddInt64(c.in, int64(n))
+
+	return n, err
+}
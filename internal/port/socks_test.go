@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package port_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/port"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSOCKSProxy(t *testing.T) {
+	addrs := make(chan string, 1)
+	p, cancel := serveSOCKS(t, func(_ context.Context, addr string, rw io.ReadWriter) error {
+		addrs <- addr
+		_, err := io.Copy(rw, rw)
+		return err
+	})
+	defer cancel()
+
+	conn, err := net.Dial("tcp", p.Addr())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte{0x05, 0x01, 0x00})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x05, 0x00}, readN(t, conn, 2))
+
+	host := "fred.default.svc"
+	req := append([]byte{0x05, 0x01, 0x00, 0x03, byte(len(host))}, host...)
+	_, err = conn.Write(append(req, 0x1f, 0x90))
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x00), readN(t, conn, 10)[1])
+	assert.Equal(t, "fred.default.svc:8080", <-addrs)
+
+	_, err = conn.Write([]byte("hello"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(readN(t, conn, 5)))
+
+	st := p.Stats()
+	assert.Equal(t, 1, st.Conns)
+	assert.Equal(t, int64(5), st.BytesIn)
+	assert.Equal(t, int64(5), st.BytesOut)
+}
+
+func TestSOCKSProxyUnsupportedCmd(t *testing.T) {
+	p, cancel := serveSOCKS(t, func(context.Context, string, io.ReadWriter) error {
+		return nil
+	})
+	defer cancel()
+
+	conn, err := net.Dial("tcp", p.Addr())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte{0x05, 0x01, 0x00})
+	assert.NoError(t, err)
+	readN(t, conn, 2)
+	_, err = conn.Write([]byte{0x05, 0x02, 0x00, 0x01, 127, 0, 0, 1, 0, 80})
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x07), readN(t, conn, 10)[1])
+
+	_, err = conn.Read(make([]byte, 1))
+	assert.Error(t, err)
+	assert.Equal(t, "unsupported socks command 2", p.Stats().LastErr)
+}
+
+func TestHTTPProxyConnect(t *testing.T) {
+	addrs := make(chan string, 1)
+	p, cancel := serveSOCKS(t, func(_ context.Context, addr string, rw io.ReadWriter) error {
+		addrs <- addr
+		_, err := io.Copy(rw, rw)
+		return err
+	})
+	defer cancel()
+
+	conn, err := net.Dial("tcp", p.Addr())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("CONNECT fred.default.svc:443 HTTP/1.1\r\nHost: fred.default.svc:443\r\n\r\nhello"))
+	assert.NoError(t, err)
+	assert.Equal(t, "HTTP/1.1 200 Connection established\r\n\r\n", string(readN(t, conn, 39)))
+	assert.Equal(t, "fred.default.svc:443", <-addrs)
+	assert.Equal(t, "hello", string(readN(t, conn, 5)))
+}
+
+func TestHTTPProxyForward(t *testing.T) {
+	type proxied struct {
+		addr, uri, conn, proxyConn string
+	}
+	reqs := make(chan proxied, 1)
+	p, cancel := serveSOCKS(t, func(_ context.Context, addr string, rw io.ReadWriter) error {
+		req, err := http.ReadRequest(bufio.NewReader(rw))
+		if err != nil {
+			return err
+		}
+		reqs <- proxied{
+			addr:      addr,
+			uri:       req.RequestURI,
+			conn:      req.Header.Get("Connection"),
+			proxyConn: req.Header.Get("Proxy-Connection"),
+		}
+		_, err = io.WriteString(rw, "HTTP/1.1 200 OK\r\nContent-Length: 5\r\nConnection: close\r\n\r\nhello")
+		return err
+	})
+	defer cancel()
+
+	u, _ := url.Parse("http://" + p.Addr())
+	c := http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(u)}}
+	resp, err := c.Get("http://fred.default.svc/blee?a=1")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "hello", string(body))
+	assert.Equal(t, proxied{addr: "fred.default.svc:80", uri: "/blee?a=1", conn: "close"}, <-reqs)
+}
+
+func TestHTTPProxyBadRequest(t *testing.T) {
+	p, cancel := serveSOCKS(t, func(context.Context, string, io.ReadWriter) error {
+		return nil
+	})
+	defer cancel()
+
+	conn, err := net.Dial("tcp", p.Addr())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("GET /fred HTTP/1.1\r\nHost: fred\r\n\r\n"))
+	assert.NoError(t, err)
+	resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Eventually(t, func() bool {
+		return p.Stats().LastErr == "unsupported proxy request GET /fred"
+	}, time.Second, 10*time.Millisecond)
+}
+
+// Helpers...
+
+func serveSOCKS(t *testing.T, f port.TunnelFunc) (*port.SOCKSProxy, context.CancelFunc) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	p := port.NewSOCKSProxy(l, f)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		_ = p.Serve(ctx)
+	}()
+
+	return p, cancel
+}
+
+func readN(t *testing.T, r io.Reader, n int) []byte {
+	bb := make([]byte, n)
+	_, err := io.ReadFull(r, bb)
+	assert.NoError(t, err)
+
+	return bb
+}
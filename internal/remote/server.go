@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package remote
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// OK flags a successful command.
+	OK = "ok"
+
+	// ErrPrefix prefixes a failed command response.
+	ErrPrefix = "error: "
+)
+
+// Command represents a remote command.
+type Command struct {
+	Name string
+	Args []string
+}
+
+// String returns the command line.
+func (c Command) String() string {
+	return strings.TrimSpace(c.Name + " " + strings.Join(c.Args, " "))
+}
+
+// Parse parses a command line.
+func Parse(line string) (Command, error) {
+	ff := strings.Fields(line)
+	if len(ff) == 0 {
+		return Command{}, errors.New("blank command")
+	}
+
+	return Command{Name: strings.ToLower(ff[0]), Args: ff[1:]}, nil
+}
+
+// Handler executes a remote command and returns its output if any.
+type Handler func(Command) (string, error)
+
+// Server accepts commands on a local unix socket. Each command line gets
+// its output lines if any followed by either an ok or an error line.
+type Server struct {
+	path     string
+	handler  Handler
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+	mx       sync.Mutex
+	once     sync.Once
+}
+
+// NewServer returns a new remote control server.
+func NewServer(path string, h Handler) *Server {
+	return &Server{
+		path:    path,
+		handler: h,
+		conns:   make(map[net.Conn]struct{}),
+	}
+}
+
+// Start starts listening on the server socket.
+func (s *Server) Start() error {
+	if err := clearStaleSocket(s.path); err != nil {
+		return err
+	}
+	l, err := listen(s.path)
+	if err != nil {
+		return err
+	}
+	s.listener = l
+	go s.accept()
+	log.Info().Msgf("Remote control listening on %s", s.path)
+
+	return nil
+}
+
+// Stop stops the server and removes its socket.
+func (s *Server) Stop() {
+	s.once.Do(func() {
+		if s.listener != nil {
+			_ = s.listener.Close()
+		}
+		s.mx.Lock()
+		for c := range s.conns {
+			_ = c.Close()
+		}
+		s.mx.Unlock()
+	})
+}
+
+// Path returns the server socket path.
+func (s *Server) Path() string {
+	return s.path
+}
+
+func (s *Server) accept() {
+	for {
+		c, err := s.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Error().Err(err).Msg("Remote control accept failed")
+			}
+			return
+		}
+		s.track(c, true)
+		go s.serve(c)
+	}
+}
+
+func (s *Server) track(c net.Conn, add bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if add {
+		s.conns[c] = struct{}{}
+	} else {
+		delete(s.conns, c)
+	}
+}
+
+func (s *Server) serve(c net.Conn) {
+	defer func() {
+		s.track(c, false)
+		_ = c.Close()
+	}()
+
+	sc := bufio.NewScanner(c)
+	for sc.Scan() {
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+		if err := s.exec(c, sc.Text()); err != nil {
+			log.Warn().Err(err).Msg("Remote control response failed")
+			return
+		}
+	}
+}
+
+func (s *Server) exec(w io.Writer, line string) error {
+	cmd, err := Parse(line)
+	if err != nil {
+		return reply(w, "", err)
+	}
+	log.Debug().Msgf("Remote command %q", cmd)
+	out, err := s.handler(cmd)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Remote command %q failed", cmd)
+	}
+
+	return reply(w, out, err)
+}
+
+func reply(w io.Writer, out string, err error) error {
+	var b strings.Builder
+	if out != "" {
+		b.WriteString(strings.TrimSuffix(out, "\n"))
+		b.WriteString("\n")
+	}
+	if err != nil {
+		b.WriteString(ErrPrefix + strings.ReplaceAll(err.Error(), "\n", " ") + "\n")
+	} else {
+		b.WriteString(OK + "\n")
+	}
+	_, e := io.WriteString(w, b.String())
+
+	return e
+}
+
+// clearStaleSocket removes a socket left behind by a previous session. It
+// fails if the socket is still in use, owned by another user or the path
+// isn't a socket.
+func clearStaleSocket(path string) error {
+	fi, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&fs.ModeSymlink != 0 {
+		return fmt.Errorf("%s is a symlink", path)
+	}
+	if fi.Mode()&fs.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a socket", path)
+	}
+	if err := checkOwner(path, fi); err != nil {
+		return err
+	}
+	if c, err := net.Dial("unix", path); err == nil {
+		_ = c.Close()
+		return fmt.Errorf("socket %s is already in use", path)
+	}
+
+	return os.Remove(path)
+}
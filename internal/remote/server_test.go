@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package remote
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	uu := map[string]struct {
+		line string
+		e    Command
+		err  bool
+	}{
+		"blank": {
+			line: "  ",
+			err:  true,
+		},
+		"plain": {
+			line: "dump",
+			e:    Command{Name: "dump", Args: []string{}},
+		},
+		"args": {
+			line: " VIEW  pods -n prod ",
+			e:    Command{Name: "view", Args: []string{"pods", "-n", "prod"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := Parse(u.line)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, c)
+		})
+	}
+}
+
+func TestServer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "k9s.sock")
+	s := NewServer(path, func(c Command) (string, error) {
+		switch c.Name {
+		case "dump":
+			return "/tmp/pods.csv", nil
+		case "filter":
+			return "", nil
+		default:
+			return "", errors.New("unknown command\nbozo")
+		}
+	})
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), fi.Mode().Perm())
+
+	c, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer c.Close()
+	_, err = c.Write([]byte("filter app=web\n\ndump\nfred\n"))
+	require.NoError(t, err)
+
+	r := bufio.NewReader(c)
+	ll := make([]string, 0, 4)
+	for i := 0; i < 4; i++ {
+		l, err := r.ReadString('\n')
+		require.NoError(t, err)
+		ll = append(ll, strings.TrimSuffix(l, "\n"))
+	}
+	assert.Equal(t, []string{"ok", "/tmp/pods.csv", "ok", "error: unknown command bozo"}, ll)
+}
+
+func TestServerInUse(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "k9s.sock")
+	s := NewServer(path, nil)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	assert.Error(t, NewServer(path, nil).Start())
+}
+
+func TestServerNotSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "k9s.sock")
+	require.NoError(t, os.WriteFile(path, []byte("fred"), 0o600))
+
+	assert.Error(t, NewServer(path, nil).Start())
+}
+
+func TestServerSymlink(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "fred.sock")
+	l, err := net.Listen("unix", target)
+	require.NoError(t, err)
+	l.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, l.Close())
+	path := filepath.Join(dir, "k9s.sock")
+	require.NoError(t, os.Symlink(target, path))
+
+	assert.Error(t, NewServer(path, nil).Start())
+	_, err = os.Lstat(target)
+	assert.NoError(t, err)
+}
+
+func TestServerStaleSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "k9s.sock")
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	l.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, l.Close())
+
+	s := NewServer(path, nil)
+	require.NoError(t, s.Start())
+	s.Stop()
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
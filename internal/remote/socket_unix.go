@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build !windows
+
+package remote
+
+import (
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"syscall"
+)
+
+// socketUmask ensures the socket is only ever reachable by its owner.
+const socketUmask = 0o177
+
+// listen creates the server socket under a restrictive umask so other users
+// can't connect to it before its permissions are set.
+func listen(path string) (net.Listener, error) {
+	old := syscall.Umask(socketUmask)
+	defer syscall.Umask(old)
+
+	return net.Listen("unix", path)
+}
+
+// checkOwner ensures an existing socket belongs to the current user.
+func checkOwner(path string, fi fs.FileInfo) error {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	if int(st.Uid) != os.Getuid() {
+		return fmt.Errorf("socket %s is owned by another user", path)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build windows
+
+package remote
+
+import (
+	"io/fs"
+	"net"
+)
+
+// listen creates the server socket.
+func listen(path string) (net.Listener, error) {
+	return net.Listen("unix", path)
+}
+
+// checkOwner is a noop as socket ownership is enforced by ACLs.
+func checkOwner(string, fs.FileInfo) error {
+	return nil
+}
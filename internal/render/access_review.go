@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AccessReview renders an access review breakdown to screen.
+type AccessReview struct {
+	Base
+}
+
+// Header returns a header row.
+func (AccessReview) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "CHECK"},
+		model1.HeaderColumn{Name: "VERB"},
+		model1.HeaderColumn{Name: "RESOURCE"},
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "ALLOWED"},
+		model1.HeaderColumn{Name: "BINDING"},
+		model1.HeaderColumn{Name: "ROLE"},
+		model1.HeaderColumn{Name: "SUBJECTS"},
+		model1.HeaderColumn{Name: "REASON", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders an access review entry to screen.
+func (AccessReview) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(AccessReviewRes)
+	if !ok {
+		return fmt.Errorf("expected AccessReviewRes, but got %T", o)
+	}
+
+	allowed := strconv.FormatBool(res.Allowed)
+	if res.Check != "review" {
+		allowed = ""
+	}
+	r.ID = res.ID()
+	r.Fields = model1.Fields{
+		res.Check,
+		res.Verb,
+		res.Resource,
+		res.Namespace,
+		allowed,
+		res.Binding,
+		res.Role,
+		res.Subjects,
+		res.Reason,
+		AsStatus(res.Err),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// AccessReviewRes represents an access review entry.
+type AccessReviewRes struct {
+	Check     string
+	Verb      string
+	Resource  string
+	Namespace string
+	Allowed   bool
+	Binding   string
+	Role      string
+	Subjects  string
+	Reason    string
+	Err       error
+}
+
+// ID returns the entry identifier.
+func (a AccessReviewRes) ID() string {
+	return a.Check + ":" + a.Verb + ":" + a.Binding
+}
+
+// GetObjectKind returns a schema object.
+func (AccessReviewRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns an entry copy.
+func (a AccessReviewRes) DeepCopyObject() runtime.Object {
+	return a
+}
@@ -26,6 +26,12 @@ var (
 	okRx    = regexp.MustCompile(`\[2\d{2}\]\s+(\d+)\s+responses`)
 	errRx   = regexp.MustCompile(`\[[4-5]\d{2}\]\s+(\d+)\s+responses`)
 	toastRx = regexp.MustCompile(`Error distribution`)
+	p50Rx   = regexp.MustCompile(`50%+ in ([0-9.]+) secs`)
+	p95Rx   = regexp.MustCompile(`95%+ in ([0-9.]+) secs`)
+	p99Rx   = regexp.MustCompile(`99%+ in ([0-9.]+) secs`)
+	histoRx = regexp.MustCompile(`(?m)^\s+[0-9.]+ \[(\d+)\]\s+\|`)
+	codeRx  = regexp.MustCompile(`\[\d{3}\]\s+(\d+)\s+responses`)
+	faultRx = regexp.MustCompile(`(?m)^\s+\[(\d+)\]\s`)
 )
 
 // Benchmark renders a benchmarks to screen.
@@ -54,6 +60,9 @@ func (Benchmark) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "REQ/S", Align: tview.AlignRight},
 		model1.HeaderColumn{Name: "2XX", Align: tview.AlignRight},
 		model1.HeaderColumn{Name: "4XX/5XX", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "P50", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "P99", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "LATENCY"},
 		model1.HeaderColumn{Name: "REPORT"},
 		model1.HeaderColumn{Name: "VALID", Wide: true},
 		model1.HeaderColumn{Name: "AGE", Time: true},
@@ -78,7 +87,7 @@ func (b Benchmark) Render(o interface{}, ns string, r *model1.Row) error {
 		return err
 	}
 	b.augmentRow(r.Fields, data)
-	r.Fields[8] = AsStatus(b.diagnose(ns, r.Fields))
+	r.Fields[11] = AsStatus(b.diagnose(ns, r.Fields))
 
 	return nil
 }
@@ -118,8 +127,8 @@ func (b Benchmark) initRow(row model1.Fields, f os.FileInfo) error {
 	}
 	row[0] = tokens[0]
 	row[1] = tokens[1]
-	row[7] = f.Name()
-	row[9] = ToAge(metav1.Time{Time: f.ModTime()})
+	row[10] = f.Name()
+	row[12] = ToAge(metav1.Time{Time: f.ModTime()})
 
 	return nil
 }
@@ -155,6 +164,15 @@ func (b Benchmark) augmentRow(fields model1.Fields, data string) {
 
 	me := errRx.FindAllStringSubmatch(data, -1)
 	fields[col] = b.countReq(me)
+	col++
+
+	fields[col] = AsLatency(toFloat(p50Rx.FindStringSubmatch(data)))
+	col++
+
+	fields[col] = AsLatency(toFloat(p99Rx.FindStringSubmatch(data)))
+	col++
+
+	fields[col] = toSparkline(histoRx.FindAllStringSubmatch(data, -1))
 }
 
 func (Benchmark) countReq(rr [][]string) string {
@@ -171,6 +189,89 @@ func (Benchmark) countReq(rr [][]string) string {
 	return AsThousands(int64(sum))
 }
 
+// AsLatency converts a latency in seconds to milliseconds.
+func AsLatency(secs float64) string {
+	if secs < 0 {
+		return NAValue
+	}
+
+	return strconv.FormatFloat(secs*1_000, 'f', 1, 64) + "ms"
+}
+
+// toSparkline renders a response time histogram buckets as bars.
+func toSparkline(mm [][]string) string {
+	counts := make([]int, 0, len(mm))
+	var top int
+	for _, m := range mm {
+		c, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+		counts = append(counts, c)
+		top = max(top, c)
+	}
+
+	return Sparkline(counts, top)
+}
+
+// BenchReport represents a benchmark run outcome.
+type BenchReport struct {
+	Failed          bool
+	Total, RPS      float64
+	P50, P95, P99   float64
+	Requests, Fails int
+}
+
+// ParseBenchReport extracts a benchmark outcome from a report. Latencies
+// are in seconds and are negative when not reported.
+func ParseBenchReport(data string) BenchReport {
+	r := BenchReport{
+		Failed: toastRx.MatchString(data),
+		Total:  toFloat(totalRx.FindStringSubmatch(data)),
+		RPS:    toFloat(reqRx.FindStringSubmatch(data)),
+		P50:    toFloat(p50Rx.FindStringSubmatch(data)),
+		P95:    toFloat(p95Rx.FindStringSubmatch(data)),
+		P99:    toFloat(p99Rx.FindStringSubmatch(data)),
+	}
+	for _, m := range codeRx.FindAllStringSubmatch(data, -1) {
+		n, _ := strconv.Atoi(m[1])
+		r.Requests += n
+	}
+	for _, m := range errRx.FindAllStringSubmatch(data, -1) {
+		n, _ := strconv.Atoi(m[1])
+		r.Fails += n
+	}
+	if i := strings.Index(data, "Error distribution"); i >= 0 {
+		for _, m := range faultRx.FindAllStringSubmatch(data[i:], -1) {
+			n, _ := strconv.Atoi(m[1])
+			r.Requests, r.Fails = r.Requests+n, r.Fails+n
+		}
+	}
+
+	return r
+}
+
+// ErrRate returns the percentage of failed requests.
+func (r BenchReport) ErrRate() float64 {
+	if r.Requests == 0 {
+		return 0
+	}
+
+	return float64(r.Fails) * 100 / float64(r.Requests)
+}
+
+func toFloat(mm []string) float64 {
+	if len(mm) < 2 {
+		return -1
+	}
+	f, err := strconv.ParseFloat(mm[1], 64)
+	if err != nil {
+		return -1
+	}
+
+	return f
+}
+
 // BenchInfo represents benchmark run info.
 type BenchInfo struct {
 	File os.FileInfo
@@ -23,19 +23,19 @@ func TestAugmentRow(t *testing.T) {
 	}{
 		"cool": {
 			"testdata/b1.txt",
-			model1.Fields{"pass", "3.3544", "29.8116", "100", "0"},
+			model1.Fields{"pass", "3.3544", "29.8116", "100", "0", "32.0ms", "103.1ms", "▁█▁▁▁▁▁▁▁▁▁"},
 		},
 		"2XX": {
 			"testdata/b4.txt",
-			model1.Fields{"pass", "3.3544", "29.8116", "160", "0"},
+			model1.Fields{"pass", "3.3544", "29.8116", "160", "0", "32.0ms", "103.1ms", "▁█▁▁▁▁▁▁▁▁▁"},
 		},
 		"4XX/5XX": {
 			"testdata/b2.txt",
-			model1.Fields{"pass", "3.3544", "29.8116", "100", "12"},
+			model1.Fields{"pass", "3.3544", "29.8116", "100", "12", "32.0ms", "103.1ms", "▁█▁▁▁▁▁▁▁▁▁"},
 		},
 		"toast": {
 			"testdata/b3.txt",
-			model1.Fields{"fail", "2.3688", "35.4606", "0", "0"},
+			model1.Fields{"fail", "2.3688", "35.4606", "0", "0", "n/a", "n/a", ""},
 		},
 	}
 
@@ -45,10 +45,44 @@ func TestAugmentRow(t *testing.T) {
 			data, err := os.ReadFile(u.file)
 
 			assert.Nil(t, err)
-			fields := make(model1.Fields, 8)
+			fields := make(model1.Fields, 13)
 			b := Benchmark{}
 			b.augmentRow(fields, string(data))
-			assert.Equal(t, u.e, fields[2:7])
+			assert.Equal(t, u.e, fields[2:10])
+		})
+	}
+}
+
+func TestParseBenchReport(t *testing.T) {
+	uu := map[string]struct {
+		file string
+		e    BenchReport
+		rate float64
+	}{
+		"cool": {
+			file: "testdata/b1.txt",
+			e:    BenchReport{Total: 3.3544, RPS: 29.8116, P50: 0.032, P95: 0.0394, P99: 0.1031, Requests: 100},
+		},
+		"4XX/5XX": {
+			file: "testdata/b2.txt",
+			e:    BenchReport{Total: 3.3544, RPS: 29.8116, P50: 0.032, P95: 0.0394, P99: 0.1031, Requests: 112, Fails: 12},
+			rate: 12 * 100 / 112.0,
+		},
+		"toast": {
+			file: "testdata/b3.txt",
+			e:    BenchReport{Failed: true, Total: 2.3688, RPS: 35.4606, P50: -1, P95: -1, P99: -1, Requests: 84, Fails: 84},
+			rate: 100,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			data, err := os.ReadFile(u.file)
+			assert.NoError(t, err)
+			r := ParseBenchReport(string(data))
+			assert.Equal(t, u.e, r)
+			assert.InDelta(t, u.rate, r.ErrRate(), 0.001)
 		})
 	}
 }
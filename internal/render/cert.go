@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	// CertExpiryWarnDays flags certificates expiring within a month.
+	CertExpiryWarnDays = 30
+
+	// CertExpiryCritDays flags certificates expiring within a week.
+	CertExpiryCritDays = 7
+
+	notAfterCol = "NOT-AFTER"
+	daysLeftCol = "DAYS-LEFT"
+)
+
+// CertNotAfter returns the expiry of the leaf certificate in a PEM bundle.
+func CertNotAfter(bb []byte) (time.Time, error) {
+	for {
+		var b *pem.Block
+		b, bb = pem.Decode(bb)
+		if b == nil {
+			return time.Time{}, errors.New("no certificate found")
+		}
+		if b.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(b.Bytes)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return cert.NotAfter, nil
+	}
+}
+
+// DaysLeft returns the number of whole days until a given time.
+func DaysLeft(t time.Time) int {
+	return int(time.Until(t).Hours() / 24)
+}
+
+// CertExpiryStatus returns an error when a certificate expired or is about to.
+func CertExpiryStatus(days int) error {
+	switch {
+	case days < 0:
+		return errors.New("certificate expired")
+	case days <= CertExpiryCritDays:
+		return fmt.Errorf("certificate expires in %d days", days)
+	default:
+		return nil
+	}
+}
+
+// certColorer flags rows with certificates expiring soon.
+func certColorer(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+	c := model1.DefaultColorer(ns, h, re)
+	if c == model1.ErrColor {
+		return c
+	}
+	idx, ok := h.IndexOf(daysLeftCol, true)
+	if !ok {
+		return c
+	}
+	days, err := strconv.Atoi(strings.TrimSpace(re.Row.Fields[idx]))
+	if err == nil && days <= CertExpiryWarnDays {
+		return model1.PendingColor
+	}
+
+	return c
+}
+
+func certCols(na *time.Time) (string, string) {
+	if na == nil {
+		return "", ""
+	}
+
+	return na.UTC().Format(time.RFC3339), strconv.Itoa(DaysLeft(*na))
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCertNotAfter(t *testing.T) {
+	exp := time.Now().Add(10*24*time.Hour + time.Hour).UTC().Truncate(time.Second)
+	cert := makeCert(t, exp)
+	key := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("blee")})
+
+	uu := map[string]struct {
+		pem  []byte
+		err  string
+		days int
+	}{
+		"cert": {
+			pem:  cert,
+			days: 10,
+		},
+		"bundle": {
+			pem:  append(key, cert...),
+			days: 10,
+		},
+		"none": {
+			pem: key,
+			err: "no certificate found",
+		},
+		"empty": {
+			err: "no certificate found",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			na, err := render.CertNotAfter(u.pem)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, exp, na)
+			assert.Equal(t, u.days, render.DaysLeft(na))
+		})
+	}
+}
+
+func TestCertExpiryStatus(t *testing.T) {
+	uu := map[string]struct {
+		days int
+		err  string
+	}{
+		"expired": {days: -1, err: "certificate expired"},
+		"crit":    {days: 7, err: "certificate expires in 7 days"},
+		"warn":    {days: 20},
+		"ok":      {days: 90},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := render.CertExpiryStatus(u.days)
+			if u.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestSecretRenderTLS(t *testing.T) {
+	exp := time.Now().Add(3*24*time.Hour + time.Hour).UTC().Truncate(time.Second)
+	sec := v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tls1"},
+		Type:       v1.SecretTypeTLS,
+		Data:       map[string][]byte{v1.TLSCertKey: makeCert(t, exp)},
+	}
+	raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&sec)
+	assert.NoError(t, err)
+
+	var (
+		r model1.Row
+		s render.Secret
+	)
+	assert.NoError(t, s.Render(&unstructured.Unstructured{Object: raw}, "", &r))
+	assert.Equal(t, "ns1/tls1", r.ID)
+	assert.Equal(t, model1.Fields{"ns1", "tls1", "kubernetes.io/tls", "1", exp.Format(time.RFC3339), "3", "certificate expires in 3 days"}, r.Fields[:7])
+}
+
+func TestCertificateRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"namespace": "ns1", "name": "c1"},
+		"spec": map[string]interface{}{
+			"secretName": "c1-tls",
+			"issuerRef":  map[string]interface{}{"name": "letsencrypt"},
+		},
+		"status": map[string]interface{}{
+			"notAfter": "2020-01-02T03:04:05Z",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+		},
+	}}
+
+	var (
+		r model1.Row
+		c render.Certificate
+	)
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, "ns1/c1", r.ID)
+	assert.Equal(t, model1.Fields{"ns1", "c1", "True", "c1-tls", "letsencrypt", "2020-01-02T03:04:05Z"}, r.Fields[:6])
+	assert.Equal(t, "certificate expired", r.Fields[7])
+}
+
+// Helpers...
+
+func makeCert(t *testing.T, notAfter time.Time) []byte {
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fred"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &k.PublicKey, k)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Certificate renders a cert-manager Certificate to screen.
+type Certificate struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Certificate) ColorerFunc() model1.ColorerFunc {
+	return certColorer
+}
+
+// Header returns a header row.
+func (Certificate) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "READY"},
+		model1.HeaderColumn{Name: "SECRET"},
+		model1.HeaderColumn{Name: "ISSUER"},
+		model1.HeaderColumn{Name: notAfterCol},
+		model1.HeaderColumn{Name: daysLeftCol, Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Certificate) Render(o interface{}, _ string, r *model1.Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Certificate, but got %T", o)
+	}
+
+	secret, _, _ := unstructured.NestedString(raw.Object, "spec", "secretName")
+	issuer, _, _ := unstructured.NestedString(raw.Object, "spec", "issuerRef", "name")
+	na, err := CertificateNotAfter(raw)
+	notAfter, days := certCols(na)
+	r.ID = client.FQN(raw.GetNamespace(), raw.GetName())
+	r.Fields = model1.Fields{
+		raw.GetNamespace(),
+		raw.GetName(),
+		certificateReady(raw),
+		secret,
+		issuer,
+		notAfter,
+		days,
+		AsStatus(secretDiagnose(na, err)),
+		ToAge(raw.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// CertificateNotAfter returns a cert-manager Certificate expiry if issued.
+func CertificateNotAfter(raw *unstructured.Unstructured) (*time.Time, error) {
+	s, ok, err := unstructured.NestedString(raw.Object, "status", "notAfter")
+	if err != nil || !ok || s == "" {
+		return nil, err
+	}
+	na, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &na, nil
+}
+
+func certificateReady(raw *unstructured.Unstructured) string {
+	cc, _, _ := unstructured.NestedSlice(raw.Object, "status", "conditions")
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok || m["type"] != "Ready" {
+			continue
+		}
+		if s, ok := m["status"].(string); ok {
+			return s
+		}
+	}
+
+	return MissingValue
+}
+
+// ----------------------------------------------------------------------------
+
+// Cert renders certificates expiry to screen.
+type Cert struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Cert) ColorerFunc() model1.ColorerFunc {
+	return certColorer
+}
+
+// Header returns a header row.
+func (Cert) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: notAfterCol},
+		model1.HeaderColumn{Name: daysLeftCol, Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Cert) Render(o interface{}, _ string, r *model1.Row) error {
+	res, ok := o.(CertRes)
+	if !ok {
+		return fmt.Errorf("expected CertRes, but got %T", o)
+	}
+
+	notAfter, days := certCols(&res.NotAfter)
+	r.ID = res.Kind + ":" + client.FQN(res.Namespace, res.Name)
+	r.Fields = model1.Fields{
+		res.Namespace,
+		res.Name,
+		res.Kind,
+		notAfter,
+		days,
+		AsStatus(CertExpiryStatus(DaysLeft(res.NotAfter))),
+	}
+
+	return nil
+}
+
+// CertRes represents a certificate expiry.
+type CertRes struct {
+	Namespace string
+	Name      string
+	Kind      string
+	NotAfter  time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (CertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c CertRes) DeepCopyObject() runtime.Object {
+	return c
+}
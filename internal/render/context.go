@@ -39,6 +39,7 @@ func (Context) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "CLUSTER"},
 		model1.HeaderColumn{Name: "AUTHINFO"},
 		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "FILE"},
 	}
 }
 
@@ -60,6 +61,7 @@ func (c Context) Render(o interface{}, _ string, r *model1.Row) error {
 		ctx.Context.Cluster,
 		ctx.Context.AuthInfo,
 		ctx.Context.Namespace,
+		ctx.Context.LocationOfOrigin,
 	}
 
 	return nil
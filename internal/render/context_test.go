@@ -15,7 +15,7 @@ import (
 func TestContextHeader(t *testing.T) {
 	var c render.Context
 
-	assert.Equal(t, 4, len(c.Header("")))
+	assert.Equal(t, 5, len(c.Header("")))
 }
 
 func TestContextRender(t *testing.T) {
@@ -36,7 +36,7 @@ func TestContextRender(t *testing.T) {
 			},
 			e: model1.Row{
 				ID:     "c1",
-				Fields: model1.Fields{"c1", "c1", "u1", "ns1"},
+				Fields: model1.Fields{"c1", "c1", "u1", "ns1", "fred"},
 			},
 		},
 	}
@@ -45,7 +45,7 @@ func TestContextRender(t *testing.T) {
 	for k := range uu {
 		uc := uu[k]
 		t.Run(k, func(t *testing.T) {
-			row := model1.NewRow(4)
+			row := model1.NewRow(5)
 			err := r.Render(uc.ctx, "", &row)
 
 			assert.Nil(t, err)
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/crash"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Crash renders crash reports to screen.
+type Crash struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Crash) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, _ model1.Header, re *model1.RowEvent) tcell.Color {
+		return tcell.ColorOrangeRed
+	}
+}
+
+// Header returns a header row.
+func (Crash) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "VERSION"},
+		model1.HeaderColumn{Name: "REASON"},
+		model1.HeaderColumn{Name: "VIEW"},
+		model1.HeaderColumn{Name: "LOGS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a crash report to screen.
+func (Crash) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(CrashRes)
+	if !ok {
+		return fmt.Errorf("expected CrashRes, but got %T", o)
+	}
+
+	var view string
+	if n := len(res.Report.Views); n > 0 {
+		view = res.Report.Views[n-1]
+	}
+	r.ID = res.Path
+	r.Fields = model1.Fields{
+		filepath.Base(res.Path),
+		res.Report.Version,
+		Truncate(res.Report.Reason, 80),
+		view,
+		strconv.Itoa(len(res.Report.Logs)),
+		"",
+		timeToAge(res.Report.Time),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// CrashRes represents a crash report resource.
+type CrashRes struct {
+	Path   string
+	Report crash.Report
+}
+
+// GetObjectKind returns a schema object.
+func (CrashRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a crash report copy.
+func (c CrashRes) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	deprecatedInRX = regexp.MustCompile(`deprecated in (v[\d.]+\+?)`)
+	removedInRX    = regexp.MustCompile(`unavailable in (v[\d.]+\+?)`)
+	replacementRX  = regexp.MustCompile(`use ([\w./-]+(?: \w+)?)`)
+)
+
+// Deprecation renders the api server deprecation warnings to screen.
+type Deprecation struct {
+	Base
+}
+
+// Header returns a header row.
+func (Deprecation) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "RESOURCE"},
+		model1.HeaderColumn{Name: "DEPRECATED"},
+		model1.HeaderColumn{Name: "REMOVED"},
+		model1.HeaderColumn{Name: "REPLACEMENT"},
+		model1.HeaderColumn{Name: "WARNINGS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "MESSAGE", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "LAST_SEEN", Time: true},
+	}
+}
+
+// Render renders a deprecation warning to screen.
+func (Deprecation) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(DeprecationRes)
+	if !ok {
+		return fmt.Errorf("expected DeprecationRes, but got %T", o)
+	}
+
+	r.ID = res.GVR
+	r.Fields = model1.Fields{
+		res.GVR,
+		matchOrNA(deprecatedInRX, res.Message),
+		matchOrNA(removedInRX, res.Message),
+		matchOrNA(replacementRX, res.Message),
+		strconv.Itoa(res.Count),
+		res.Message,
+		AsStatus(res.diagnose()),
+		ToAge(metav1.NewTime(res.LastSeen)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func matchOrNA(rx *regexp.Regexp, s string) string {
+	if mm := rx.FindStringSubmatch(s); len(mm) == 2 {
+		return mm[1]
+	}
+
+	return NAValue
+}
+
+// DeprecationRes represents an api server deprecation warning.
+type DeprecationRes struct {
+	client.Deprecation
+}
+
+func (d DeprecationRes) diagnose() error {
+	if removedInRX.MatchString(d.Message) {
+		return fmt.Errorf("%s is slated for removal", d.GVR)
+	}
+
+	return nil
+}
+
+// GetObjectKind returns a schema object.
+func (DeprecationRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a deprecation copy.
+func (d DeprecationRes) DeepCopyObject() runtime.Object {
+	return d
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecationRender(t *testing.T) {
+	uu := map[string]struct {
+		msg string
+		e   model1.Fields
+	}{
+		"removed": {
+			msg: "policy/v1beta1 PodDisruptionBudget is deprecated in v1.21+, unavailable in v1.25+; use policy/v1 PodDisruptionBudget",
+			e: model1.Fields{
+				"policy/v1beta1/poddisruptionbudgets",
+				"v1.21+",
+				"v1.25+",
+				"policy/v1 PodDisruptionBudget",
+				"3",
+				"policy/v1beta1 PodDisruptionBudget is deprecated in v1.21+, unavailable in v1.25+; use policy/v1 PodDisruptionBudget",
+				"policy/v1beta1/poddisruptionbudgets is slated for removal",
+			},
+		},
+		"deprecated": {
+			msg: "fred is deprecated",
+			e: model1.Fields{
+				"policy/v1beta1/poddisruptionbudgets",
+				render.NAValue,
+				render.NAValue,
+				render.NAValue,
+				"3",
+				"fred is deprecated",
+				"",
+			},
+		},
+	}
+
+	var d render.Deprecation
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res := render.DeprecationRes{
+				Deprecation: client.Deprecation{
+					GVR:      "policy/v1beta1/poddisruptionbudgets",
+					Message:  u.msg,
+					Count:    3,
+					LastSeen: time.Now(),
+				},
+			}
+			r := model1.NewRow(8)
+			assert.NoError(t, d.Render(res, "", &r))
+			assert.Equal(t, "policy/v1beta1/poddisruptionbudgets", r.ID)
+			assert.Equal(t, u.e, r.Fields[:7])
+		})
+	}
+}
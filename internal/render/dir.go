@@ -44,7 +44,10 @@ func (Dir) Render(o interface{}, ns string, r *model1.Row) error {
 	}
 
 	name := "🦄 "
-	if d.Entry.IsDir() {
+	switch {
+	case d.Kustomized:
+		name = "🧩 "
+	case d.Entry.IsDir():
 		name = "📁 "
 	}
 	name += d.Entry.Name()
@@ -58,8 +61,9 @@ func (Dir) Render(o interface{}, ns string, r *model1.Row) error {
 
 // DirRes represents an alias resource.
 type DirRes struct {
-	Entry os.DirEntry
-	Path  string
+	Entry      os.DirEntry
+	Path       string
+	Kustomized bool
 }
 
 // GetObjectKind returns a schema object.
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Find renders the cluster wide search results to screen.
+type Find struct {
+	Base
+}
+
+// Header returns a header row.
+func (Find) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "RESOURCE"},
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "MATCH"},
+		model1.HeaderColumn{Name: "VALUE"},
+		model1.HeaderColumn{Name: "SCORE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "SOURCE", Wide: true},
+	}
+}
+
+// Render renders a search result to screen.
+func (Find) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(FindRes)
+	if !ok {
+		return fmt.Errorf("expected FindRes, but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = model1.Fields{
+		res.GVR,
+		res.Namespace,
+		res.Name,
+		res.Match,
+		res.Value,
+		strconv.Itoa(res.Score),
+		res.Source,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// FindRes represents a resource matching a search query.
+type FindRes struct {
+	GVR       string
+	Namespace string
+	Name      string
+	Match     string
+	Value     string
+	Score     int
+	Source    string
+}
+
+// ID returns the result identifier ie the resource gvr and path.
+func (f FindRes) ID() string {
+	return f.GVR + " " + client.FQN(f.Namespace, f.Name)
+}
+
+// GetObjectKind returns a schema object.
+func (FindRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a search result copy.
+func (f FindRes) DeepCopyObject() runtime.Object {
+	return f
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindRender(t *testing.T) {
+	var (
+		f render.Find
+		r model1.Row
+	)
+	o := render.FindRes{
+		GVR:       "v1/pods",
+		Namespace: "default",
+		Name:      "fred",
+		Match:     "label",
+		Value:     "app=blee",
+		Score:     40,
+		Source:    "cache",
+	}
+
+	assert.NoError(t, f.Render(o, "", &r))
+	assert.Equal(t, "v1/pods default/fred", r.ID)
+	assert.Equal(t, model1.Fields{"v1/pods", "default", "fred", "label", "app=blee", "40", "cache"}, r.Fields)
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Fleet renders contexts health summaries to screen.
+type Fleet struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Fleet) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, r *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, r)
+		if c == model1.ErrColor {
+			return c
+		}
+		if strings.Contains(strings.TrimSpace(r.Row.Fields[0]), "*") {
+			return model1.HighlightColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Fleet) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "CONTEXT"},
+		model1.HeaderColumn{Name: "CLUSTER"},
+		model1.HeaderColumn{Name: "VERSION"},
+		model1.HeaderColumn{Name: "NODES", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "FAILING", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "CSRS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a context health summary to screen.
+func (Fleet) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(FleetRes)
+	if !ok {
+		return fmt.Errorf("expected FleetRes, but got %T", o)
+	}
+
+	name := res.Context
+	if res.Active {
+		name += "(*)"
+	}
+	nodes := NAValue
+	if res.Nodes >= 0 {
+		nodes = strconv.Itoa(res.NodesReady) + "/" + strconv.Itoa(res.Nodes)
+	}
+
+	r.ID = res.ID()
+	r.Fields = model1.Fields{
+		name,
+		res.Cluster,
+		res.Version,
+		nodes,
+		fleetCount(res.FailingPods),
+		fleetCount(res.PendingCSRs),
+		AsStatus(res.Health()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func fleetCount(n int) string {
+	if n < 0 {
+		return NAValue
+	}
+
+	return strconv.Itoa(n)
+}
+
+// FleetRes represents a context health summary. Negative counts are unknown.
+type FleetRes struct {
+	Context     string
+	Cluster     string
+	Version     string
+	Active      bool
+	Nodes       int
+	NodesReady  int
+	FailingPods int
+	PendingCSRs int
+	Err         error
+}
+
+// ID returns the summary identifier.
+func (f FleetRes) ID() string {
+	return f.Context
+}
+
+// Health returns the context health issues if any.
+func (f FleetRes) Health() error {
+	if f.Err != nil {
+		return f.Err
+	}
+	var ii []string
+	if f.Nodes >= 0 && f.NodesReady < f.Nodes {
+		ii = append(ii, fmt.Sprintf("%d nodes not ready", f.Nodes-f.NodesReady))
+	}
+	if f.FailingPods > 0 {
+		ii = append(ii, fmt.Sprintf("%d failing pods", f.FailingPods))
+	}
+	if len(ii) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", strings.Join(ii, ", "))
+}
+
+// GetObjectKind returns a schema object.
+func (FleetRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a summary copy.
+func (f FleetRes) DeepCopyObject() runtime.Object {
+	return f
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFleetRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.FleetRes
+		e model1.Fields
+	}{
+		"healthy": {
+			o: render.FleetRes{Context: "fred", Cluster: "c1", Version: "v1.29.1", Active: true, Nodes: 3, NodesReady: 3},
+			e: model1.Fields{"fred(*)", "c1", "v1.29.1", "3/3", "0", "0", ""},
+		},
+		"unhealthy": {
+			o: render.FleetRes{Context: "blee", Cluster: "c2", Version: "v1.28.0", Nodes: 3, NodesReady: 1, FailingPods: 2, PendingCSRs: -1},
+			e: model1.Fields{"blee", "c2", "v1.28.0", "1/3", "2", "n/a", "2 nodes not ready, 2 failing pods"},
+		},
+		"unreachable": {
+			o: render.FleetRes{Context: "zorg", Nodes: -1, FailingPods: -1, PendingCSRs: -1, Err: errors.New("dial failed")},
+			e: model1.Fields{"zorg", "", "", "n/a", "n/a", "n/a", "dial failed"},
+		},
+	}
+
+	var f render.Fleet
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			assert.NoError(t, f.Render(u.o, "", &r))
+			assert.Equal(t, u.o.Context, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -57,7 +57,7 @@ func (g *Generic) Header(ns string) model1.Header {
 			g.ageIndex = i
 			continue
 		}
-		h = append(h, model1.HeaderColumn{Name: strings.ToUpper(c.Name)})
+		h = append(h, model1.HeaderColumn{Name: strings.ToUpper(c.Name), Wide: c.Priority > 0})
 	}
 	if g.ageIndex > 0 {
 		h = append(h, model1.HeaderColumn{Name: "AGE", Time: true})
@@ -80,6 +80,16 @@ func TestGenericRender(t *testing.T) {
 				model1.HeaderColumn{Name: "AGE", Time: true},
 			},
 		},
+		"wide": {
+			ns:      client.ClusterScope,
+			table:   makeWideGeneric(),
+			eID:     "-/fred",
+			eFields: model1.Fields{"c1", "c2"},
+			eHeader: model1.Header{
+				model1.HeaderColumn{Name: "A"},
+				model1.HeaderColumn{Name: "B", Wide: true},
+			},
+		},
 	}
 
 	for k := range uu {
@@ -181,3 +191,14 @@ func makeAgeGeneric() *metav1beta1.Table {
 		},
 	}
 }
+
+func makeWideGeneric() *metav1beta1.Table {
+	t := makeNoNSGeneric()
+	t.ColumnDefinitions = []metav1beta1.TableColumnDefinition{
+		{Name: "a"},
+		{Name: "b", Priority: 1},
+	}
+	t.Rows[0].Cells = []interface{}{"c1", "c2"}
+
+	return t
+}
@@ -277,6 +277,21 @@ func toMi(v int64) string {
 	return strconv.Itoa(int(client.ToMB(v)))
 }
 
+// toBytes returns a human readable byte count.
+func toBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return strconv.FormatInt(n, 10) + "B"
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit && exp < 3; v /= unit {
+		div *= unit
+		exp++
+	}
+
+	return strconv.FormatFloat(float64(n)/float64(div), 'f', 1, 64) + string("KMGT"[exp]) + "i"
+}
+
 func boolPtrToStr(b *bool) string {
 	if b == nil {
 		return "false"
@@ -405,6 +405,23 @@ func TestToMi(t *testing.T) {
 	}
 }
 
+func TestToBytes(t *testing.T) {
+	uu := []struct {
+		v int64
+		e string
+	}{
+		{0, "0B"},
+		{1023, "1023B"},
+		{1536, "1.5Ki"},
+		{3 * client.MegaByte, "3.0Mi"},
+		{5 * 1024 * client.MegaByte, "5.0Gi"},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, toBytes(u.v))
+	}
+}
+
 func TestIntToStr(t *testing.T) {
 	uu := []struct {
 		v int
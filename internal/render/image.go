@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Image renders the images in use to screen.
+type Image struct {
+	Base
+}
+
+// Header returns a header row.
+func (Image) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "IMAGE"},
+		model1.HeaderColumn{Name: "TAG"},
+		model1.HeaderColumn{Name: "PULL-POLICY"},
+		model1.HeaderColumn{Name: "DIGESTS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "DRIFT"},
+		model1.HeaderColumn{Name: "PODS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "WORKLOADS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "STALE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders an image to screen.
+func (Image) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(ImageRes)
+	if !ok {
+		return fmt.Errorf("expected ImageRes, but got %T", o)
+	}
+
+	name, tag := res.Ref()
+	r.ID = res.Image
+	r.Fields = append(r.Fields,
+		name,
+		tag,
+		strings.Join(res.Policies, ","),
+		strconv.Itoa(len(res.Digests)),
+		boolToStr(res.Drifted()),
+		strconv.Itoa(len(res.Pods)),
+		strconv.Itoa(len(uniqueConsumers(res.Workloads))),
+		strconv.Itoa(len(res.StaleWorkloads())),
+		AsStatus(res.diagnose()),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ImageConsumer represents a workload or pod running an image.
+type ImageConsumer struct {
+	GVR    string
+	FQN    string
+	Digest string
+}
+
+// ImageRes represents an image in use and its consumers. Workloads are
+// tracked once per digest their pods run.
+type ImageRes struct {
+	Image     string
+	Policies  []string
+	Digests   map[string]time.Time
+	Pods      []ImageConsumer
+	Workloads []ImageConsumer
+}
+
+// Ref returns the image name and tag. Images pinned by digest report the
+// digest as their tag.
+func (i ImageRes) Ref() (string, string) {
+	if idx := strings.LastIndex(i.Image, "@"); idx >= 0 {
+		return i.Image[:idx], i.Image[idx+1:]
+	}
+	if idx := strings.LastIndex(i.Image, ":"); idx > strings.LastIndex(i.Image, "/") {
+		return i.Image[:idx], i.Image[idx+1:]
+	}
+
+	return i.Image, "latest"
+}
+
+// Pinned returns true if the image is referenced by digest.
+func (i ImageRes) Pinned() bool {
+	return strings.Contains(i.Image, "@")
+}
+
+// Drifted returns true if the image tag resolves to more than one digest.
+func (i ImageRes) Drifted() bool {
+	return !i.Pinned() && len(i.Digests) > 1
+}
+
+// CurrentDigest returns the digest pulled by the most recently started pod.
+func (i ImageRes) CurrentDigest() string {
+	var (
+		current string
+		latest  time.Time
+	)
+	for d, t := range i.Digests {
+		if current == "" || t.After(latest) || (t.Equal(latest) && d < current) {
+			current, latest = d, t
+		}
+	}
+
+	return current
+}
+
+// StaleWorkloads returns the workloads whose pods run an outdated digest of the image tag.
+func (i ImageRes) StaleWorkloads() []ImageConsumer {
+	if !i.Drifted() {
+		return nil
+	}
+	current := i.CurrentDigest()
+	cc := make([]ImageConsumer, 0, len(i.Workloads))
+	for _, w := range i.Workloads {
+		if w.Digest != current {
+			cc = append(cc, w)
+		}
+	}
+
+	return uniqueConsumers(cc)
+}
+
+func (i ImageRes) diagnose() error {
+	if !i.Drifted() {
+		return nil
+	}
+	_, tag := i.Ref()
+
+	return fmt.Errorf("tag %s resolves to %d digests", tag, len(i.Digests))
+}
+
+// uniqueConsumers returns the distinct consumers regardless of their digests.
+func uniqueConsumers(cc []ImageConsumer) []ImageConsumer {
+	uu := make([]ImageConsumer, 0, len(cc))
+	for _, c := range cc {
+		c.Digest = ""
+		if !slices.Contains(uu, c) {
+			uu = append(uu, c)
+		}
+	}
+
+	return uu
+}
+
+// GetObjectKind returns a schema object.
+func (ImageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns an image copy.
+func (i ImageRes) DeepCopyObject() runtime.Object {
+	return i
+}
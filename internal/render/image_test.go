@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageRef(t *testing.T) {
+	uu := map[string]struct {
+		img, name, tag string
+	}{
+		"tag": {
+			img:  "nginx:1.25",
+			name: "nginx",
+			tag:  "1.25",
+		},
+		"no-tag": {
+			img:  "nginx",
+			name: "nginx",
+			tag:  "latest",
+		},
+		"registry-port": {
+			img:  "localhost:5000/fred",
+			name: "localhost:5000/fred",
+			tag:  "latest",
+		},
+		"digest": {
+			img:  "nginx@sha256:abc",
+			name: "nginx",
+			tag:  "sha256:abc",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			name, tag := render.ImageRes{Image: u.img}.Ref()
+			assert.Equal(t, u.name, name)
+			assert.Equal(t, u.tag, tag)
+		})
+	}
+}
+
+func TestImageRender(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	res := render.ImageRes{
+		Image:    "nginx:1.25",
+		Policies: []string{"Always"},
+		Digests: map[string]time.Time{
+			"sha256:old": t0,
+			"sha256:new": t0.Add(time.Hour),
+		},
+		Pods: []render.ImageConsumer{
+			{GVR: "v1/pods", FQN: "default/p1", Digest: "sha256:old"},
+			{GVR: "v1/pods", FQN: "default/p2", Digest: "sha256:new"},
+			{GVR: "v1/pods", FQN: "default/p3", Digest: "sha256:new"},
+		},
+		Workloads: []render.ImageConsumer{
+			{GVR: "apps/v1/deployments", FQN: "default/dp1", Digest: "sha256:old"},
+			{GVR: "apps/v1/deployments", FQN: "default/dp1", Digest: "sha256:new"},
+		},
+	}
+
+	var (
+		i render.Image
+		r model1.Row
+	)
+	assert.NoError(t, i.Render(res, "", &r))
+	assert.Equal(t, "nginx:1.25", r.ID)
+	assert.Equal(t, model1.Fields{"nginx", "1.25", "Always", "2", "true", "3", "1", "1", "tag 1.25 resolves to 2 digests"}, r.Fields)
+}
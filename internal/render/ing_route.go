@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// IngressRoute renders an ingress resolved routes to screen.
+type IngressRoute struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (IngressRoute) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		if c == model1.ErrColor {
+			return c
+		}
+		idx, ok := h.IndexOf("ENDPOINTS", true)
+		if !ok {
+			return c
+		}
+		if ready, total, ok := strings.Cut(strings.TrimSpace(re.Row.Fields[idx]), "/"); ok && ready != total {
+			return model1.PendingColor
+		}
+
+		return model1.StdColor
+	}
+}
+
+// Header returns a header row.
+func (IngressRoute) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "HOST"},
+		model1.HeaderColumn{Name: "PATH"},
+		model1.HeaderColumn{Name: "SERVICE"},
+		model1.HeaderColumn{Name: "PORT"},
+		model1.HeaderColumn{Name: "ENDPOINTS"},
+		model1.HeaderColumn{Name: "TLS"},
+		model1.HeaderColumn{Name: "DAYS-LEFT", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (IngressRoute) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(IngressRouteRes)
+	if !ok {
+		return fmt.Errorf("expected IngressRouteRes, but got %T", o)
+	}
+
+	_, svc := client.Namespaced(res.Service)
+	r.ID = res.Service + "@" + strconv.Itoa(res.Index)
+	r.Fields = append(r.Fields,
+		res.Host,
+		res.Path,
+		svc,
+		res.Port,
+		res.endpoints(),
+		res.TLSSecret,
+		res.daysLeft(),
+		AsStatus(res.diagnose()),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// IngressRouteRes represents an ingress rule resolved to its backend.
+type IngressRouteRes struct {
+	Index       int
+	Host        string
+	Path        string
+	Service     string
+	Port        string
+	Ready       int
+	Total       int
+	TLSSecret   string
+	TLSDaysLeft *int
+	Issue       string
+}
+
+func (i IngressRouteRes) endpoints() string {
+	if i.Service == "" {
+		return NAValue
+	}
+
+	return strconv.Itoa(i.Ready) + "/" + strconv.Itoa(i.Total)
+}
+
+func (i IngressRouteRes) daysLeft() string {
+	if i.TLSDaysLeft == nil {
+		return ""
+	}
+
+	return strconv.Itoa(*i.TLSDaysLeft)
+}
+
+func (i IngressRouteRes) diagnose() error {
+	if i.Issue != "" {
+		return errors.New(i.Issue)
+	}
+	if i.Service != "" && i.Ready == 0 {
+		return errors.New("no ready endpoints")
+	}
+	if i.TLSDaysLeft != nil && *i.TLSDaysLeft < 0 {
+		return fmt.Errorf("tls certificate %s expired", i.TLSSecret)
+	}
+
+	return nil
+}
+
+// GetObjectKind returns a schema object.
+func (IngressRouteRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (i IngressRouteRes) DeepCopyObject() runtime.Object {
+	return i
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/logring"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const k9sLogTimeFmt = "15:04:05.000"
+
+// K9sLog renders k9s own log entries to screen.
+type K9sLog struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (K9sLog) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		idx, ok := h.IndexOf("LEVEL", true)
+		if !ok {
+			return model1.StdColor
+		}
+		switch re.Row.Fields[idx] {
+		case "ERROR", "FATAL", "PANIC":
+			return model1.ErrColor
+		case "WARN":
+			return model1.PendingColor
+		case "DEBUG", "TRACE":
+			return model1.CompletedColor
+		default:
+			return model1.StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (K9sLog) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "TIME"},
+		model1.HeaderColumn{Name: "LEVEL"},
+		model1.HeaderColumn{Name: "MESSAGE"},
+		model1.HeaderColumn{Name: "FIELDS"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a log entry to screen.
+func (K9sLog) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(K9sLogRes)
+	if !ok {
+		return fmt.Errorf("expected K9sLogRes, but got %T", o)
+	}
+
+	r.ID = strconv.FormatUint(res.Entry.Seq, 10)
+	r.Fields = model1.Fields{
+		res.Entry.Time.Format(k9sLogTimeFmt),
+		strings.ToUpper(res.Entry.Level.String()),
+		Truncate(strings.ReplaceAll(res.Entry.Message, "\n", " "), 120),
+		Truncate(res.Entry.FieldsString(), 80),
+		"",
+		timeToAge(res.Entry.Time),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// K9sLogRes represents a k9s log entry resource.
+type K9sLogRes struct {
+	Entry logring.Entry
+}
+
+// GetObjectKind returns a schema object.
+func (K9sLogRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a log entry copy.
+func (k K9sLogRes) DeepCopyObject() runtime.Object {
+	return k
+}
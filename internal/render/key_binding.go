@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// KeyBindingKeymap tracks bindings remapped via the keymap.
+const KeyBindingKeymap = "keymap"
+
+// KeyBinding renders a key binding to screen.
+type KeyBinding struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (KeyBinding) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		if !model1.IsValid(ns, h, re.Row) {
+			return model1.ErrColor
+		}
+		idx, ok := h.IndexOf("SOURCE", true)
+		if ok && re.Row.Fields[idx] == KeyBindingKeymap {
+			return model1.HighlightColor
+		}
+
+		return model1.StdColor
+	}
+}
+
+// Header returns a header row.
+func (KeyBinding) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "KEY"},
+		model1.HeaderColumn{Name: "ACTION"},
+		model1.HeaderColumn{Name: "SOURCE"},
+		model1.HeaderColumn{Name: "VISIBLE"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a key binding to screen.
+func (KeyBinding) Render(o interface{}, _ string, r *model1.Row) error {
+	kb, ok := o.(KeyBindingRes)
+	if !ok {
+		return fmt.Errorf("expecting a KeyBindingRes but got %T", o)
+	}
+
+	r.ID = kb.Key
+	if kb.Err != "" {
+		r.ID = "!" + strings.ToLower(kb.Action)
+	}
+	r.Fields = model1.Fields{
+		kb.Key,
+		kb.Action,
+		kb.Source,
+		boolToStr(kb.Visible),
+		kb.Err,
+	}
+
+	return nil
+}
+
+// KeyBindingRes represents a key binding resource.
+type KeyBindingRes struct {
+	Key     string
+	Action  string
+	Source  string
+	Visible bool
+	Err     string
+}
+
+// GetObjectKind returns a schema object.
+func (KeyBindingRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a key binding copy.
+func (k KeyBindingRes) DeepCopyObject() runtime.Object {
+	return k
+}
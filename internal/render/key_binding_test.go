@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyBindingRender(t *testing.T) {
+	uu := map[string]struct {
+		kb      render.KeyBindingRes
+		eID     string
+		eFields model1.Fields
+	}{
+		"builtin": {
+			kb:      render.KeyBindingRes{Key: "d", Action: "Describe", Source: "k9s", Visible: true},
+			eID:     "d",
+			eFields: model1.Fields{"d", "Describe", "k9s", "true", ""},
+		},
+		"conflict": {
+			kb:      render.KeyBindingRes{Key: "z", Action: "Describe", Source: "keymap", Err: "conflict"},
+			eID:     "!describe",
+			eFields: model1.Fields{"z", "Describe", "keymap", "false", "conflict"},
+		},
+	}
+
+	var re render.KeyBinding
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			assert.NoError(t, re.Render(u.kb, "", &r))
+			assert.Equal(t, u.eID, r.ID)
+			assert.Equal(t, u.eFields, r.Fields)
+		})
+	}
+}
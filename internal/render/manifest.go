@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ManifestChanged tracks a document that differs from the cluster.
+	ManifestChanged = "Changed"
+	// ManifestUnchanged tracks a document that matches the cluster.
+	ManifestUnchanged = "Unchanged"
+	// ManifestFailed tracks a document that failed its dry-run.
+	ManifestFailed = "Failed"
+)
+
+// Manifest renders manifest documents to screen.
+type Manifest struct {
+	Base
+}
+
+// Header returns a header row.
+func (Manifest) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "DOC", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "CHANGES"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a manifest document to screen.
+func (Manifest) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(ManifestRes)
+	if !ok {
+		return fmt.Errorf("expected ManifestRes, but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = append(r.Fields,
+		r.ID,
+		res.Kind,
+		res.Namespace,
+		res.Name,
+		res.Status(),
+		res.changes(),
+		AsStatus(res.Err),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ManifestRes represents a manifest document and its server-side dry-run diff.
+type ManifestRes struct {
+	Index     int
+	Kind      string
+	Namespace string
+	Name      string
+	Raw       []byte
+	Diff      string
+	Adds      int
+	Dels      int
+	Err       error
+}
+
+// ID returns the document id.
+func (m ManifestRes) ID() string {
+	return strconv.Itoa(m.Index + 1)
+}
+
+// Status returns the document dry-run status.
+func (m ManifestRes) Status() string {
+	switch {
+	case m.Err != nil:
+		return ManifestFailed
+	case m.Diff == "":
+		return ManifestUnchanged
+	default:
+		return ManifestChanged
+	}
+}
+
+func (m ManifestRes) changes() string {
+	if m.Err != nil || m.Diff == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("+%d -%d", m.Adds, m.Dels)
+}
+
+// GetObjectKind returns a schema object.
+func (ManifestRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a manifest copy.
+func (m ManifestRes) DeepCopyObject() runtime.Object {
+	return m
+}
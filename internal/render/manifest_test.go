@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.ManifestRes
+		e   model1.Row
+	}{
+		"unchanged": {
+			res: render.ManifestRes{Kind: "ConfigMap", Namespace: "ns1", Name: "cm1"},
+			e: model1.Row{
+				ID:     "1",
+				Fields: model1.Fields{"1", "ConfigMap", "ns1", "cm1", "Unchanged", "", ""},
+			},
+		},
+		"changed": {
+			res: render.ManifestRes{Index: 1, Kind: "Deployment", Namespace: "ns1", Name: "d1", Diff: "+a", Adds: 3, Dels: 1},
+			e: model1.Row{
+				ID:     "2",
+				Fields: model1.Fields{"2", "Deployment", "ns1", "d1", "Changed", "+3 -1", ""},
+			},
+		},
+		"failed": {
+			res: render.ManifestRes{Index: 2, Kind: "Fred", Name: "f1", Err: errors.New("no matches for kind")},
+			e: model1.Row{
+				ID:     "3",
+				Fields: model1.Fields{"3", "Fred", "", "f1", "Failed", "", "no matches for kind"},
+			},
+		},
+	}
+
+	var m render.Manifest
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			assert.NoError(t, m.Render(u.res, "", &r))
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NetSim renders network policies flows to screen.
+type NetSim struct {
+	Base
+}
+
+// Header returns a header row.
+func (NetSim) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "POD"},
+		model1.HeaderColumn{Name: "DIRECTION"},
+		model1.HeaderColumn{Name: "POLICY"},
+		model1.HeaderColumn{Name: "PEERS"},
+		model1.HeaderColumn{Name: "PORTS"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (NetSim) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(NetSimRes)
+	if !ok {
+		return fmt.Errorf("expected NetSimRes, but got %T", o)
+	}
+
+	pns, pod := client.Namespaced(res.Pod)
+	r.ID = res.Pod + ":" + strconv.Itoa(res.Index)
+	r.Fields = append(r.Fields,
+		pns,
+		pod,
+		res.Direction,
+		res.Policy,
+		res.Peer,
+		res.Ports,
+		AsStatus(res.diagnose()),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NetSimRes represents a pod allowed flow.
+type NetSimRes struct {
+	Pod       string
+	Index     int
+	Direction string
+	Policy    string
+	Peer      string
+	Ports     string
+	Covered   bool
+}
+
+func (n NetSimRes) diagnose() error {
+	if n.Covered {
+		return nil
+	}
+
+	return fmt.Errorf("no %s policy coverage", strings.ToLower(n.Direction))
+}
+
+// GetObjectKind returns a schema object.
+func (NetSimRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NetSimRes) DeepCopyObject() runtime.Object {
+	return n
+}
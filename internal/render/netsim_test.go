@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetSimRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.NetSimRes
+		e   model1.Row
+	}{
+		"covered": {
+			res: render.NetSimRes{Pod: "ns1/p1", Index: 1, Direction: "Ingress", Policy: "np1", Peer: "*", Ports: "TCP:80", Covered: true},
+			e: model1.Row{
+				ID:     "ns1/p1:1",
+				Fields: model1.Fields{"ns1", "p1", "Ingress", "np1", "*", "TCP:80", ""},
+			},
+		},
+		"uncovered": {
+			res: render.NetSimRes{Pod: "ns1/p1", Direction: "Egress", Peer: "*", Ports: "*"},
+			e: model1.Row{
+				ID:     "ns1/p1:0",
+				Fields: model1.Fields{"ns1", "p1", "Egress", "", "*", "*", "no egress policy coverage"},
+			},
+		},
+	}
+
+	var n render.NetSim
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			assert.NoError(t, n.Render(u.res, "", &r))
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
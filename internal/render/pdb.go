@@ -10,7 +10,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/tview"
-	v1beta1 "k8s.io/api/policy/v1beta1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -32,6 +32,7 @@ func (PodDisruptionBudget) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "CURRENT", Align: tview.AlignRight},
 		model1.HeaderColumn{Name: "DESIRED", Align: tview.AlignRight},
 		model1.HeaderColumn{Name: "EXPECTED", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "DRAINS"},
 		model1.HeaderColumn{Name: "LABELS", Wide: true},
 		model1.HeaderColumn{Name: "VALID", Wide: true},
 		model1.HeaderColumn{Name: "AGE", Time: true},
@@ -44,7 +45,7 @@ func (p PodDisruptionBudget) Render(o interface{}, ns string, r *model1.Row) err
 	if !ok {
 		return fmt.Errorf("expected PodDisruptionBudget, but got %T", o)
 	}
-	var pdb v1beta1.PodDisruptionBudget
+	var pdb policyv1.PodDisruptionBudget
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pdb)
 	if err != nil {
 		return err
@@ -60,26 +61,41 @@ func (p PodDisruptionBudget) Render(o interface{}, ns string, r *model1.Row) err
 		strconv.Itoa(int(pdb.Status.CurrentHealthy)),
 		strconv.Itoa(int(pdb.Status.DesiredHealthy)),
 		strconv.Itoa(int(pdb.Status.ExpectedPods)),
+		drainsStatus(&pdb),
 		mapToStr(pdb.Labels),
-		AsStatus(p.diagnose(pdb.Spec.MinAvailable, pdb.Status.CurrentHealthy)),
+		AsStatus(p.diagnose(&pdb)),
 		ToAge(pdb.GetCreationTimestamp()),
 	}
 
 	return nil
 }
 
-func (PodDisruptionBudget) diagnose(min *intstr.IntOrString, healthy int32) error {
-	if min == nil {
-		return nil
+func (PodDisruptionBudget) diagnose(pdb *policyv1.PodDisruptionBudget) error {
+	if min := pdb.Spec.MinAvailable; min != nil && min.IntVal > pdb.Status.CurrentHealthy {
+		return fmt.Errorf("expected %d but got %d", min.IntVal, pdb.Status.CurrentHealthy)
 	}
-	if min.IntVal > healthy {
-		return fmt.Errorf("expected %d but got %d", min.IntVal, healthy)
+	if blocksDrains(pdb) {
+		return fmt.Errorf("blocks node drains: %d/%d pods healthy and no disruptions allowed", pdb.Status.CurrentHealthy, pdb.Status.DesiredHealthy)
 	}
+
 	return nil
 }
 
 // Helpers...
 
+// blocksDrains returns true if evicting any of the covered pods is disallowed.
+func blocksDrains(pdb *policyv1.PodDisruptionBudget) bool {
+	return pdb.Status.ExpectedPods > 0 && pdb.Status.DisruptionsAllowed == 0
+}
+
+func drainsStatus(pdb *policyv1.PodDisruptionBudget) string {
+	if blocksDrains(pdb) {
+		return "Blocked"
+	}
+
+	return "Allowed"
+}
+
 func numbToStr(n *intstr.IntOrString) string {
 	if n == nil {
 		return NAValue
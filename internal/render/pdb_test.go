@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestPodDisruptionBudgetRender(t *testing.T) {
@@ -17,5 +18,19 @@ func TestPodDisruptionBudgetRender(t *testing.T) {
 
 	assert.NoError(t, c.Render(load(t, "pdb"), "", &r))
 	assert.Equal(t, "default/fred", r.ID)
-	assert.Equal(t, model1.Fields{"default", "fred", "2", render.NAValue, "0", "0", "2", "0"}, r.Fields[:8])
+	assert.Equal(t, model1.Fields{"default", "fred", "2", render.NAValue, "0", "0", "2", "0", "Allowed"}, r.Fields[:9])
+	assert.Equal(t, "expected 2 but got 0", r.Fields[10])
+}
+
+func TestPodDisruptionBudgetRenderBlocked(t *testing.T) {
+	o := load(t, "pdb")
+	for k, v := range map[string]int64{"currentHealthy": 2, "desiredHealthy": 2, "expectedPods": 2} {
+		assert.NoError(t, unstructured.SetNestedField(o.Object, v, "status", k))
+	}
+
+	c := render.PodDisruptionBudget{}
+	r := model1.NewRow(9)
+	assert.NoError(t, c.Render(o, "", &r))
+	assert.Equal(t, model1.Fields{"default", "fred", "2", render.NAValue, "0", "2", "2", "2", "Blocked"}, r.Fields[:9])
+	assert.Equal(t, "blocks node drains: 2/2 pods healthy and no disruptions allowed", r.Fields[10])
 }
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// PluginJobber represents a plugin job.
+type PluginJobber interface {
+	// ID returns the job id.
+	ID() string
+
+	// Name returns the plugin name.
+	Name() string
+
+	// Command returns the job command line.
+	Command() string
+
+	// Status returns the job status.
+	Status() string
+
+	// Started returns the job start time.
+	Started() time.Time
+
+	// Duration returns how long the job ran for.
+	Duration() time.Duration
+}
+
+// PluginJob renders a plugin job to screen.
+type PluginJob struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (PluginJob) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		idx, ok := h.IndexOf("STATUS", true)
+		if !ok {
+			return model1.StdColor
+		}
+		switch re.Row.Fields[idx] {
+		case "Running":
+			return model1.PendingColor
+		case "Failed":
+			return model1.ErrColor
+		case "Killed":
+			return model1.KillColor
+		default:
+			return model1.CompletedColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (PluginJob) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "ID"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "DURATION"},
+		model1.HeaderColumn{Name: "COMMAND", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a plugin job to screen.
+func (PluginJob) Render(o interface{}, _ string, r *model1.Row) error {
+	j, ok := o.(PluginJobRes)
+	if !ok {
+		return fmt.Errorf("expecting a PluginJobRes but got %T", o)
+	}
+
+	r.ID = j.ID()
+	r.Fields = model1.Fields{
+		j.ID(),
+		j.Name(),
+		j.Status(),
+		duration.HumanDuration(j.Duration()),
+		j.Command(),
+		"",
+		timeToAge(j.Started()),
+	}
+
+	return nil
+}
+
+// PluginJobRes represents a plugin job resource.
+type PluginJobRes struct {
+	PluginJobber
+}
+
+// GetObjectKind returns a schema object.
+func (PluginJobRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a plugin job copy.
+func (j PluginJobRes) DeepCopyObject() runtime.Object {
+	return j
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginJobRender(t *testing.T) {
+	var (
+		re render.PluginJob
+		r  model1.Row
+	)
+	o := render.PluginJobRes{PluginJobber: pluginJob{}}
+
+	assert.NoError(t, re.Render(o, "", &r))
+	assert.Equal(t, "1", r.ID)
+	assert.Equal(t, model1.Fields{
+		"1",
+		"fred",
+		"Running",
+		"2m",
+		"sh -c blee",
+		"",
+	}, r.Fields[:len(r.Fields)-1])
+	assert.Error(t, re.Render("blee", "", &r))
+}
+
+type pluginJob struct{}
+
+func (pluginJob) ID() string              { return "1" }
+func (pluginJob) Name() string            { return "fred" }
+func (pluginJob) Command() string         { return "sh -c blee" }
+func (pluginJob) Status() string          { return "Running" }
+func (pluginJob) Started() time.Time      { return time.Now().Add(-2 * time.Minute) }
+func (pluginJob) Duration() time.Duration { return 2 * time.Minute }
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PluginRows renders rows returned by a plugin.
+type PluginRows struct {
+	Base
+
+	header model1.Header
+	mx     sync.RWMutex
+}
+
+// IsGeneric identifies a generic handler.
+func (*PluginRows) IsGeneric() bool {
+	return true
+}
+
+// SetTable sets the plugin columns.
+func (p *PluginRows) SetTable(_ string, t *metav1.Table) {
+	h := make(model1.Header, 0, len(t.ColumnDefinitions))
+	for _, c := range t.ColumnDefinitions {
+		h = append(h, model1.HeaderColumn{Name: strings.ToUpper(c.Name), Wide: c.Priority > 0})
+	}
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	p.header = h
+}
+
+// Header returns a header row.
+func (p *PluginRows) Header(string) model1.Header {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.header
+}
+
+// Render renders a plugin row to screen.
+func (p *PluginRows) Render(o interface{}, _ string, r *model1.Row) error {
+	row, ok := o.(metav1.TableRow)
+	if !ok {
+		return fmt.Errorf("expecting a TableRow but got %T", o)
+	}
+	if len(row.Cells) == 0 {
+		return fmt.Errorf("expecting plugin row cells")
+	}
+
+	h := p.Header("")
+	r.Fields = make(model1.Fields, 0, len(h))
+	for i := range h {
+		if i >= len(row.Cells) || row.Cells[i] == nil {
+			r.Fields = append(r.Fields, Blank)
+			continue
+		}
+		r.Fields = append(r.Fields, fmt.Sprintf("%v", row.Cells[i]))
+	}
+	r.ID = p.rowID(h, r.Fields)
+
+	return nil
+}
+
+// rowID uses the NAMESPACE and NAME columns when present so plugins and
+// env vars resolve the row as a resource. Defaults to the first column.
+func (p *PluginRows) rowID(h model1.Header, ff model1.Fields) string {
+	var ns string
+	if idx, ok := h.IndexOf("NAMESPACE", true); ok {
+		ns = ff[idx]
+	}
+	if idx, ok := h.IndexOf("NAME", true); ok {
+		return client.FQN(ns, ff[idx])
+	}
+	if len(ff) == 0 {
+		return ""
+	}
+
+	return client.FQN(ns, ff[0])
+}
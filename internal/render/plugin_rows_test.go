@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPluginRowsRender(t *testing.T) {
+	uu := map[string]struct {
+		cols    []string
+		cells   []interface{}
+		eID     string
+		eFields model1.Fields
+	}{
+		"first-col": {
+			cols:    []string{"image", "count"},
+			cells:   []interface{}{"nginx", 2},
+			eID:     "nginx",
+			eFields: model1.Fields{"nginx", "2"},
+		},
+		"name": {
+			cols:    []string{"status", "name"},
+			cells:   []interface{}{"ok", "fred"},
+			eID:     "fred",
+			eFields: model1.Fields{"ok", "fred"},
+		},
+		"namespaced": {
+			cols:    []string{"namespace", "name", "status"},
+			cells:   []interface{}{"ns1", "fred", "ok"},
+			eID:     "ns1/fred",
+			eFields: model1.Fields{"ns1", "fred", "ok"},
+		},
+		"short": {
+			cols:    []string{"name", "status"},
+			cells:   []interface{}{"fred"},
+			eID:     "fred",
+			eFields: model1.Fields{"fred", ""},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var re render.PluginRows
+			table := metav1.Table{Rows: []metav1.TableRow{{Cells: u.cells}}}
+			for _, c := range u.cols {
+				table.ColumnDefinitions = append(table.ColumnDefinitions, metav1.TableColumnDefinition{Name: c})
+			}
+			re.SetTable("", &table)
+			assert.Equal(t, len(u.cols), len(re.Header("")))
+
+			var r model1.Row
+			assert.NoError(t, re.Render(table.Rows[0], "", &r))
+			assert.Equal(t, u.eID, r.ID)
+			assert.Equal(t, u.eFields, r.Fields)
+		})
+	}
+}
+
+func TestPluginRowsRenderFail(t *testing.T) {
+	var re render.PluginRows
+	var r model1.Row
+
+	assert.Error(t, re.Render("blee", "", &r))
+	assert.Error(t, re.Render(metav1.TableRow{}, "", &r))
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// DiagOK tracks a passing check.
+	DiagOK = "OK"
+	// DiagWarn tracks a check worth looking into.
+	DiagWarn = "WARN"
+	// DiagFail tracks a failing check.
+	DiagFail = "FAIL"
+)
+
+// PodDiag renders a pod troubleshooting findings to screen.
+type PodDiag struct {
+	Base
+}
+
+// Header returns a header row.
+func (PodDiag) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "POD"},
+		model1.HeaderColumn{Name: "CHECK"},
+		model1.HeaderColumn{Name: "RESULT"},
+		model1.HeaderColumn{Name: "FINDING"},
+		model1.HeaderColumn{Name: "NEXT"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PodDiag) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(PodDiagRes)
+	if !ok {
+		return fmt.Errorf("expected PodDiagRes, but got %T", o)
+	}
+
+	pns, pod := client.Namespaced(res.Pod)
+	r.ID = res.Pod + ":" + strconv.Itoa(res.Index)
+	r.Fields = append(r.Fields,
+		pns,
+		pod,
+		res.Check,
+		res.Result,
+		res.Finding,
+		res.Next,
+		AsStatus(res.diagnose()),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PodDiagRes represents a pod troubleshooting finding.
+type PodDiagRes struct {
+	Pod     string
+	Index   int
+	Check   string
+	Result  string
+	Finding string
+	Next    string
+}
+
+func (p PodDiagRes) diagnose() error {
+	if p.Result != DiagFail {
+		return nil
+	}
+
+	return errors.New(p.Finding)
+}
+
+// GetObjectKind returns a schema object.
+func (PodDiagRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PodDiagRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodDiagRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.PodDiagRes
+		e   model1.Row
+	}{
+		"ok": {
+			res: render.PodDiagRes{Pod: "ns1/p1", Check: "Scheduling", Result: render.DiagOK, Finding: "scheduled on n1"},
+			e: model1.Row{
+				ID:     "ns1/p1:0",
+				Fields: model1.Fields{"ns1", "p1", "Scheduling", "OK", "scheduled on n1", "", ""},
+			},
+		},
+		"fail": {
+			res: render.PodDiagRes{Pod: "ns1/p1", Index: 1, Check: "Volumes", Result: render.DiagFail, Finding: `claim "c1" is Pending`, Next: ":events /c1"},
+			e: model1.Row{
+				ID:     "ns1/p1:1",
+				Fields: model1.Fields{"ns1", "p1", "Volumes", "FAIL", `claim "c1" is Pending`, ":events /c1", `claim "c1" is Pending`},
+			},
+		},
+	}
+
+	var p render.PodDiag
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			assert.NoError(t, p.Render(u.res, "", &r))
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
@@ -4,8 +4,10 @@
 package render_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tcell/v2"
@@ -13,6 +15,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	res "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
@@ -167,6 +170,24 @@ func TestPodRender(t *testing.T) {
 	assert.Equal(t, e, r.Fields[:19])
 }
 
+func TestPodPatchReuse(t *testing.T) {
+	var po render.Pod
+	td := model1.NewTableData(client.NewGVR("v1/pods"))
+	oo := []runtime.Object{&render.PodWithMetrics{Raw: load(t, "po"), MX: makePodMX("nginx", "100m", "50Mi")}}
+	assert.NoError(t, td.Reconcile(context.Background(), &po, oo))
+
+	oo = []runtime.Object{&render.PodWithMetrics{Raw: load(t, "po"), MX: makePodMX("nginx", "200m", "50Mi")}}
+	assert.NoError(t, td.Patch(context.Background(), &po, oo, map[string]struct{}{}))
+	re, ok := td.FindRow("default/nginx")
+	assert.True(t, ok)
+	assert.Equal(t, "100", re.Row.Fields[7])
+
+	assert.NoError(t, td.Patch(context.Background(), &po, oo, map[string]struct{}{"default/nginx": {}}))
+	re, ok = td.FindRow("default/nginx")
+	assert.True(t, ok)
+	assert.Equal(t, "200", re.Row.Fields[7])
+}
+
 func BenchmarkPodRender(b *testing.B) {
 	pom := render.PodWithMetrics{
 		Raw: load(b, "po"),
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// PopeyeTrendWindow tracks the number of scores rendered in a trend.
+	PopeyeTrendWindow = 10
+
+	maxPopeyeScore = 100
+)
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+// PopeyeScore renders popeye scans scores to screen.
+type PopeyeScore struct {
+	Base
+}
+
+// Header returns a header row.
+func (PopeyeScore) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "TIME"},
+		model1.HeaderColumn{Name: "SCORE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "ERRORS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "DELTA", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "TREND"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PopeyeScore) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(PopeyeScoreRes)
+	if !ok {
+		return fmt.Errorf("expected PopeyeScoreRes, but got %T", o)
+	}
+
+	r.ID = strconv.Itoa(res.Index)
+	r.Fields = append(r.Fields,
+		res.Time.Format(time.RFC3339),
+		strconv.Itoa(res.Score),
+		strconv.Itoa(res.Errors),
+		deltaStr(res.Delta),
+		Sparkline(res.Trend, maxPopeyeScore),
+		AsStatus(res.diagnose()),
+		timeToAge(res.Time),
+	)
+
+	return nil
+}
+
+// Sparkline renders a collection of values as a sparkline scaled to max.
+func Sparkline(vv []int, max int) string {
+	if max <= 0 {
+		return ""
+	}
+	var b strings.Builder
+	for _, v := range vv {
+		switch {
+		case v < 0:
+			v = 0
+		case v > max:
+			v = max
+		}
+		b.WriteRune(sparks[v*(len(sparks)-1)/max])
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func deltaStr(d int) string {
+	if d > 0 {
+		return "+" + strconv.Itoa(d)
+	}
+
+	return strconv.Itoa(d)
+}
+
+// PopeyeScoreRes represents a popeye scan outcome.
+type PopeyeScoreRes struct {
+	Index  int
+	Time   time.Time
+	Score  int
+	Errors int
+	Delta  int
+	Trend  []int
+}
+
+func (p PopeyeScoreRes) diagnose() error {
+	if p.Delta >= 0 {
+		return nil
+	}
+
+	return fmt.Errorf("score regressed by %d", -p.Delta)
+}
+
+// GetObjectKind returns a schema object.
+func (PopeyeScoreRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PopeyeScoreRes) DeepCopyObject() runtime.Object {
+	return p
+}
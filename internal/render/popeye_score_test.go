@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline(t *testing.T) {
+	uu := map[string]struct {
+		vv  []int
+		max int
+		e   string
+	}{
+		"empty": {
+			max: 100,
+		},
+		"no-max": {
+			vv: []int{10, 20},
+		},
+		"scaled": {
+			vv:  []int{0, 50, 100},
+			max: 100,
+			e:   "▁▄█",
+		},
+		"clamped": {
+			vv:  []int{-10, 200},
+			max: 100,
+			e:   "▁█",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.Sparkline(u.vv, u.max))
+		})
+	}
+}
+
+func TestPopeyeScoreRender(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	uu := map[string]struct {
+		res render.PopeyeScoreRes
+		e   model1.Fields
+	}{
+		"improved": {
+			res: render.PopeyeScoreRes{Index: 1, Time: ts, Score: 90, Errors: 2, Delta: 5, Trend: []int{85, 90}},
+			e:   model1.Fields{"2024-01-02T03:04:05Z", "90", "2", "+5", "▆▇", ""},
+		},
+		"regressed": {
+			res: render.PopeyeScoreRes{Index: 1, Time: ts, Score: 80, Errors: 4, Delta: -10, Trend: []int{90, 80}},
+			e:   model1.Fields{"2024-01-02T03:04:05Z", "80", "4", "-10", "▇▆", "score regressed by 10"},
+		},
+	}
+
+	var r render.PopeyeScore
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var row model1.Row
+			assert.NoError(t, r.Render(u.res, "", &row))
+			assert.Equal(t, "1", row.ID)
+			assert.Equal(t, u.e, row.Fields[:len(row.Fields)-1])
+		})
+	}
+}
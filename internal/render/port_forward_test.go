@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/port"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,10 +34,13 @@ func TestPortForwardRender(t *testing.T) {
 		"co",
 		"p1:p2",
 		"http://0.0.0.0:p1/",
+		"2",
+		"512B",
+		"1.5Ki",
 		"1",
 		"1",
-		"",
-	}, r.Fields[:8])
+		"lost connection to pod",
+	}, r.Fields[:11])
 }
 
 // Helpers...
@@ -66,3 +70,7 @@ func (f fwd) Active() bool {
 func (f fwd) Age() time.Time {
 	return testTime()
 }
+
+func (f fwd) Stats() port.TunnelStats {
+	return port.TunnelStats{BytesIn: 512, BytesOut: 1536, Conns: 2, LastErr: "lost connection to pod"}
+}
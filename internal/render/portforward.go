@@ -5,12 +5,15 @@ package render
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/port"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -32,6 +35,9 @@ type Forwarder interface {
 
 	// Age returns forwarder age.
 	Age() time.Time
+
+	// Stats returns forwarder traffic stats.
+	Stats() port.TunnelStats
 }
 
 // PortForward renders a portforwards to screen.
@@ -41,7 +47,11 @@ type PortForward struct {
 
 // ColorerFunc colors a resource row.
 func (PortForward) ColorerFunc() model1.ColorerFunc {
-	return func(ns string, _ model1.Header, re *model1.RowEvent) tcell.Color {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		if !model1.IsValid(ns, h, re.Row) {
+			return model1.ErrColor
+		}
+
 		return tcell.ColorSkyblue
 	}
 }
@@ -54,6 +64,9 @@ func (PortForward) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "CONTAINER"},
 		model1.HeaderColumn{Name: "PORTS"},
 		model1.HeaderColumn{Name: "URL"},
+		model1.HeaderColumn{Name: "CONNS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "IN", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "OUT", Align: tview.AlignRight},
 		model1.HeaderColumn{Name: "C"},
 		model1.HeaderColumn{Name: "N"},
 		model1.HeaderColumn{Name: "VALID", Wide: true},
@@ -68,7 +81,7 @@ func (f PortForward) Render(o interface{}, gvr string, r *model1.Row) error {
 		return fmt.Errorf("expecting a ForwardRes but got %T", o)
 	}
 
-	ports := strings.Split(pf.Port(), ":")
+	ports, st := strings.Split(pf.Port(), ":"), pf.Stats()
 	r.ID = pf.ID()
 	ns, n := client.Namespaced(r.ID)
 
@@ -78,9 +91,12 @@ func (f PortForward) Render(o interface{}, gvr string, r *model1.Row) error {
 		pf.Container(),
 		pf.Port(),
 		UrlFor(pf.Config.Host, pf.Config.Path, ports[0]),
+		strconv.Itoa(st.Conns),
+		toBytes(st.BytesIn),
+		toBytes(st.BytesOut),
 		AsThousands(int64(pf.Config.C)),
 		AsThousands(int64(pf.Config.N)),
-		"",
+		st.LastErr,
 		ToAge(metav1.Time{Time: pf.Age()}),
 	}
 
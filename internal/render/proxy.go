@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Proxy renders cluster proxies to screen.
+type Proxy struct {
+	Base
+}
+
+// Header returns a header row.
+func (Proxy) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "PORT"},
+		model1.HeaderColumn{Name: "PROXY"},
+		model1.HeaderColumn{Name: "POD"},
+		model1.HeaderColumn{Name: "CONNS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "IN", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "OUT", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a cluster proxy to screen.
+func (Proxy) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(ProxyRes)
+	if !ok {
+		return fmt.Errorf("expected ProxyRes, but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = model1.Fields{
+		res.Port,
+		res.Address,
+		res.Pod,
+		strconv.Itoa(res.Stats.Conns),
+		toBytes(res.Stats.BytesIn),
+		toBytes(res.Stats.BytesOut),
+		res.Stats.LastErr,
+		ToAge(metav1.Time{Time: res.Started}),
+	}
+
+	return nil
+}
+
+// ProxyRes represents a local SOCKS5/HTTP proxy tunneled through the cluster.
+type ProxyRes struct {
+	Port    string
+	Address string
+	Pod     string
+	Started time.Time
+	Stats   port.TunnelStats
+}
+
+// ID returns the proxy identifier.
+func (p ProxyRes) ID() string {
+	return p.Port
+}
+
+// GetObjectKind returns a schema object.
+func (ProxyRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a proxy copy.
+func (p ProxyRes) DeepCopyObject() runtime.Object {
+	return p
+}
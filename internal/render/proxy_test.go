@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProxyRender(t *testing.T) {
+	o := render.ProxyRes{
+		Port:    "1080",
+		Address: "socks5h://127.0.0.1:1080",
+		Pod:     "default/k9s-proxy-1-1080",
+		Started: time.Now().Add(-2 * time.Minute),
+		Stats:   port.TunnelStats{Conns: 2, BytesIn: 2048, BytesOut: 10, LastErr: "nc: bad address"},
+	}
+
+	var (
+		p render.Proxy
+		r model1.Row
+	)
+	assert.NoError(t, p.Render(o, "", &r))
+	assert.Equal(t, "1080", r.ID)
+	assert.Equal(t, model1.Fields{
+		"1080",
+		"socks5h://127.0.0.1:1080",
+		"default/k9s-proxy-1-1080",
+		"2",
+		"2.0Ki",
+		"10B",
+		"nc: bad address",
+	}, r.Fields[:7])
+}
@@ -8,9 +8,12 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // PersistentVolumeClaim renders a K8s PersistentVolumeClaim to screen.
@@ -28,6 +31,8 @@ func (PersistentVolumeClaim) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "CAPACITY", Capacity: true},
 		model1.HeaderColumn{Name: "ACCESS MODES"},
 		model1.HeaderColumn{Name: "STORAGECLASS"},
+		model1.HeaderColumn{Name: "USED", Align: tview.AlignRight, Capacity: true},
+		model1.HeaderColumn{Name: "%USED", Align: tview.AlignRight},
 		model1.HeaderColumn{Name: "LABELS", Wide: true},
 		model1.HeaderColumn{Name: "VALID", Wide: true},
 		model1.HeaderColumn{Name: "AGE", Time: true},
@@ -36,9 +41,14 @@ func (PersistentVolumeClaim) Header(ns string) model1.Header {
 
 // Render renders a K8s resource to screen.
 func (p PersistentVolumeClaim) Render(o interface{}, ns string, r *model1.Row) error {
+	var stats *client.PVCStats
 	raw, ok := o.(*unstructured.Unstructured)
 	if !ok {
-		return fmt.Errorf("expected PersistentVolumeClaim, but got %T", o)
+		pws, ok := o.(*PVCWithStats)
+		if !ok {
+			return fmt.Errorf("expected PersistentVolumeClaim, but got %T", o)
+		}
+		raw, stats = pws.Raw, pws.Stats
 	}
 	var pvc v1.PersistentVolumeClaim
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pvc)
@@ -65,6 +75,12 @@ func (p PersistentVolumeClaim) Render(o interface{}, ns string, r *model1.Row) e
 		}
 	}
 
+	used, perc := NAValue, NAValue
+	if stats != nil {
+		used = resource.NewQuantity(stats.UsedBytes, resource.BinarySI).String()
+		perc = client.ToPercentageStr(stats.UsedBytes, stats.CapacityBytes)
+	}
+
 	r.ID = client.MetaFQN(pvc.ObjectMeta)
 	r.Fields = model1.Fields{
 		pvc.Namespace,
@@ -74,6 +90,8 @@ func (p PersistentVolumeClaim) Render(o interface{}, ns string, r *model1.Row) e
 		capacity,
 		accessModes,
 		class,
+		used,
+		perc,
 		mapToStr(pvc.Labels),
 		AsStatus(p.diagnose(string(phase))),
 		ToAge(pvc.GetCreationTimestamp()),
@@ -88,3 +106,24 @@ func (PersistentVolumeClaim) diagnose(r string) error {
 	}
 	return nil
 }
+
+// PVCWithStats represents a pvc and its volume usage.
+type PVCWithStats struct {
+	Raw   *unstructured.Unstructured
+	Stats *client.PVCStats
+}
+
+// GetObjectKind returns a schema object.
+func (p *PVCWithStats) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p *PVCWithStats) DeepCopyObject() runtime.Object {
+	return p
+}
+
+// RawObject returns the wrapped resource.
+func (p *PVCWithStats) RawObject() *unstructured.Unstructured {
+	return p.Raw
+}
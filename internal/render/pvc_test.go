@@ -6,6 +6,7 @@ package render_test
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
@@ -19,3 +20,16 @@ func TestPersistentVolumeClaimRender(t *testing.T) {
 	assert.Equal(t, "default/www-nginx-sts-0", r.ID)
 	assert.Equal(t, model1.Fields{"default", "www-nginx-sts-0", "Bound", "pvc-fbabd470-8725-11e9-a8e8-42010a80015b", "1Gi", "RWO", "standard"}, r.Fields[:7])
 }
+
+func TestPersistentVolumeClaimRenderWithStats(t *testing.T) {
+	c := render.PersistentVolumeClaim{}
+	r := model1.NewRow(12)
+
+	o := render.PVCWithStats{
+		Raw:   load(t, "pvc"),
+		Stats: &client.PVCStats{CapacityBytes: 1073741824, UsedBytes: 268435456},
+	}
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, "default/www-nginx-sts-0", r.ID)
+	assert.Equal(t, model1.Fields{"256Mi", "25"}, r.Fields[7:9])
+}
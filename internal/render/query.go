@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Query renders a resource query results to screen.
+type Query struct {
+	Base
+}
+
+// Header returns a header row.
+func (Query) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "RESULT"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a query result to screen.
+func (Query) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(QueryRes)
+	if !ok {
+		return fmt.Errorf("expected QueryRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(res.Namespace, res.Name)
+	r.Fields = model1.Fields{
+		res.Namespace,
+		res.Name,
+		res.Result,
+		AsStatus(res.Err),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// QueryRes represents a query result for a given resource.
+type QueryRes struct {
+	Namespace, Name string
+	Result          string
+	Err             error
+}
+
+// GetObjectKind returns a schema object.
+func (QueryRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a query result copy.
+func (q QueryRes) DeepCopyObject() runtime.Object {
+	return q
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.QueryRes
+		id string
+		e  model1.Fields
+	}{
+		"result": {
+			o:  render.QueryRes{Namespace: "default", Name: "fred", Result: "n1"},
+			id: "default/fred",
+			e:  model1.Fields{"default", "fred", "n1", ""},
+		},
+		"cluster": {
+			o:  render.QueryRes{Name: "fred", Result: "n1"},
+			id: "fred",
+			e:  model1.Fields{"", "fred", "n1", ""},
+		},
+		"error": {
+			o:  render.QueryRes{Namespace: "default", Name: "fred", Err: errors.New("boom")},
+			id: "default/fred",
+			e:  model1.Fields{"default", "fred", "", "boom"},
+		},
+	}
+
+	var q render.Query
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			assert.NoError(t, q.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
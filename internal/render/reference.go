@@ -31,6 +31,7 @@ func (Reference) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "NAMESPACE"},
 		model1.HeaderColumn{Name: "NAME"},
 		model1.HeaderColumn{Name: "GVR"},
+		model1.HeaderColumn{Name: "USAGE"},
 	}
 }
 
@@ -47,6 +48,7 @@ func (Reference) Render(o interface{}, ns string, r *model1.Row) error {
 		ref.Namespace,
 		ref.Name,
 		ref.GVR,
+		ref.Usage,
 	)
 
 	return nil
@@ -60,6 +62,7 @@ type ReferenceRes struct {
 	Namespace string
 	Name      string
 	GVR       string
+	Usage     string
 }
 
 // GetObjectKind returns a schema object.
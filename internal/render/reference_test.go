@@ -16,6 +16,7 @@ func TestReferenceRender(t *testing.T) {
 		Namespace: "ns1",
 		Name:      "blee",
 		GVR:       "v1/secrets",
+		Usage:     "env,volume",
 	}
 
 	var (
@@ -28,5 +29,6 @@ func TestReferenceRender(t *testing.T) {
 		"ns1",
 		"blee",
 		"v1/secrets",
+		"env,volume",
 	}, r.Fields)
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
@@ -33,6 +34,7 @@ func (ScreenDump) Header(ns string) model1.Header {
 	return model1.Header{
 		model1.HeaderColumn{Name: "NAME"},
 		model1.HeaderColumn{Name: "DIR"},
+		model1.HeaderColumn{Name: "SIZE", Align: tview.AlignRight},
 		model1.HeaderColumn{Name: "VALID", Wide: true},
 		model1.HeaderColumn{Name: "AGE", Time: true},
 	}
@@ -49,6 +51,7 @@ func (b ScreenDump) Render(o interface{}, ns string, r *model1.Row) error {
 	r.Fields = model1.Fields{
 		f.File.Name(),
 		f.Dir,
+		toBytes(f.File.Size()),
 		"",
 		timeToAge(f.File.ModTime()),
 	}
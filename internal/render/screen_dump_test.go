@@ -26,6 +26,7 @@ func TestScreenDumpRender(t *testing.T) {
 	assert.Equal(t, model1.Fields{
 		"bob",
 		"fred/blee",
+		"100B",
 		"",
 	}, r.Fields[:len(r.Fields)-1])
 }
@@ -6,12 +6,14 @@ package render
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -20,6 +22,11 @@ type Secret struct {
 	Base
 }
 
+// ColorerFunc colors a resource row.
+func (Secret) ColorerFunc() model1.ColorerFunc {
+	return certColorer
+}
+
 // Header returns a header rbw.
 func (Secret) Header(string) model1.Header {
 	return model1.Header{
@@ -27,6 +34,8 @@ func (Secret) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "NAME"},
 		model1.HeaderColumn{Name: "TYPE"},
 		model1.HeaderColumn{Name: "DATA"},
+		model1.HeaderColumn{Name: notAfterCol},
+		model1.HeaderColumn{Name: daysLeftCol, Align: tview.AlignRight},
 		model1.HeaderColumn{Name: "VALID", Wide: true},
 		model1.HeaderColumn{Name: "AGE", Time: true},
 	}
@@ -44,15 +53,46 @@ func (n Secret) Render(o interface{}, _ string, r *model1.Row) error {
 		return err
 	}
 
+	na, err := secretNotAfter(&sec)
+	notAfter, days := certCols(na)
 	r.ID = client.FQN(sec.Namespace, sec.Name)
 	r.Fields = model1.Fields{
 		sec.Namespace,
 		sec.Name,
 		string(sec.Type),
 		strconv.Itoa(len(sec.Data)),
-		"",
+		notAfter,
+		days,
+		AsStatus(secretDiagnose(na, err)),
 		ToAge(raw.GetCreationTimestamp()),
 	}
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// secretNotAfter returns a tls secret certificate expiry if any.
+func secretNotAfter(sec *v1.Secret) (*time.Time, error) {
+	if sec.Type != v1.SecretTypeTLS {
+		return nil, nil
+	}
+	na, err := CertNotAfter(sec.Data[v1.TLSCertKey])
+	if err != nil {
+		return nil, err
+	}
+
+	return &na, nil
+}
+
+func secretDiagnose(na *time.Time, err error) error {
+	if err != nil {
+		return err
+	}
+	if na == nil {
+		return nil
+	}
+
+	return CertExpiryStatus(DaysLeft(*na))
+}
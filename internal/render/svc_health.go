@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ServiceHealth renders a service pods health to screen.
+type ServiceHealth struct {
+	Base
+}
+
+// Header returns a header row.
+func (ServiceHealth) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "POD"},
+		model1.HeaderColumn{Name: "PORT"},
+		model1.HeaderColumn{Name: "TARGET"},
+		model1.HeaderColumn{Name: "ENDPOINT"},
+		model1.HeaderColumn{Name: "REACHABLE"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ServiceHealth) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(ServiceHealthRes)
+	if !ok {
+		return fmt.Errorf("expected ServiceHealthRes, but got %T", o)
+	}
+
+	pns, pod := client.Namespaced(res.Pod)
+	r.ID = res.Pod + ":" + strconv.Itoa(res.Index)
+	r.Fields = append(r.Fields,
+		pns,
+		pod,
+		res.Port,
+		res.Target,
+		res.Endpoint,
+		boolToStr(res.Reachable),
+		AsStatus(res.diagnose()),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ServiceHealthRes represents a service pod port health.
+type ServiceHealthRes struct {
+	Pod       string
+	Index     int
+	Port      string
+	Target    string
+	Endpoint  string
+	Reachable bool
+	Issue     string
+}
+
+func (s ServiceHealthRes) diagnose() error {
+	if s.Issue == "" {
+		return nil
+	}
+
+	return errors.New(s.Issue)
+}
+
+// GetObjectKind returns a schema object.
+func (ServiceHealthRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s ServiceHealthRes) DeepCopyObject() runtime.Object {
+	return s
+}
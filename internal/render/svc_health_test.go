@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceHealthRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.ServiceHealthRes
+		e   model1.Row
+	}{
+		"healthy": {
+			res: render.ServiceHealthRes{Pod: "ns1/p1", Port: "http:80/TCP", Target: "8080", Endpoint: "Ready", Reachable: true},
+			e: model1.Row{
+				ID:     "ns1/p1:0",
+				Fields: model1.Fields{"ns1", "p1", "http:80/TCP", "8080", "Ready", "true", ""},
+			},
+		},
+		"no-pods": {
+			res: render.ServiceHealthRes{Issue: "selector app=fred matches no pods"},
+			e: model1.Row{
+				ID:     ":0",
+				Fields: model1.Fields{"", "", "", "", "", "false", "selector app=fred matches no pods"},
+			},
+		},
+	}
+
+	var s render.ServiceHealth
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			assert.NoError(t, s.Render(u.res, "", &r))
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TopPod renders pods resource usage to screen.
+type TopPod struct {
+	Base
+}
+
+// Header returns a header row.
+func (TopPod) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "CONTAINERS", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "CPU", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "MEM", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "%CPU/R", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "ΔCPU", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "ΔMEM", Align: tview.AlignRight, MX: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (TopPod) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(TopRes)
+	if !ok {
+		return fmt.Errorf("expected TopRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(res.Namespace, res.Name)
+	r.Fields = append(r.Fields,
+		res.Namespace,
+		res.Name,
+		strconv.Itoa(res.Count),
+	)
+	r.Fields = append(r.Fields, res.usage()...)
+
+	return nil
+}
+
+// TopNamespace renders namespaces aggregated pods resource usage to screen.
+type TopNamespace struct {
+	Base
+}
+
+// Header returns a header row.
+func (TopNamespace) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "PODS", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "CPU", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "MEM", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "%CPU/R", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "ΔCPU", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "ΔMEM", Align: tview.AlignRight, MX: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (TopNamespace) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(TopRes)
+	if !ok {
+		return fmt.Errorf("expected TopRes, but got %T", o)
+	}
+
+	r.ID = res.Name
+	r.Fields = append(r.Fields,
+		res.Name,
+		strconv.Itoa(res.Count),
+	)
+	r.Fields = append(r.Fields, res.usage()...)
+
+	return nil
+}
+
+// TopNode renders nodes resource usage to screen.
+type TopNode struct {
+	Base
+}
+
+// Header returns a header row.
+func (TopNode) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "CPU", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "MEM", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "%CPU", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "%MEM", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "ΔCPU", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "ΔMEM", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "CPU/A", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "MEM/A", Align: tview.AlignRight, MX: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (TopNode) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(TopRes)
+	if !ok {
+		return fmt.Errorf("expected TopRes, but got %T", o)
+	}
+
+	r.ID = res.Name
+	r.Fields = append(r.Fields, res.Name)
+	r.Fields = append(r.Fields, res.usage()...)
+	r.Fields = append(r.Fields,
+		toMc(res.CPUCap),
+		toMi(res.MEMCap),
+	)
+
+	return nil
+}
+
+// PodRequests returns a pod cpu (millicores) and memory (bytes) requests.
+func PodRequests(po *v1.Pod) (int64, int64) {
+	cpu, mem := cosRequests(po.Spec.Containers)
+
+	return cpu.MilliValue(), mem.Value()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// TopRes represents a resource usage sample.
+type TopRes struct {
+	Namespace, Name    string
+	Count              int
+	CPU, MEM           int64
+	CPUCap, MEMCap     int64
+	DeltaCPU, DeltaMEM int64
+}
+
+func (t TopRes) usage() model1.Fields {
+	return model1.Fields{
+		toMc(t.CPU),
+		toMi(t.MEM),
+		client.ToPercentageStr(t.CPU, t.CPUCap),
+		client.ToPercentageStr(t.MEM, t.MEMCap),
+		deltaStr(int(t.DeltaCPU)),
+		deltaStr(int(client.ToMB(t.DeltaMEM))),
+	}
+}
+
+// GetObjectKind returns a schema object.
+func (TopRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t TopRes) DeepCopyObject() runtime.Object {
+	return t
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopPodRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.TopRes
+		e   model1.Fields
+	}{
+		"plain": {
+			res: render.TopRes{
+				Namespace: "ns1",
+				Name:      "p1",
+				Count:     2,
+				CPU:       50,
+				MEM:       200 * client.MegaByte,
+				CPUCap:    100,
+				MEMCap:    400 * client.MegaByte,
+				DeltaCPU:  -10,
+				DeltaMEM:  20 * client.MegaByte,
+			},
+			e: model1.Fields{"ns1", "p1", "2", "50", "200", "50", "50", "-10", "+20"},
+		},
+		"no-requests": {
+			res: render.TopRes{Namespace: "ns1", Name: "p1", Count: 1, CPU: 10, MEM: client.MegaByte},
+			e:   model1.Fields{"ns1", "p1", "1", "10", "1", "n/a", "n/a", "0", "0"},
+		},
+	}
+
+	var r render.TopPod
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var row model1.Row
+			assert.NoError(t, r.Render(u.res, "", &row))
+			assert.Equal(t, "ns1/p1", row.ID)
+			assert.Equal(t, u.e, row.Fields)
+			assert.Equal(t, len(r.Header("")), len(row.Fields))
+		})
+	}
+}
+
+func TestTopNamespaceRender(t *testing.T) {
+	var (
+		r   render.TopNamespace
+		row model1.Row
+	)
+	res := render.TopRes{Name: "ns1", Count: 3, CPU: 300, MEM: 2 * client.MegaByte, CPUCap: 600, DeltaCPU: 30}
+
+	assert.NoError(t, r.Render(res, "", &row))
+	assert.Equal(t, "ns1", row.ID)
+	assert.Equal(t, model1.Fields{"ns1", "3", "300", "2", "50", "n/a", "+30", "0"}, row.Fields)
+	assert.Equal(t, len(r.Header("")), len(row.Fields))
+}
+
+func TestTopNodeRender(t *testing.T) {
+	var (
+		r   render.TopNode
+		row model1.Row
+	)
+	res := render.TopRes{Name: "n1", CPU: 1000, MEM: 1024 * client.MegaByte, CPUCap: 4000, MEMCap: 4096 * client.MegaByte}
+
+	assert.NoError(t, r.Render(res, "", &row))
+	assert.Equal(t, "n1", row.ID)
+	assert.Equal(t, model1.Fields{"n1", "1000", "1024", "25", "25", "0", "0", "4000", "4096"}, row.Fields)
+	assert.Equal(t, len(r.Header("")), len(row.Fields))
+}
+
+func TestTopRenderToast(t *testing.T) {
+	var row model1.Row
+	assert.Error(t, render.TopPod{}.Render("fred", "", &row))
+}
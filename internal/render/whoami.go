@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WhoAmI renders the current user identity attributes to screen.
+type WhoAmI struct {
+	Base
+}
+
+// Header returns a header row.
+func (WhoAmI) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "SOURCE"},
+		model1.HeaderColumn{Name: "KEY"},
+		model1.HeaderColumn{Name: "VALUE"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders an identity attribute to screen.
+func (WhoAmI) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(WhoAmIRes)
+	if !ok {
+		return fmt.Errorf("expected WhoAmIRes, but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = model1.Fields{
+		res.Source,
+		res.Key,
+		res.Value,
+		AsStatus(res.Err),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// WhoAmIRes represents a user identity attribute.
+type WhoAmIRes struct {
+	Source string
+	Key    string
+	Value  string
+	Err    error
+}
+
+// ID returns the attribute identifier.
+func (w WhoAmIRes) ID() string {
+	return w.Source + ":" + w.Key
+}
+
+// GetObjectKind returns a schema object.
+func (WhoAmIRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns an attribute copy.
+func (w WhoAmIRes) DeepCopyObject() runtime.Object {
+	return w
+}
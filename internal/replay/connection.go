@@ -0,0 +1,286 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+// ContextName tracks the kubeconfig context of a replay.
+const ContextName = "replay"
+
+// ErrReplay indicates an operation that is not available while replaying.
+var ErrReplay = errors.New("not available while replaying a recording")
+
+var _ client.Connection = (*Connection)(nil)
+
+// Connection represents a fake api server connection backed by in memory
+// object trackers that recorded events get replayed against.
+type Connection struct {
+	config *client.Config
+	dyn    *dynamicfake.FakeDynamicClient
+	dial   *kubefake.Clientset
+	disco  discovery.CachedDiscoveryInterface
+}
+
+// NewConnection returns a new connection serving a recording resources.
+func NewConnection(cfg *client.Config, rec *Recording) *Connection {
+	rr := rec.APIResources()
+	info := rec.Header.Version
+	if info == nil {
+		info = &version.Info{GitVersion: "v0.0.0-replay"}
+	}
+
+	return &Connection{
+		config: cfg,
+		dyn:    newDynamicClient(rr),
+		dial:   kubefake.NewSimpleClientset(),
+		disco: memory.NewMemCacheClient(&fakediscovery.FakeDiscovery{
+			Fake:               &k8stesting.Fake{Resources: rr},
+			FakedServerVersion: info,
+		}),
+	}
+}
+
+// Apply applies a watch event to the connection resources.
+func (c *Connection) Apply(e Event) error {
+	gvr := client.NewGVR(e.GVR).GVR()
+	if err := apply(c.dyn.Tracker(), gvr, e.Type, e.Object.DeepCopy()); err != nil {
+		return fmt.Errorf("replay %s %s failed: %w", e.Type, e.GVR, err)
+	}
+
+	// Typed clients only know about standard resources.
+	gvk := e.Object.GroupVersionKind()
+	if !scheme.Scheme.Recognizes(gvk) {
+		return nil
+	}
+	o, err := scheme.Scheme.New(gvk)
+	if err != nil {
+		return err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(e.Object.Object, o); err != nil {
+		return err
+	}
+
+	return apply(c.dial.Tracker(), gvr, e.Type, o)
+}
+
+// CanI grants read access to all resources.
+func (c *Connection) CanI(_, _, _ string, verbs []string) (bool, error) {
+	for _, v := range verbs {
+		if !slices.Contains(client.ReadAllAccess, v) {
+			return false, fmt.Errorf("`%s access denied: %w", v, ErrReplay)
+		}
+	}
+
+	return true, nil
+}
+
+// Config returns current config.
+func (c *Connection) Config() *client.Config {
+	return c.config
+}
+
+// ConnectionOK checks api server connection status.
+func (c *Connection) ConnectionOK() bool {
+	return true
+}
+
+// Dial returns a typed client.
+func (c *Connection) Dial() (kubernetes.Interface, error) {
+	return c.dial, nil
+}
+
+// DialLogs returns a typed client for logs.
+func (c *Connection) DialLogs() (kubernetes.Interface, error) {
+	return c.dial, nil
+}
+
+// SwitchContext is not supported.
+func (c *Connection) SwitchContext(string) error {
+	return fmt.Errorf("context switch %w", ErrReplay)
+}
+
+// Impersonate is not supported.
+func (c *Connection) Impersonate(string, []string) error {
+	return fmt.Errorf("impersonation %w", ErrReplay)
+}
+
+// Relogin is not supported.
+func (c *Connection) Relogin(string) error {
+	return fmt.Errorf("relogin %w", ErrReplay)
+}
+
+// CachedDiscovery returns a discovery client for the recorded resources.
+func (c *Connection) CachedDiscovery() (discovery.CachedDiscoveryInterface, error) {
+	return c.disco, nil
+}
+
+// RestConfig is not supported.
+func (c *Connection) RestConfig() (*restclient.Config, error) {
+	return nil, fmt.Errorf("rest client %w", ErrReplay)
+}
+
+// MXDial is not supported.
+func (c *Connection) MXDial() (*versioned.Clientset, error) {
+	return nil, fmt.Errorf("metrics %w", ErrReplay)
+}
+
+// DynDial returns a dynamic client.
+func (c *Connection) DynDial() (dynamic.Interface, error) {
+	return c.dyn, nil
+}
+
+// HasMetrics checks if metrics are available.
+func (c *Connection) HasMetrics() bool {
+	return false
+}
+
+// HasMetricsAPI checks if metrics server is available.
+func (c *Connection) HasMetricsAPI() bool {
+	return false
+}
+
+// ValidNamespaceNames returns all recorded namespace names.
+func (c *Connection) ValidNamespaceNames() (client.NamespaceNames, error) {
+	l, err := c.dyn.Resource(client.NewGVR("v1/namespaces").GVR()).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	nn := make(client.NamespaceNames, len(l.Items))
+	for _, o := range l.Items {
+		nn[o.GetName()] = struct{}{}
+	}
+
+	return nn, nil
+}
+
+// IsValidNamespace checks if given namespace is known.
+func (c *Connection) IsValidNamespace(ns string) bool {
+	if client.IsClusterWide(ns) || ns == client.NotNamespaced {
+		return true
+	}
+	nn, err := c.ValidNamespaceNames()
+	if err != nil {
+		return false
+	}
+	_, ok := nn[ns]
+
+	return ok
+}
+
+// ServerVersion returns the recorded server version.
+func (c *Connection) ServerVersion() (*version.Info, error) {
+	return c.disco.ServerVersion()
+}
+
+// CheckConnectivity checks if api server connection is happy or not.
+func (c *Connection) CheckConnectivity() bool {
+	return true
+}
+
+// ActiveContext returns the current context name.
+func (c *Connection) ActiveContext() string {
+	n, err := c.config.CurrentContextName()
+	if err != nil {
+		return ContextName
+	}
+
+	return n
+}
+
+// ActiveNamespace returns the current namespace.
+func (c *Connection) ActiveNamespace() string {
+	if ns, err := c.config.CurrentNamespaceName(); err == nil {
+		return ns
+	}
+
+	return client.BlankNamespace
+}
+
+// IsActiveNamespace checks if given ns is active.
+func (c *Connection) IsActiveNamespace(ns string) bool {
+	if c.ActiveNamespace() == client.BlankNamespace {
+		return true
+	}
+
+	return c.ActiveNamespace() == ns
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newDynamicClient(rr []*metav1.APIResourceList) *dynamicfake.FakeDynamicClient {
+	s := runtime.NewScheme()
+	for gvk := range scheme.Scheme.AllKnownTypes() {
+		if strings.HasSuffix(gvk.Kind, "List") {
+			s.AddKnownTypeWithName(gvk, &unstructured.UnstructuredList{})
+			continue
+		}
+		s.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+	}
+
+	kinds := make(map[schema.GroupVersionResource]string)
+	for _, l := range rr {
+		for _, r := range l.APIResources {
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+			gvr := client.FromGVAndR(l.GroupVersion, r.Name).GVR()
+			kinds[gvr] = r.Kind + "List"
+			if gvk := gvr.GroupVersion().WithKind(r.Kind); !s.Recognizes(gvk) {
+				s.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+			}
+		}
+	}
+
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(s, kinds)
+}
+
+func apply(t k8stesting.ObjectTracker, gvr schema.GroupVersionResource, kind watch.EventType, o runtime.Object) error {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return err
+	}
+	ns := m.GetNamespace()
+	switch kind {
+	case watch.Added, watch.Modified:
+		err = t.Create(gvr, o, ns)
+		if kerrors.IsAlreadyExists(err) {
+			err = t.Update(gvr, o, ns)
+		}
+	case watch.Deleted:
+		err = t.Delete(gvr, ns, m.GetName())
+		if kerrors.IsNotFound(err) {
+			err = nil
+		}
+	}
+
+	return err
+}
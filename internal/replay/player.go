@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package replay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Player replays a recording against a connection.
+type Player struct {
+	conn  *Connection
+	rec   *Recording
+	speed float64
+	pos   int
+	mx    sync.Mutex
+}
+
+// NewPlayer returns a new player. Speed scales the recorded event delays, a
+// speed of 2 replays twice as fast. A speed <= 0 replays without delays.
+func NewPlayer(conn *Connection, rec *Recording, speed float64) *Player {
+	return &Player{
+		conn:  conn,
+		rec:   rec,
+		speed: speed,
+	}
+}
+
+// Conn returns the player connection.
+func (p *Player) Conn() *Connection {
+	return p.conn
+}
+
+// Done checks if all events were replayed.
+func (p *Player) Done() bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	return p.pos >= len(p.rec.Events)
+}
+
+// Step replays the next event. Returns false once the recording is exhausted.
+func (p *Player) Step() (bool, error) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.pos >= len(p.rec.Events) {
+		return false, nil
+	}
+	e := p.rec.Events[p.pos]
+	p.pos++
+
+	return true, p.conn.Apply(e)
+}
+
+// Seek replays all events up to the given recording offset.
+func (p *Player) Seek(offset time.Duration) error {
+	for {
+		next, ok := p.next()
+		if !ok || next > offset {
+			return nil
+		}
+		if _, err := p.Step(); err != nil {
+			return err
+		}
+	}
+}
+
+// Play replays the remaining events at the player speed until the recording
+// is exhausted or the context is canceled.
+func (p *Player) Play(ctx context.Context) error {
+	start, origin := time.Now(), p.offset()
+	for {
+		next, ok := p.next()
+		if !ok {
+			return nil
+		}
+		if p.speed > 0 {
+			delay := time.Duration(float64(next-origin)/p.speed) - time.Since(start)
+			if delay > 0 {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(delay):
+				}
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, err := p.Step(); err != nil {
+			log.Warn().Err(err).Msg("Replay event skipped")
+		}
+	}
+}
+
+// next returns the next event offset.
+func (p *Player) next() (time.Duration, bool) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.pos >= len(p.rec.Events) {
+		return 0, false
+	}
+
+	return p.rec.Events[p.pos].Offset.Duration, true
+}
+
+// offset returns the last replayed event offset.
+func (p *Player) offset() time.Duration {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.pos == 0 {
+		return 0
+	}
+
+	return p.rec.Events[p.pos-1].Offset.Duration
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package replay_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/replay"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func init() {
+	zerolog.SetGlobalLevel(zerolog.FatalLevel)
+}
+
+func TestPlayerSeek(t *testing.T) {
+	uu := map[string]struct {
+		offset time.Duration
+		pods   []string
+		done   bool
+	}{
+		"start": {
+			pods: []string{"nginx-1"},
+		},
+		"added": {
+			offset: 3 * time.Second,
+			pods:   []string{"nginx-1", "nginx-2"},
+		},
+		"deleted": {
+			offset: 10 * time.Second,
+			pods:   []string{"nginx-2"},
+			done:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := newPlayer(t, 0)
+			assert.NoError(t, p.Seek(u.offset))
+			assert.Equal(t, u.done, p.Done())
+
+			f := watch.NewFactory(p.Conn())
+			f.Start("demo")
+			defer f.Terminate()
+			oo, err := f.List("v1/pods", "demo", true, labels.Everything())
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, u.pods, names(oo))
+		})
+	}
+}
+
+func TestPlayerPlay(t *testing.T) {
+	p := newPlayer(t, 0)
+	f := watch.NewFactory(p.Conn())
+	f.Start(client.NamespaceAll)
+	defer f.Terminate()
+
+	assert.NoError(t, p.Seek(0))
+	oo, err := f.List("v1/pods", client.NamespaceAll, true, labels.Everything())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"nginx-1"}, names(oo))
+
+	assert.NoError(t, p.Play(context.Background()))
+	assert.True(t, p.Done())
+	assert.Eventually(t, func() bool {
+		oo, err := f.List("v1/pods", client.NamespaceAll, false, labels.Everything())
+		return err == nil && len(oo) == 1 && names(oo)[0] == "nginx-2"
+	}, time.Second, 10*time.Millisecond)
+
+	o, err := f.Get("v1/pods", "demo/nginx-2", true, labels.Everything())
+	assert.NoError(t, err)
+	phase, _, _ := unstructured.NestedString(o.(*unstructured.Unstructured).Object, "status", "phase")
+	assert.Equal(t, "Running", phase)
+}
+
+func TestPlayerPlayCanceled(t *testing.T) {
+	p := newPlayer(t, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, p.Play(ctx), context.DeadlineExceeded)
+	assert.False(t, p.Done())
+
+	// Events recorded at the start are replayed right away.
+	nn, err := p.Conn().ValidNamespaceNames()
+	assert.NoError(t, err)
+	assert.Equal(t, client.NamespaceNames{"default": {}, "demo": {}}, nn)
+}
+
+func TestPlayerRender(t *testing.T) {
+	p := newPlayer(t, 0)
+	assert.NoError(t, p.Seek(3*time.Second))
+	f := watch.NewFactory(p.Conn())
+	f.Start("demo")
+	defer f.Terminate()
+	_, err := f.ForResource("demo", "v1/pods")
+	assert.NoError(t, err)
+	f.WaitForCacheSync()
+
+	var po dao.Pod
+	po.Init(f, client.NewGVR("v1/pods"))
+	oo, err := po.List(context.Background(), "demo")
+	assert.NoError(t, err)
+
+	var re render.Pod
+	idx, ok := re.Header("demo").IndexOf("STATUS", true)
+	assert.True(t, ok)
+	status := make(map[string]string, len(oo))
+	for _, o := range oo {
+		var row model1.Row
+		assert.NoError(t, re.Render(o, "demo", &row))
+		status[row.ID] = row.Fields[idx]
+	}
+	assert.Equal(t, map[string]string{"demo/nginx-1": "Running", "demo/nginx-2": "Pending"}, status)
+}
+
+func TestConnection(t *testing.T) {
+	p := newPlayer(t, 0)
+	assert.NoError(t, p.Seek(0))
+	conn := p.Conn()
+
+	assert.Equal(t, replay.ContextName, conn.ActiveContext())
+	info, err := conn.ServerVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.29.2", info.GitVersion)
+	assert.True(t, conn.IsValidNamespace("demo"))
+	assert.False(t, conn.IsValidNamespace("fred"))
+	assert.ErrorIs(t, conn.SwitchContext("fred"), replay.ErrReplay)
+
+	ok, err := conn.CanI("demo", "v1/pods", "", client.ReadAllAccess)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	ok, err = conn.CanI("demo", "v1/pods", "", []string{client.DeleteVerb})
+	assert.False(t, ok)
+	assert.ErrorIs(t, err, replay.ErrReplay)
+
+	dial, err := conn.Dial()
+	assert.NoError(t, err)
+	pp, err := dial.CoreV1().Pods("demo").List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pp.Items))
+	assert.Equal(t, "nginx:1.25", pp.Items[0].Spec.Containers[0].Image)
+
+	disco, err := conn.CachedDiscovery()
+	assert.NoError(t, err)
+	rr, err := disco.ServerPreferredResources()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rr))
+}
+
+// Helpers...
+
+func newPlayer(t *testing.T, speed float64) *replay.Player {
+	rec, err := replay.LoadFile("testdata/demo.jsonl")
+	assert.NoError(t, err)
+	kubeConfig := "testdata/kubeconfig"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+
+	return replay.NewPlayer(replay.NewConnection(cfg, rec), rec, speed)
+}
+
+func names(oo []runtime.Object) []string {
+	nn := make([]string, 0, len(oo))
+	for _, o := range oo {
+		nn = append(nn, o.(*unstructured.Unstructured).GetName())
+	}
+
+	return nn
+}
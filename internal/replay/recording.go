@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Header describes the recorded cluster.
+type Header struct {
+	Context   string                    `json:"context,omitempty"`
+	Cluster   string                    `json:"cluster,omitempty"`
+	Version   *version.Info             `json:"version,omitempty"`
+	Resources []*metav1.APIResourceList `json:"resources,omitempty"`
+}
+
+// Event represents a recorded watch event.
+type Event struct {
+	// Offset tracks the event time since the recording started.
+	Offset metav1.Duration `json:"offset"`
+
+	// Type tracks the watch event type.
+	Type watch.EventType `json:"type"`
+
+	// GVR tracks the event resource. Guessed from the object kind when blank.
+	GVR string `json:"gvr,omitempty"`
+
+	// Object tracks the event resource.
+	Object *unstructured.Unstructured `json:"object"`
+}
+
+// Recording represents recorded watch event streams.
+type Recording struct {
+	Header Header
+	Events []Event
+}
+
+// record represents a recording line, either a header or an event.
+type record struct {
+	Header
+	Event
+}
+
+// Load reads a recording. Recordings are a stream of json values: an optional
+// header followed by watch events sorted by offset. Watch events as produced by
+// `kubectl get -w -o json --output-watch-events` are valid recording events.
+func Load(r io.Reader) (*Recording, error) {
+	var rec Recording
+	dec := json.NewDecoder(r)
+	for i := 0; ; i++ {
+		var l record
+		err := dec.Decode(&l)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid recording entry #%d: %w", i, err)
+		}
+		if l.Type == "" {
+			if i > 0 {
+				return nil, fmt.Errorf("invalid recording entry #%d: missing event type", i)
+			}
+			rec.Header = l.Header
+			continue
+		}
+		if l.Object == nil {
+			return nil, fmt.Errorf("invalid recording entry #%d: missing object", i)
+		}
+		rec.Events = append(rec.Events, l.Event)
+	}
+	if len(rec.Events) == 0 {
+		return nil, errors.New("invalid recording: no events found")
+	}
+	rec.resolveGVRs()
+	sort.SliceStable(rec.Events, func(i, j int) bool {
+		return rec.Events[i].Offset.Duration < rec.Events[j].Offset.Duration
+	})
+
+	return &rec, nil
+}
+
+// LoadFile reads a recording from a file.
+func LoadFile(file string) (*Recording, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+// Write writes out the recording.
+func (r *Recording) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(r.Header); err != nil {
+		return err
+	}
+	for _, e := range r.Events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Duration returns the recording length.
+func (r *Recording) Duration() time.Duration {
+	if len(r.Events) == 0 {
+		return 0
+	}
+
+	return r.Events[len(r.Events)-1].Offset.Duration
+}
+
+// APIResources returns the recorded resources. Resources missing from the
+// header are inferred from the recorded events.
+func (r *Recording) APIResources() []*metav1.APIResourceList {
+	gvs := make(map[string]*metav1.APIResourceList)
+	rr := make([]*metav1.APIResourceList, 0, len(r.Header.Resources))
+	known := make(map[string]struct{})
+	for _, l := range r.Header.Resources {
+		l := l.DeepCopy()
+		gvs[l.GroupVersion] = l
+		rr = append(rr, l)
+		for _, res := range l.APIResources {
+			known[client.FromGVAndR(l.GroupVersion, res.Name).String()] = struct{}{}
+		}
+	}
+
+	add := func(gvr client.GVR, kind string, namespaced bool) {
+		if _, ok := known[gvr.String()]; ok {
+			return
+		}
+		known[gvr.String()] = struct{}{}
+		gv := gvr.GV().String()
+		l, ok := gvs[gv]
+		if !ok {
+			l = &metav1.APIResourceList{GroupVersion: gv}
+			gvs[gv] = l
+			rr = append(rr, l)
+		}
+		l.APIResources = append(l.APIResources, metav1.APIResource{
+			Name:         gvr.R(),
+			SingularName: strings.ToLower(kind),
+			Kind:         kind,
+			Namespaced:   namespaced,
+			Verbs:        metav1.Verbs(client.ReadAllAccess),
+		})
+	}
+	for _, e := range r.Events {
+		add(client.NewGVR(e.GVR), e.Object.GetKind(), e.Object.GetNamespace() != "")
+	}
+	// K9s needs namespaces and pods to boot.
+	add(client.NewGVR("v1/namespaces"), "Namespace", false)
+	add(client.NewGVR("v1/pods"), "Pod", true)
+
+	return rr
+}
+
+// resolveGVRs guesses missing event resources from their kind.
+func (r *Recording) resolveGVRs() {
+	kinds := make(map[schema.GroupVersionKind]string)
+	for _, l := range r.Header.Resources {
+		for _, res := range l.APIResources {
+			gvr := client.FromGVAndR(l.GroupVersion, res.Name)
+			kinds[gvr.GV().WithKind(res.Kind)] = gvr.String()
+		}
+	}
+	for i := range r.Events {
+		if r.Events[i].GVR != "" {
+			continue
+		}
+		gvk := r.Events[i].Object.GroupVersionKind()
+		if gvr, ok := kinds[gvk]; ok {
+			r.Events[i].GVR = gvr
+			continue
+		}
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		r.Events[i].GVR = client.FromGVAndR(gvr.GroupVersion().String(), gvr.Resource).String()
+	}
+}
+
+// KubeConfig returns a kubeconfig describing the recorded cluster.
+func (r *Recording) KubeConfig() *clientcmdapi.Config {
+	cluster := r.Header.Cluster
+	if cluster == "" {
+		cluster = ContextName
+	}
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[cluster] = &clientcmdapi.Cluster{Server: "https://replay.invalid"}
+	cfg.AuthInfos[ContextName] = &clientcmdapi.AuthInfo{}
+	cfg.Contexts[ContextName] = &clientcmdapi.Context{
+		Cluster:  cluster,
+		AuthInfo: ContextName,
+	}
+	cfg.CurrentContext = ContextName
+
+	return cfg
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package replay_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/replay"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestLoad(t *testing.T) {
+	rec, err := replay.LoadFile("testdata/demo.jsonl")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "kind-demo", rec.Header.Context)
+	assert.Equal(t, "v1.29.2", rec.Header.Version.GitVersion)
+	assert.Equal(t, 7, len(rec.Events))
+	assert.Equal(t, 8*time.Second, rec.Duration())
+
+	e := rec.Events[4]
+	assert.Equal(t, watch.Added, e.Type)
+	assert.Equal(t, "v1/pods", e.GVR)
+	assert.Equal(t, "nginx-2", e.Object.GetName())
+	assert.Equal(t, 2*time.Second, e.Offset.Duration)
+}
+
+func TestLoadFail(t *testing.T) {
+	uu := map[string]struct {
+		data string
+		err  string
+	}{
+		"blank": {
+			err: "invalid recording: no events found",
+		},
+		"header-only": {
+			data: `{"context":"fred"}`,
+			err:  "invalid recording: no events found",
+		},
+		"toast": {
+			data: `{"context":`,
+			err:  "invalid recording entry #0: unexpected EOF",
+		},
+		"no-type": {
+			data: `{"context":"fred"} {"context":"blee"}`,
+			err:  "invalid recording entry #1: missing event type",
+		},
+		"no-object": {
+			data: `{"type":"ADDED"}`,
+			err:  "invalid recording entry #0: missing object",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := replay.Load(strings.NewReader(u.data))
+			assert.Equal(t, u.err, err.Error())
+		})
+	}
+}
+
+func TestLoadKubectlEvents(t *testing.T) {
+	data := `{"type":"ADDED","object":{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"name":"fred","namespace":"default"}}}
+{"type":"MODIFIED","object":{"apiVersion":"networking.k8s.io/v1","kind":"Ingress","metadata":{"name":"blee","namespace":"default"}}}`
+
+	rec, err := replay.Load(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rec.Events))
+	assert.Equal(t, "apps/v1/statefulsets", rec.Events[0].GVR)
+	assert.Equal(t, "networking.k8s.io/v1/ingresses", rec.Events[1].GVR)
+}
+
+func TestRecordingWrite(t *testing.T) {
+	rec, err := replay.LoadFile("testdata/demo.jsonl")
+	assert.NoError(t, err)
+
+	var buff bytes.Buffer
+	assert.NoError(t, rec.Write(&buff))
+	rec1, err := replay.Load(&buff)
+	assert.NoError(t, err)
+	assert.Equal(t, rec.Header, rec1.Header)
+	assert.Equal(t, rec.Events, rec1.Events)
+}
+
+func TestRecordingAPIResources(t *testing.T) {
+	rec, err := replay.LoadFile("testdata/demo.jsonl")
+	assert.NoError(t, err)
+
+	rr := make(map[string]bool)
+	for _, l := range rec.APIResources() {
+		for _, r := range l.APIResources {
+			rr[l.GroupVersion+"/"+r.Name] = r.Namespaced
+		}
+	}
+	assert.Equal(t, map[string]bool{
+		"v1/namespaces":       false,
+		"v1/pods":             true,
+		"apps/v1/deployments": true,
+	}, rr)
+}
@@ -0,0 +1,402 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/version"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// ContextName tracks the kubeconfig context serving a snapshot.
+	ContextName = "snapshot"
+
+	snapshotRV = "1"
+	sarPath    = "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews"
+)
+
+// Server serves a snapshot as a read-only api server.
+type Server struct {
+	snap     *Snapshot
+	listener net.Listener
+	srv      *http.Server
+	done     chan struct{}
+	once     sync.Once
+}
+
+// NewServer returns a new snapshot server.
+func NewServer(s *Snapshot) *Server {
+	return &Server{
+		snap: s,
+		done: make(chan struct{}),
+	}
+}
+
+// Start starts serving the snapshot on a local port.
+func (s *Server) Start() error {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	s.listener, s.srv = l, &http.Server{Handler: s}
+	go func() {
+		if err := s.srv.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msg("Snapshot server failed")
+		}
+	}()
+
+	return nil
+}
+
+// Stop stops the server.
+func (s *Server) Stop() {
+	s.once.Do(func() {
+		close(s.done)
+		if s.srv != nil {
+			_ = s.srv.Close()
+		}
+	})
+}
+
+// URL returns the server address.
+func (s *Server) URL() string {
+	if s.listener == nil {
+		return ""
+	}
+
+	return "http://" + s.listener.Addr().String()
+}
+
+// KubeConfig returns a kubeconfig pointing to the server.
+func (s *Server) KubeConfig() *clientcmdapi.Config {
+	cluster := s.snap.Meta.Cluster
+	if cluster == "" {
+		cluster = ContextName
+	}
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[cluster] = &clientcmdapi.Cluster{Server: s.URL()}
+	cfg.AuthInfos[ContextName] = &clientcmdapi.AuthInfo{}
+	cfg.Contexts[ContextName] = &clientcmdapi.Context{
+		Cluster:  cluster,
+		AuthInfo: ContextName,
+	}
+	cfg.CurrentContext = ContextName
+
+	return cfg
+}
+
+// ServeHTTP serves the snapshot api requests.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Trace().Msgf("[SNAPSHOT] %s %s", r.Method, r.URL)
+	if r.Method == http.MethodPost && r.URL.Path == sarPath {
+		s.review(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeStatus(w, http.StatusForbidden, metav1.StatusReasonForbidden, "snapshot is read-only")
+		return
+	}
+
+	switch p := strings.Trim(r.URL.Path, "/"); {
+	case p == "version":
+		info := s.snap.Meta.Version
+		if info == nil {
+			info = &version.Info{}
+		}
+		writeResponse(w, info)
+	case p == "api":
+		writeResponse(w, &metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+	case p == "apis":
+		writeResponse(w, s.groupList())
+	case strings.HasPrefix(p, "api/"):
+		s.serve(w, r, "v1", strings.Split(strings.TrimPrefix(p, "api/"), "/")[1:])
+	case strings.HasPrefix(p, "apis/"):
+		tt := strings.Split(strings.TrimPrefix(p, "apis/"), "/")
+		if len(tt) == 1 {
+			s.serveGroup(w, tt[0])
+			return
+		}
+		s.serve(w, r, tt[0]+"/"+tt[1], tt[2:])
+	default:
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("%s not found in snapshot", r.URL.Path))
+	}
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request, gv string, tt []string) {
+	if len(tt) == 0 || tt[0] == "" {
+		s.serveResources(w, gv)
+		return
+	}
+
+	var ns, res, name string
+	switch {
+	case len(tt) >= 3 && tt[0] == "namespaces":
+		ns, res = tt[1], tt[2]
+		tt = tt[3:]
+	default:
+		res = tt[0]
+		tt = tt[1:]
+	}
+	if len(tt) > 0 {
+		name, tt = tt[0], tt[1:]
+	}
+	if len(tt) > 0 {
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("%s/%s is not available in snapshot", res, tt[0]))
+		return
+	}
+
+	gvr := gv + "/" + res
+	if name != "" {
+		s.get(w, r, gvr, ns, name)
+		return
+	}
+	if r.URL.Query().Get("watch") == "true" {
+		s.watch(w, r)
+		return
+	}
+	s.list(w, r, gvr, ns)
+}
+
+func (s *Server) get(w http.ResponseWriter, r *http.Request, gvr, ns, name string) {
+	for _, o := range s.snap.Objects[gvr] {
+		if o.GetNamespace() == ns && o.GetName() == name {
+			if isTable(r) {
+				writeResponse(w, toTable([]unstructured.Unstructured{o}))
+				return
+			}
+			writeResponse(w, &o)
+			return
+		}
+	}
+	writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("%s %q not found", gvr, name))
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, gvr, ns string) {
+	q := r.URL.Query()
+	lsel, err := labels.Parse(q.Get("labelSelector"))
+	if err != nil {
+		writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+		return
+	}
+	fsel, err := fields.ParseSelector(q.Get("fieldSelector"))
+	if err != nil {
+		writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+		return
+	}
+
+	oo := make([]unstructured.Unstructured, 0, len(s.snap.Objects[gvr]))
+	for _, o := range s.snap.Objects[gvr] {
+		if ns != "" && o.GetNamespace() != ns {
+			continue
+		}
+		if !lsel.Matches(labels.Set(o.GetLabels())) || !matchFields(&o, fsel) {
+			continue
+		}
+		oo = append(oo, o)
+	}
+	if isTable(r) {
+		writeResponse(w, toTable(oo))
+		return
+	}
+
+	gv, res := splitGVR(gvr)
+	l := unstructured.UnstructuredList{
+		Object: map[string]interface{}{
+			"apiVersion": gv,
+			"kind":       s.kindFor(gv, res) + "List",
+		},
+		Items: oo,
+	}
+	l.SetResourceVersion(snapshotRV)
+	writeResponse(w, &l)
+}
+
+// watch hangs on to the request as snapshots never change.
+func (s *Server) watch(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	select {
+	case <-r.Context().Done():
+	case <-s.done:
+	}
+}
+
+// review grants read access to all resources.
+func (s *Server) review(w http.ResponseWriter, r *http.Request) {
+	var sar authorizationv1.SelfSubjectAccessReview
+	if err := json.NewDecoder(r.Body).Decode(&sar); err != nil {
+		writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+		return
+	}
+	if ra := sar.Spec.ResourceAttributes; ra != nil {
+		switch ra.Verb {
+		case "get", "list", "watch":
+			sar.Status.Allowed = true
+		default:
+			sar.Status.Reason = "snapshot is read-only"
+		}
+	}
+	sar.Kind, sar.APIVersion = "SelfSubjectAccessReview", authorizationv1.SchemeGroupVersion.String()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(&sar)
+}
+
+func (s *Server) groupList() *metav1.APIGroupList {
+	l := metav1.APIGroupList{
+		TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"},
+		Groups:   make([]metav1.APIGroup, 0, len(s.snap.Groups)),
+	}
+	for _, g := range s.snap.Groups {
+		if g.Name != "" {
+			l.Groups = append(l.Groups, *g)
+		}
+	}
+
+	return &l
+}
+
+func (s *Server) serveGroup(w http.ResponseWriter, n string) {
+	for _, g := range s.snap.Groups {
+		if g.Name == n {
+			grp := *g
+			grp.Kind, grp.APIVersion = "APIGroup", "v1"
+			writeResponse(w, &grp)
+			return
+		}
+	}
+	writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("group %q not found in snapshot", n))
+}
+
+func (s *Server) serveResources(w http.ResponseWriter, gv string) {
+	for _, l := range s.snap.Resources {
+		if l.GroupVersion == gv {
+			rl := *l
+			rl.Kind, rl.APIVersion = "APIResourceList", "v1"
+			writeResponse(w, &rl)
+			return
+		}
+	}
+	writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("%s not found in snapshot", gv))
+}
+
+func (s *Server) kindFor(gv, res string) string {
+	for _, l := range s.snap.Resources {
+		if l.GroupVersion != gv {
+			continue
+		}
+		for _, r := range l.APIResources {
+			if r.Name == res {
+				return r.Kind
+			}
+		}
+	}
+	if oo := s.snap.Objects[gv+"/"+res]; len(oo) > 0 {
+		return oo[0].GetKind()
+	}
+
+	return ""
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func splitGVR(gvr string) (string, string) {
+	i := strings.LastIndex(gvr, "/")
+
+	return gvr[:i], gvr[i+1:]
+}
+
+func isTable(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "as=Table")
+}
+
+// toTable renders resources the way the api server does for types without printer columns.
+func toTable(oo []unstructured.Unstructured) *metav1.Table {
+	t := metav1.Table{
+		TypeMeta: metav1.TypeMeta{Kind: "Table", APIVersion: metav1.SchemeGroupVersion.String()},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Created At", Type: "date"},
+		},
+		Rows: make([]metav1.TableRow, 0, len(oo)),
+	}
+	t.ResourceVersion = snapshotRV
+	for i := range oo {
+		raw, err := oo[i].MarshalJSON()
+		if err != nil {
+			log.Error().Err(err).Msgf("Snapshot encode failed for %s", oo[i].GetName())
+			continue
+		}
+		t.Rows = append(t.Rows, metav1.TableRow{
+			Cells:  []interface{}{oo[i].GetName(), oo[i].GetCreationTimestamp().UTC().Format(time.RFC3339)},
+			Object: runtime.RawExtension{Raw: raw},
+		})
+	}
+
+	return &t
+}
+
+func matchFields(o *unstructured.Unstructured, sel fields.Selector) bool {
+	for _, r := range sel.Requirements() {
+		v, _, _ := unstructured.NestedFieldNoCopy(o.Object, strings.Split(r.Field, ".")...)
+		val := ""
+		if v != nil {
+			val = fmt.Sprintf("%v", v)
+		}
+		switch r.Operator {
+		case selection.NotEquals:
+			if val == r.Value {
+				return false
+			}
+		default:
+			if val != r.Value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func writeStatus(w http.ResponseWriter, code int, reason metav1.StatusReason, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(&metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Code:     int32(code),
+		Reason:   reason,
+		Message:  msg,
+	})
+}
+
+func writeResponse(w http.ResponseWriter, o interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(o); err != nil {
+		log.Error().Err(err).Msg("Snapshot encode failed")
+	}
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestServerList(t *testing.T) {
+	uu := map[string]struct {
+		gvr  schema.GroupVersionResource
+		ns   string
+		opts metav1.ListOptions
+		e    []string
+	}{
+		"all": {
+			gvr: schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			e:   []string{"p1", "p2"},
+		},
+		"ns": {
+			gvr: schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			ns:  "kube-system",
+			e:   []string{"p2"},
+		},
+		"labels": {
+			gvr:  schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			opts: metav1.ListOptions{LabelSelector: "app=p1"},
+			e:    []string{"p1"},
+		},
+		"fields": {
+			gvr:  schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			opts: metav1.ListOptions{FieldSelector: "spec.nodeName=node-p2"},
+			e:    []string{"p2"},
+		},
+		"not-fields": {
+			gvr:  schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			opts: metav1.ListOptions{FieldSelector: "metadata.name!=p2"},
+			e:    []string{"p1"},
+		},
+		"group": {
+			gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+			ns:  "default",
+			e:   []string{"fred"},
+		},
+		"not-cached": {
+			gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"},
+			e:   []string{},
+		},
+	}
+
+	dial, err := dynamic.NewForConfig(serverConfig(t))
+	assert.NoError(t, err)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, err := dial.Resource(u.gvr).Namespace(u.ns).List(context.Background(), u.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, snapshotRV, l.GetResourceVersion())
+			nn := make([]string, 0, len(l.Items))
+			for _, o := range l.Items {
+				nn = append(nn, o.GetName())
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func TestServerGet(t *testing.T) {
+	dial, err := dynamic.NewForConfig(serverConfig(t))
+	assert.NoError(t, err)
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+	o, err := dial.Resource(gvr).Namespace("default").Get(context.Background(), "s1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "s1", o.GetName())
+
+	_, err = dial.Resource(gvr).Namespace("default").Get(context.Background(), "s2", metav1.GetOptions{})
+	assert.True(t, kerrors.IsNotFound(err))
+}
+
+func TestServerReadOnly(t *testing.T) {
+	dial, err := dynamic.NewForConfig(serverConfig(t))
+	assert.NoError(t, err)
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+	err = dial.Resource(gvr).Namespace("default").Delete(context.Background(), "p1", metav1.DeleteOptions{})
+	assert.True(t, kerrors.IsForbidden(err))
+	_, err = dial.Resource(gvr).Namespace("default").Create(context.Background(), makeObj("v1", "Pod", "default", "p3"), metav1.CreateOptions{})
+	assert.True(t, kerrors.IsForbidden(err))
+}
+
+func TestServerReview(t *testing.T) {
+	uu := map[string]struct {
+		verb string
+		e    bool
+	}{
+		"get":    {verb: "get", e: true},
+		"list":   {verb: "list", e: true},
+		"watch":  {verb: "watch", e: true},
+		"delete": {verb: "delete"},
+		"patch":  {verb: "patch"},
+	}
+
+	c, err := kubernetes.NewForConfig(serverConfig(t))
+	assert.NoError(t, err)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sar := authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{Verb: u.verb, Resource: "pods"},
+				},
+			}
+			resp, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(context.Background(), &sar, metav1.CreateOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, resp.Status.Allowed)
+		})
+	}
+}
+
+func TestServerDiscovery(t *testing.T) {
+	c, err := kubernetes.NewForConfig(serverConfig(t))
+	assert.NoError(t, err)
+
+	info, err := c.Discovery().ServerVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.29.1", info.GitVersion)
+
+	gg, rr, err := c.Discovery().ServerGroupsAndResources()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(gg))
+	assert.Equal(t, 2, len(rr))
+}
+
+func TestServerTable(t *testing.T) {
+	cfg := serverConfig(t)
+	req, err := http.NewRequest(http.MethodGet, cfg.Host+"/apis/apps/v1/namespaces/default/deployments", nil)
+	assert.NoError(t, err)
+	req.Header.Set("Accept", "application/json;as=Table;v=v1;g=meta.k8s.io, application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	var tt metav1.Table
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&tt))
+	assert.Equal(t, 2, len(tt.ColumnDefinitions))
+	assert.Equal(t, 1, len(tt.Rows))
+	assert.Equal(t, "fred", tt.Rows[0].Cells[0])
+
+	var o unstructured.Unstructured
+	assert.NoError(t, o.UnmarshalJSON(tt.Rows[0].Object.Raw))
+	assert.Equal(t, "Deployment", o.GetKind())
+}
+
+func TestServerInformer(t *testing.T) {
+	dial, err := dynamic.NewForConfig(serverConfig(t))
+	assert.NoError(t, err)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	f := dynamicinformer.NewDynamicSharedInformerFactory(dial, 0)
+	inf := f.ForResource(schema.GroupVersionResource{Version: "v1", Resource: "pods"})
+	f.Start(stop)
+	assert.True(t, cache.WaitForCacheSync(stop, inf.Informer().HasSynced))
+
+	oo, err := inf.Lister().ByNamespace("default").List(labels.Everything())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(oo))
+}
+
+func TestServerKubeConfig(t *testing.T) {
+	s := NewServer(makeSnapshot())
+	assert.NoError(t, s.Start())
+	defer s.Stop()
+
+	cfg, err := clientcmd.NewDefaultClientConfig(*s.KubeConfig(), nil).ClientConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, s.URL(), cfg.Host)
+
+	c, err := kubernetes.NewForConfig(cfg)
+	assert.NoError(t, err)
+	nn, err := c.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(nn.Items))
+}
+
+// Helpers...
+
+func serverConfig(t *testing.T) *rest.Config {
+	s := NewServer(makeSnapshot())
+	assert.NoError(t, s.Start())
+	t.Cleanup(s.Stop)
+
+	return &rest.Config{Host: s.URL()}
+}
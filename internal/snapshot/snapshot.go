@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+)
+
+const (
+	metaFile      = "meta.json"
+	groupsFile    = "discovery/groups.json"
+	resourcesFile = "discovery/resources.json"
+	resourcesDir  = "resources/"
+	jsonExt       = ".json"
+
+	secretGVR  = "v1/secrets"
+	metricsGRP = "metrics.k8s.io"
+)
+
+// Meta tracks where and when a snapshot was taken.
+type Meta struct {
+	Context   string        `json:"context"`
+	Cluster   string        `json:"cluster"`
+	Version   *version.Info `json:"version,omitempty"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
+
+// Snapshot represents the cached cluster state at a given time.
+type Snapshot struct {
+	Meta      Meta
+	Groups    []*metav1.APIGroup
+	Resources []*metav1.APIResourceList
+	Objects   map[string][]unstructured.Unstructured
+}
+
+// New returns a snapshot of all the factory active informers caches.
+func New(f *watch.Factory) (*Snapshot, error) {
+	conn := f.Client()
+	s := Snapshot{
+		Meta: Meta{
+			Context:   conn.ActiveContext(),
+			CreatedAt: time.Now().UTC(),
+		},
+		Objects: make(map[string][]unstructured.Unstructured),
+	}
+	if n, err := conn.Config().CurrentClusterName(); err == nil {
+		s.Meta.Cluster = n
+	}
+	if info, err := conn.ServerVersion(); err == nil {
+		s.Meta.Version = info
+	}
+
+	dial, err := conn.CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+	gg, rr, err := dial.ServerGroupsAndResources()
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, err
+		}
+		log.Warn().Err(err).Msg("Snapshot partial discovery")
+	}
+	s.setDiscovery(gg, rr)
+
+	for gvr, oo := range f.Cached() {
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			s.Objects[gvr] = append(s.Objects[gvr], *sanitize(gvr, u))
+		}
+	}
+
+	return &s, nil
+}
+
+// Load reads a snapshot from an archive.
+func Load(r io.Reader) (*Snapshot, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot archive: %w", err)
+	}
+	defer gz.Close()
+
+	s := Snapshot{Objects: make(map[string][]unstructured.Unstructured)}
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		switch {
+		case h.Name == metaFile:
+			err = json.NewDecoder(tr).Decode(&s.Meta)
+		case h.Name == groupsFile:
+			err = json.NewDecoder(tr).Decode(&s.Groups)
+		case h.Name == resourcesFile:
+			err = json.NewDecoder(tr).Decode(&s.Resources)
+		case strings.HasPrefix(h.Name, resourcesDir) && strings.HasSuffix(h.Name, jsonExt):
+			var l unstructured.UnstructuredList
+			bb, e := io.ReadAll(tr)
+			if e != nil {
+				return nil, e
+			}
+			if err = l.UnmarshalJSON(bb); err == nil {
+				gvr := strings.TrimSuffix(strings.TrimPrefix(h.Name, resourcesDir), jsonExt)
+				s.Objects[gvr] = l.Items
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshot entry %q: %w", h.Name, err)
+		}
+	}
+	if s.Meta.CreatedAt.IsZero() {
+		return nil, fmt.Errorf("invalid snapshot archive: missing %s", metaFile)
+	}
+
+	return &s, nil
+}
+
+// LoadFile reads a snapshot from a file.
+func LoadFile(file string) (*Snapshot, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+// Write archives the snapshot as a gzipped tarball.
+func (s *Snapshot) Write(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	if err := archive(tw, metaFile, s.Meta); err != nil {
+		return err
+	}
+	if err := archive(tw, groupsFile, s.Groups); err != nil {
+		return err
+	}
+	if err := archive(tw, resourcesFile, s.Resources); err != nil {
+		return err
+	}
+	for _, gvr := range s.GVRs() {
+		l := unstructured.UnstructuredList{
+			Object: map[string]interface{}{"kind": "List", "apiVersion": "v1"},
+			Items:  s.Objects[gvr],
+		}
+		if err := archive(tw, resourcesDir+gvr+jsonExt, &l); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// WriteFile archives the snapshot to a file.
+func (s *Snapshot) WriteFile(file string) error {
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if err := s.Write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// GVRs returns the sorted snapshot resources.
+func (s *Snapshot) GVRs() []string {
+	gvrs := make([]string, 0, len(s.Objects))
+	for gvr := range s.Objects {
+		gvrs = append(gvrs, gvr)
+	}
+	sort.Strings(gvrs)
+
+	return gvrs
+}
+
+// Count returns the number of resources in the snapshot.
+func (s *Snapshot) Count() int {
+	var count int
+	for _, oo := range s.Objects {
+		count += len(oo)
+	}
+
+	return count
+}
+
+func (s *Snapshot) setDiscovery(gg []*metav1.APIGroup, rr []*metav1.APIResourceList) {
+	// Metrics are not cached by informers, don't advertise them.
+	for _, g := range gg {
+		if g.Name != metricsGRP {
+			s.Groups = append(s.Groups, g)
+		}
+	}
+	for _, r := range rr {
+		if !strings.HasPrefix(r.GroupVersion, metricsGRP+"/") {
+			s.Resources = append(s.Resources, r)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// sanitize strips bulky fields and secret values off a resource.
+func sanitize(gvr string, o *unstructured.Unstructured) *unstructured.Unstructured {
+	u := o.DeepCopy()
+	u.SetManagedFields(nil)
+	if gvr != secretGVR {
+		return u
+	}
+	for _, k := range []string{"data", "stringData"} {
+		m, ok, _ := unstructured.NestedMap(u.Object, k)
+		if !ok {
+			continue
+		}
+		for key := range m {
+			m[key] = ""
+		}
+		_ = unstructured.SetNestedMap(u.Object, m, k)
+	}
+	if aa := u.GetAnnotations(); aa != nil {
+		delete(aa, "kubectl.kubernetes.io/last-applied-configuration")
+		u.SetAnnotations(aa)
+	}
+
+	return u
+}
+
+func archive(tw *tar.Writer, name string, o interface{}) error {
+	bb, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+	h := tar.Header{
+		Name:     path.Clean(name),
+		Mode:     0600,
+		Size:     int64(len(bb)),
+		ModTime:  time.Now(),
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(&h); err != nil {
+		return err
+	}
+	_, err = tw.Write(bb)
+
+	return err
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func TestSnapshotWriteLoad(t *testing.T) {
+	s := makeSnapshot()
+
+	var buff bytes.Buffer
+	assert.NoError(t, s.Write(&buff))
+	l, err := Load(&buff)
+	assert.NoError(t, err)
+
+	assert.Equal(t, s.Meta.Context, l.Meta.Context)
+	assert.Equal(t, s.Meta.Cluster, l.Meta.Cluster)
+	assert.Equal(t, s.Meta.Version, l.Meta.Version)
+	assert.True(t, s.Meta.CreatedAt.Equal(l.Meta.CreatedAt))
+	assert.Equal(t, []string{"apps/v1/deployments", "v1/pods", "v1/secrets"}, l.GVRs())
+	assert.Equal(t, 4, l.Count())
+	assert.Equal(t, 2, len(l.Groups))
+	assert.Equal(t, 2, len(l.Resources))
+	assert.Equal(t, "fred", l.Objects["apps/v1/deployments"][0].GetName())
+}
+
+func TestSnapshotLoadFail(t *testing.T) {
+	uu := map[string]struct {
+		data []byte
+	}{
+		"blank": {},
+		"toast": {
+			data: []byte("blee"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := Load(bytes.NewReader(u.data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	uu := map[string]struct {
+		gvr  string
+		o    *unstructured.Unstructured
+		data map[string]interface{}
+	}{
+		"pod": {
+			gvr: "v1/pods",
+			o:   makeObj("v1", "Pod", "default", "fred"),
+		},
+		"secret": {
+			gvr: secretGVR,
+			o:   makeSecret("default", "fred"),
+			data: map[string]interface{}{
+				"password": "",
+				"user":     "",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := sanitize(u.gvr, u.o)
+			assert.Empty(t, o.GetManagedFields())
+			assert.Empty(t, o.GetAnnotations()["kubectl.kubernetes.io/last-applied-configuration"])
+			data, _, _ := unstructured.NestedMap(o.Object, "data")
+			assert.Equal(t, u.data, data)
+			assert.NotEmpty(t, u.o.GetManagedFields())
+		})
+	}
+}
+
+func TestSetDiscovery(t *testing.T) {
+	var s Snapshot
+	s.setDiscovery(
+		[]*metav1.APIGroup{{Name: ""}, {Name: "apps"}, {Name: metricsGRP}},
+		[]*metav1.APIResourceList{{GroupVersion: "v1"}, {GroupVersion: "apps/v1"}, {GroupVersion: metricsGRP + "/v1beta1"}},
+	)
+
+	assert.Equal(t, 2, len(s.Groups))
+	assert.Equal(t, 2, len(s.Resources))
+}
+
+// Helpers...
+
+func makeSnapshot() *Snapshot {
+	return &Snapshot{
+		Meta: Meta{
+			Context:   "fred",
+			Cluster:   "blee",
+			Version:   &version.Info{Major: "1", Minor: "29", GitVersion: "v1.29.1"},
+			CreatedAt: time.Now().UTC().Truncate(time.Second),
+		},
+		Groups: []*metav1.APIGroup{
+			{
+				Name:     "",
+				Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "v1", Version: "v1"}},
+			},
+			{
+				Name:             "apps",
+				Versions:         []metav1.GroupVersionForDiscovery{{GroupVersion: "apps/v1", Version: "v1"}},
+				PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "apps/v1", Version: "v1"},
+			},
+		},
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+					{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+				},
+			},
+			{
+				GroupVersion: "apps/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+				},
+			},
+		},
+		Objects: map[string][]unstructured.Unstructured{
+			"v1/pods": {
+				*makeObj("v1", "Pod", "default", "p1"),
+				*makeObj("v1", "Pod", "kube-system", "p2"),
+			},
+			"v1/secrets": {
+				*makeSecret("default", "s1"),
+			},
+			"apps/v1/deployments": {
+				*makeObj("apps/v1", "Deployment", "default", "fred"),
+			},
+		},
+	}
+}
+
+func makeObj(apiVersion, kind, ns, n string) *unstructured.Unstructured {
+	var o unstructured.Unstructured
+	o.SetAPIVersion(apiVersion)
+	o.SetKind(kind)
+	o.SetNamespace(ns)
+	o.SetName(n)
+	o.SetLabels(map[string]string{"app": n})
+	o.SetCreationTimestamp(metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	o.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+	_ = unstructured.SetNestedField(o.Object, "node-"+n, "spec", "nodeName")
+
+	return &o
+}
+
+func makeSecret(ns, n string) *unstructured.Unstructured {
+	o := makeObj("v1", "Secret", ns, n)
+	o.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"})
+	_ = unstructured.SetNestedMap(o.Object, map[string]interface{}{
+		"user":     "ZnJlZA==",
+		"password": "YmxlZQ==",
+	}, "data")
+
+	return o
+}
@@ -73,6 +73,13 @@ func (s *SparkLine) Add(m Metric) {
 	s.data = append(s.data, m)
 }
 
+// SetMetrics replaces the graph metrics.
+func (s *SparkLine) SetMetrics(mm []Metric) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.data = mm
+}
+
 // Draw draws the graph.
 func (s *SparkLine) Draw(screen tcell.Screen) {
 	s.Component.Draw(screen)
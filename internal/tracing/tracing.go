@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName tracks the k9s tracer name.
+const TracerName = "github.com/derailed/k9s"
+
+// Span attributes keys.
+const (
+	CmdKey       = attribute.Key("k9s.command")
+	GVRKey       = attribute.Key("k9s.gvr")
+	NamespaceKey = attribute.Key("k9s.namespace")
+	CountKey     = attribute.Key("k9s.count")
+	SyncedKey    = attribute.Key("k9s.synced")
+)
+
+var enabled atomic.Bool
+
+// SetProvider installs the tracer provider and turns tracing on.
+func SetProvider(tp trace.TracerProvider) {
+	otel.SetTracerProvider(tp)
+	enabled.Store(true)
+}
+
+// Enabled checks if tracing is on.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Start starts a new span. Spans are no-ops unless tracing is enabled.
+func Start(ctx context.Context, name string, kvs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(kvs...))
+}
+
+// End ends a span, flagging it as failed if an error occurred.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// WrapTransport returns a round tripper tracing api server calls while
+// tracing is enabled.
+func WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &traceRoundTripper{
+		rt:     rt,
+		traced: otelhttp.NewTransport(rt),
+	}
+}
+
+type traceRoundTripper struct {
+	rt, traced http.RoundTripper
+}
+
+// RoundTrip executes a http request.
+func (t *traceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !Enabled() {
+		return t.rt.RoundTrip(req)
+	}
+
+	return t.traced.RoundTrip(req)
+}
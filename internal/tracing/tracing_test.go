@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/derailed/k9s/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSpans(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tracing.SetProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp)))
+	assert.True(t, tracing.Enabled())
+
+	ctx, span := tracing.Start(context.Background(), "command", tracing.CmdKey.String("pods"))
+	_, child := tracing.Start(ctx, "dao.list", tracing.GVRKey.String("v1/pods"))
+	tracing.End(child, errors.New("boom"))
+	tracing.End(span, nil)
+
+	ss := exp.GetSpans()
+	require.Len(t, ss, 2)
+	assert.Equal(t, "dao.list", ss[0].Name)
+	assert.Equal(t, codes.Error, ss[0].Status.Code)
+	assert.Equal(t, "boom", ss[0].Status.Description)
+	assert.Equal(t, ss[1].SpanContext.TraceID(), ss[0].Parent.TraceID())
+	assert.Equal(t, "command", ss[1].Name)
+	assert.Equal(t, codes.Unset, ss[1].Status.Code)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	c := http.Client{Transport: tracing.WrapTransport(http.DefaultTransport)}
+	resp, err := c.Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Len(t, exp.GetSpans(), 3)
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// Announcer announces messages to assistive technologies.
+type Announcer func(msg string)
+
+type statusMarker struct {
+	color          *tcell.Color
+	marker, status string
+}
+
+var (
+	accessible   bool
+	announcer    Announcer
+	stockBorders = tview.Borders
+	tagRX        = regexp.MustCompile(`\[[^\[\]]*\]`)
+
+	// statusMarkers conveys row statuses otherwise only signaled by colors.
+	statusMarkers = []statusMarker{
+		{color: &model1.ErrColor, marker: "!", status: "error"},
+		{color: &model1.KillColor, marker: "x", status: "terminating"},
+		{color: &model1.PendingColor, marker: "?", status: "pending"},
+		{color: &model1.AddColor, marker: "+", status: "added"},
+		{color: &model1.ModColor, marker: "~", status: "modified"},
+		{color: &model1.CompletedColor, marker: ".", status: "completed"},
+		{color: &model1.HighlightColor, marker: "*", status: "highlighted"},
+	}
+)
+
+// SetAccessible toggles the accessibility mode. Box drawings are swapped
+// for plain ascii borders.
+func SetAccessible(on bool) {
+	accessible = on
+	if !on {
+		tview.Borders = stockBorders
+		return
+	}
+
+	b := &tview.Borders
+	b.Horizontal, b.Vertical = '-', '|'
+	b.HorizontalFocus, b.VerticalFocus = '=', '|'
+	for _, r := range []*rune{
+		&b.TopLeft, &b.TopRight, &b.BottomLeft, &b.BottomRight,
+		&b.LeftT, &b.RightT, &b.TopT, &b.BottomT, &b.Cross,
+		&b.TopLeftFocus, &b.TopRightFocus, &b.BottomLeftFocus, &b.BottomRightFocus,
+	} {
+		*r = '+'
+	}
+}
+
+// IsAccessible returns true if the accessibility mode is on.
+func IsAccessible() bool {
+	return accessible
+}
+
+// SetAnnouncer registers the announcer of selection changes.
+func SetAnnouncer(a Announcer) {
+	announcer = a
+}
+
+// Announce announces a message when in accessibility mode.
+func Announce(msg string) {
+	if !accessible || announcer == nil {
+		return
+	}
+	announcer(msg)
+}
+
+// NewOSCAnnouncer returns an announcer setting the terminal title via the
+// OSC 2 escape sequence, which most screen readers pick up.
+func NewOSCAnnouncer(w io.Writer) Announcer {
+	return func(msg string) {
+		fmt.Fprintf(w, "\x1b]2;%s\a", strings.Map(func(r rune) rune {
+			if r < ' ' {
+				return -1
+			}
+			return r
+		}, msg))
+	}
+}
+
+// cursorAttrs returns the selected row attributes.
+func cursorAttrs() tcell.AttrMask {
+	if accessible {
+		return tcell.AttrBold | tcell.AttrUnderline
+	}
+
+	return tcell.AttrBold
+}
+
+// rowMarker returns a textual marker for a row status color.
+func rowMarker(c tcell.Color, marked bool) string {
+	if marked {
+		return "# "
+	}
+	if c == model1.StdColor {
+		return "  "
+	}
+	for _, m := range statusMarkers {
+		if *m.color == c {
+			return m.marker + " "
+		}
+	}
+
+	return "  "
+}
+
+// splitMarker extracts the status of a marked cell.
+func splitMarker(s string) (string, string) {
+	if len(s) < 2 || s[1] != ' ' {
+		return "", s
+	}
+	if s[0] == '#' {
+		return "marked", s[2:]
+	}
+	for _, m := range statusMarkers {
+		if s[0] == m.marker[0] {
+			return m.status, s[2:]
+		}
+	}
+
+	return "", s
+}
+
+func plainText(s string) string {
+	return strings.TrimRight(strings.TrimSpace(tagRX.ReplaceAllString(s, "")), ascIndicator+descIndicator+"0123456789")
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAccessible(t *testing.T) {
+	stock := tview.Borders
+	ui.SetAccessible(true)
+	assert.True(t, ui.IsAccessible())
+	assert.Equal(t, '-', tview.Borders.Horizontal)
+	assert.Equal(t, '+', tview.Borders.TopLeftFocus)
+
+	ui.SetAccessible(false)
+	assert.False(t, ui.IsAccessible())
+	assert.Equal(t, stock, tview.Borders)
+}
+
+func TestAnnounce(t *testing.T) {
+	var msg string
+	ui.SetAnnouncer(func(s string) { msg = s })
+	defer ui.SetAnnouncer(nil)
+
+	ui.Announce("blee")
+	assert.Empty(t, msg)
+
+	ui.SetAccessible(true)
+	defer ui.SetAccessible(false)
+	ui.Announce("blee")
+	assert.Equal(t, "blee", msg)
+}
+
+func TestOSCAnnouncer(t *testing.T) {
+	var w bytes.Buffer
+	ui.NewOSCAnnouncer(&w)("Row 1 of 2:\n blee")
+
+	assert.Equal(t, "\x1b]2;Row 1 of 2: blee\a", w.String())
+}
+
+func TestTableAnnounce(t *testing.T) {
+	var msg string
+	ui.SetAnnouncer(func(s string) { msg = s })
+	defer ui.SetAnnouncer(nil)
+	ui.SetAccessible(true)
+	defer ui.SetAccessible(false)
+
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	data := makeTableData()
+	v.UpdateUI(v.Update(data, false), data)
+	v.Select(2, 0)
+
+	assert.Equal(t, "Row 2 of 2: A blee, B duh, C zorg", msg)
+	assert.Equal(t, "blee", v.GetSelectedCell(0))
+}
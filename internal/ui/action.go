@@ -4,12 +4,13 @@
 package ui
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/tcell/v2"
-	"github.com/rs/zerolog/log"
 )
 
 type (
@@ -26,6 +27,7 @@ type (
 		Plugin    bool
 		HotKey    bool
 		Dangerous bool
+		Remapped  bool
 	}
 
 	// KeyAction represents a keyboard action.
@@ -33,6 +35,8 @@ type (
 		Description string
 		Action      ActionHandler
 		Opts        ActionOpts
+
+		origKey tcell.Key
 	}
 
 	// KeyMap tracks key to action mappings.
@@ -189,6 +193,50 @@ func (a *KeyActions) Delete(kk ...tcell.Key) {
 	}
 }
 
+// Remap binds a built-in action to a new key. The action is matched
+// on its description. Remapping onto a key bound to another action
+// is reported as a conflict.
+func (a *KeyActions) Remap(action string, key tcell.Key) error {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	if ka, ok := a.actions[key]; ok {
+		if strings.EqualFold(ka.Description, action) {
+			return nil
+		}
+		return fmt.Errorf("key %q for %q conflicts with action %q", tcell.KeyNames[key], action, ka.Description)
+	}
+	for k, ka := range a.actions {
+		if ka.Opts.Plugin || ka.Opts.HotKey || ka.Opts.Remapped || !strings.EqualFold(ka.Description, action) {
+			continue
+		}
+		delete(a.actions, k)
+		ka.Opts.Remapped, ka.origKey = true, k
+		a.actions[key] = ka
+		return nil
+	}
+
+	return fmt.Errorf("no action found for %q", action)
+}
+
+// ClearRemaps restores remapped actions to their original keys.
+func (a *KeyActions) ClearRemaps() {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	for k, ka := range a.actions {
+		if !ka.Opts.Remapped {
+			continue
+		}
+		delete(a.actions, k)
+		if _, ok := a.actions[ka.origKey]; ok {
+			continue
+		}
+		ka.Opts.Remapped = false
+		a.actions[ka.origKey] = ka
+	}
+}
+
 // Hints returns a collection of hints.
 func (a *KeyActions) Hints() model.MenuHints {
 	a.mx.RLock()
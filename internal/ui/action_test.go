@@ -8,6 +8,7 @@ import (
 
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,3 +24,80 @@ func TestKeyActionsHints(t *testing.T) {
 	assert.Equal(t, 3, len(hh))
 	assert.Equal(t, model.MenuHint{Mnemonic: "b", Description: "blee", Visible: true}, hh[0])
 }
+
+func TestKeyActionsRemap(t *testing.T) {
+	uu := map[string]struct {
+		action string
+		key    tcell.Key
+		err    string
+		e      string
+	}{
+		"happy": {
+			action: "Fred",
+			key:    ui.KeyShiftF,
+			e:      "fred",
+		},
+		"same": {
+			action: "fred",
+			key:    ui.KeyF,
+			e:      "fred",
+		},
+		"conflict": {
+			action: "fred",
+			key:    ui.KeyB,
+			err:    `key "b" for "fred" conflicts with action "blee"`,
+			e:      "blee",
+		},
+		"unknown": {
+			action: "duh",
+			key:    ui.KeyShiftF,
+			err:    `no action found for "duh"`,
+		},
+		"plugin": {
+			action: "zorg",
+			key:    ui.KeyShiftF,
+			err:    `no action found for "zorg"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			kk := ui.NewKeyActionsFromMap(ui.KeyMap{
+				ui.KeyF: ui.NewKeyAction("fred", nil, true),
+				ui.KeyB: ui.NewKeyAction("blee", nil, true),
+				ui.KeyZ: ui.NewKeyActionWithOpts("zorg", nil, ui.ActionOpts{Plugin: true}),
+			})
+			err := kk.Remap(u.action, u.key)
+			if u.err != "" {
+				assert.Equal(t, u.err, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			a, ok := kk.Get(u.key)
+			assert.Equal(t, u.e != "", ok)
+			assert.Equal(t, u.e, a.Description)
+		})
+	}
+}
+
+func TestKeyActionsClearRemaps(t *testing.T) {
+	kk := ui.NewKeyActionsFromMap(ui.KeyMap{
+		ui.KeyF: ui.NewKeyAction("fred", nil, true),
+		ui.KeyB: ui.NewKeyAction("blee", nil, true),
+	})
+	assert.NoError(t, kk.Remap("fred", ui.KeyShiftF))
+	a, _ := kk.Get(ui.KeyShiftF)
+	assert.True(t, a.Opts.Remapped)
+	_, ok := kk.Get(ui.KeyF)
+	assert.False(t, ok)
+
+	kk.ClearRemaps()
+	_, ok = kk.Get(ui.KeyShiftF)
+	assert.False(t, ok)
+	a, ok = kk.Get(ui.KeyF)
+	assert.True(t, ok)
+	assert.Equal(t, "fred", a.Description)
+	assert.False(t, a.Opts.Remapped)
+	assert.Equal(t, 2, kk.Len())
+}
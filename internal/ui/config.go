@@ -6,6 +6,7 @@ package ui
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -15,7 +16,6 @@ import (
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/fsnotify/fsnotify"
-	"github.com/rs/zerolog/log"
 )
 
 // Synchronizer manages ui event queue.
@@ -244,6 +244,17 @@ func (c *Configurator) RefreshStyles(s synchronizer) {
 }
 
 func (c *Configurator) loadSkinFile(s synchronizer) {
+	if c.Config != nil && c.Config.K9s != nil && c.Config.K9s.UI.Palette != "" {
+		p := c.Config.K9s.UI.Palette
+		if err := c.Styles.LoadPalette(p); err == nil {
+			log.Debug().Msgf("[Skin] Loading palette (%q)", p)
+			c.skinFile = ""
+			c.applyStyles()
+			return
+		}
+		log.Warn().Msgf("Unknown palette %q. Using skins", p)
+	}
+
 	skin, ok := c.activeSkin()
 	if !ok {
 		log.Debug().Msgf("No custom skin found. Using stock skin")
@@ -266,11 +277,30 @@ func (c *Configurator) loadSkinFile(s synchronizer) {
 	}
 }
 
+// ApplySkin loads the named skin and notifies all styles listeners.
+func (c *Configurator) ApplySkin(name string) error {
+	skinFile := config.SkinFileFromName(name)
+	if _, err := os.Stat(skinFile); err != nil {
+		return fmt.Errorf("skin %q not found in skins dir: %s", name, config.AppSkinsDir)
+	}
+	c.Styles.Reset()
+	if err := c.Styles.Load(skinFile); err != nil {
+		return fmt.Errorf("failed to parse skin file %s: %w", filepath.Base(skinFile), err)
+	}
+	c.updateStyles(skinFile)
+
+	return nil
+}
+
 func (c *Configurator) updateStyles(f string) {
 	c.skinFile = f
 	if f == "" {
 		c.Styles.Reset()
 	}
+	c.applyStyles()
+}
+
+func (c *Configurator) applyStyles() {
 	c.Styles.Update()
 
 	model1.ModColor = c.Styles.Frame().Status.ModifyColor.Color()
@@ -58,6 +58,15 @@ func (c *Crumbs) StackPopped(_, _ model.Component) {
 // StackTop indicates the top of the stack.
 func (c *Crumbs) StackTop(top model.Component) {}
 
+// Reset rebuilds the breadcrumbs from a components stack.
+func (c *Crumbs) Reset(cc []model.Component) {
+	c.stack = model.NewStack()
+	for _, comp := range cc {
+		c.stack.Push(comp)
+	}
+	c.refresh(c.stack.Flatten())
+}
+
 // Refresh updates view with new crumbs.
 func (c *Crumbs) refresh(crumbs []string) {
 	c.Clear()
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const copyKey = "copy"
+
+// CopyArgs tracks a resources copy destination.
+type CopyArgs struct {
+	Namespace, Context string
+	Overwrite          bool
+}
+
+// CopyFunc copies resources to a given destination.
+type CopyFunc func(CopyArgs)
+
+// CopyDialogOpts tracks the copy dialog options.
+type CopyDialogOpts struct {
+	Title, Message string
+	Namespaced     bool
+	Args           CopyArgs
+	Contexts       []string
+	Ack            CopyFunc
+	Cancel         cancelFunc
+}
+
+// ShowCopy pops a resources copy destination dialog.
+func ShowCopy(styles config.Dialog, pages *ui.Pages, opts CopyDialogOpts) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	args := opts.Args
+	var current int
+	for i, c := range opts.Contexts {
+		if c == args.Context {
+			current = i
+		}
+	}
+	f.AddDropDown(i18n.T("Context:"), opts.Contexts, current, func(option string, _ int) {
+		args.Context = option
+	})
+	if opts.Namespaced {
+		f.AddInputField(i18n.T("Namespace:"), args.Namespace, 40, nil, func(v string) {
+			args.Namespace = strings.TrimSpace(v)
+		})
+	}
+	f.AddCheckbox(i18n.T("Overwrite:"), args.Overwrite, func(_ string, checked bool) {
+		args.Overwrite = checked
+	})
+
+	modal := tview.NewModalForm("<"+opts.Title+">", f)
+	f.AddButton(i18n.T("Cancel"), func() {
+		dismissCopy(pages)
+		opts.Cancel()
+	})
+	f.AddButton(i18n.T("OK"), func() {
+		if opts.Namespaced && args.Namespace == "" {
+			modal.SetText("A target namespace is required!")
+			return
+		}
+		dismissCopy(pages)
+		opts.Ack(args)
+	})
+	for i := 0; i < 2; i++ {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+	f.SetFocus(0)
+
+	modal.SetText(opts.Message)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissCopy(pages)
+		opts.Cancel()
+	})
+	pages.AddPage(copyKey, modal, false, false)
+	pages.ShowPage(copyKey)
+}
+
+func dismissCopy(pages *ui.Pages) {
+	pages.RemovePage(copyKey)
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowCopy(config.Dialog{}, p, CopyDialogOpts{
+		Title:      "Copy",
+		Message:    "Copy fred?",
+		Namespaced: true,
+		Args:       CopyArgs{Namespace: "ns1", Context: "ct2"},
+		Contexts:   []string{"ct1", "ct2"},
+		Ack:        func(CopyArgs) {},
+		Cancel:     func() {},
+	})
+
+	d := p.GetPrimitive(copyKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissCopy(p)
+	assert.Nil(t, p.GetPrimitive(copyKey))
+}
@@ -5,6 +5,7 @@ package dialog
 
 import (
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,7 +30,28 @@ var propagationOptions []string = []string{
 
 // ShowDelete pops a resource deletion dialog.
 func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
-	propagation, force := "", false
+	showDelete(styles, pages, msg, "", ok, cancel)
+}
+
+// ShowProtectedDelete pops a protected resource deletion dialog. Deletion
+// proceeds only once the accept string has been typed in.
+func ShowProtectedDelete(styles config.Dialog, pages *ui.Pages, msg, accept string, ok okFunc, cancel cancelFunc) {
+	showDelete(styles, pages, msg, accept, ok, cancel)
+}
+
+func showDelete(styles config.Dialog, pages *ui.Pages, msg, accept string, ok okFunc, cancel cancelFunc) {
+	confirm := tview.NewModalForm("<"+i18n.T("Delete")+">", deleteForm(styles, pages, accept, ok, cancel))
+	confirm.SetText(msg)
+	confirm.SetDoneFunc(func(int, string) {
+		dismiss(pages)
+		cancel()
+	})
+	pages.AddPage(dialogKey, confirm, false, false)
+	pages.ShowPage(dialogKey)
+}
+
+func deleteForm(styles config.Dialog, pages *ui.Pages, accept string, ok okFunc, cancel cancelFunc) *tview.Form {
+	propagation, force, confirmed := "", false, accept == ""
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
@@ -37,22 +59,30 @@ func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, ca
 		SetButtonTextColor(styles.ButtonFgColor.Color()).
 		SetLabelColor(styles.LabelFgColor.Color()).
 		SetFieldTextColor(styles.FieldFgColor.Color())
-	f.AddDropDown("Propagation:", propagationOptions, defaultPropagationIdx, func(_ string, optionIndex int) {
+	f.AddDropDown(i18n.T("Propagation:"), propagationOptions, defaultPropagationIdx, func(_ string, optionIndex int) {
 		propagation = propagationOptions[optionIndex]
 	})
-	propField := f.GetFormItemByLabel("Propagation:").(*tview.DropDown)
+	propField := f.GetFormItemByLabel(i18n.T("Propagation:")).(*tview.DropDown)
 	propField.SetListStyles(
 		styles.FgColor.Color(), styles.BgColor.Color(),
 		styles.ButtonFocusFgColor.Color(), styles.ButtonFocusBgColor.Color(),
 	)
-	f.AddCheckbox("Force:", force, func(_ string, checked bool) {
+	f.AddCheckbox(i18n.T("Force:"), force, func(_ string, checked bool) {
 		force = checked
 	})
-	f.AddButton("Cancel", func() {
+	if accept != "" {
+		f.AddInputField(i18n.T("Confirm:"), "", 30, nil, func(t string) {
+			confirmed = t == accept
+		})
+	}
+	f.AddButton(i18n.T("Cancel"), func() {
 		dismiss(pages)
 		cancel()
 	})
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
+		if !confirmed {
+			return
+		}
 		switch propagation {
 		case noDeletePropagation:
 			ok(nil, force)
@@ -73,12 +103,5 @@ func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, ca
 	}
 	f.SetFocus(2)
 
-	confirm := tview.NewModalForm("<Delete>", f)
-	confirm.SetText(msg)
-	confirm.SetDoneFunc(func(int, string) {
-		dismiss(pages)
-		cancel()
-	})
-	pages.AddPage(dialogKey, confirm, false, false)
-	pages.ShowPage(dialogKey)
+	return f
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,3 +32,30 @@ func TestDeleteDialog(t *testing.T) {
 	dismiss(p)
 	assert.Nil(t, p.GetPrimitive(dialogKey))
 }
+
+func TestProtectedDeleteDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	var deleted bool
+	okFunc := func(*metav1.DeletionPropagation, bool) {
+		deleted = true
+	}
+	ShowProtectedDelete(config.Dialog{}, p, "Yo", "fred", okFunc, func() {})
+	assert.NotNil(t, p.GetPrimitive(dialogKey).(*tview.ModalForm))
+
+	f := deleteForm(config.Dialog{}, p, "fred", okFunc, func() {})
+	in, ok := f.GetFormItemByLabel("Confirm:").(*tview.InputField)
+	assert.True(t, ok)
+	okb := f.GetButton(f.GetButtonIndex("OK"))
+	enter := tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone)
+
+	in.SetText("blee")
+	okb.InputHandler()(enter, nil)
+	assert.False(t, deleted)
+	assert.NotNil(t, p.GetPrimitive(dialogKey))
+
+	in.SetText("fred")
+	okb.InputHandler()(enter, nil)
+	assert.True(t, deleted)
+	assert.Nil(t, p.GetPrimitive(dialogKey))
+}
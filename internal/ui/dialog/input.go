@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const inputKey = "input"
+
+// InputFunc accepts a dialog input.
+type InputFunc func(string)
+
+// InputDialogOpts tracks the input dialog options.
+type InputDialogOpts struct {
+	Title, Message string
+	Label, Value   string
+	Ack            InputFunc
+	Cancel         cancelFunc
+}
+
+// ShowInput pops a single field input dialog.
+func ShowInput(styles config.Dialog, pages *ui.Pages, opts InputDialogOpts) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	value := opts.Value
+	f.AddInputField(opts.Label, value, 50, nil, func(changed string) {
+		value = changed
+	})
+	f.AddButton(i18n.T("Cancel"), func() {
+		dismissInput(pages)
+		opts.Cancel()
+	})
+	f.AddButton(i18n.T("OK"), func() {
+		v := strings.TrimSpace(value)
+		if v == "" {
+			return
+		}
+		dismissInput(pages)
+		opts.Ack(v)
+	})
+	for i := 0; i < 2; i++ {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+	f.SetFocus(0)
+
+	modal := tview.NewModalForm("<"+opts.Title+">", f)
+	modal.SetText(opts.Message)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissInput(pages)
+		opts.Cancel()
+	})
+	pages.AddPage(inputKey, modal, false, false)
+	pages.ShowPage(inputKey)
+}
+
+func dismissInput(pages *ui.Pages) {
+	pages.RemovePage(inputKey)
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInputDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowInput(config.Dialog{}, p, InputDialogOpts{
+		Title:  "Query",
+		Label:  "JSONPath:",
+		Value:  ".spec.nodeName",
+		Ack:    func(string) {},
+		Cancel: func() {},
+	})
+
+	d := p.GetPrimitive(inputKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissInput(p)
+	assert.Nil(t, p.GetPrimitive(inputKey))
+}
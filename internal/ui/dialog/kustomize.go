@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	kustomizeKey           = "kustomize"
+	kustomizeSelectorLabel = "Prune Selector:"
+)
+
+// KustomizeApplyFunc applies a kustomization. An empty selector disables pruning.
+type KustomizeApplyFunc func(prune bool, selector string)
+
+// ShowKustomizeApply pops a kustomization apply dialog with a prune option.
+func ShowKustomizeApply(styles config.Dialog, pages *ui.Pages, path, selector string, ok KustomizeApplyFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	var prune bool
+	f.AddCheckbox(i18n.T("Prune:"), false, func(_ string, checked bool) {
+		prune = checked
+	})
+	f.AddInputField(kustomizeSelectorLabel, selector, 40, nil, func(changed string) {
+		selector = changed
+	})
+
+	modal := tview.NewModalForm("<Apply Kustomization>", f)
+	f.AddButton(i18n.T("Cancel"), func() {
+		dismissKustomize(pages)
+		cancel()
+	})
+	f.AddButton(i18n.T("OK"), func() {
+		sel := strings.TrimSpace(selector)
+		if prune && sel == "" {
+			modal.SetText("Pruning requires a label selector!")
+			return
+		}
+		dismissKustomize(pages)
+		ok(prune, sel)
+	})
+	for i := 0; i < 2; i++ {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+	f.SetFocus(0)
+
+	modal.SetText("Apply " + path + "?")
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissKustomize(pages)
+		cancel()
+	})
+	pages.AddPage(kustomizeKey, modal, false, false)
+	pages.ShowPage(kustomizeKey)
+}
+
+func dismissKustomize(pages *ui.Pages) {
+	pages.RemovePage(kustomizeKey)
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKustomizeApplyDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	okFunc := func(bool, string) {
+		assert.True(t, true)
+	}
+	caFunc := func() {
+		assert.True(t, true)
+	}
+	ShowKustomizeApply(config.Dialog{}, p, "fred", "app=fred", okFunc, caFunc)
+
+	d := p.GetPrimitive(kustomizeKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissKustomize(p)
+	assert.Nil(t, p.GetPrimitive(kustomizeKey))
+}
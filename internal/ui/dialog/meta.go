@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	metaKey        = "meta"
+	metaKeyLabel   = "Key:"
+	metaValueLabel = "Value:"
+)
+
+// MetaEditFunc applies a labels or annotations edit. A nil value removes the key.
+type MetaEditFunc func(key string, value *string)
+
+// ShowMetaEditor pops a labels or annotations editor dialog. Keys lists
+// known keys used to autocomplete the key field.
+func ShowMetaEditor(styles config.Dialog, pages *ui.Pages, title string, current map[string]string, keys []string, ok MetaEditFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	var (
+		key, value string
+		remove     bool
+	)
+	f.AddInputField(metaKeyLabel, "", 40, nil, nil)
+	f.AddInputField(metaValueLabel, "", 40, nil, func(changed string) {
+		value = changed
+	})
+	valueField := f.GetFormItemByLabel(metaValueLabel).(*tview.InputField)
+	keyField := f.GetFormItemByLabel(metaKeyLabel).(*tview.InputField)
+	keyField.SetChangedFunc(func(changed string) {
+		key = changed
+		if v, ok := current[changed]; ok {
+			valueField.SetText(v)
+		}
+	})
+	keyField.SetAutocompleteFunc(func(text string) []string {
+		return metaSuggestions(text, current, keys)
+	})
+	f.AddCheckbox(i18n.T("Delete:"), false, func(_ string, checked bool) {
+		remove = checked
+	})
+
+	f.AddButton(i18n.T("Cancel"), func() {
+		dismissMeta(pages)
+		cancel()
+	})
+	f.AddButton(i18n.T("OK"), func() {
+		k := strings.TrimSpace(key)
+		if k == "" {
+			return
+		}
+		dismissMeta(pages)
+		if remove {
+			ok(k, nil)
+			return
+		}
+		ok(k, &value)
+	})
+	for i := 0; i < 2; i++ {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+	f.SetFocus(0)
+
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetText(metaText(current))
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissMeta(pages)
+		cancel()
+	})
+	pages.AddPage(metaKey, modal, false, false)
+	pages.ShowPage(metaKey)
+}
+
+func dismissMeta(pages *ui.Pages) {
+	pages.RemovePage(metaKey)
+}
+
+func metaText(current map[string]string) string {
+	if len(current) == 0 {
+		return "<none>"
+	}
+	kk := make([]string, 0, len(current))
+	for k := range current {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for i, k := range kk {
+		kk[i] = fmt.Sprintf("%s=%s", k, current[k])
+	}
+
+	return strings.Join(kk, "\n")
+}
+
+func metaSuggestions(text string, current map[string]string, keys []string) []string {
+	if text == "" {
+		return nil
+	}
+	set := make(map[string]struct{}, len(current)+len(keys))
+	for k := range current {
+		set[k] = struct{}{}
+	}
+	for _, k := range keys {
+		set[k] = struct{}{}
+	}
+	ss := make([]string, 0, len(set))
+	for k := range set {
+		if strings.HasPrefix(k, text) && k != text {
+			ss = append(ss, k)
+		}
+	}
+	sort.Strings(ss)
+
+	return ss
+}
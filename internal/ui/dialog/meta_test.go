@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetaEditorDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	okFunc := func(string, *string) {
+		assert.True(t, true)
+	}
+	caFunc := func() {
+		assert.True(t, true)
+	}
+	ShowMetaEditor(config.Dialog{}, p, "Labels", map[string]string{"app": "fred"}, nil, okFunc, caFunc)
+
+	d := p.GetPrimitive(metaKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissMeta(p)
+	assert.Nil(t, p.GetPrimitive(metaKey))
+}
+
+func TestMetaText(t *testing.T) {
+	uu := map[string]struct {
+		mm map[string]string
+		e  string
+	}{
+		"empty": {
+			e: "<none>",
+		},
+		"sorted": {
+			mm: map[string]string{"b": "2", "a": "1"},
+			e:  "a=1\nb=2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, metaText(u.mm))
+		})
+	}
+}
+
+func TestMetaSuggestions(t *testing.T) {
+	uu := map[string]struct {
+		text string
+		e    []string
+	}{
+		"empty": {},
+		"prefix": {
+			text: "app",
+			e:    []string{"app.kubernetes.io/name", "application"},
+		},
+		"exact": {
+			text: "application",
+			e:    []string{},
+		},
+	}
+
+	current := map[string]string{"app.kubernetes.io/name": "fred"}
+	keys := []string{"application", "tier", "app.kubernetes.io/name"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, metaSuggestions(u.text, current, keys))
+		})
+	}
+}
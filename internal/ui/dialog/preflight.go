@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const preflightKey = "preflight"
+
+// ShowPreflight pops a context switch preflight checks dialog. The switch
+// button is focused only when all checks passed.
+func ShowPreflight(styles config.Dialog, pages *ui.Pages, title, msg string, passed bool, ack confirmFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddButton(i18n.T("Cancel"), func() {
+		dismissPreflight(pages)
+		cancel()
+	})
+	f.AddButton(i18n.T("Switch"), func() {
+		dismissPreflight(pages)
+		ack()
+	})
+	for i := 0; i < 2; i++ {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+	if passed {
+		f.SetFocus(1)
+	} else {
+		f.SetFocus(0)
+	}
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetText(msg)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissPreflight(pages)
+		cancel()
+	})
+	pages.AddPage(preflightKey, modal, false, false)
+	pages.ShowPage(preflightKey)
+}
+
+func dismissPreflight(pages *ui.Pages) {
+	pages.RemovePage(preflightKey)
+}
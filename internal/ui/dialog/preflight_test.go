@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreflightDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowPreflight(config.Dialog{}, p, "Blee", "Yo", true, func() {}, func() {})
+
+	d := p.GetPrimitive(preflightKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissPreflight(p)
+	assert.Nil(t, p.GetPrimitive(preflightKey))
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	progressKey   = "progress"
+	progressWidth = 30
+)
+
+// Progress tracks a bulk operation progress.
+type Progress struct {
+	modal    *tview.ModalForm
+	pages    *ui.Pages
+	action   string
+	total    int
+	count    int
+	failures int
+}
+
+// ShowProgress pops a progress dialog. Cancel is called when the operation is aborted.
+func ShowProgress(styles config.Dialog, pages *ui.Pages, action string, total int, cancel cancelFunc) *Progress {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddButton(i18n.T("Cancel"), func() {
+		cancel()
+	})
+	if b := f.GetButton(0); b != nil {
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+	f.SetFocus(0)
+
+	p := Progress{
+		modal:  tview.NewModalForm("<"+action+">", f),
+		pages:  pages,
+		action: action,
+		total:  total,
+	}
+	p.modal.SetTextColor(styles.FgColor.Color())
+	p.modal.SetText(p.text(""))
+	p.modal.SetDoneFunc(func(int, string) {
+		cancel()
+	})
+	pages.AddPage(progressKey, p.modal, false, false)
+	pages.ShowPage(progressKey)
+
+	return &p
+}
+
+// Update records the outcome of a single item.
+func (p *Progress) Update(item string, err error) {
+	p.count++
+	if err != nil {
+		p.failures++
+	}
+	p.modal.SetText(p.text(item))
+}
+
+// Count returns the number of processed items.
+func (p *Progress) Count() int {
+	return p.count
+}
+
+// Failures returns the number of failed items.
+func (p *Progress) Failures() int {
+	return p.failures
+}
+
+// Dismiss closes the dialog.
+func (p *Progress) Dismiss() {
+	p.pages.RemovePage(progressKey)
+}
+
+func (p *Progress) text(item string) string {
+	done := progressWidth
+	if p.total > 0 {
+		done = p.count * progressWidth / p.total
+	}
+	msg := fmt.Sprintf("%s %d/%d\n[%s%s]",
+		p.action,
+		p.count,
+		p.total,
+		strings.Repeat("█", done),
+		strings.Repeat("░", progressWidth-done),
+	)
+	if item != "" {
+		msg += "\n" + item
+	}
+	if p.failures > 0 {
+		msg += fmt.Sprintf("\nFailed: %d", p.failures)
+	}
+
+	return msg
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	var canceled bool
+	pg := ShowProgress(config.Dialog{}, p, "Delete", 3, func() {
+		canceled = true
+	})
+	d := p.GetPrimitive(progressKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	pg.Update("default/p1", nil)
+	pg.Update("default/p2", errors.New("boom"))
+	assert.Equal(t, 2, pg.Count())
+	assert.Equal(t, 1, pg.Failures())
+	assert.Equal(t, "Delete 2/3\n[████████████████████░░░░░░░░░░]\ndefault/p2\nFailed: 1", pg.text("default/p2"))
+	assert.False(t, canceled)
+
+	pg.Dismiss()
+	assert.Nil(t, p.GetPrimitive(progressKey))
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const reloginKey = "relogin"
+
+// ShowRelogin pops an expired credentials dialog. A pasted token overrides the
+// kubeconfig credentials, a blank one reloads them.
+func ShowRelogin(styles config.Dialog, pages *ui.Pages, title, msg string, ack InputFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	var token string
+	f.AddPasswordField("Token:", "", 50, '*', func(changed string) {
+		token = changed
+	})
+	f.AddButton(i18n.T("Cancel"), func() {
+		dismissRelogin(pages)
+		cancel()
+	})
+	f.AddButton(i18n.T("Re-Login"), func() {
+		dismissRelogin(pages)
+		ack(strings.TrimSpace(token))
+	})
+	for i := 0; i < 2; i++ {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+	f.SetFocus(2)
+
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetText(msg)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissRelogin(pages)
+		cancel()
+	})
+	pages.AddPage(reloginKey, modal, false, false)
+	pages.ShowPage(reloginKey)
+}
+
+func dismissRelogin(pages *ui.Pages) {
+	pages.RemovePage(reloginKey)
+}
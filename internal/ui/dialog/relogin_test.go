@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloginDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowRelogin(config.Dialog{}, p, "Blee", "Yo", func(string) {}, func() {})
+
+	d := p.GetPrimitive(reloginKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissRelogin(p)
+	assert.Nil(t, p.GetPrimitive(reloginKey))
+}
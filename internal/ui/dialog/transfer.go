@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 )
@@ -39,7 +40,7 @@ func ShowUploads(styles config.Dialog, pages *ui.Pages, opts TransferDialogOpts)
 		SetButtonTextColor(styles.ButtonFgColor.Color()).
 		SetLabelColor(styles.LabelFgColor.Color()).
 		SetFieldTextColor(styles.FieldFgColor.Color())
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		dismissConfirm(pages)
 		opts.Cancel()
 	})
@@ -52,7 +53,7 @@ func ShowUploads(styles config.Dialog, pages *ui.Pages, opts TransferDialogOpts)
 	}
 	var fromField, toField *tview.InputField
 	args.Download = true
-	f.AddCheckbox("Download:", args.Download, func(_ string, flag bool) {
+	f.AddCheckbox(i18n.T("Download:"), args.Download, func(_ string, flag bool) {
 		if flag {
 			modal.SetText(strings.Replace(opts.Message, "Upload", "Download", 1))
 		} else {
@@ -64,26 +65,26 @@ func ShowUploads(styles config.Dialog, pages *ui.Pages, opts TransferDialogOpts)
 		toField.SetText(args.To)
 	})
 
-	f.AddInputField("From:", args.From, 40, nil, func(v string) {
+	f.AddInputField(i18n.T("From:"), args.From, 40, nil, func(v string) {
 		args.From = v
 	})
-	f.AddInputField("To:", args.To, 40, nil, func(v string) {
+	f.AddInputField(i18n.T("To:"), args.To, 40, nil, func(v string) {
 		args.To = v
 	})
-	fromField, _ = f.GetFormItemByLabel("From:").(*tview.InputField)
-	toField, _ = f.GetFormItemByLabel("To:").(*tview.InputField)
+	fromField, _ = f.GetFormItemByLabel(i18n.T("From:")).(*tview.InputField)
+	toField, _ = f.GetFormItemByLabel(i18n.T("To:")).(*tview.InputField)
 
-	f.AddCheckbox("NoPreserve:", args.NoPreserve, func(_ string, f bool) {
+	f.AddCheckbox(i18n.T("NoPreserve:"), args.NoPreserve, func(_ string, f bool) {
 		args.NoPreserve = f
 	})
 	if len(opts.Containers) > 0 {
 		args.CO = opts.Containers[0]
 	}
-	f.AddInputField("Container:", args.CO, 30, nil, func(v string) {
+	f.AddInputField(i18n.T("Container:"), args.CO, 30, nil, func(v string) {
 		args.CO = v
 	})
 	retries := strconv.Itoa(opts.Retries)
-	f.AddInputField("Retries:", retries, 30, nil, func(v string) {
+	f.AddInputField(i18n.T("Retries:"), retries, 30, nil, func(v string) {
 		retries = v
 
 		if retriesInt, err := strconv.Atoi(retries); err == nil {
@@ -91,7 +92,7 @@ func ShowUploads(styles config.Dialog, pages *ui.Pages, opts TransferDialogOpts)
 		}
 	})
 
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
 		if !opts.Ack(args) {
 			return
 		}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// FilesChangedFunc notifies files changed.
+type FilesChangedFunc func(files []string)
+
+// WatchFiles watches the given files and notifies once edits settled for the
+// given delay. Parent directories are watched rather than the files so files
+// replaced by editors or created later on are tracked too.
+func WatchFiles(ctx context.Context, files []string, delay time.Duration, fn FilesChangedFunc) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	tracked := make(map[string]struct{}, len(files))
+	dirs := make(map[string]struct{}, len(files))
+	for _, f := range files {
+		if f == "" {
+			continue
+		}
+		f = filepath.Clean(f)
+		tracked[f] = struct{}{}
+		dirs[filepath.Dir(f)] = struct{}{}
+	}
+	var watched int
+	for d := range dirs {
+		if _, err := os.Stat(d); errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err := w.Add(d); err != nil {
+			_ = w.Close()
+			return err
+		}
+		log.Debug().Msgf("FilesWatcher watching %q", d)
+		watched++
+	}
+	if watched == 0 {
+		_ = w.Close()
+		return errors.New("no files to watch")
+	}
+
+	go func() {
+		defer func() {
+			if err := w.Close(); err != nil {
+				log.Error().Err(err).Msg("Closing FilesWatcher")
+			}
+		}()
+		var (
+			fire    <-chan time.Time
+			changed = make(map[string]struct{})
+		)
+		for {
+			select {
+			case evt := <-w.Events:
+				if evt.Op == fsnotify.Chmod {
+					continue
+				}
+				f := filepath.Clean(evt.Name)
+				if _, ok := tracked[f]; !ok {
+					continue
+				}
+				changed[f] = struct{}{}
+				fire = time.After(delay)
+			case <-fire:
+				ff := make([]string, 0, len(changed))
+				for f := range changed {
+					ff = append(ff, f)
+					delete(changed, f)
+				}
+				sort.Strings(ff)
+				log.Debug().Msgf("FilesWatcher files changed: %v", ff)
+				fn(ff)
+			case err := <-w.Errors:
+				log.Warn().Err(err).Msg("FilesWatcher failed")
+				return
+			case <-ctx.Done():
+				log.Debug().Msg("FilesWatcher CANCELED")
+				return
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchFiles(t *testing.T) {
+	dir := t.TempDir()
+	aliases, plugins := filepath.Join(dir, "aliases.yaml"), filepath.Join(dir, "plugins.yaml")
+	require.NoError(t, os.WriteFile(aliases, []byte("aliases: {}"), 0600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := make(chan []string, 10)
+	err := ui.WatchFiles(ctx, []string{aliases, plugins, filepath.Join(dir, "missing", "hotkeys.yaml")}, 50*time.Millisecond, func(ff []string) {
+		changes <- ff
+	})
+	require.NoError(t, err)
+
+	// Several edits including an editor like swap settle in a single notification.
+	require.NoError(t, os.WriteFile(aliases, []byte("aliases: {pp: v1/pods}"), 0600))
+	tmp := filepath.Join(dir, "plugins.yaml.swp")
+	require.NoError(t, os.WriteFile(tmp, []byte("plugins: {}"), 0600))
+	require.NoError(t, os.Rename(tmp, plugins))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "fred.yaml"), []byte("blee"), 0600))
+
+	select {
+	case ff := <-changes:
+		assert.Equal(t, []string{aliases, plugins}, ff)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "no files change notification")
+	}
+	select {
+	case ff := <-changes:
+		assert.Fail(t, "unexpected notification", ff)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestWatchFilesNone(t *testing.T) {
+	err := ui.WatchFiles(context.Background(), []string{filepath.Join(t.TempDir(), "missing", "aliases.yaml"), ""}, time.Millisecond, func([]string) {})
+
+	assert.Error(t, err)
+}
@@ -3,7 +3,11 @@
 
 package ui
 
-import "github.com/derailed/tcell/v2"
+import (
+	"fmt"
+
+	"github.com/derailed/tcell/v2"
+)
 
 func init() {
 	initKeys()
@@ -13,6 +17,19 @@ func initKeys() {
 	tcell.KeyNames[KeyHelp] = "?"
 	tcell.KeyNames[KeySlash] = "/"
 	tcell.KeyNames[KeySpace] = "space"
+	tcell.KeyNames[KeyLeftBracket] = "["
+	tcell.KeyNames[KeyRightBracket] = "]"
+	tcell.KeyNames[KeyAltA] = "Alt-a"
+	tcell.KeyNames[KeyAltB] = "Alt-b"
+	tcell.KeyNames[KeyAltC] = "Alt-c"
+	tcell.KeyNames[KeyAltG] = "Alt-g"
+	tcell.KeyNames[KeyAltL] = "Alt-l"
+	tcell.KeyNames[KeyAltN] = "Alt-n"
+	tcell.KeyNames[KeyAltP] = "Alt-p"
+	tcell.KeyNames[KeyAltQ] = "Alt-q"
+	tcell.KeyNames[KeyAltR] = "Alt-r"
+	tcell.KeyNames[KeyAltS] = "Alt-s"
+	tcell.KeyNames[KeyAltV] = "Alt-v"
 
 	initNumbKeys()
 	initStdKeys()
@@ -76,10 +93,12 @@ const (
 	KeyX
 	KeyY
 	KeyZ
-	KeyHelp  = 63
-	KeySlash = 47
-	KeyColon = 58
-	KeySpace = 32
+	KeyHelp         = 63
+	KeySlash        = 47
+	KeyColon        = 58
+	KeySpace        = 32
+	KeyLeftBracket  = 91
+	KeyRightBracket = 93
 )
 
 // Define Shift Keys.
@@ -112,6 +131,45 @@ const (
 	KeyShiftZ
 )
 
+// Alt keys...
+const (
+	// KeyAltA represents the alt-a key.
+	KeyAltA = tcell.Key(int16(KeyA) * int16(tcell.ModAlt))
+
+	// KeyAltB represents the alt-b key.
+	KeyAltB = tcell.Key(int16(KeyB) * int16(tcell.ModAlt))
+
+	// KeyAltC represents the alt-c key.
+	KeyAltC = tcell.Key(int16(KeyC) * int16(tcell.ModAlt))
+
+	// KeyAltG represents the alt-g key.
+	KeyAltG = tcell.Key(int16(KeyG) * int16(tcell.ModAlt))
+
+	// KeyAltL represents the alt-l key.
+	KeyAltL = tcell.Key(int16(KeyL) * int16(tcell.ModAlt))
+
+	// KeyAltN represents the alt-n key.
+	KeyAltN = tcell.Key(int16(KeyN) * int16(tcell.ModAlt))
+
+	// KeyAltP represents the alt-p key.
+	KeyAltP = tcell.Key(int16(KeyP) * int16(tcell.ModAlt))
+
+	// KeyAltQ represents the alt-q key.
+	KeyAltQ = tcell.Key(int16(KeyQ) * int16(tcell.ModAlt))
+
+	// KeyAltR represents the alt-r key.
+	KeyAltR = tcell.Key(int16(KeyR) * int16(tcell.ModAlt))
+
+	// KeyAltS represents the alt-s key.
+	KeyAltS = tcell.Key(int16(KeyS) * int16(tcell.ModAlt))
+
+	// KeyAltV represents the alt-v key.
+	KeyAltV = tcell.Key(int16(KeyV) * int16(tcell.ModAlt))
+)
+
+// AltNumKeys tracks alt number keys.
+var AltNumKeys = map[int]tcell.Key{}
+
 // NumKeys tracks number keys.
 var NumKeys = map[int]tcell.Key{
 	0: Key0,
@@ -137,6 +195,11 @@ func initNumbKeys() {
 	tcell.KeyNames[Key7] = "7"
 	tcell.KeyNames[Key8] = "8"
 	tcell.KeyNames[Key9] = "9"
+
+	for i, k := range NumKeys {
+		AltNumKeys[i] = tcell.Key(int16(k) * int16(tcell.ModAlt))
+		tcell.KeyNames[AltNumKeys[i]] = fmt.Sprintf("Alt-%d", i)
+	}
 }
 
 func initStdKeys() {
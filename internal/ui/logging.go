@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui
+
+import "github.com/derailed/k9s/internal/logging"
+
+// log tracks the ui subsystem logger.
+var log = logging.For(logging.UI)
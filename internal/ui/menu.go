@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/tview"
 	runewidth "github.com/mattn/go-runewidth"
@@ -209,5 +210,5 @@ func formatPlainMenu(h model.MenuHint, size int, styles config.Frame) string {
 	fmat = strings.Replace(fmat, "[fg", "["+styles.Menu.FgColor.String(), 1)
 	fmat = strings.Replace(fmat, ":bg:", ":"+styles.Title.BgColor.String()+":", -1)
 
-	return fmt.Sprintf(fmat, ToMnemonic(h.Mnemonic), h.Description)
+	return fmt.Sprintf(fmat, ToMnemonic(h.Mnemonic), i18n.T(h.Description))
 }
@@ -8,7 +8,6 @@ import (
 
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 )
 
 // Pages represents a stack of view pages.
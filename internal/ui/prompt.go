@@ -81,7 +81,9 @@ type Prompt struct {
 	app     *App
 	noIcons bool
 	icon    rune
+	root    *config.Styles
 	styles  *config.Styles
+	view    string
 	model   PromptModel
 	spacer  int
 	mx      sync.RWMutex
@@ -91,6 +93,7 @@ type Prompt struct {
 func NewPrompt(app *App, noIcons bool, styles *config.Styles) *Prompt {
 	p := Prompt{
 		app:      app,
+		root:     styles,
 		styles:   styles,
 		noIcons:  noIcons,
 		TextView: tview.NewTextView(),
@@ -188,9 +191,18 @@ func (p *Prompt) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 
 // StylesChanged notifies skin changed.
 func (p *Prompt) StylesChanged(s *config.Styles) {
-	p.styles = s
-	p.SetBackgroundColor(s.K9s.Prompt.BgColor.Color())
-	p.SetTextColor(s.K9s.Prompt.FgColor.Color())
+	p.root, p.styles = s, s.For(p.view)
+	p.SetBackgroundColor(p.styles.K9s.Prompt.BgColor.Color())
+	p.SetTextColor(p.styles.K9s.Prompt.FgColor.Color())
+}
+
+// SetView applies the prompt overrides of the given view if any.
+func (p *Prompt) SetView(view string) {
+	if p.view == view {
+		return
+	}
+	p.view = view
+	p.StylesChanged(p.root)
 }
 
 // InCmdMode returns true if command is active, false otherwise.
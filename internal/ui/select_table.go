@@ -4,6 +4,10 @@
 package ui
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 )
@@ -17,6 +21,7 @@ type SelectTable struct {
 	marks      map[string]struct{}
 	selFgColor tcell.Color
 	selBgColor tcell.Color
+	lazyRowFn  func(int)
 }
 
 // SetModel sets the table model.
@@ -76,7 +81,7 @@ func (s *SelectTable) GetSelectedItem() string {
 		return ""
 	}
 	sel, ok := s.GetCell(s.GetSelectedRowIndex(), 0).GetReference().(string)
-	if !ok {
+	if !ok || model1.IsGroupRow(sel) {
 		return ""
 	}
 	if s.selectedFn != nil {
@@ -111,9 +116,17 @@ func (s *SelectTable) SelectRow(r, c int, broadcast bool) {
 		r = c + 1
 	}
 	defer s.SetSelectionChangedFunc(s.selectionChanged)
+	s.buildRow(r)
 	s.Select(r, c)
 }
 
+// buildRow builds a lazily rendered row if needed.
+func (s *SelectTable) buildRow(r int) {
+	if s.lazyRowFn != nil {
+		s.lazyRowFn(r)
+	}
+}
+
 // UpdateSelection refresh selected row.
 func (s *SelectTable) updateSelection(broadcast bool) {
 	r, c := s.GetSelection()
@@ -124,11 +137,38 @@ func (s *SelectTable) selectionChanged(r, c int) {
 	if r < 0 {
 		return
 	}
+	s.buildRow(r)
 	if cell := s.GetCell(r, c); cell != nil {
 		s.SetSelectedStyle(
 			tcell.StyleDefault.Foreground(s.selFgColor).
-				Background(cell.Color).Attributes(tcell.AttrBold))
+				Background(cell.Color).Attributes(cursorAttrs()))
+	}
+	if accessible {
+		s.announce(r)
+	}
+}
+
+// announce describes the selected row to assistive technologies.
+func (s *SelectTable) announce(r int) {
+	if r == 0 {
+		return
 	}
+	cc := make([]string, 0, s.GetColumnCount()+1)
+	for c := 0; c < s.GetColumnCount(); c++ {
+		h, cell := s.GetCell(0, c), s.GetCell(r, c)
+		if h == nil || cell == nil {
+			continue
+		}
+		v := strings.TrimRight(cell.Text, " ")
+		if c == 0 {
+			var status string
+			if status, v = splitMarker(v); status != "" {
+				cc = append(cc, status)
+			}
+		}
+		cc = append(cc, plainText(h.Text)+" "+strings.TrimSpace(v))
+	}
+	Announce(fmt.Sprintf("Row %d of %d: %s", r, s.GetRowCount()-1, strings.Join(cc, ", ")))
 }
 
 // ClearMarks delete all marked items.
@@ -156,7 +196,7 @@ func (s *SelectTable) ToggleMark() {
 	}
 
 	if cell := s.GetCell(s.GetSelectedRowIndex(), 0); cell != nil {
-		s.SetSelectedStyle(tcell.StyleDefault.Foreground(cell.BackgroundColor).Background(cell.Color).Attributes(tcell.AttrBold))
+		s.SetSelectedStyle(tcell.StyleDefault.Foreground(cell.BackgroundColor).Background(cell.Color).Attributes(cursorAttrs()))
 	}
 }
 
@@ -208,6 +248,9 @@ func (s *SelectTable) markRange(prev, curr int) {
 		if !ok {
 			break
 		}
+		if model1.IsGroupRow(id) {
+			continue
+		}
 		s.marks[id] = struct{}{}
 		cell := s.GetCell(s.GetSelectedRowIndex(), 0)
 		if cell == nil {
@@ -6,6 +6,7 @@ package ui
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 
 	"github.com/derailed/k9s/internal"
@@ -17,7 +18,6 @@ import (
 	"github.com/derailed/k9s/internal/vul"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -40,25 +40,36 @@ type Table struct {
 	gvr        client.GVR
 	sortCol    model1.SortColumn
 	manualSort bool
+	thenSort   bool
 	Path       string
 	Extras     string
+	Version    string
+	Page       string
 	*SelectTable
-	actions     *KeyActions
-	cmdBuff     *model.FishBuff
-	styles      *config.Styles
-	viewSetting *config.ViewSetting
-	colorerFn   model1.ColorerFunc
-	decorateFn  DecorateFunc
-	wide        bool
-	toast       bool
-	hasMetrics  bool
-	ctx         context.Context
-	mx          sync.RWMutex
+	actions       *KeyActions
+	cmdBuff       *model.FishBuff
+	styles        *config.Styles
+	skin          *config.Styles
+	viewSetting   *config.ViewSetting
+	colorerFn     model1.ColorerFunc
+	decorateFn    DecorateFunc
+	wide          bool
+	toast         bool
+	changes       bool
+	highlight     bool
+	window        int
+	hasMetrics    bool
+	lazy          *lazyRows
+	groupCol      string
+	collapsed     map[string]struct{}
+	sortChangedFn func(model1.SortColumn)
+	ctx           context.Context
+	mx            sync.RWMutex
 }
 
 // NewTable returns a new table view.
 func NewTable(gvr client.GVR) *Table {
-	return &Table{
+	t := Table{
 		SelectTable: &SelectTable{
 			Table: tview.NewTable(),
 			model: model.NewTable(gvr),
@@ -69,6 +80,9 @@ func NewTable(gvr client.GVR) *Table {
 		cmdBuff: model.NewFishBuff('/', model.FilterBuffer),
 		sortCol: model1.SortColumn{ASC: true},
 	}
+	t.lazyRowFn = t.buildLazyRow
+
+	return &t
 }
 
 func (t *Table) setSortCol(sc model1.SortColumn) {
@@ -157,12 +171,14 @@ func (t *Table) ViewSettingsChanged(vs config.ViewSetting) {
 
 // StylesChanged notifies the skin changed.
 func (t *Table) StylesChanged(s *config.Styles) {
+	s = s.For(t.gvr.R())
+	t.skin = s
 	t.SetBackgroundColor(s.Table().BgColor.Color())
 	t.SetBorderColor(s.Frame().Border.FgColor.Color())
 	t.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
 	t.SetSelectedStyle(
-		tcell.StyleDefault.Foreground(t.styles.Table().CursorFgColor.Color()).
-			Background(t.styles.Table().CursorBgColor.Color()).Attributes(tcell.AttrBold))
+		tcell.StyleDefault.Foreground(s.Table().CursorFgColor.Color()).
+			Background(s.Table().CursorBgColor.Color()).Attributes(cursorAttrs()))
 	t.selFgColor = s.Table().CursorFgColor.Color()
 	t.selBgColor = s.Table().CursorBgColor.Color()
 	t.Refresh()
@@ -180,6 +196,23 @@ func (t *Table) ToggleToast() {
 	t.Refresh()
 }
 
+// ToggleChanges toggles to only show recently changed resources.
+func (t *Table) ToggleChanges() {
+	t.changes = !t.changes
+	t.Refresh()
+}
+
+// SetHighlightChanges toggles changed cells highlighting.
+func (t *Table) SetHighlightChanges(b bool) {
+	t.highlight = b
+}
+
+// SetChangesWindow sets the number of refreshes a row must remain unchanged
+// before being hidden in changes only mode.
+func (t *Table) SetChangesWindow(n int) {
+	t.window = n
+}
+
 // ToggleWide toggles wide col display.
 func (t *Table) ToggleWide() {
 	t.wide = !t.wide
@@ -243,6 +276,11 @@ func (t *Table) SetColorerFn(f model1.ColorerFunc) {
 	t.colorerFn = f
 }
 
+// GetSortCol returns the current sort column and order.
+func (t *Table) GetSortCol() model1.SortColumn {
+	return t.getSortCol()
+}
+
 // SetSortCol sets in sort column index and order.
 func (t *Table) SetSortCol(name string, asc bool) {
 	t.setSortCol(model1.SortColumn{Name: name, ASC: asc})
@@ -276,8 +314,8 @@ func (t *Table) doUpdate(data *model1.TableData) *model1.TableData {
 
 func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 	t.Clear()
-	fg := t.styles.Table().Header.FgColor.Color()
-	bg := t.styles.Table().Header.BgColor.Color()
+	fg := t.skin.Table().Header.FgColor.Color()
+	bg := t.skin.Table().Header.BgColor.Color()
 
 	var col int
 	for _, h := range cdata.Header() {
@@ -301,11 +339,26 @@ func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 		col++
 	}
 	cdata.Sort(t.getSortCol())
+	if col := t.GroupCol(); col != "" {
+		cdata = cdata.Group(col, t.collapsedGroups())
+	}
 
 	pads := make(MaxyPad, cdata.HeaderCount())
 	ComputeMaxColumns(pads, t.getSortCol().Name, cdata)
+	t.lazy = nil
+	if cdata.RowCount() > lazyRowThreshold {
+		t.lazy = newLazyRows(cdata, data, pads)
+		cdata.RowsRange(func(row int, re model1.RowEvent) bool {
+			t.buildStub(row+1, re)
+			return true
+		})
+		t.buildWindow()
+		t.updateSelection(true)
+		t.UpdateTitle()
+		return
+	}
 	cdata.RowsRange(func(row int, re model1.RowEvent) bool {
-		ore, ok := data.FindRow(re.Row.ID)
+		ore, ok := findOrigRow(data, re)
 		if !ok {
 			log.Error().Msgf("unable to find original re: %q", re.Row.ID)
 			return true
@@ -325,6 +378,10 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 		color = t.colorerFn
 	}
 
+	if model1.IsGroupRow(re.Row.ID) {
+		color = t.groupColorer
+	}
+
 	marked := t.IsMarked(re.Row.ID)
 	var col int
 	ns := t.GetModel().GetNamespace()
@@ -358,13 +415,19 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 			field = formatCell(field, pads[c])
 		}
 
+		fgColor := color(ns, h, &re)
+		if col == 0 && accessible {
+			field = rowMarker(fgColor, marked) + field
+		}
 		cell := tview.NewTableCell(field)
 		cell.SetExpansion(1)
 		cell.SetAlign(h[c].Align)
-		fgColor := color(ns, h, &re)
 		cell.SetTextColor(fgColor)
+		if t.highlight && re.Kind == model1.EventUpdate && c < len(re.Deltas) && re.Deltas[c] != "" {
+			cell.SetAttributes(tcell.AttrReverse)
+		}
 		if marked {
-			cell.SetTextColor(t.styles.Table().MarkColor.Color())
+			cell.SetTextColor(t.skin.Table().MarkColor.Color())
 		}
 		if col == 0 {
 			cell.SetReference(re.Row.ID)
@@ -374,18 +437,28 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 	}
 }
 
+// Draw builds the rows in view prior to drawing the table.
+func (t *Table) Draw(screen tcell.Screen) {
+	t.buildWindow()
+	t.SelectTable.Draw(screen)
+}
+
 // SortColCmd designates a sorted column.
 func (t *Table) SortColCmd(name string, asc bool) func(evt *tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		sc := t.getSortCol()
-		sc.ASC = !sc.ASC
-		if sc.Name != name {
-			sc.ASC = asc
+		switch {
+		case t.takeThenSort():
+			sc = sc.Then(name, asc)
+		case sc.Name == name:
+			sc.ASC = !sc.ASC
+		default:
+			sc = model1.SortColumn{Name: name, ASC: asc}
 		}
-		sc.Name = name
 		t.setSortCol(sc)
 		t.setMSort(true)
 		t.Refresh()
+		t.fireSortChanged()
 		return nil
 	}
 }
@@ -394,10 +467,46 @@ func (t *Table) SortColCmd(name string, asc bool) func(evt *tcell.EventKey) *tce
 func (t *Table) SortInvertCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.toggleSortCol()
 	t.Refresh()
+	t.fireSortChanged()
 
 	return nil
 }
 
+// ThenSort arms the next sort command to add a secondary sort column.
+func (t *Table) ThenSort() {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.thenSort = true
+}
+
+func (t *Table) takeThenSort() bool {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	b := t.thenSort
+	t.thenSort = false
+
+	return b
+}
+
+// SetSortChangedFn specifies a callback fired when users change the sort columns.
+func (t *Table) SetSortChangedFn(f func(model1.SortColumn)) {
+	t.sortChangedFn = f
+}
+
+func (t *Table) fireSortChanged() {
+	if t.sortChangedFn != nil {
+		t.sortChangedFn(t.getSortCol())
+	}
+}
+
+// SetSortColumn sets the sort column and its secondary columns.
+func (t *Table) SetSortColumn(sc model1.SortColumn) {
+	t.setSortCol(sc)
+	t.setMSort(true)
+}
+
 // ClearMarks clear out marked items.
 func (t *Table) ClearMarks() {
 	t.SelectTable.ClearMarks()
@@ -442,18 +551,29 @@ func (t *Table) NameColIndex() int {
 // AddHeaderCell configures a table cell header.
 func (t *Table) AddHeaderCell(col int, h model1.HeaderColumn) {
 	sc := t.getSortCol()
-	sortCol := h.Name == sc.Name
-	c := tview.NewTableCell(sortIndicator(sortCol, sc.ASC, t.styles.Table(), h.Name))
+	name := sortIndicator(h.Name == sc.Name, sc.ASC, t.skin.Table(), h.Name)
+	for i, s := range sc.Secondary {
+		if s.Name == h.Name {
+			name = sortIndicator(true, s.ASC, t.skin.Table(), h.Name+strconv.Itoa(i+2))
+			break
+		}
+	}
+	c := tview.NewTableCell(name)
 	c.SetExpansion(1)
 	c.SetAlign(h.Align)
 	t.SetCell(0, col, c)
 }
 
 func (t *Table) filtered(data *model1.TableData) *model1.TableData {
-	return data.Filter(model1.FilterOpts{
+	opts := model1.FilterOpts{
 		Toast:  t.toast,
 		Filter: t.cmdBuff.GetText(),
-	})
+	}
+	if t.changes {
+		opts.Changes = t.window
+	}
+
+	return data.Filter(opts)
 }
 
 // CmdBuff returns the associated command buffer.
@@ -482,6 +602,9 @@ func (t *Table) styleTitle() string {
 	}
 
 	base := cases.Title(language.Und, cases.NoLower).String(t.gvr.R())
+	if t.Version != "" {
+		base += "@" + t.Version
+	}
 	ns := t.GetModel().GetNamespace()
 	if client.IsClusterWide(ns) || ns == client.NotNamespaced {
 		ns = client.NamespaceAll
@@ -498,11 +621,15 @@ func (t *Table) styleTitle() string {
 	if t.Extras != "" {
 		ns = t.Extras
 	}
+	count := render.AsThousands(rc)
+	if t.Page != "" {
+		count += " " + t.Page
+	}
 	var title string
 	if ns == client.ClusterScope {
-		title = SkinTitle(fmt.Sprintf(TitleFmt, base, render.AsThousands(rc)), t.styles.Frame())
+		title = SkinTitle(fmt.Sprintf(TitleFmt, base, count), t.skin.Frame())
 	} else {
-		title = SkinTitle(fmt.Sprintf(NSTitleFmt, base, ns, render.AsThousands(rc)), t.styles.Frame())
+		title = SkinTitle(fmt.Sprintf(NSTitleFmt, base, ns, count), t.skin.Frame())
 	}
 
 	buff := t.cmdBuff.GetText()
@@ -516,5 +643,5 @@ func (t *Table) styleTitle() string {
 		return title
 	}
 
-	return title + SkinTitle(fmt.Sprintf(SearchFmt, buff), t.styles.Frame())
+	return title + SkinTitle(fmt.Sprintf(SearchFmt, buff), t.skin.Frame())
 }
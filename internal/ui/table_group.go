@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+)
+
+// GroupBy groups the table rows by the given column. An empty column ungroups the table.
+func (t *Table) GroupBy(col string) {
+	t.mx.Lock()
+	t.groupCol, t.collapsed = strings.ToUpper(col), make(map[string]struct{})
+	t.mx.Unlock()
+	t.Refresh()
+}
+
+// GroupCol returns the column rows are grouped by if any.
+func (t *Table) GroupCol() string {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.groupCol
+}
+
+// SelectedGroup returns the group of the selected row if it's a group header row.
+func (t *Table) SelectedGroup() (string, bool) {
+	cell := t.GetCell(t.GetSelectedRowIndex(), 0)
+	if cell == nil {
+		return "", false
+	}
+	id, ok := cell.GetReference().(string)
+	if !ok || !model1.IsGroupRow(id) {
+		return "", false
+	}
+
+	return model1.GroupFromID(id), true
+}
+
+// ToggleGroup collapses or expands the given group.
+func (t *Table) ToggleGroup(group string) {
+	t.mx.Lock()
+	if _, ok := t.collapsed[group]; ok {
+		delete(t.collapsed, group)
+	} else {
+		t.collapsed[group] = struct{}{}
+	}
+	t.mx.Unlock()
+	t.Refresh()
+}
+
+func (t *Table) collapsedGroups() map[string]struct{} {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	cc := make(map[string]struct{}, len(t.collapsed))
+	for k := range t.collapsed {
+		cc[k] = struct{}{}
+	}
+
+	return cc
+}
+
+func (t *Table) groupColorer(string, model1.Header, *model1.RowEvent) tcell.Color {
+	return t.skin.Table().Header.FgColor.Color()
+}
+
+// findOrigRow returns the original row of a rendered row. Group header rows
+// are their own originals.
+func findOrigRow(data *model1.TableData, re model1.RowEvent) (model1.RowEvent, bool) {
+	if model1.IsGroupRow(re.Row.ID) {
+		return re, true
+	}
+
+	return data.FindRow(re.Row.ID)
+}
@@ -10,7 +10,6 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
-	"github.com/rs/zerolog/log"
 )
 
 const (
@@ -46,11 +45,17 @@ func mustExtractStyles(ctx context.Context) *config.Styles {
 
 // TrimCell removes superfluous padding.
 func TrimCell(tv *SelectTable, row, col int) string {
+	tv.buildRow(row)
 	c := tv.GetCell(row, col)
 	if c == nil {
 		log.Error().Err(fmt.Errorf("No cell at location [%d:%d]", row, col)).Msg("Trim cell failed!")
 		return ""
 	}
+	if col == 0 && accessible {
+		_, txt := splitMarker(c.Text)
+		return strings.TrimSpace(txt)
+	}
+
 	return strings.TrimSpace(c.Text)
 }
 
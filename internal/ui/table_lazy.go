@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui
+
+import (
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+)
+
+const (
+	// lazyRowThreshold tracks the row count beyond which rows are built on demand.
+	lazyRowThreshold = 500
+
+	// lazyRowMargin tracks the minimum number of rows built around the viewport.
+	lazyRowMargin = 50
+)
+
+// lazyRows tracks table rows that are only built once scrolled into view.
+type lazyRows struct {
+	cdata, data *model1.TableData
+	pads        MaxyPad
+	built       []bool
+}
+
+func newLazyRows(cdata, data *model1.TableData, pads MaxyPad) *lazyRows {
+	return &lazyRows{
+		cdata: cdata,
+		data:  data,
+		pads:  pads,
+		built: make([]bool, cdata.RowCount()+1),
+	}
+}
+
+// buildStub adds a placeholder row only carrying the row id.
+func (t *Table) buildStub(r int, re model1.RowEvent) {
+	cell := tview.NewTableCell("")
+	cell.SetExpansion(1)
+	cell.SetReference(re.Row.ID)
+	t.SetCell(r, 0, cell)
+}
+
+// buildLazyRow builds the given row if it's still a placeholder.
+func (t *Table) buildLazyRow(r int) {
+	if t.lazy == nil || r <= 0 || r >= len(t.lazy.built) || t.lazy.built[r] {
+		return
+	}
+	t.lazy.built[r] = true
+
+	re, ok := t.lazy.cdata.RowAt(r - 1)
+	if !ok {
+		return
+	}
+	ore, ok := findOrigRow(t.lazy.data, re)
+	if !ok {
+		log.Error().Msgf("unable to find original re: %q", re.Row.ID)
+		return
+	}
+	t.buildRow(r, re, ore, t.lazy.cdata.Header(), t.lazy.pads)
+}
+
+// buildWindow builds the rows surrounding the viewport and the selection.
+func (t *Table) buildWindow() {
+	if t.lazy == nil {
+		return
+	}
+	_, _, _, h := t.GetInnerRect()
+	m := max(h, lazyRowMargin)
+	off, _ := t.GetOffset()
+	t.buildRange(off-m, off+h+m)
+	sel := t.GetSelectedRowIndex()
+	t.buildRange(sel-h-m, sel+h+m)
+}
+
+func (t *Table) buildRange(lo, hi int) {
+	for r := max(lo, 1); r <= hi && r < len(t.lazy.built); r++ {
+		t.buildLazyRow(r)
+	}
+}
@@ -5,6 +5,7 @@ package ui_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -63,6 +64,69 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableUpdateLazy(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+
+	data := makeBigTableData(1_000)
+	cdata := v.Update(data, false)
+	v.UpdateUI(cdata, data)
+
+	assert.Equal(t, data.RowCount()+1, v.GetRowCount())
+	assert.Equal(t, "n0010", ui.TrimCell(v.SelectTable, 10, 0))
+	assert.Equal(t, "", v.GetCell(900, 1).Text)
+
+	id, ok := v.GetRowID(900)
+	assert.True(t, ok)
+	assert.Equal(t, "r0900", id)
+	assert.Equal(t, "v0900", ui.TrimCell(v.SelectTable, 900, 1))
+
+	v.SelectRow(950, 0, true)
+	assert.Equal(t, 950, v.GetSelectedRowIndex())
+	assert.Equal(t, "v0950", v.GetSelectedCell(1))
+}
+
+func TestTableSortThen(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	var sorted []string
+	v.SetSortChangedFn(func(sc model1.SortColumn) {
+		sorted = append(sorted, sc.String())
+	})
+
+	v.SortColCmd("A", true)(nil)
+	v.ThenSort()
+	v.SortColCmd("B", false)(nil)
+	v.SortColCmd("A", true)(nil)
+	v.SortColCmd("C", true)(nil)
+
+	assert.Equal(t, []string{"A:asc", "A:asc,B:desc", "A:desc,B:desc", "C:asc"}, sorted)
+}
+
+func TestTableGroupBy(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+
+	v.GroupBy("b")
+	assert.Equal(t, "B", v.GroupCol())
+	assert.Equal(t, 4, v.GetRowCount())
+
+	v.SelectRow(1, 0, true)
+	g, ok := v.SelectedGroup()
+	assert.True(t, ok)
+	assert.Equal(t, "duh", g)
+	assert.Equal(t, "", v.GetSelectedItem())
+
+	v.ToggleGroup(g)
+	assert.Equal(t, 2, v.GetRowCount())
+	v.ToggleGroup(g)
+	assert.Equal(t, 4, v.GetRowCount())
+
+	v.GroupBy("")
+	assert.Equal(t, 3, v.GetRowCount())
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -131,3 +195,24 @@ func makeContext() context.Context {
 
 	return ctx
 }
+
+func makeBigTableData(n int) *model1.TableData {
+	rr := make([]model1.RowEvent, 0, n)
+	for i := 1; i <= n; i++ {
+		rr = append(rr, model1.RowEvent{
+			Row: model1.Row{
+				ID:     fmt.Sprintf("r%04d", i),
+				Fields: model1.Fields{fmt.Sprintf("n%04d", i), fmt.Sprintf("v%04d", i)},
+			},
+		})
+	}
+
+	return model1.NewTableDataWithRows(
+		client.NewGVR("test"),
+		model1.Header{
+			model1.HeaderColumn{Name: "A"},
+			model1.HeaderColumn{Name: "B"},
+		},
+		model1.NewRowEventsWithEvts(rr...),
+	)
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// accessReviewDoc documents the access review view.
+var accessReviewDoc = ViewDoc{
+	Summary: "Access review breakdown of the last denied request along with the bindings granting the denied access",
+	Columns: model.MenuHints{
+		{Mnemonic: "CHECK", Description: "review for self subject access reviews, binding for bindings granting the denied verb"},
+		{Mnemonic: "ALLOWED", Description: "Whether the current user is allowed the verb on the resource"},
+		{Mnemonic: "BINDING", Description: "Role or cluster role binding granting the denied access"},
+		{Mnemonic: "SUBJECTS", Description: "Binding subjects. Ask to be added to one of these"},
+		{Mnemonic: "REASON", Description: "Authorizer decision reason if any"},
+	},
+}
+
+// AccessReview represents an access denial review view.
+type AccessReview struct {
+	ResourceViewer
+
+	denied *client.AccessDeniedError
+}
+
+// NewAccessReview returns a new access review view.
+func NewAccessReview(denied *client.AccessDeniedError) ResourceViewer {
+	gvr := client.NewGVR("accessreviews")
+	RegisterViewDoc(gvr.R(), accessReviewDoc)
+	a := AccessReview{
+		ResourceViewer: NewBrowser(gvr),
+		denied:         denied,
+	}
+	a.GetTable().SetSortCol("CHECK", false)
+	a.AddBindKeysFn(a.bindKeys)
+	a.SetContextFn(a.reviewContext)
+
+	return &a
+}
+
+func (a *AccessReview) reviewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyAccessDenied, a.denied)
+}
+
+func (a *AccessReview) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftV: ui.NewKeyAction("Sort Verb", a.GetTable().SortColCmd("VERB", true), false),
+		ui.KeyShiftB: ui.NewKeyAction("Sort Binding", a.GetTable().SortColCmd("BINDING", true), false),
+	})
+}
@@ -4,6 +4,7 @@
 package view
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -12,7 +13,6 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
-	"github.com/rs/zerolog/log"
 )
 
 // AllScopes represents actions available for all views.
@@ -83,6 +83,18 @@ func hotKeyActions(r Runner, aa *ui.KeyActions) error {
 			log.Debug().Msgf("Action %q has been overridden by hotkey in %q", hk.ShortCut, k)
 		}
 
+		if hk.Macro != "" {
+			aa.Add(key, ui.NewKeyActionWithOpts(
+				hk.Description,
+				macroCmd(r, hk.Macro),
+				ui.ActionOpts{
+					Shared: true,
+					HotKey: true,
+				},
+			))
+			continue
+		}
+
 		command, err := r.EnvFn()().Substitute(hk.Command)
 		if err != nil {
 			log.Warn().Err(err).Msg("Invalid shortcut command")
@@ -102,6 +114,29 @@ func hotKeyActions(r Runner, aa *ui.KeyActions) error {
 	return errs
 }
 
+func keyMapActions(r Runner, aa *ui.KeyActions) error {
+	aa.ClearRemaps()
+
+	km := config.NewKeyMaps()
+	if err := km.Load(config.AppKeyMapFile); err != nil {
+		return err
+	}
+
+	var errs error
+	for action, shortCut := range km.BindingsFor(r.Aliases()) {
+		key, err := asKey(shortCut)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if err := aa.Remap(action, key); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return errs
+}
+
 func gotoCmd(r Runner, cmd, path string, clearStack bool) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		r.App().gotoResource(cmd, path, clearStack)
@@ -147,7 +182,7 @@ func pluginActions(r Runner, aa *ui.KeyActions) error {
 			log.Debug().Msgf("Action %q has been overridden by plugin in %q", plugin.ShortCut, k)
 		}
 
-		if plugin.Dangerous && ro {
+		if ro && !plugin.AllowedInReadOnly() {
 			continue
 		}
 		aa.Add(key, ui.NewKeyActionWithOpts(
@@ -184,12 +219,31 @@ func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
 			args[i] = arg
 		}
 
+		var input []byte
+		if p.IsV2() {
+			bb, err := json.Marshal(newPluginContext(r))
+			if err != nil {
+				log.Error().Err(err).Msg("Plugin context failed")
+				return nil
+			}
+			input = bb
+		}
+
 		cb := func() {
 			opts := shellOpts{
 				binary:     p.Command,
 				background: p.Background,
 				pipes:      p.Pipes,
 				args:       args,
+				input:      input,
+			}
+			if p.CapturesOutput() {
+				runCapture(r.App(), p, opts)
+				return
+			}
+			if p.Background && len(p.Pipes) == 0 {
+				runJob(r.App(), p, opts)
+				return
 			}
 			suspend, errChan, statusChan := run(r.App(), opts)
 			if !suspend {
@@ -217,7 +271,7 @@ func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
 			}()
 
 		}
-		if p.Confirm {
+		if p.NeedsConfirm() {
 			msg := fmt.Sprintf("Run?\n%s %s", p.Command, strings.Join(args, " "))
 			dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm "+p.Description, msg, cb, func() {})
 			return nil
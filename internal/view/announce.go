@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"os"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+// initAnnouncer turns on the accessibility mode. Selection changes are
+// announced on a status line unless the terminal title was requested.
+func (a *App) initAnnouncer(main *tview.Flex) {
+	ui.SetAccessible(true)
+	if a.Config.K9s.UI.Announce == config.AnnounceOSC {
+		ui.SetAnnouncer(ui.NewOSCAnnouncer(os.Stdout))
+		return
+	}
+
+	line := tview.NewTextView()
+	line.SetDynamicColors(false)
+	line.SetWrap(false)
+	line.SetTextColor(a.Styles.FgColor())
+	line.SetBackgroundColor(a.Styles.BgColor())
+	main.AddItem(line, 1, 1, false)
+	ui.SetAnnouncer(func(msg string) {
+		line.SetText(msg)
+	})
+}
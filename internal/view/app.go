@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"syscall"
@@ -20,7 +22,10 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/logging"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/snapshot"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/view/cmd"
@@ -28,7 +33,6 @@ import (
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 )
 
 // ExitStatus indicates UI exit conditions.
@@ -37,8 +41,14 @@ var ExitStatus = ""
 const (
 	splashDelay      = 1 * time.Second
 	clusterRefresh   = 15 * time.Second
+	popeyeScanDelay  = 30 * time.Second
+	stormCooldown    = 10 * time.Minute
+	reloginCooldown  = time.Minute
 	clusterInfoWidth = 50
 	clusterInfoPad   = 15
+
+	degradedMsg  = "API server unreachable. Running degraded until connectivity returns..."
+	reloginTitle = "Session Expired"
 )
 
 // App represents an application view.
@@ -46,13 +56,31 @@ type App struct {
 	version string
 	*ui.App
 	Content       *PageStack
+	body          *Split
+	tabs          *Tabs
+	workspace     *tview.Flex
+	tail          *LogTail
 	command       *Command
 	factory       *watch.Factory
+	rowWatcher    *model.RowWatcher
+	notifier      *model.Notifier
+	crdWatcher    *model.CRDWatcher
+	memMonitor    *model.MemMonitor
+	jobs          *dao.BackgroundJobs
 	cancelFn      context.CancelFunc
 	clusterModel  *model.ClusterInfo
 	cmdHistory    *model.History
 	filterHistory *model.History
+	nav           *model.Navigation
+	usage         map[string]*model.UsageHistory
+	navigating    bool
 	conRetry      int32
+	popeyeScans   int32
+	preflights    int32
+	degraded      int32
+	relogin       int32
+	degradedView  string
+	stormAt       time.Time
 	showHeader    bool
 	showLogo      bool
 	showCrumbs    bool
@@ -64,8 +92,14 @@ func NewApp(cfg *config.Config) *App {
 		App:           ui.NewApp(cfg, cfg.K9s.ActiveContextName()),
 		cmdHistory:    model.NewHistory(model.MaxHistory),
 		filterHistory: model.NewHistory(model.MaxHistory),
+		nav:           model.NewNavigation(model.MaxNavigation),
+		usage:         make(map[string]*model.UsageHistory),
 		Content:       NewPageStack(),
+		jobs:          dao.NewBackgroundJobs(),
 	}
+	a.body = NewSplit(a.Content)
+	a.tabs = NewTabs(a.Styles, a.body)
+	a.workspace = tview.NewFlex().SetDirection(tview.FlexRow).AddItem(a.body, 0, 1, true)
 	a.ReloadStyles()
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
@@ -105,21 +139,32 @@ func (a *App) Init(version string, rate int) error {
 	a.App.Init()
 	a.SetInputCapture(a.keyboard)
 	a.bindKeys()
+	a.loadHistory()
+	dao.SetReadOnlyFn(func() bool { return a.Config.K9s.IsReadOnly() })
 	if a.Conn() == nil {
 		return errors.New("no client connection detected")
 	}
 	ns := a.Config.ActiveNamespace()
 
 	a.factory = watch.NewFactory(a.Conn())
+	a.factory.SetReleaseInformers(a.Config.K9s.Client.ReleaseInformers)
+	a.factory.SetMetrics(a.Config.K9s.Watch.Metrics)
+	a.memMonitor = model.NewMemMonitor(a.factory, a.Config.K9s.Watch.MemoryCeiling)
+	a.rowWatcher = model.NewRowWatcher(a.factory)
+	a.rowWatcher.AddListener(a)
+	if !a.Conn().ConnectionOK() {
+		a.enterDegraded()
+	}
 	a.initFactory(ns)
 
 	a.clusterModel = model.NewClusterInfo(a.factory, a.version, a.Config.K9s)
+	a.clusterModel.SetMemMonitor(a.memMonitor)
 	a.clusterModel.AddListener(a.clusterInfo())
 	a.clusterModel.AddListener(a.statusIndicator())
-	if a.Conn().ConnectionOK() {
+	if !a.IsDegraded() {
 		a.clusterModel.Refresh()
-		a.clusterInfo().Init()
 	}
+	a.clusterInfo().Init()
 
 	a.command = NewCommand(a)
 	if err := a.command.Init(a.Config.ContextAliasesPath()); err != nil {
@@ -159,11 +204,14 @@ func (a *App) layout(ctx context.Context) {
 
 	main := tview.NewFlex().SetDirection(tview.FlexRow)
 	main.AddItem(a.statusIndicator(), 1, 1, false)
-	main.AddItem(a.Content, 0, 10, true)
+	main.AddItem(a.workspace, 0, 10, true)
 	if !a.Config.K9s.IsCrumbsless() {
 		main.AddItem(a.Crumbs(), 1, 1, false)
 	}
 	main.AddItem(flash, 1, 1, false)
+	if a.Config.K9s.UI.Accessible {
+		a.initAnnouncer(main)
+	}
 
 	a.Main.AddPage("main", main, true, false)
 	a.Main.AddPage("splash", ui.NewSplash(a.Styles, a.version), true, true)
@@ -193,6 +241,17 @@ func (a *App) suggestCommand() model.SuggestionFunc {
 			}
 			return a.cmdHistory.List()
 		}
+		if s = cmd.ChainTail(s); s == "" {
+			return nil
+		}
+
+		namespaceNames, err := a.factory.Client().ValidNamespaceNames()
+		if err != nil {
+			log.Error().Err(err).Msg("failed to list namespaces")
+		}
+		if exp, ok := a.command.alias.Check(cmd.NewInterpreter(s).Cmd()); ok && cmd.AliasParams(exp) > 0 && strings.Contains(s, " ") {
+			return cmd.SuggestAliasParam(s, exp, namespaceNames, a.Config.NamespaceUsage(), contextNames)
+		}
 
 		ls := strings.ToLower(s)
 		for _, k := range a.command.alias.Aliases.Keys() {
@@ -200,16 +259,12 @@ func (a *App) suggestCommand() model.SuggestionFunc {
 				entries = append(entries, suggest)
 			}
 		}
+		entries.Sort()
 
-		namespaceNames, err := a.factory.Client().ValidNamespaceNames()
-		if err != nil {
-			log.Error().Err(err).Msg("failed to list namespaces")
-		}
-		entries = append(entries, cmd.SuggestSubCommand(s, namespaceNames, contextNames)...)
+		entries = append(entries, cmd.SuggestSubCommand(s, namespaceNames, a.Config.NamespaceUsage(), contextNames)...)
 		if len(entries) == 0 {
 			return nil
 		}
-		entries.Sort()
 		return
 	}
 }
@@ -240,13 +295,34 @@ func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 
 func (a *App) bindKeys() {
 	a.AddActions(ui.NewKeyActionsFromMap(ui.KeyMap{
-		ui.KeyShift9:   ui.NewSharedKeyAction("DumpGOR", a.dumpGOR, false),
-		tcell.KeyCtrlE: ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
-		tcell.KeyCtrlG: ui.NewSharedKeyAction("toggleCrumbs", a.toggleCrumbsCmd, false),
-		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false),
-		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
-		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false),
+		ui.KeyShift9:       ui.NewSharedKeyAction("DumpGOR", a.dumpGOR, false),
+		tcell.KeyCtrlE:     ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
+		tcell.KeyCtrlG:     ui.NewSharedKeyAction("toggleCrumbs", a.toggleCrumbsCmd, false),
+		ui.KeyHelp:         ui.NewSharedKeyAction("Help", a.helpCmd, false),
+		tcell.KeyCtrlA:     ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
+		ui.KeyAltP:         ui.NewSharedKeyAction("Palette", a.paletteCmd, false),
+		ui.KeyAltA:         ui.NewSharedKeyAction("Review Access", a.accessReviewCmd, false),
+		tcell.KeyCtrlV:     ui.NewSharedKeyAction("Switch Pane", a.switchPaneCmd, false),
+		tcell.KeyF2:        ui.NewSharedKeyAction("Toggle Tail", a.toggleTailCmd, false),
+		ui.KeyLeftBracket:  ui.NewSharedKeyAction("Back", a.navBackCmd, false),
+		ui.KeyRightBracket: ui.NewSharedKeyAction("Forward", a.navForwardCmd, false),
+		ui.KeyAltR:         ui.NewSharedKeyAction("Recent Resources", a.recentResCmd, false),
+		tcell.KeyEnter:     ui.NewKeyAction("Goto", a.gotoCmd, false),
 	}))
+	a.bindTabKeys()
+}
+
+func (a *App) accessReviewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	denied := a.Flash().LastDenied()
+	if denied == nil {
+		a.Flash().Info("No access denial to review")
+		return nil
+	}
+	if err := a.inject(NewAccessReview(denied), false); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
 }
 
 func (a *App) dumpGOR(evt *tcell.EventKey) *tcell.EventKey {
@@ -334,6 +410,9 @@ func (a *App) Resume() {
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 
 	go a.clusterUpdater(ctx)
+	if a.Config.K9s.Popeye.Enable {
+		go a.popeyeScheduler(ctx)
+	}
 
 	if a.Config.K9s.UI.Reactive {
 		if err := a.ConfigWatcher(ctx, a); err != nil {
@@ -345,6 +424,9 @@ func (a *App) Resume() {
 		if err := a.CustomViewsWatcher(ctx, a); err != nil {
 			log.Warn().Err(err).Msgf("CustomView watcher failed")
 		}
+		if err := a.hotReloadWatcher(ctx); err != nil {
+			log.Warn().Err(err).Msgf("HotReload watcher failed")
+		}
 	}
 }
 
@@ -376,7 +458,69 @@ func (a *App) clusterUpdater(ctx context.Context) {
 	}
 }
 
+func (a *App) popeyeScheduler(ctx context.Context) {
+	interval := a.Config.K9s.Popeye.ScanInterval()
+	delay := interval
+	if ss, err := config.LoadPopeyeScores(a.Config.K9s.ContextPopeyeScoresFile()); err == nil {
+		if last, ok := ss.Last(); ok {
+			delay -= time.Since(last.Time)
+		} else {
+			delay = 0
+		}
+	}
+	if delay < popeyeScanDelay {
+		delay = popeyeScanDelay
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug().Msg("Popeye scheduler canceled!")
+			return
+		case <-time.After(delay):
+			a.popeyeScan()
+			delay = interval
+		}
+	}
+}
+
+// popeyeScan runs a popeye scan and records its score for the active context.
+func (a *App) popeyeScan() {
+	if !atomic.CompareAndSwapInt32(&a.popeyeScans, 0, 1) {
+		a.Flash().Warn("Popeye scan already in progress")
+		return
+	}
+	defer atomic.StoreInt32(&a.popeyeScans, 0)
+
+	errs, score, err := dao.ScanPopeye(a.Conn().Config().Flags())
+	if err != nil {
+		log.Error().Err(err).Msgf("Popeye scan failed")
+		a.Flash().Errf("Popeye scan failed: %s", err)
+		return
+	}
+	path := a.Config.K9s.ContextPopeyeScoresFile()
+	ss, err := config.LoadPopeyeScores(path)
+	if err != nil {
+		log.Error().Err(err).Msgf("Unable to load popeye scores %q", path)
+		return
+	}
+	prev, ok := ss.Last()
+	ss.Add(config.PopeyeScore{Time: time.Now(), Score: score, Errors: errs}, a.Config.K9s.Popeye.MaxHistory())
+	if err := ss.Save(path); err != nil {
+		log.Error().Err(err).Msgf("Unable to save popeye scores %q", path)
+	}
+	if ok && score < prev.Score {
+		a.Flash().Warnf("Popeye score regressed from %d to %d!", prev.Score, score)
+		return
+	}
+	a.Flash().Infof("Popeye score: %d", score)
+}
+
 func (a *App) refreshCluster(context.Context) error {
+	if a.IsDegraded() {
+		a.checkDegraded()
+		return nil
+	}
 	c := a.Content.Top()
 	if ok := a.Conn().CheckConnectivity(); ok {
 		if atomic.LoadInt32(&a.conRetry) > 0 {
@@ -389,6 +533,9 @@ func (a *App) refreshCluster(context.Context) error {
 			a.ClearStatus(true)
 		}
 		a.factory.ValidatePortForwards()
+		a.checkReconnectStorm()
+	} else if a.checkAuthExpired() {
+		return nil
 	} else if c != nil {
 		atomic.AddInt32(&a.conRetry, 1)
 		c.Stop()
@@ -415,11 +562,241 @@ func (a *App) refreshCluster(context.Context) error {
 		}
 	}()
 	// Update cluster info
+	a.checkMemory()
 	a.clusterModel.Refresh()
 
 	return nil
 }
 
+// IsDegraded checks if k9s runs without a reachable api server.
+func (a *App) IsDegraded() bool {
+	return atomic.LoadInt32(&a.degraded) == 1
+}
+
+// enterDegraded keeps k9s running without a reachable api server. Only views
+// not requiring a connection, ie contexts or screen dumps, are usable until
+// connectivity returns.
+func (a *App) enterDegraded() {
+	log.Warn().Msgf("API server unreachable for context %q. Starting degraded", a.Config.ActiveContextName())
+	atomic.StoreInt32(&a.degraded, 1)
+	a.degradedView = a.Config.ActiveView()
+}
+
+// checkDegraded probes the api server while degraded and resumes the
+// session once connectivity returns.
+func (a *App) checkDegraded() {
+	if !a.Conn().CheckConnectivity() {
+		if !a.checkAuthExpired() {
+			a.Status(model.FlashWarn, degradedMsg)
+		}
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&a.degraded, 1, 0) {
+		return
+	}
+	log.Info().Msg("K8s connectivity restored. Leaving degraded mode")
+
+	v := a.degradedView
+	if v == "" {
+		v = "pod"
+	}
+	a.QueueUpdateDraw(func() {
+		a.initFactory(a.Config.ActiveNamespace())
+		if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
+			log.Warn().Err(err).Msg("Command reset failed")
+		}
+		a.clusterModel.Reset(a.factory)
+		a.ClearStatus(true)
+		a.Flash().Info("K8s connectivity restored")
+		if _, ok := a.Content.Top().(*Context); ok || a.Content.Top() == nil {
+			a.gotoResource(v, "", true)
+		}
+	})
+}
+
+// checkAuthExpired prompts to renew the session credentials once the api
+// server rejects them. It returns true while the credentials are expired.
+func (a *App) checkAuthExpired() bool {
+	cfg := a.Conn().Config()
+	if cfg == nil {
+		return false
+	}
+	at, ok := cfg.AuthExpiry().Expired()
+	if !ok {
+		return false
+	}
+	if !atomic.CompareAndSwapInt32(&a.relogin, 0, 1) {
+		return true
+	}
+	if c := a.Content.Top(); c != nil && !a.IsDegraded() {
+		c.Stop()
+	}
+
+	msg := fmt.Sprintf("The api server rejected the %q credentials %s ago.\n\nPaste a new token or leave it blank to reload the kubeconfig credentials.",
+		a.Config.ActiveContextName(), time.Since(at).Truncate(time.Second))
+	a.QueueUpdateDraw(func() {
+		a.Status(model.FlashWarn, "Session credentials expired!")
+		dialog.ShowRelogin(a.Styles.Dialog(), a.Content.Pages, reloginTitle, msg, func(token string) {
+			go a.renewCredentials(token)
+		}, func() {
+			time.AfterFunc(reloginCooldown, func() {
+				atomic.StoreInt32(&a.relogin, 0)
+			})
+		})
+	})
+
+	return true
+}
+
+// renewCredentials reconnects with renewed credentials and restarts the
+// informers, leaving the views stack untouched.
+func (a *App) renewCredentials(token string) {
+	defer atomic.StoreInt32(&a.relogin, 0)
+
+	if err := a.Conn().Relogin(token); err != nil {
+		log.Error().Err(err).Msg("Re-login failed")
+		a.QueueUpdateDraw(func() {
+			a.Flash().Errf("Re-login failed: %s", err)
+		})
+		return
+	}
+	log.Info().Msg("Session credentials renewed")
+	if a.IsDegraded() {
+		return
+	}
+	atomic.StoreInt32(&a.conRetry, 0)
+	a.initFactory(a.Config.ActiveNamespace())
+	a.clusterModel.Reset(a.factory)
+	a.QueueUpdateDraw(func() {
+		a.ClearStatus(true)
+		a.Flash().Info("Session credentials renewed")
+		if c := a.Content.Top(); c != nil {
+			c.Start()
+		}
+	})
+}
+
+// checkMemory samples k9s memory usage and warns once idle informers had to be
+// evicted to stay under the configured ceiling.
+func (a *App) checkMemory() {
+	st, evicted := a.memMonitor.Check()
+	if len(evicted) == 0 {
+		return
+	}
+	a.QueueUpdateDraw(func() {
+		a.Flash().Warnf("Memory %s nearing ceiling! Evicted %d idle informers", st, len(evicted))
+	})
+}
+
+// checkReconnectStorm warns when an informer keeps losing its api server
+// watch and offers to reduce the watched namespaces.
+func (a *App) checkReconnectStorm() {
+	if time.Since(a.stormAt) < stormCooldown {
+		return
+	}
+	storm, ok := a.factory.ReconnectStorm()
+	if !ok {
+		return
+	}
+	a.stormAt = time.Now()
+	log.Warn().Msgf("Watch reconnect storm detected on %q:%q (%d reconnects)", storm.Namespace, storm.GVR, storm.Reconnects)
+
+	ns := storm.Namespace
+	if client.IsClusterWide(ns) {
+		ns = client.NamespaceAll
+	}
+	msg := fmt.Sprintf("%s watch in namespace %s reconnected %d times in the last %s. Consider raising the api server or proxy idle timeout.",
+		storm.GVR, ns, storm.Reconnects, watch.StormWindow)
+	target, err := a.Conn().Config().CurrentNamespaceName()
+	if err != nil || client.IsClusterWide(target) {
+		target = client.DefaultNamespace
+	}
+	if !client.IsClusterWide(a.Config.ActiveNamespace()) {
+		a.QueueUpdateDraw(func() {
+			a.Flash().Warn(msg)
+		})
+		return
+	}
+
+	a.QueueUpdateDraw(func() {
+		msg += fmt.Sprintf("\n\nReduce watched namespaces to %q?", target)
+		dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Watch Reconnect Storm", msg, func() {
+			a.reduceWatches(target)
+		}, func() {})
+	})
+}
+
+// setCmd changes a config setting for the session.
+func (a *App) setCmd(key, val string) error {
+	switch key {
+	case "watch.metrics":
+		on, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("invalid %s value %q: %w", key, val, err)
+		}
+		a.Config.K9s.Watch.Metrics = on
+		a.factory.SetMetrics(on)
+		a.Flash().Infof("Setting %s to %t", key, on)
+	case "watch.memoryCeiling":
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid %s value %q", key, val)
+		}
+		a.Config.K9s.Watch.MemoryCeiling = n
+		a.memMonitor.SetCeiling(n)
+		a.Flash().Infof("Setting %s to %dMi", key, n)
+	case "logger.level":
+		lvl, err := logging.ParseLevel(val)
+		if err != nil {
+			return err
+		}
+		a.Config.K9s.Logger.Level = val
+		logging.SetLevel(lvl)
+		a.Flash().Infof("Setting %s to %s", key, lvl)
+	default:
+		sub, ok := strings.CutPrefix(key, "logger.level.")
+		if !ok {
+			return fmt.Errorf("unsupported setting %q", key)
+		}
+		lvl, err := logging.ParseLevel(val)
+		if err != nil {
+			return err
+		}
+		if err := logging.SetSubsystemLevel(sub, lvl); err != nil {
+			return err
+		}
+		if a.Config.K9s.Logger.Levels == nil {
+			a.Config.K9s.Logger.Levels = make(map[string]string)
+		}
+		a.Config.K9s.Logger.Levels[sub] = val
+		a.Flash().Infof("Setting %s to %s", key, lvl)
+	}
+
+	return nil
+}
+
+// InformerStats returns the state of the active informers.
+func (a *App) InformerStats() watch.FactoryStats {
+	return a.factory.Stats()
+}
+
+// reduceWatches restarts the informers scoped to the given namespace.
+func (a *App) reduceWatches(ns string) {
+	if err := a.Config.SetActiveNamespace(ns); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	a.initFactory(ns)
+	if err := a.factory.SetActiveNS(ns); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	if v, ok := a.Content.Top().(ResourceViewer); ok {
+		a.gotoResource(v.GVR().String()+" "+ns, "", true)
+	}
+	a.Flash().Infof("Now only watching namespace %q", ns)
+}
+
 func (a *App) switchNS(ns string) error {
 	if a.Config.ActiveNamespace() == ns {
 		return nil
@@ -445,6 +822,10 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 	a.Halt()
 	defer a.Resume()
 	{
+		a.closeTabs()
+		a.unsplit()
+		a.unpinLogs()
+		dao.StopProxies()
 		a.Config.Reset()
 		ct, err := a.Config.K9s.ActivateContext(name)
 		if err != nil {
@@ -473,6 +854,7 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 		} else {
 			log.Debug().Msgf("Saved context config for: %q", name)
 		}
+		atomic.StoreInt32(&a.degraded, 0)
 		a.initFactory(ns)
 		if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
 			return err
@@ -488,9 +870,115 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 	return nil
 }
 
+func (a *App) impersonate(user string, groups []string) error {
+	if !a.Conn().Config().IsImpersonating() && user == "" {
+		return errors.New("invalid command. Use `as user [group...]`")
+	}
+
+	a.Halt()
+	defer a.Resume()
+	{
+		if err := a.Conn().Impersonate(user, groups); err != nil {
+			return err
+		}
+		a.initFactory(a.Config.ActiveNamespace())
+		if user == "" {
+			a.Flash().Info("Impersonation cleared")
+		} else {
+			a.Flash().Warnf("Impersonating %q", user)
+		}
+		a.gotoResource(a.Config.ActiveView(), "", true)
+		a.clusterModel.Reset(a.factory)
+	}
+
+	return nil
+}
+
+func (a *App) saveSnapshot(file string) {
+	if file == "" {
+		dir := a.Config.K9s.ContextSnapshotsDir()
+		if err := ensureDir(dir); err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		file = filepath.Join(dir, fmt.Sprintf("snapshot-%d.tar.gz", time.Now().Unix()))
+	}
+
+	a.Flash().Info("Saving snapshot...")
+	go func() {
+		snap, err := snapshot.New(a.factory)
+		if err != nil {
+			a.Flash().Errf("Snapshot failed: %s", err)
+			return
+		}
+		if err := snap.WriteFile(file); err != nil {
+			a.Flash().Errf("Snapshot failed: %s", err)
+			return
+		}
+		a.Flash().Infof("Snapshot of %d resources saved to %s", snap.Count(), file)
+	}()
+}
+
 func (a *App) initFactory(ns string) {
+	if a.rowWatcher != nil {
+		a.rowWatcher.Clear()
+	}
+	if a.notifier != nil {
+		a.notifier.Stop()
+	}
+	if a.crdWatcher != nil {
+		a.crdWatcher.Stop()
+	}
 	a.factory.Terminate()
 	a.factory.Start(ns)
+	if a.IsDegraded() {
+		return
+	}
+	a.initNotifier()
+	a.initCRDWatcher()
+}
+
+func (a *App) initCRDWatcher() {
+	a.crdWatcher = model.NewCRDWatcher(a.factory, a.crdsChanged)
+	go func() {
+		if err := a.crdWatcher.Start(); err != nil {
+			log.Warn().Err(err).Msg("CRDs watch disabled")
+		}
+	}()
+}
+
+// crdsChanged registers or unregisters custom resources as CRDs come and go.
+func (a *App) crdsChanged(cc model.CRDChanges) {
+	log.Debug().Msgf("%s: %v %v %v", cc, cc.Added, cc.Updated, cc.Removed)
+	if dial, err := a.Conn().CachedDiscovery(); err == nil {
+		dial.Invalidate()
+	}
+	if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
+		log.Warn().Err(err).Msg("Command reset failed")
+		return
+	}
+	a.QueueUpdateDraw(func() {
+		a.Flash().Infof("%s. Commands reloaded", cc)
+	})
+}
+
+func (a *App) initNotifier() {
+	nn := config.NewNotifications()
+	if err := nn.Load(a.Config.ContextNotificationsPath()); err != nil {
+		log.Warn().Err(err).Msg("Notifications load failed")
+		a.Logo().Warn("Notifications load failed!")
+	}
+	a.notifier = model.NewNotifier(a.factory, nn, a.Config.FavNamespaces)
+	a.notifier.AddSink(newFlashSink(a))
+	go a.notifier.Start()
+}
+
+// RowWatchChanged notifies a watched resource changed.
+func (a *App) RowWatchChanged(evt model.RowWatchEvent) {
+	if a.notifier == nil {
+		return
+	}
+	a.notifier.Notify(model.NewRowWatchNotification(evt))
 }
 
 // BailOut exists the application.
@@ -501,6 +989,8 @@ func (a *App) BailOut() {
 		}
 	}()
 
+	a.saveSession()
+	a.saveHistory()
 	if err := a.Config.Save(true); err != nil {
 		log.Error().Err(err).Msg("config save failed!")
 	}
@@ -510,6 +1000,9 @@ func (a *App) BailOut() {
 	}
 
 	a.stopImgScanner()
+	a.unpinLogs()
+	dao.StopProxies()
+	a.jobs.KillAll()
 	a.factory.Terminate()
 	a.App.BailOut()
 }
@@ -526,6 +1019,7 @@ func (a *App) Run() error {
 			if a.CmdBuff().IsActive() {
 				a.SetFocus(a.Prompt())
 			}
+			a.offerSession()
 		})
 	}()
 
@@ -653,11 +1147,21 @@ func (a *App) dirCmd(path string) error {
 			path = dir
 		}
 	}
-	a.cmdHistory.Push("dir " + path)
+	a.command.pushHistory("dir " + path)
 
 	return a.inject(NewDir(path), true)
 }
 
+func (a *App) findCmd(q string, list bool) error {
+	line := "find " + q
+	if list {
+		line = "find -l " + q
+	}
+	a.command.pushHistory(line)
+
+	return a.inject(NewFind(q, list), false)
+}
+
 func (a *App) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if evt != nil && evt.Rune() == '?' && a.Prompt().InCmdMode() {
 		return evt
@@ -691,7 +1195,7 @@ func (a *App) aliasCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (a *App) gotoResource(c, path string, clearStack bool) {
-	err := a.command.run(cmd.NewInterpreter(c), path, clearStack)
+	err := a.command.runChain(c, path, clearStack)
 	if err != nil {
 		dialog.ShowError(a.Styles.Dialog(), a.Content.Pages, err.Error())
 	}
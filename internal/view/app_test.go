@@ -6,14 +6,19 @@ package view_test
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/mock"
 	"github.com/derailed/k9s/internal/view"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestAppNew(t *testing.T) {
+	dir := config.AppConfigDir
+	defer func() { config.AppConfigDir = dir }()
+	config.AppConfigDir = t.TempDir()
+
 	a := view.NewApp(mock.NewMockConfig())
 	_ = a.Init("blee", 10)
 
-	assert.Equal(t, 12, a.GetActions().Len())
+	assert.Equal(t, 28, a.GetActions().Len())
 }
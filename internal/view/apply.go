@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+)
+
+const (
+	applyClipboard = "clipboard"
+
+	// maxManifestSize caps the size of a fetched manifest.
+	maxManifestSize = 5 << 20
+
+	manifestFetchTimeout = 10 * time.Second
+)
+
+// applyCmd fetches a manifest from a url or the clipboard and previews its
+// documents server-side dry-run diffs prior to applying them.
+func (a *App) applyCmd(src string) error {
+	if a.Config.K9s.IsReadOnly() {
+		return fmt.Errorf("apply %s denied: %w", src, dao.ErrReadOnly)
+	}
+	a.Flash().Infof("Fetching manifest from %s...", src)
+	go func() {
+		dd, err := manifestDocs(a, src)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Err(err)
+				return
+			}
+			v := NewManifests(client.NewGVR("manifests"))
+			if m, ok := v.(*Manifests); ok {
+				m.SetDocs(src, dd)
+			}
+			if err := a.inject(v, false); err != nil {
+				a.Flash().Err(err)
+				return
+			}
+			a.Flash().Infof("Manifest has %d document(s). Mark the ones to apply and press <a>", len(dd))
+		})
+	}()
+
+	return nil
+}
+
+// manifestDocs fetches a manifest and diffs each of its documents against the cluster.
+func manifestDocs(a *App, src string) ([]render.ManifestRes, error) {
+	raw, err := fetchManifest(src)
+	if err != nil {
+		return nil, err
+	}
+	dd, err := dao.SplitManifest(raw)
+	if err != nil {
+		return nil, err
+	}
+	for i := range dd {
+		res, err := runKu(a, shellOpts{args: []string{"diff", "-f", "-", "--server-side"}, input: dd[i].Raw})
+		dd[i].Diff, _, dd[i].Err = diffResult(res, err)
+		dd[i].Adds, dd[i].Dels = dao.DiffStats(dd[i].Diff)
+	}
+
+	return dd, nil
+}
+
+// fetchManifest reads a manifest from the clipboard or a http(s) url.
+func fetchManifest(src string) ([]byte, error) {
+	if src == applyClipboard {
+		s, err := clipboard.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read clipboard: %w", err)
+		}
+		if strings.TrimSpace(s) == "" {
+			return nil, fmt.Errorf("clipboard is empty")
+		}
+		return []byte(s), nil
+	}
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return nil, fmt.Errorf("invalid manifest source %q. use a http(s) url or clipboard", src)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), manifestFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch manifest %s: %s", src, resp.Status)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > maxManifestSize {
+		return nil, fmt.Errorf("manifest %s exceeds %dMB", src, maxManifestSize>>20)
+	}
+
+	return raw, nil
+}
@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+)
+
+// benchRun represents a stored benchmark run.
+type benchRun struct {
+	file   string
+	time   time.Time
+	report render.BenchReport
+}
+
+// benchMetric represents a benchmark metric tracked across runs.
+type benchMetric struct {
+	name  string
+	value func(render.BenchReport) float64
+	fmt   func(float64) string
+}
+
+var benchMetrics = []benchMetric{
+	{name: "REQ/S", value: func(r render.BenchReport) float64 { return r.RPS }, fmt: asRate},
+	{name: "P50", value: func(r render.BenchReport) float64 { return r.P50 }, fmt: render.AsLatency},
+	{name: "P95", value: func(r render.BenchReport) float64 { return r.P95 }, fmt: render.AsLatency},
+	{name: "P99", value: func(r render.BenchReport) float64 { return r.P99 }, fmt: render.AsLatency},
+	{name: "ERRORS", value: func(r render.BenchReport) float64 { return r.ErrRate() }, fmt: asPercent},
+}
+
+func (b *Benchmark) compareCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := b.GetTable().GetSelectedItems()
+	var base, run benchRun
+	switch len(sels) {
+	case 1:
+		rr, err := benchHistory(sels[0])
+		if err != nil {
+			b.App().Flash().Err(err)
+			return nil
+		}
+		i := slices.IndexFunc(rr, func(r benchRun) bool { return r.file == sels[0] })
+		if i <= 0 {
+			b.App().Flash().Warn("No previous run to compare with. Mark two runs to compare them")
+			return nil
+		}
+		base, run = rr[i-1], rr[i]
+	case 2:
+		rr := make([]benchRun, 0, len(sels))
+		for _, s := range sels {
+			r, err := loadBenchRun(s)
+			if err != nil {
+				b.App().Flash().Err(err)
+				return nil
+			}
+			rr = append(rr, r)
+		}
+		sort.Slice(rr, func(i, j int) bool { return rr[i].time.Before(rr[j].time) })
+		base, run = rr[0], rr[1]
+	default:
+		b.App().Flash().Warn("Mark two runs to compare them")
+		return nil
+	}
+
+	subject := fileToSubject(base.file)
+	if s := fileToSubject(run.file); s != subject {
+		subject += " vs " + s
+	}
+	details := NewDetails(b.App(), "Compare", subject, contentTXT, false).Update(benchDiff(base, run))
+	if err := b.App().inject(details, false); err != nil {
+		b.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (b *Benchmark) trendCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	rr, err := benchHistory(path)
+	if err != nil {
+		b.App().Flash().Err(err)
+		return nil
+	}
+
+	details := NewDetails(b.App(), "Trend", fileToSubject(path), contentTXT, false).Update(benchTrend(rr))
+	if err := b.App().inject(details, false); err != nil {
+		b.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// benchTarget returns a benchmark report target and run time.
+func benchTarget(path string) (string, time.Time, error) {
+	n := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	i := strings.LastIndex(n, "_")
+	if i <= 0 {
+		return "", time.Time{}, fmt.Errorf("invalid benchmark report name %q", n)
+	}
+	nanos, err := strconv.ParseInt(n[i+1:], 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid benchmark report name %q", n)
+	}
+
+	return n[:i], time.Unix(0, nanos), nil
+}
+
+func loadBenchRun(path string) (benchRun, error) {
+	_, t, err := benchTarget(path)
+	if err != nil {
+		return benchRun{}, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return benchRun{}, err
+	}
+
+	return benchRun{
+		file:   path,
+		time:   t,
+		report: render.ParseBenchReport(string(data)),
+	}, nil
+}
+
+// benchHistory returns all the runs of a given report target sorted by time.
+func benchHistory(path string) ([]benchRun, error) {
+	target, _, err := benchTarget(path)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(path)
+	ff, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := make([]benchRun, 0, len(ff))
+	for _, f := range ff {
+		if t, _, err := benchTarget(f.Name()); err != nil || t != target {
+			continue
+		}
+		r, err := loadBenchRun(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool { return rr[i].time.Before(rr[j].time) })
+
+	return rr, nil
+}
+
+// benchDiff renders the metrics deltas between two runs.
+func benchDiff(base, run benchRun) string {
+	var buff bytes.Buffer
+	w := tabwriter.NewWriter(&buff, 0, 4, 3, ' ', 0)
+	fmt.Fprintf(w, "METRIC\tBASE\tRUN\tDELTA\n")
+	fmt.Fprintf(w, "TIME\t%s\t%s\t%s\n", base.time.Format(time.DateTime), run.time.Format(time.DateTime), run.time.Sub(base.time).Round(time.Second))
+	for _, m := range benchMetrics {
+		a, b := m.value(base.report), m.value(run.report)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", m.name, m.fmt(a), m.fmt(b), benchDelta(m, a, b))
+	}
+	_ = w.Flush()
+
+	return buff.String()
+}
+
+// benchTrend renders the metrics of several runs over time.
+func benchTrend(rr []benchRun) string {
+	if len(rr) == 0 {
+		return "No benchmark runs found"
+	}
+
+	var buff bytes.Buffer
+	w := tabwriter.NewWriter(&buff, 0, 4, 3, ' ', 0)
+	fmt.Fprintf(w, "RUNS\t%d\tfrom %s to %s\n", len(rr), rr[0].time.Format(time.DateTime), rr[len(rr)-1].time.Format(time.DateTime))
+	for _, m := range benchMetrics {
+		vv := make([]float64, 0, len(rr))
+		for _, r := range rr {
+			vv = append(vv, m.value(r.report))
+		}
+		fmt.Fprintf(w, "%s\t%s\tlast %s\n", m.name, trendLine(vv), m.fmt(vv[len(vv)-1]))
+	}
+	_ = w.Flush()
+
+	buff.WriteString("\n")
+	w = tabwriter.NewWriter(&buff, 0, 4, 3, ' ', 0)
+	fmt.Fprintf(w, "TIME")
+	for _, m := range benchMetrics {
+		fmt.Fprintf(w, "\t%s", m.name)
+	}
+	fmt.Fprintln(w)
+	for _, r := range rr {
+		fmt.Fprintf(w, "%s", r.time.Format(time.DateTime))
+		for _, m := range benchMetrics {
+			fmt.Fprintf(w, "\t%s", m.fmt(m.value(r.report)))
+		}
+		fmt.Fprintln(w)
+	}
+	_ = w.Flush()
+
+	return buff.String()
+}
+
+func benchDelta(m benchMetric, a, b float64) string {
+	var d string
+	switch {
+	case a < 0 || b < 0:
+		return render.NAValue
+	case m.name == "ERRORS":
+		d = strconv.FormatFloat(b-a, 'f', 2, 64) + "pt"
+	case a == 0:
+		return render.NAValue
+	default:
+		d = strconv.FormatFloat((b-a)*100/a, 'f', 1, 64) + "%"
+	}
+	if b >= a {
+		return "+" + d
+	}
+
+	return d
+}
+
+// trendLine renders values as a sparkline. Missing values show as lows.
+func trendLine(vv []float64) string {
+	const scale = 1_000
+
+	var top float64
+	for _, v := range vv {
+		top = max(top, v)
+	}
+	if top <= 0 {
+		return ""
+	}
+	ii := make([]int, 0, len(vv))
+	for _, v := range vv {
+		ii = append(ii, int(v*scale/top))
+	}
+
+	return render.Sparkline(ii, scale)
+}
+
+func asRate(f float64) string {
+	if f < 0 {
+		return render.NAValue
+	}
+
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
+
+func asPercent(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64) + "%"
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchHistory(t *testing.T) {
+	dir := t.TempDir()
+	for f, rps := range map[string]string{
+		"default_fred_300.txt": "30.0",
+		"default_fred_100.txt": "10.0",
+		"default_fred_200.txt": "20.0",
+		"default_blee_150.txt": "15.0",
+		"junk.txt":             "0",
+	} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, f), []byte("Requests/sec:\t"+rps+"\n"), 0600))
+	}
+
+	rr, err := benchHistory(filepath.Join(dir, "default_fred_200.txt"))
+	assert.NoError(t, err)
+	assert.Len(t, rr, 3)
+	for i, e := range []float64{10, 20, 30} {
+		assert.Equal(t, e, rr[i].report.RPS)
+		assert.Equal(t, int64(100*(i+1)), rr[i].time.UnixNano())
+	}
+
+	_, err = benchHistory(filepath.Join(dir, "junk.txt"))
+	assert.Error(t, err)
+}
+
+func TestBenchDiff(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 10, 0, 0, 0, time.Local)
+	base := benchRun{
+		time:   t0,
+		report: render.BenchReport{RPS: 100, P50: 0.01, P95: 0.02, P99: -1, Requests: 100},
+	}
+	run := benchRun{
+		time:   t0.Add(time.Hour),
+		report: render.BenchReport{RPS: 80, P50: 0.015, P95: 0.02, P99: 0.05, Requests: 100, Fails: 5},
+	}
+
+	assert.Equal(t, `METRIC   BASE                  RUN                   DELTA
+TIME     2024-01-01 10:00:00   2024-01-01 11:00:00   1h0m0s
+REQ/S    100.00                80.00                 -20.0%
+P50      10.0ms                15.0ms                +50.0%
+P95      20.0ms                20.0ms                +0.0%
+P99      n/a                   50.0ms                n/a
+ERRORS   0.00%                 5.00%                 +5.00pt
+`, benchDiff(base, run))
+}
+
+func TestBenchTrend(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 10, 0, 0, 0, time.Local)
+	rr := []benchRun{
+		{time: t0, report: render.BenchReport{RPS: 10, P50: 0.01, P95: 0.02, P99: 0.04, Requests: 10}},
+		{time: t0.Add(time.Hour), report: render.BenchReport{RPS: 40, P50: 0.02, P95: 0.02, P99: 0.04, Requests: 10, Fails: 1}},
+	}
+
+	assert.Equal(t, `RUNS     2    from 2024-01-01 10:00:00 to 2024-01-01 11:00:00
+REQ/S    ▂█   last 40.00
+P50      ▄█   last 20.0ms
+P95      ██   last 20.0ms
+P99      ██   last 40.0ms
+ERRORS   ▁█   last 10.00%
+
+TIME                  REQ/S   P50      P95      P99      ERRORS
+2024-01-01 10:00:00   10.00   10.0ms   20.0ms   40.0ms   0.00%
+2024-01-01 11:00:00   40.00   20.0ms   20.0ms   40.0ms   10.00%
+`, benchTrend(rr))
+	assert.Equal(t, "No benchmark runs found", benchTrend(nil))
+}
@@ -5,17 +5,20 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
-	"github.com/rs/zerolog/log"
 )
 
 // Benchmark represents a service benchmark results view.
@@ -33,10 +36,18 @@ func NewBenchmark(gvr client.GVR) ResourceViewer {
 	b.GetTable().SetSortCol(ageCol, true)
 	b.SetContextFn(b.benchContext)
 	b.GetTable().SetEnterFn(b.viewBench)
+	b.AddBindKeysFn(b.bindKeys)
 
 	return &b
 }
 
+func (b *Benchmark) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftC: ui.NewKeyAction("Compare", b.compareCmd, true),
+		ui.KeyT:      ui.NewKeyAction("Trend", b.trendCmd, true),
+	})
+}
+
 func (b *Benchmark) benchContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyDir, benchDir(b.App().Config))
 }
@@ -56,12 +67,94 @@ func (b *Benchmark) viewBench(app *App, model ui.Tabular, gvr client.GVR, path s
 
 func (b *Benchmark) benchFile() string {
 	r := b.GetTable().GetSelectedRowIndex()
-	return ui.TrimCell(b.GetTable().SelectTable, r, 7)
+	return ui.TrimCell(b.GetTable().SelectTable, r, 10)
+}
+
+// benchRuns tracks benchmarks running concurrently against several endpoints.
+type benchRuns struct {
+	runs map[string]*perf.Benchmark
+	mx   sync.Mutex
+}
+
+// cancel cancels the benchmarks running on the given paths and returns how
+// many were canceled.
+func (b *benchRuns) cancel(paths []string) int {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	var n int
+	for _, p := range paths {
+		if r, ok := b.runs[p]; ok {
+			r.Cancel()
+			n++
+		}
+	}
+
+	return n
+}
+
+// run benchmarks a given url in the background.
+func (b *benchRuns) run(app *App, path, base string, cfg config.BenchConfig) error {
+	r, err := perf.NewBenchmark(base, app.version, cfg)
+	if err != nil {
+		return err
+	}
+	ct, err := app.Config.K9s.ActiveContext()
+	if err != nil {
+		return err
+	}
+
+	b.mx.Lock()
+	if b.runs == nil {
+		b.runs = make(map[string]*perf.Benchmark)
+	}
+	b.runs[path] = r
+	n := len(b.runs)
+	b.mx.Unlock()
+
+	app.Status(model.FlashWarn, benchProgress(n))
+	log.Debug().Msgf("Benchmark %q starting...", path)
+	go r.Run(ct.ClusterName, app.Config.K9s.ActiveContextName(), func() {
+		b.done(app, path, r)
+	})
+
+	return nil
+}
+
+func (b *benchRuns) done(app *App, path string, r *perf.Benchmark) {
+	log.Debug().Msgf("Benchmark %q completed!", path)
+	canceled := r.Canceled()
+	r.Cancel()
+	b.mx.Lock()
+	delete(b.runs, path)
+	n := len(b.runs)
+	b.mx.Unlock()
+
+	app.QueueUpdate(func() {
+		switch {
+		case n > 0:
+			app.Status(model.FlashWarn, benchProgress(n))
+			return
+		case canceled:
+			app.Status(model.FlashInfo, "Benchmark canceled")
+		default:
+			app.Status(model.FlashInfo, "Benchmark Completed!")
+		}
+		go clearStatus(app)
+	})
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func benchProgress(n int) string {
+	if n == 1 {
+		return "Benchmark in progress..."
+	}
+
+	return fmt.Sprintf("%d benchmarks in progress...", n)
+}
+
 func fileToSubject(path string) string {
 	tokens := strings.Split(path, "/")
 	ee := strings.Split(tokens[len(tokens)-1], "_")
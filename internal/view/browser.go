@@ -20,11 +20,13 @@ import (
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tcell/v2"
-	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const versionsTitle = "API Versions"
+
 // Browser represents a generic resource browser.
 type Browser struct {
 	*Table
@@ -36,6 +38,10 @@ type Browser struct {
 	cancelFn   context.CancelFunc
 	mx         sync.RWMutex
 	updating   bool
+	deprecated bool
+	lease      *watch.Lease
+	versions   client.GVRs
+	pager      *dao.Pager
 }
 
 // NewBrowser returns a new browser.
@@ -78,6 +84,7 @@ func (b *Browser) Init(ctx context.Context) error {
 		if _, e := b.app.factory.CanForResource(ns, b.GVR().String(), client.ListAccess); e != nil {
 			return e
 		}
+		b.loadVersions()
 	}
 	if b.App().IsRunning() {
 		b.app.CmdBuff().Reset()
@@ -118,6 +125,10 @@ func (b *Browser) suggestFilter() model.SuggestionFunc {
 			return b.App().filterHistory.List()
 		}
 
+		if q, ok := internal.IsExprSelector(s); ok {
+			return suggestExprCols(q, b.GetModel().Peek().Header())
+		}
+
 		s = strings.ToLower(s)
 		for _, h := range b.App().filterHistory.List() {
 			if s == h {
@@ -131,6 +142,25 @@ func (b *Browser) suggestFilter() model.SuggestionFunc {
 	}
 }
 
+// suggestExprCols completes the column name of the last expression term.
+func suggestExprCols(q string, h model1.Header) (entries sort.StringSlice) {
+	if i := strings.LastIndexAny(q, "&|"); i >= 0 {
+		q = q[i+1:]
+	}
+	q = strings.ToLower(strings.TrimLeft(q, " "))
+	if strings.ContainsAny(q, " =!<>~") {
+		return nil
+	}
+	for _, c := range h.ColumnNames(true) {
+		n := strings.ToLower(strings.ReplaceAll(c, " ", "_"))
+		if n != q && strings.HasPrefix(n, q) {
+			entries = append(entries, strings.TrimPrefix(n, q))
+		}
+	}
+
+	return
+}
+
 func (b *Browser) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", b.resetCmd, false),
@@ -155,6 +185,10 @@ func (b *Browser) Start() {
 	}
 
 	b.Stop()
+	b.deprecated = false
+	if b.pager == nil {
+		b.AcquireInformers()
+	}
 	b.GetModel().AddListener(b)
 	b.Table.Start()
 	b.CmdBuff().AddListener(b)
@@ -163,6 +197,30 @@ func (b *Browser) Start() {
 	}
 }
 
+// RefreshActions rebinds the browser key actions.
+func (b *Browser) RefreshActions() {
+	b.refreshActions()
+}
+
+// AcquireInformers leases the informer backing the browser namespace.
+func (b *Browser) AcquireInformers() {
+	ns, gvr := b.GetModel().GetNamespace(), b.GVR().String()
+	if b.lease != nil && b.lease.Matches(ns, gvr) {
+		return
+	}
+	b.ReleaseInformers()
+	b.lease = b.app.factory.Lease(ns, gvr)
+}
+
+// ReleaseInformers releases the informer leased by the browser if any.
+func (b *Browser) ReleaseInformers() {
+	if b.lease == nil {
+		return
+	}
+	b.lease.Release()
+	b.lease = nil
+}
+
 // Stop terminates browser updates.
 func (b *Browser) Stop() {
 	b.mx.Lock()
@@ -290,10 +348,29 @@ func (b *Browser) TableDataChanged(data *model1.TableData) {
 		b.setUpdating(true)
 		defer b.setUpdating(false)
 		b.refreshActions()
+		b.Page = ""
+		if b.pager != nil {
+			b.Page = b.pager.String()
+		}
 		b.UpdateUI(cdata, data)
+		b.warnDeprecated()
 	})
 }
 
+// warnDeprecated flashes the api server deprecation warning for the viewed
+// resource once per view activation.
+func (b *Browser) warnDeprecated() {
+	if b.deprecated {
+		return
+	}
+	dep, ok := b.app.Conn().Config().Deprecations().Lookup(b.GVR().String())
+	if !ok {
+		return
+	}
+	b.deprecated = true
+	b.app.Flash().Warnf("%s (see :deprecations)", dep.Message)
+}
+
 // TableLoadFailed notifies view something went south.
 func (b *Browser) TableLoadFailed(err error) {
 	b.app.QueueUpdateDraw(func() {
@@ -318,6 +395,19 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) ownersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := b.app.inject(NewOwners(b.GVR(), path), false); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (b *Browser) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if b.CmdBuff().InCmdMode() {
 		return nil
@@ -396,12 +486,163 @@ func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 		if len(selections) > 1 {
 			msg = fmt.Sprintf("Delete %d marked %s?", len(selections), b.GVR())
 		}
+		var accept string
+		for _, sel := range selections {
+			if isProtected(b.app, b.GVR(), sel) {
+				accept = protectedAccept(selections)
+				break
+			}
+		}
 		if !dao.IsK8sMeta(b.meta) {
-			b.simpleDelete(selections, msg)
+			b.simpleDelete(selections, msg, accept)
 			return nil
 		}
-		b.resourceDelete(selections, msg)
+		b.resourceDelete(selections, msg, accept)
+	}
+
+	return nil
+}
+
+func (b *Browser) labelCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := b.GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+	if len(sels) == 1 {
+		showMetaEditor(b, "Label", dao.LabelsField, sels[0])
+		return nil
+	}
+	showMetaEditDialog(b, "Label", dao.LabelsField, sels)
+
+	return nil
+}
+
+func (b *Browser) annotateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := b.GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+	if len(sels) == 1 {
+		showMetaEditor(b, "Annotate", dao.AnnotationsField, sels[0])
+		return nil
+	}
+	showMetaEditDialog(b, "Annotate", dao.AnnotationsField, sels)
+
+	return nil
+}
+
+func (b *Browser) queryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := b.GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+	cols := b.GetModel().Peek().Header().ColumnNames(false)
+	dialog.ShowInput(b.app.Styles.Dialog(), b.app.Content.Pages, dialog.InputDialogOpts{
+		Title:   "Query",
+		Message: fmt.Sprintf("JSONPath or yq expression evaluated against %d %s", len(sels), b.GVR().R()),
+		Label:   "Query:",
+		Ack: func(expr string) {
+			if err := b.app.inject(NewQuery(b.GVR(), expr, sels, cols), false); err != nil {
+				b.app.Flash().Err(err)
+			}
+		},
+		Cancel: func() {},
+	})
+
+	return nil
+}
+
+func (b *Browser) togglePagingCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if b.pager == nil {
+		b.pager = dao.NewPager(dao.DefaultPageSize)
+		b.ReleaseInformers()
+		b.app.Flash().Infof("Paging %s by %d", b.GVR().R(), dao.DefaultPageSize)
+	} else {
+		b.pager = nil
+		b.Actions().Delete(ui.KeyAltN, ui.KeyAltB)
+		b.app.Flash().Infof("Paging off for %s", b.GVR().R())
+	}
+	b.refresh()
+
+	return nil
+}
+
+func (b *Browser) nextPageCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if b.pager == nil {
+		return evt
+	}
+	if !b.pager.Next() {
+		b.app.Flash().Info("Already on the last page")
+		return nil
+	}
+	b.refresh()
+
+	return nil
+}
+
+func (b *Browser) prevPageCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if b.pager == nil {
+		return evt
+	}
+	if !b.pager.Prev() {
+		b.app.Flash().Info("Already on the first page")
+		return nil
+	}
+	b.refresh()
+
+	return nil
+}
+
+// loadVersions tracks the api versions serving the resource.
+func (b *Browser) loadVersions() {
+	vv, pref, err := dao.MetaAccess.ResourceVersions(b.app.factory, b.GVR())
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to load %s versions", b.GVR())
+		return
+	}
+	b.versions = vv
+	if pref != "" && b.GVR().V() != pref {
+		b.GetTable().Version = b.GVR().V()
+	}
+}
+
+func (b *Browser) versionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if len(b.versions) < 2 {
+		return evt
+	}
+
+	ss := make([]string, 0, len(b.versions))
+	for _, v := range b.versions {
+		s := v.G() + "/" + v.V()
+		if v.G() == "" {
+			s = v.V()
+		}
+		if v == b.GVR() {
+			s += " (current)"
+		}
+		ss = append(ss, s)
+	}
+	picker := NewPicker()
+	picker.populate(ss)
+	picker.SetSelectedFunc(func(idx int, _, _ string, _ rune) {
+		b.app.Content.Pop()
+		gvr := b.versions[idx]
+		if gvr == b.GVR() {
+			return
+		}
+		if err := dao.MetaAccess.RegisterVersion(b.GVR(), gvr); err != nil {
+			b.app.Flash().Err(err)
+			return
+		}
+		if err := b.app.inject(NewBrowser(gvr), false); err != nil {
+			b.app.Flash().Err(err)
+		}
+	})
+	if err := b.app.inject(picker, false); err != nil {
+		b.app.Flash().Err(err)
+		return nil
 	}
+	picker.SetTitle(" [aqua::b]" + versionsTitle + " ")
 
 	return nil
 }
@@ -445,6 +686,9 @@ func editRes(app *App, gvr client.GVR, path string) error {
 	if ok, err := app.Conn().CanI(ns, gvr.String(), n, client.PatchAccess); !ok || err != nil {
 		return fmt.Errorf("current user can't edit resource %s", gvr)
 	}
+	if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil && dao.IsCRD(meta) {
+		return editCR(app, gvr, path)
+	}
 
 	args := make([]string, 0, 10)
 	args = append(args, "edit")
@@ -520,6 +764,10 @@ func (b *Browser) defaultContext() context.Context {
 	}
 	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(b.App().Config.ActiveNamespace()))
 	ctx = context.WithValue(ctx, internal.KeyWithMetrics, b.app.factory.Client().HasMetrics())
+	ctx = context.WithValue(ctx, internal.KeyViewConfig, b.app.CustomView)
+	if b.pager != nil {
+		ctx = context.WithValue(ctx, internal.KeyPager, b.pager)
+	}
 
 	return ctx
 }
@@ -544,6 +792,25 @@ func (b *Browser) refreshActions() {
 						Dangerous: true,
 					}))
 			}
+			if client.Can(b.meta.Verbs, "edit") && dao.IsK8sMeta(b.meta) {
+				aa.Bulk(ui.KeyMap{
+					tcell.KeyCtrlT: ui.NewKeyActionWithOpts("Label", b.labelCmd,
+						ui.ActionOpts{
+							Visible:   true,
+							Dangerous: true,
+						}),
+					tcell.KeyCtrlN: ui.NewKeyActionWithOpts("Annotate", b.annotateCmd,
+						ui.ActionOpts{
+							Visible:   true,
+							Dangerous: true,
+						}),
+					ui.KeyAltC: ui.NewKeyActionWithOpts("Copy To", b.copyToCmd,
+						ui.ActionOpts{
+							Visible:   true,
+							Dangerous: true,
+						}),
+				})
+			}
 			if client.Can(b.meta.Verbs, "delete") {
 				aa.Add(tcell.KeyCtrlD, ui.NewKeyActionWithOpts("Delete", b.deleteCmd,
 					ui.ActionOpts{
@@ -559,6 +826,22 @@ func (b *Browser) refreshActions() {
 		aa.Add(ui.KeyY, ui.NewKeyAction(yamlAction, b.viewCmd, true))
 		aa.Add(ui.KeyD, ui.NewKeyAction("Describe", b.describeCmd, true))
 	}
+	if dao.IsK8sMeta(b.meta) {
+		aa.Add(tcell.KeyCtrlO, ui.NewKeyAction("Owners", b.ownersCmd, true))
+		aa.Add(ui.KeyAltQ, ui.NewKeyAction("Query", b.queryCmd, true))
+	}
+	if dao.IsK8sMeta(b.meta) && b.GetTable().Path == "" {
+		aa.Add(ui.KeyAltL, ui.NewKeyAction("Toggle Paging", b.togglePagingCmd, true))
+		if b.pager != nil {
+			aa.Bulk(ui.KeyMap{
+				ui.KeyAltN: ui.NewKeyAction("Next Page", b.nextPageCmd, true),
+				ui.KeyAltB: ui.NewKeyAction("Prev Page", b.prevPageCmd, true),
+			})
+		}
+	}
+	if len(b.versions) > 1 {
+		aa.Add(ui.KeyAltG, ui.NewKeyAction("API Version", b.versionCmd, true))
+	}
 	for _, f := range b.bindKeysFn {
 		f(aa)
 	}
@@ -572,6 +855,10 @@ func (b *Browser) refreshActions() {
 		log.Warn().Msgf("Hotkeys load failed: %s", err)
 		b.app.Logo().Warn("HotKeys load failed!")
 	}
+	if err := keyMapActions(b, b.Actions()); err != nil {
+		log.Warn().Msgf("Keymap load failed: %s", err)
+		b.app.Logo().Warn("Keymap load failed!")
+	}
 	b.app.Menu().HydrateMenu(b.Hints())
 }
 
@@ -595,52 +882,55 @@ func (b *Browser) namespaceActions(aa *ui.KeyActions) {
 	}
 }
 
-func (b *Browser) simpleDelete(selections []string, msg string) {
-	dialog.ShowConfirm(b.app.Styles.Dialog(), b.app.Content.Pages, "Confirm Delete", msg, func() {
-		b.ShowDeleted()
-		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR().R())
-		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
-		}
-		for _, sel := range selections {
-			nuker, ok := b.accessor.(dao.Nuker)
-			if !ok {
-				b.app.Flash().Errf("Invalid nuker %T", b.accessor)
-				continue
-			}
-			if err := nuker.Delete(context.Background(), sel, nil, dao.DefaultGrace); err != nil {
-				b.app.Flash().Errf("Delete failed with `%s", err)
-			} else {
-				b.app.factory.DeleteForwarder(sel)
-			}
-			b.GetTable().DeleteMark(sel)
+func (b *Browser) simpleDelete(selections []string, msg, accept string) {
+	okFn := func() {
+		nuker, ok := b.accessor.(dao.Nuker)
+		if !ok {
+			b.app.Flash().Errf("Invalid nuker %T", b.accessor)
+			return
 		}
-		b.refresh()
-	}, func() {})
+		b.ShowDeleted()
+		b.bulkDelete(selections, func(ctx context.Context, sel string) error {
+			return nuker.Delete(ctx, sel, nil, dao.DefaultGrace)
+		})
+	}
+	if accept != "" {
+		dialog.ShowConfirmAck(b.app.App, b.app.Content.Pages, accept, true, "Confirm Delete", protectedMsg(msg, accept), okFn, func() {})
+		return
+	}
+	dialog.ShowConfirm(b.app.Styles.Dialog(), b.app.Content.Pages, "Confirm Delete", msg, okFn, func() {})
 }
 
-func (b *Browser) resourceDelete(selections []string, msg string) {
+func (b *Browser) resourceDelete(selections []string, msg, accept string) {
 	okFn := func(propagation *metav1.DeletionPropagation, force bool) {
 		b.ShowDeleted()
-		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
-		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
+		grace := dao.DefaultGrace
+		if force {
+			grace = dao.ForceGrace
 		}
+		b.bulkDelete(selections, func(ctx context.Context, sel string) error {
+			return b.GetModel().Delete(ctx, sel, propagation, grace)
+		})
+	}
+	if accept != "" {
+		dialog.ShowProtectedDelete(b.app.Styles.Dialog(), b.app.Content.Pages, protectedMsg(msg, accept), accept, okFn, func() {})
+		return
+	}
+	dialog.ShowDelete(b.app.Styles.Dialog(), b.app.Content.Pages, msg, okFn, func() {})
+}
+
+func (b *Browser) bulkDelete(selections []string, nuke BulkFunc) {
+	runBulk(b.defaultContext(), b.app, "Delete", selections, func(ctx context.Context, sel string) error {
+		if err := nuke(ctx, sel); err != nil {
+			return err
+		}
+		b.app.factory.DeleteForwarder(sel)
+
+		return nil
+	}, func() {
 		for _, sel := range selections {
-			grace := dao.DefaultGrace
-			if force {
-				grace = dao.ForceGrace
-			}
-			if err := b.GetModel().Delete(b.defaultContext(), sel, propagation, grace); err != nil {
-				b.app.Flash().Errf("Delete failed with `%s", err)
-			} else {
-				b.app.factory.DeleteForwarder(sel)
-			}
 			b.GetTable().DeleteMark(sel)
 		}
 		b.refresh()
-	}
-	dialog.ShowDelete(b.app.Styles.Dialog(), b.app.Content.Pages, msg, okFn, func() {})
+	})
 }
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestExprCols(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "NOMINATED NODE", Wide: true},
+	}
+
+	uu := map[string]struct {
+		q string
+		e sort.StringSlice
+	}{
+		"first": {
+			q: "na",
+			e: sort.StringSlice{"me", "mespace"},
+		},
+		"next-term": {
+			q: "status=Running && nom",
+			e: sort.StringSlice{"inated_node"},
+		},
+		"or-term": {
+			q: "status=Running ||st",
+			e: sort.StringSlice{"atus"},
+		},
+		"full": {
+			q: "status",
+		},
+		"value": {
+			q: "status=R",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, suggestExprCols(u.q, h))
+		})
+	}
+}
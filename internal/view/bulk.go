@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+)
+
+const metaEditDialogKey = "meta-edit"
+
+// BulkFunc applies an action to a single resource.
+type BulkFunc func(ctx context.Context, path string) error
+
+// runBulk applies an action on a collection of resources while reporting
+// progress. The operation can be canceled from the progress dialog.
+func runBulk(ctx context.Context, app *App, action string, paths []string, fn BulkFunc, done func()) {
+	bulk(ctx, app, action, paths, fn, done, false)
+}
+
+// runOrdered applies an action on a collection of resources one after the
+// other and stops at the first failure, leaving the remaining ones untouched.
+func runOrdered(ctx context.Context, app *App, action string, paths []string, fn BulkFunc, done func()) {
+	bulk(ctx, app, action, paths, fn, done, true)
+}
+
+func bulk(ctx context.Context, app *App, action string, paths []string, fn BulkFunc, done func(), stopOnErr bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	p := dialog.ShowProgress(app.Styles.Dialog(), app.Content.Pages, action, len(paths), func() { cancel() })
+
+	go func() {
+		defer cancel()
+		errs := make([]string, 0, len(paths))
+		for _, path := range paths {
+			if ctx.Err() != nil {
+				break
+			}
+			err := fn(ctx, path)
+			if err != nil {
+				log.Error().Err(err).Msgf("%s failed for %s", action, path)
+				errs = append(errs, fmt.Sprintf("%s: %s", path, err))
+			}
+			item := path
+			app.QueueUpdateDraw(func() {
+				p.Update(item, err)
+			})
+			if err != nil && stopOnErr {
+				break
+			}
+		}
+		canceled := ctx.Err() != nil
+		app.QueueUpdateDraw(func() {
+			p.Dismiss()
+			bulkReport(app, action, p.Count(), len(paths), errs, canceled)
+			if done != nil {
+				done()
+			}
+		})
+	}()
+}
+
+func bulkReport(app *App, action string, count, total int, errs []string, canceled bool) {
+	switch {
+	case len(errs) > 0 && count < total:
+		msg := fmt.Sprintf("%s stopped after %d/%d resources\n%s", action, count, total, strings.Join(errs, "\n"))
+		dialog.ShowError(app.Styles.Dialog(), app.Content.Pages, msg)
+	case len(errs) > 0:
+		msg := fmt.Sprintf("%s failed for %d/%d resources\n%s", action, len(errs), total, strings.Join(errs, "\n"))
+		dialog.ShowError(app.Styles.Dialog(), app.Content.Pages, msg)
+	case canceled:
+		app.Flash().Warnf("%s canceled after %d/%d resources", action, count, total)
+	case total == 1:
+		app.Flash().Infof("%s completed", action)
+	default:
+		app.Flash().Infof("%s completed on %d resources", action, total)
+	}
+}
+
+// showMetaEditDialog prompts for kubectl style labels or annotations edits
+// and applies them to all the given resources.
+func showMetaEditDialog(v ResourceViewer, action, field string, paths []string) {
+	styles := v.App().Styles.Dialog()
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	var edits string
+	f.AddInputField("Edits:", "", 40, nil, func(changed string) {
+		edits = changed
+	})
+	dismiss := func() {
+		v.App().Content.RemovePage(metaEditDialogKey)
+	}
+	f.AddButton(i18n.T("OK"), func() {
+		kvs, err := dao.ParseMetaEdits(edits)
+		if err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		dismiss()
+		patchMeta(v, action, field, paths, kvs)
+	})
+	f.AddButton(i18n.T("Cancel"), dismiss)
+	for i := 0; i < 2; i++ {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	modal := tview.NewModalForm("<"+action+">", f)
+	msg := fmt.Sprintf("Update %s on %s %s (k1=v1,k2-)", field, singularize(v.GVR().R()), paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Update %s on %d marked %s (k1=v1,k2-)", field, len(paths), v.GVR().R())
+	}
+	modal.SetText(msg)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismiss()
+	})
+	v.App().Content.AddPage(metaEditDialogKey, modal, false, false)
+	v.App().Content.ShowPage(metaEditDialogKey)
+}
+
+// showMetaEditor pops a labels or annotations editor for a single resource.
+func showMetaEditor(v ResourceViewer, action, field, path string) {
+	f := v.App().factory
+	current, err := dao.FetchMeta(f, v.GVR(), path, field)
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	ns, _ := client.Namespaced(path)
+	keys, err := dao.MetaKeys(f, v.GVR(), ns, field)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list %s keys for %q", field, v.GVR())
+	}
+
+	dialog.ShowMetaEditor(v.App().Styles.Dialog(), v.App().Content.Pages, action, current, keys, func(k string, val *string) {
+		patchMeta(v, action, field, []string{path}, map[string]*string{k: val})
+	}, func() {})
+}
+
+func patchMeta(v ResourceViewer, action, field string, paths []string, kvs map[string]*string) {
+	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	patcher, ok := res.(dao.Patcher)
+	if !ok {
+		v.App().Flash().Errf("expecting a patcher for %q", v.GVR())
+		return
+	}
+	var data []byte
+	if field == dao.LabelsField {
+		data, err = dao.GetMetaPatch(kvs, nil)
+	} else {
+		data, err = dao.GetMetaPatch(nil, kvs)
+	}
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+
+	runBulk(context.Background(), v.App(), action, paths, func(ctx context.Context, path string) error {
+		return patcher.Patch(ctx, path, data)
+	}, func() {
+		v.GetTable().ClearMarks()
+		v.Refresh()
+	})
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Cert represents a certificates expiry view.
+type Cert struct {
+	ResourceViewer
+}
+
+// NewCert returns a new certificates view.
+func NewCert(gvr client.GVR) ResourceViewer {
+	c := Cert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetSortCol("NOT-AFTER", true)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *Cert) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", c.gotoCmd, true),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", c.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftD:   ui.NewKeyAction("Sort Expiry", c.GetTable().SortColCmd("NOT-AFTER", true), false),
+	})
+}
+
+func (c *Cert) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	kind, fqn, ok := strings.Cut(path, ":")
+	if !ok {
+		return nil
+	}
+	gvr := "v1/secrets"
+	if kind == "Certificate" {
+		gvr = dao.CertificateGVR
+	}
+	c.App().gotoResource(client.NewGVR(gvr).R(), fqn, false)
+
+	return nil
+}
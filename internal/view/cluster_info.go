@@ -13,7 +13,6 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 )
 
 var _ model.ClusterInfoListener = (*ClusterInfo)(nil)
@@ -108,6 +107,18 @@ func (c *ClusterInfo) warnCell(s string, w bool) string {
 	return s
 }
 
+// memCell returns k9s memory usage, flagged once nearing its ceiling.
+func (c *ClusterInfo) memCell(m model.MemStats) string {
+	if m.Usage() == 0 {
+		return ""
+	}
+	if m.Pressured() {
+		return fmt.Sprintf(" [orangered::b](%s)", m)
+	}
+
+	return fmt.Sprintf(" [-::b](%s)", m)
+}
+
 // ClusterInfoChanged notifies the cluster meta was changed.
 func (c *ClusterInfo) ClusterInfoChanged(prev, curr model.ClusterMeta) {
 	c.app.QueueUpdateDraw(func() {
@@ -115,13 +126,21 @@ func (c *ClusterInfo) ClusterInfoChanged(prev, curr model.ClusterMeta) {
 		c.layout()
 		row := c.setCell(0, curr.Context)
 		row = c.setCell(row, curr.Cluster)
-		row = c.setCell(row, curr.User)
+		if curr.Impersonating {
+			row = c.setCell(row, c.warnCell(curr.User+" (impersonated)", true))
+		} else {
+			row = c.setCell(row, curr.User)
+		}
+		rev := curr.K9sVer
 		if curr.K9sLatest != "" {
-			row = c.setCell(row, fmt.Sprintf("%s ⚡️[cadetblue::b]%s", curr.K9sVer, curr.K9sLatest))
+			rev = fmt.Sprintf("%s ⚡️[cadetblue::b]%s", curr.K9sVer, curr.K9sLatest)
+		}
+		row = c.setCell(row, rev+c.memCell(curr.K9sMem))
+		if curr.Throttled {
+			row = c.setCell(row, c.warnCell(fmt.Sprintf("%s (throttled %.1fqps)", curr.K8sVer, curr.QPS), true))
 		} else {
-			row = c.setCell(row, curr.K9sVer)
+			row = c.setCell(row, curr.K8sVer)
 		}
-		row = c.setCell(row, curr.K8sVer)
 		if c.hasMetrics() {
 			row = c.setCell(row, ui.AsPercDelta(prev.Cpu, curr.Cpu))
 			_ = c.setCell(row, ui.AsPercDelta(prev.Mem, curr.Mem))
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+var paramRX = regexp.MustCompile(`\$([1-9]\d*)`)
+
+// AliasParams returns the number of positional placeholders ie $1, $2... in
+// an alias expansion.
+func AliasParams(exp string) int {
+	var n int
+	for _, m := range paramRX.FindAllStringSubmatch(exp, -1) {
+		if i, err := strconv.Atoi(m[1]); err == nil && i > n {
+			n = i
+		}
+	}
+
+	return n
+}
+
+// ExpandAlias resolves the prompt against the given alias expansion. The alias
+// placeholders are substituted with the prompt positional arguments, extra
+// arguments being appended to the expansion.
+func (c *Interpreter) ExpandAlias(gvr, exp string) error {
+	ff := strings.Fields(exp)
+	if len(ff) == 0 {
+		return nil
+	}
+	ff[0] = gvr
+	n := AliasParams(exp)
+	if n == 0 {
+		c.Amend(NewInterpreter(strings.Join(ff, " ")))
+		return nil
+	}
+
+	params := strings.Fields(c.line)[1:]
+	if len(params) < n {
+		return fmt.Errorf("alias %q expects %d argument(s) but got %d. Use `%s`", c.cmd, n, len(params), c.cmd+" "+strings.Join(ff[1:], " "))
+	}
+	for _, p := range params[:n] {
+		if paramRX.MatchString(p) {
+			return fmt.Errorf("alias %q missing value for %q", c.cmd, p)
+		}
+	}
+	line := paramRX.ReplaceAllStringFunc(strings.Join(ff, " "), func(s string) string {
+		i, _ := strconv.Atoi(s[1:])
+		return params[i-1]
+	})
+	ap := NewInterpreter(strings.TrimSpace(line + " " + strings.Join(params[n:], " ")))
+	c.cmd, c.args = ap.cmd, ap.args
+
+	return nil
+}
+
+// SuggestAliasParam suggests a value or hints at the placeholder for the alias
+// argument being typed. Namespaces and contexts placeholders are completed.
+func SuggestAliasParam(command, exp string, namespaces client.NamespaceNames, usage map[string]int, contexts []string) []string {
+	ff := strings.Fields(command)
+	if len(ff) == 0 {
+		return nil
+	}
+	var cur string
+	if !strings.HasSuffix(command, " ") {
+		cur, ff = ff[len(ff)-1], ff[:len(ff)-1]
+	}
+	if len(ff) == 0 {
+		return nil
+	}
+	idx := len(ff)
+	if idx > AliasParams(exp) {
+		return nil
+	}
+
+	param := "$" + strconv.Itoa(idx)
+	tt := strings.Fields(exp)
+	for i := 1; i < len(tt); i++ {
+		if !hasParam(tt[i], idx) {
+			continue
+		}
+		switch {
+		case tt[i] == contextFlag+param:
+			return completeCtx(cur, contexts)
+		case tt[i] == param && tt[i-1] != selectorFlag && tt[i-1] != selectorLong:
+			return completeNS(cur, namespaces, usage)
+		case cur == "":
+			return []string{tt[i]}
+		}
+		return nil
+	}
+
+	return nil
+}
+
+func hasParam(s string, idx int) bool {
+	for _, m := range paramRX.FindAllStringSubmatch(s, -1) {
+		if i, err := strconv.Atoi(m[1]); err == nil && i == idx {
+			return true
+		}
+	}
+
+	return false
+}
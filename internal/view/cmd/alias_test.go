@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasParams(t *testing.T) {
+	uu := map[string]struct {
+		exp string
+		e   int
+	}{
+		"none":     {exp: "pods fred app=blee"},
+		"zero":     {exp: "pods $0"},
+		"single":   {exp: "pods -l app=$1", e: 1},
+		"multi":    {exp: "pods -l app=$1 -n $2", e: 2},
+		"shuffled": {exp: "pods -n $3 -l app=$1,tier=$2", e: 3},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, AliasParams(u.exp))
+		})
+	}
+}
+
+func TestExpandAlias(t *testing.T) {
+	uu := map[string]struct {
+		line, exp string
+		ns        string
+		labels    map[string]string
+		filter    string
+		context   string
+		err       bool
+	}{
+		"plain": {
+			line: "fred",
+			exp:  "pods fred app=blee",
+			ns:   "fred",
+			labels: map[string]string{
+				"app": "blee",
+			},
+		},
+		"params": {
+			line: "wp web prod",
+			exp:  "pods -l app=$1 -n $2",
+			ns:   "prod",
+			labels: map[string]string{
+				"app": "web",
+			},
+		},
+		"params-extra": {
+			line: "wp web prod /zorg @dev",
+			exp:  "pods -l app=$1 -n $2",
+			ns:   "prod",
+			labels: map[string]string{
+				"app": "web",
+			},
+			filter:  "zorg",
+			context: "dev",
+		},
+		"params-reuse": {
+			line: "wp blee",
+			exp:  "pods $1 -l app=$1",
+			ns:   "blee",
+			labels: map[string]string{
+				"app": "blee",
+			},
+		},
+		"params-context": {
+			line:    "wc dev",
+			exp:     "pods @$1 kube-system",
+			ns:      "kube-system",
+			context: "dev",
+		},
+		"params-missing": {
+			line: "wp web",
+			exp:  "pods -l app=$1 -n $2",
+			err:  true,
+		},
+		"params-placeholder": {
+			line: "wp app=$1 prod",
+			exp:  "pods -l app=$1 -n $2",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewInterpreter(u.line)
+			err := p.ExpandAlias("v1/pods", u.exp)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "v1/pods", p.Cmd())
+			assert.Equal(t, u.line, p.GetLine())
+			ns, _ := p.NSArg()
+			assert.Equal(t, u.ns, ns)
+			ll, _ := p.LabelsArg()
+			assert.Equal(t, u.labels, ll)
+			f, _ := p.FilterArg()
+			assert.Equal(t, u.filter, f)
+			ctx, _ := p.HasContext()
+			assert.Equal(t, u.context, ctx)
+		})
+	}
+}
+
+func TestSuggestAliasParam(t *testing.T) {
+	nn := client.NamespaceNames{"prod": {}, "preview": {}, "dev": {}}
+	cc := []string{"dev-1", "dev-2", "prod-1"}
+
+	uu := map[string]struct {
+		command, exp string
+		e            []string
+	}{
+		"hint": {
+			command: "wp ",
+			exp:     "pods -l app=$1 -n $2",
+			e:       []string{"app=$1"},
+		},
+		"hint-typing": {
+			command: "wp we",
+			exp:     "pods -l app=$1 -n $2",
+		},
+		"ns": {
+			command: "wp web pr",
+			exp:     "pods -l app=$1 -n $2",
+			e:       []string{"eview", "od"},
+		},
+		"ns-positional": {
+			command: "wp p",
+			exp:     "pods $1",
+			e:       []string{"review", "rod"},
+		},
+		"context": {
+			command: "wc dev",
+			exp:     "pods @$1 kube-system",
+			e:       []string{"-1", "-2"},
+		},
+		"done": {
+			command: "wp web prod ",
+			exp:     "pods -l app=$1 -n $2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, SuggestAliasParam(u.command, u.exp, nn, nil, cc))
+		})
+	}
+}
@@ -39,6 +39,16 @@ func newArgs(p *Interpreter, aa []string) args {
 				args[fuzzyKey] = strings.ToLower(a[2:])
 			}
 
+		case (a == nsFlag || a == nsLongFlag) && i+1 < len(aa) && !p.IsFindCmd():
+			i++
+			args[nsKey] = strings.ToLower(strings.TrimSpace(aa[i]))
+
+		case (a == selectorFlag || a == selectorLong) && i+1 < len(aa) && !p.IsFindCmd():
+			i++
+			if ll := ToLabels(aa[i]); len(ll) != 0 {
+				args[labelKey] = strings.ToLower(strings.TrimSpace(aa[i]))
+			}
+
 		case strings.Index(a, filterFlag) == 0:
 			args[filterKey] = strings.ToLower(a[1:])
 
@@ -55,7 +65,7 @@ func newArgs(p *Interpreter, aa []string) args {
 				if _, ok := args[topicKey]; !ok {
 					args[topicKey] = a
 				}
-			case p.IsXrayCmd():
+			case p.IsXrayCmd(), p.IsTopCmd():
 				if _, ok := args[topicKey]; ok {
 					args[nsKey] = strings.ToLower(a)
 				} else {
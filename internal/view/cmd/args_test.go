@@ -59,6 +59,11 @@ func TestFlagsNew(t *testing.T) {
 			aa: []string{"app=fred"},
 			ll: args{labelKey: "app=fred"},
 		},
+		"ns+label-flags": {
+			i:  NewInterpreter("po"),
+			aa: []string{"-l", "app=fred", "--namespace", "ns1"},
+			ll: args{labelKey: "app=fred", nsKey: "ns1"},
+		},
 		"label-toast": {
 			i:  NewInterpreter("po"),
 			aa: []string{"="},
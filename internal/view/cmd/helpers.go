@@ -29,6 +29,28 @@ func ToLabels(s string) map[string]string {
 	return lbls
 }
 
+// SplitChain splits a compound prompt ie `ns prod; pods app=web` into its commands.
+func SplitChain(s string) []string {
+	ff := strings.Split(s, chainSep)
+	cc := make([]string, 0, len(ff))
+	for _, f := range ff {
+		if f = strings.TrimSpace(f); f != "" {
+			cc = append(cc, f)
+		}
+	}
+
+	return cc
+}
+
+// ChainTail returns the command being typed in a compound prompt.
+func ChainTail(s string) string {
+	if i := strings.LastIndex(s, chainSep); i != -1 {
+		return strings.TrimLeft(s[i+1:], " ")
+	}
+
+	return s
+}
+
 // ShouldAddSuggest checks if a suggestion match the given command.
 func ShouldAddSuggest(command, suggest string) (string, bool) {
 	if command != suggest && strings.HasPrefix(suggest, command) {
@@ -39,7 +61,8 @@ func ShouldAddSuggest(command, suggest string) (string, bool) {
 }
 
 // SuggestSubCommand suggests namespaces or contexts based on current command.
-func SuggestSubCommand(command string, namespaces client.NamespaceNames, contexts []string) []string {
+// Namespaces suggestions are ranked by usage when provided.
+func SuggestSubCommand(command string, namespaces client.NamespaceNames, usage map[string]int, contexts []string) []string {
 	p := NewInterpreter(command)
 	var suggests []string
 	switch {
@@ -61,7 +84,14 @@ func SuggestSubCommand(command string, namespaces client.NamespaceNames, context
 		if !ok || ns == "" {
 			return nil
 		}
-		suggests = completeNS(ns, namespaces)
+		suggests = completeNS(ns, namespaces, usage)
+
+	case p.IsTopCmd():
+		_, ns, ok := p.TopArgs()
+		if !ok || ns == "" {
+			return nil
+		}
+		suggests = completeNS(ns, namespaces, usage)
 
 	case p.IsContextCmd():
 		n, ok := p.ContextArg()
@@ -82,19 +112,18 @@ func SuggestSubCommand(command string, namespaces client.NamespaceNames, context
 		if !ok {
 			return nil
 		}
-		suggests = completeNS(ns, namespaces)
+		suggests = completeNS(ns, namespaces, usage)
 
 	default:
 		if n, ok := p.HasContext(); ok {
 			suggests = completeCtx(n, contexts)
 		}
 	}
-	slices.Sort(suggests)
 
 	return suggests
 }
 
-func completeNS(s string, nn client.NamespaceNames) []string {
+func completeNS(s string, nn client.NamespaceNames, usage map[string]int) []string {
 	s = strings.ToLower(s)
 	var suggests []string
 	if suggest, ok := ShouldAddSuggest(s, client.NamespaceAll); ok {
@@ -105,6 +134,12 @@ func completeNS(s string, nn client.NamespaceNames) []string {
 			suggests = append(suggests, suggest)
 		}
 	}
+	slices.SortFunc(suggests, func(a, b string) int {
+		if c := usage[s+b] - usage[s+a]; c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
 
 	return suggests
 }
@@ -116,6 +151,7 @@ func completeCtx(s string, cc []string) []string {
 			suggests = append(suggests, suggest)
 		}
 	}
+	slices.Sort(suggests)
 
 	return suggests
 }
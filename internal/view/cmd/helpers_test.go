@@ -86,7 +86,70 @@ func TestSuggestSubCommand(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		got := SuggestSubCommand(tt.Command, namespaceNames, contextNames)
+		got := SuggestSubCommand(tt.Command, namespaceNames, nil, contextNames)
 		assert.Equal(t, tt.Suggestions, got)
 	}
 }
+
+func TestSuggestSubCommandUsage(t *testing.T) {
+	namespaceNames := map[string]struct{}{
+		"kube-system": {},
+		"kube-public": {},
+		"kube-fred":   {},
+	}
+	usage := map[string]int{"kube-system": 3, "kube-fred": 1}
+
+	got := SuggestSubCommand("po kube-", namespaceNames, usage, nil)
+	assert.Equal(t, []string{"system", "fred", "public"}, got)
+}
+
+func TestSplitChain(t *testing.T) {
+	uu := map[string]struct {
+		s  string
+		cc []string
+	}{
+		"empty": {
+			cc: []string{},
+		},
+		"single": {
+			s:  "pods app=web",
+			cc: []string{"pods app=web"},
+		},
+		"chain": {
+			s:  " ns prod ;pods app=web; ;",
+			cc: []string{"ns prod", "pods app=web"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.cc, SplitChain(u.s))
+		})
+	}
+}
+
+func TestChainTail(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"single": {
+			s: "po",
+			e: "po",
+		},
+		"chain": {
+			s: "ns prod;  po",
+			e: "po",
+		},
+		"blank": {
+			s: "ns prod; ",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ChainTail(u.s))
+		})
+	}
+}
@@ -107,6 +107,13 @@ func (c *Interpreter) IsXrayCmd() bool {
 	return ok
 }
 
+// IsTopCmd returns true if top cmd is detected.
+func (c *Interpreter) IsTopCmd() bool {
+	_, ok := topCmd[c.cmd]
+
+	return ok
+}
+
 // IsContextCmd returns true if context cmd is detected.
 func (c *Interpreter) IsContextCmd() bool {
 	_, ok := contextCmd[c.cmd]
@@ -125,6 +132,105 @@ func (c *Interpreter) IsRBACCmd() bool {
 	return c.cmd == canCmd
 }
 
+// IsImpersonateCmd returns true if impersonate cmd is detected.
+func (c *Interpreter) IsImpersonateCmd() bool {
+	return c.cmd == asCmd
+}
+
+// IsSnapshotCmd returns true if snapshot cmd is detected.
+func (c *Interpreter) IsSnapshotCmd() bool {
+	return c.cmd == snapshotCmd
+}
+
+// IsConfigCmd returns true if config cmd is detected.
+func (c *Interpreter) IsConfigCmd() bool {
+	return c.cmd == configCmd
+}
+
+// IsMacroCmd returns true if macro cmd is detected.
+func (c *Interpreter) IsMacroCmd() bool {
+	return c.cmd == macroCmd
+}
+
+// IsRecentCmd returns true if recent cmd is detected.
+func (c *Interpreter) IsRecentCmd() bool {
+	return c.cmd == recentCmd
+}
+
+// IsTabCmd returns true if tab cmd is detected.
+func (c *Interpreter) IsTabCmd() bool {
+	return c.cmd == tabCmd
+}
+
+// IsTabCloseCmd returns true if tab close cmd is detected.
+func (c *Interpreter) IsTabCloseCmd() bool {
+	return c.cmd == tabCloseCmd
+}
+
+// IsSkinCmd returns true if skin cmd is detected.
+func (c *Interpreter) IsSkinCmd() bool {
+	return c.cmd == skinCmd
+}
+
+// IsPaletteCmd returns true if palette cmd is detected.
+func (c *Interpreter) IsPaletteCmd() bool {
+	return c.cmd == paletteCmd
+}
+
+// IsFiltersCmd returns true if saved filters cmd is detected.
+func (c *Interpreter) IsFiltersCmd() bool {
+	return c.cmd == filtersCmd
+}
+
+// IsGroupByCmd returns true if a group by cmd is detected.
+func (c *Interpreter) IsGroupByCmd() bool {
+	return c.cmd == groupByCmd
+}
+
+// IsNewCmd returns true if a new resource cmd is detected.
+func (c *Interpreter) IsNewCmd() bool {
+	return c.cmd == newCmd
+}
+
+// IsSetCmd returns true if a config setting cmd is detected.
+func (c *Interpreter) IsSetCmd() bool {
+	return c.cmd == setCmd
+}
+
+// IsFindCmd returns true if a resources search cmd is detected.
+func (c *Interpreter) IsFindCmd() bool {
+	return c.cmd == findCmd
+}
+
+// IsFwdCmd returns true if a selector port-forward cmd is detected.
+func (c *Interpreter) IsFwdCmd() bool {
+	return c.cmd == fwdCmd
+}
+
+// IsLogsCmd returns true if a logs cmd is detected.
+func (c *Interpreter) IsLogsCmd() bool {
+	return c.cmd == logsCmd
+}
+
+// IsApplyCmd returns true if an apply manifest cmd is detected.
+func (c *Interpreter) IsApplyCmd() bool {
+	return c.cmd == applyCmd
+}
+
+// IsSplitCmd returns true if a split cmd is detected.
+func (c *Interpreter) IsSplitCmd() bool {
+	_, ok := splitCmd[c.cmd]
+
+	return ok
+}
+
+// IsUnsplitCmd returns true if unsplit cmd is detected.
+func (c *Interpreter) IsUnsplitCmd() bool {
+	_, ok := unsplitCmd[c.cmd]
+
+	return ok
+}
+
 // ContextArg returns context cmd arg.
 func (c *Interpreter) ContextArg() (string, bool) {
 	if !c.IsContextCmd() {
@@ -172,6 +278,20 @@ func (c *Interpreter) RBACArgs() (string, string, bool) {
 	return tt[1], tt[2], true
 }
 
+// ImpersonateArgs returns the user and groups to impersonate. A blank user
+// reverts the impersonation.
+func (c *Interpreter) ImpersonateArgs() (string, []string, bool) {
+	if !c.IsImpersonateCmd() {
+		return "", nil, false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) < 2 {
+		return "", nil, true
+	}
+
+	return ff[1], ff[2:], true
+}
+
 // XRayArgs return the gvr and ns if any.
 func (c *Interpreter) XrayArgs() (string, string, bool) {
 	if !c.IsXrayCmd() {
@@ -193,6 +313,19 @@ func (c *Interpreter) XrayArgs() (string, string, bool) {
 	}
 }
 
+// TopArgs return the resource and ns if any.
+func (c *Interpreter) TopArgs() (string, string, bool) {
+	if !c.IsTopCmd() {
+		return "", "", false
+	}
+	res, ok := c.args[topicKey]
+	if !ok {
+		return "", "", false
+	}
+
+	return res, c.args[nsKey], true
+}
+
 // FilterArg returns the current filter if any.
 func (c *Interpreter) FilterArg() (string, bool) {
 	f, ok := c.args[filterKey]
@@ -227,3 +360,238 @@ func (c *Interpreter) LabelsArg() (map[string]string, bool) {
 
 	return ToLabels(ll), ok
 }
+
+// SnapshotArgs returns the snapshot file if any. The action must be save.
+func (c *Interpreter) SnapshotArgs() (string, bool) {
+	if !c.IsSnapshotCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) < 2 || ff[1] != saveAction || len(ff) > 3 {
+		return "", false
+	}
+	if len(ff) == 2 {
+		return "", true
+	}
+
+	return ff[2], true
+}
+
+// ConfigArgs returns the config bundle action and file if any. The action
+// is either export or import.
+func (c *Interpreter) ConfigArgs() (string, string, bool) {
+	if !c.IsConfigCmd() {
+		return "", "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) != 3 || (ff[1] != exportAction && ff[1] != importAction) {
+		return "", "", false
+	}
+
+	return ff[1], ff[2], true
+}
+
+// MacroArg returns the macro name if any.
+func (c *Interpreter) MacroArg() (string, bool) {
+	if !c.IsMacroCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) != 2 {
+		return "", false
+	}
+
+	return ff[1], true
+}
+
+// FiltersArgs returns the saved filter action and name if any. The action
+// is either save, delete or blank to apply the named filter.
+func (c *Interpreter) FiltersArgs() (string, string, bool) {
+	if !c.IsFiltersCmd() {
+		return "", "", false
+	}
+	ff := strings.Fields(c.line)
+	switch len(ff) {
+	case 1:
+		return "", "", true
+	case 2:
+		if ff[1] == saveAction || ff[1] == delAction {
+			return "", "", false
+		}
+		return "", ff[1], true
+	case 3:
+		if ff[1] != saveAction && ff[1] != delAction {
+			return "", "", false
+		}
+		return ff[1], ff[2], true
+	default:
+		return "", "", false
+	}
+}
+
+// NewArgs returns the kind and optional name of a resource to scaffold.
+func (c *Interpreter) NewArgs() (string, string, bool) {
+	if !c.IsNewCmd() {
+		return "", "", false
+	}
+	ff := strings.Fields(c.line)
+	switch len(ff) {
+	case 2:
+		return ff[1], "", true
+	case 3:
+		return ff[1], ff[2], true
+	default:
+		return "", "", false
+	}
+}
+
+// LogsArg returns the logs target.
+func (c *Interpreter) LogsArg() (string, bool) {
+	if !c.IsLogsCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) != 2 {
+		return "", false
+	}
+
+	return ff[1], true
+}
+
+// ApplyArg returns the manifest source ie a url or clipboard.
+func (c *Interpreter) ApplyArg() (string, bool) {
+	if !c.IsApplyCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) != 2 {
+		return "", false
+	}
+
+	return ff[1], true
+}
+
+// SetArgs returns the config setting key and value.
+func (c *Interpreter) SetArgs() (string, string, bool) {
+	if !c.IsSetCmd() {
+		return "", "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) != 3 {
+		return "", "", false
+	}
+
+	return ff[1], ff[2], true
+}
+
+// FindArgs returns the search query and whether uncached resources should be listed.
+func (c *Interpreter) FindArgs() (string, bool, bool) {
+	if !c.IsFindCmd() {
+		return "", false, false
+	}
+	ff := strings.Fields(c.line)[1:]
+	var list bool
+	if len(ff) > 0 && ff[0] == listFlag {
+		list, ff = true, ff[1:]
+	}
+	if len(ff) == 0 {
+		return "", false, false
+	}
+
+	return strings.Join(ff, " "), list, true
+}
+
+// FwdArgs returns the pods selector and the optional namespace to forward to.
+func (c *Interpreter) FwdArgs() (string, string, bool) {
+	if !c.IsFwdCmd() {
+		return "", "", false
+	}
+	ff := strings.Fields(c.line)
+	switch len(ff) {
+	case 2:
+		return ff[1], "", true
+	case 3:
+		return ff[1], ff[2], true
+	default:
+		return "", "", false
+	}
+}
+
+// GroupByArg returns the column to group rows by. A blank column ungroups rows.
+func (c *Interpreter) GroupByArg() (string, bool) {
+	if !c.IsGroupByCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	switch len(ff) {
+	case 1:
+		return "", true
+	case 2:
+		return ff[1], true
+	default:
+		return "", false
+	}
+}
+
+// SkinArg returns the skin name if any.
+func (c *Interpreter) SkinArg() (string, bool) {
+	if !c.IsSkinCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	switch len(ff) {
+	case 1:
+		return "", true
+	case 2:
+		return ff[1], true
+	default:
+		return "", false
+	}
+}
+
+// SplitArgs returns the split direction and the command to run in the new pane if any.
+func (c *Interpreter) SplitArgs() (bool, string, bool) {
+	vertical, ok := splitCmd[c.cmd]
+	if !ok {
+		return false, "", false
+	}
+	ff := strings.Fields(c.line)
+
+	return vertical, strings.Join(ff[1:], " "), true
+}
+
+// TabArgs returns the tab name and the command to run in the tab if any.
+func (c *Interpreter) TabArgs() (string, string, bool) {
+	if !c.IsTabCmd() {
+		return "", "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) < 2 {
+		return "", "", false
+	}
+
+	return ff[1], strings.Join(ff[2:], " "), true
+}
+
+// ResourceArgs returns the kind, path and optional action of a resource
+// one-liner ie `deploy/web logs` or `deploy/prod/web describe`.
+func (c *Interpreter) ResourceArgs() (string, string, string, bool) {
+	ff := strings.Split(c.cmd, pathSep)
+	if len(ff) < 2 || len(ff) > 3 {
+		return "", "", "", false
+	}
+	for _, f := range ff {
+		if f == "" {
+			return "", "", "", false
+		}
+	}
+	aa := strings.Fields(c.line)[1:]
+	switch len(aa) {
+	case 0:
+		return ff[0], strings.Join(ff[1:], pathSep), "", true
+	case 1:
+		return ff[0], strings.Join(ff[1:], pathSep), strings.ToLower(aa[0]), true
+	default:
+		return "", "", "", false
+	}
+}
@@ -215,6 +215,56 @@ func TestXRayCmd(t *testing.T) {
 	}
 }
 
+func TestTopCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd     string
+		ok      bool
+		res, ns string
+	}{
+		"empty": {},
+
+		"happy": {
+			cmd: "top pods",
+			ok:  true,
+			res: "pods",
+		},
+
+		"happy+ns": {
+			cmd: "top po ns1",
+			ok:  true,
+			res: "po",
+			ns:  "ns1",
+		},
+
+		"nodes": {
+			cmd: "top NODES",
+			ok:  true,
+			res: "nodes",
+		},
+
+		"toast": {
+			cmd: "topper po",
+		},
+
+		"toast-1": {
+			cmd: "top",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			res, ns, ok := p.TopArgs()
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.res, res)
+				assert.Equal(t, u.ns, ns)
+			}
+		})
+	}
+}
+
 func TestDirCmd(t *testing.T) {
 	uu := map[string]struct {
 		cmd string
@@ -310,6 +360,53 @@ func TestRBACCmd(t *testing.T) {
 	}
 }
 
+func TestImpersonateCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd  string
+		ok   bool
+		user string
+		gg   []string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "ask fred",
+		},
+		"reset": {
+			cmd: "as",
+			ok:  true,
+		},
+		"user": {
+			cmd:  "as Fred",
+			ok:   true,
+			user: "Fred",
+			gg:   []string{},
+		},
+		"sa": {
+			cmd:  "as system:serviceaccount:default:fred",
+			ok:   true,
+			user: "system:serviceaccount:default:fred",
+			gg:   []string{},
+		},
+		"groups": {
+			cmd:  "as fred system:masters  blee",
+			ok:   true,
+			user: "fred",
+			gg:   []string{"system:masters", "blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			user, gg, ok := p.ImpersonateArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.user, user)
+			assert.Equal(t, u.gg, gg)
+		})
+	}
+}
+
 func TestContextCmd(t *testing.T) {
 	uu := map[string]struct {
 		cmd string
@@ -468,3 +565,595 @@ func TestCowCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestSnapshotCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd  string
+		ok   bool
+		file string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "snap save",
+		},
+		"no-action": {
+			cmd: "snapshot",
+		},
+		"bad-action": {
+			cmd: "snapshot load /tmp/fred.tar.gz",
+		},
+		"save": {
+			cmd: "snapshot save",
+			ok:  true,
+		},
+		"save-file": {
+			cmd:  "snapshot save /tmp/Fred.tar.gz",
+			ok:   true,
+			file: "/tmp/Fred.tar.gz",
+		},
+		"too-many": {
+			cmd: "snapshot save fred blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			file, ok := p.SnapshotArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.file, file)
+		})
+	}
+}
+
+func TestConfigCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd          string
+		ok           bool
+		action, file string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "conf export /tmp/fred.yaml",
+		},
+		"no-action": {
+			cmd: "config",
+		},
+		"bad-action": {
+			cmd: "config load /tmp/fred.yaml",
+		},
+		"no-file": {
+			cmd: "config export",
+		},
+		"export": {
+			cmd:    "config export /tmp/Fred.yaml",
+			ok:     true,
+			action: "export",
+			file:   "/tmp/Fred.yaml",
+		},
+		"import": {
+			cmd:    "config import fred.yaml",
+			ok:     true,
+			action: "import",
+			file:   "fred.yaml",
+		},
+		"too-many": {
+			cmd: "config import fred blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			action, file, ok := p.ConfigArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.action, action)
+			assert.Equal(t, u.file, file)
+		})
+	}
+}
+
+func TestLogsCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd    string
+		ok     bool
+		target string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "log k9s",
+		},
+		"no-target": {
+			cmd: "logs",
+		},
+		"k9s": {
+			cmd:    "logs k9s",
+			ok:     true,
+			target: "k9s",
+		},
+		"too-many": {
+			cmd: "logs k9s fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			target, ok := p.LogsArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.target, target)
+		})
+	}
+}
+
+func TestMacroCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd  string
+		ok   bool
+		name string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "mac incident",
+		},
+		"no-name": {
+			cmd: "macro",
+		},
+		"happy": {
+			cmd:  "macro Incident",
+			ok:   true,
+			name: "Incident",
+		},
+		"too-many": {
+			cmd: "macro incident blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			name, ok := p.MacroArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.name, name)
+		})
+	}
+}
+
+func TestSkinCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd  string
+		ok   bool
+		name string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "ski dracula",
+		},
+		"picker": {
+			cmd: "skin",
+			ok:  true,
+		},
+		"happy": {
+			cmd:  "skin dracula",
+			ok:   true,
+			name: "dracula",
+		},
+		"too-many": {
+			cmd: "skin dracula blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			name, ok := p.SkinArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.name, name)
+		})
+	}
+}
+
+func TestGroupByCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+		col string
+	}{
+		"empty": {},
+		"ungroup": {
+			cmd: "groupby",
+			ok:  true,
+		},
+		"happy": {
+			cmd: "groupby node",
+			ok:  true,
+			col: "node",
+		},
+		"too-many": {
+			cmd: "groupby node status",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			col, ok := p.GroupByArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.col, col)
+		})
+	}
+}
+
+func TestNewCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd        string
+		ok         bool
+		kind, name string
+	}{
+		"empty": {},
+		"no-kind": {
+			cmd: "new",
+		},
+		"kind": {
+			cmd:  "new deploy",
+			ok:   true,
+			kind: "deploy",
+		},
+		"kind-name": {
+			cmd:  "new cm fred",
+			ok:   true,
+			kind: "cm",
+			name: "fred",
+		},
+		"too-many": {
+			cmd: "new cm fred blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			kind, name, ok := p.NewArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.kind, kind)
+			assert.Equal(t, u.name, name)
+		})
+	}
+}
+
+func TestApplyCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+		src string
+	}{
+		"empty": {},
+		"no-source": {
+			cmd: "apply",
+		},
+		"clipboard": {
+			cmd: "apply clipboard",
+			ok:  true,
+			src: "clipboard",
+		},
+		"url": {
+			cmd: "apply https://example.com/fred.yaml",
+			ok:  true,
+			src: "https://example.com/fred.yaml",
+		},
+		"too-many": {
+			cmd: "apply clipboard fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			src, ok := p.ApplyArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.src, src)
+		})
+	}
+}
+
+func TestSetCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd, key, val string
+		ok            bool
+	}{
+		"empty": {},
+		"no-value": {
+			cmd: "set watch.metrics",
+		},
+		"toggle": {
+			cmd: "set watch.metrics true",
+			key: "watch.metrics",
+			val: "true",
+			ok:  true,
+		},
+		"too-many": {
+			cmd: "set watch.metrics true fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			key, val, ok := p.SetArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.key, key)
+			assert.Equal(t, u.val, val)
+		})
+	}
+}
+
+func TestFwdCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd, sel, ns string
+		ok           bool
+	}{
+		"empty": {},
+		"no-selector": {
+			cmd: "fwd",
+		},
+		"selector": {
+			cmd: "fwd app=web",
+			sel: "app=web",
+			ok:  true,
+		},
+		"namespaced": {
+			cmd: "fwd app=web,tier=fe blee",
+			sel: "app=web,tier=fe",
+			ns:  "blee",
+			ok:  true,
+		},
+		"too-many": {
+			cmd: "fwd app=web blee duh",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			sel, ns, ok := p.FwdArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.sel, sel)
+			assert.Equal(t, u.ns, ns)
+		})
+	}
+}
+
+func TestFindCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd, q   string
+		list, ok bool
+	}{
+		"empty": {},
+		"no-query": {
+			cmd: "find",
+		},
+		"no-query-list": {
+			cmd: "find -l",
+		},
+		"cached": {
+			cmd: "find fred",
+			q:   "fred",
+			ok:  true,
+		},
+		"spaces": {
+			cmd: "find  fred   blee",
+			q:   "fred blee",
+			ok:  true,
+		},
+		"uncached": {
+			cmd:  "find -l fred",
+			q:    "fred",
+			list: true,
+			ok:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			q, list, ok := p.FindArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.q, q)
+			assert.Equal(t, u.list, list)
+		})
+	}
+}
+
+func TestFiltersCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd          string
+		ok           bool
+		action, name string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "filt fred",
+		},
+		"picker": {
+			cmd: "filter",
+			ok:  true,
+		},
+		"apply": {
+			cmd:  "filter fred",
+			ok:   true,
+			name: "fred",
+		},
+		"save": {
+			cmd:    "filter save fred",
+			ok:     true,
+			action: "save",
+			name:   "fred",
+		},
+		"delete": {
+			cmd:    "filter delete fred",
+			ok:     true,
+			action: "delete",
+			name:   "fred",
+		},
+		"no-name": {
+			cmd: "filter save",
+		},
+		"bad-action": {
+			cmd: "filter blee fred",
+		},
+		"too-many": {
+			cmd: "filter save fred blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			action, name, ok := p.FiltersArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.action, action)
+			assert.Equal(t, u.name, name)
+		})
+	}
+}
+
+func TestSplitCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd      string
+		ok       bool
+		vertical bool
+		line     string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "spl pods",
+		},
+		"blank": {
+			cmd: "split",
+			ok:  true,
+		},
+		"horizontal": {
+			cmd:  "split pods -n fred",
+			ok:   true,
+			line: "pods -n fred",
+		},
+		"vertical": {
+			cmd:      "vsplit svc",
+			ok:       true,
+			vertical: true,
+			line:     "svc",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			vertical, line, ok := p.SplitArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.ok, p.IsSplitCmd())
+			assert.Equal(t, u.vertical, vertical)
+			assert.Equal(t, u.line, line)
+		})
+	}
+}
+
+func TestTabCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd        string
+		ok         bool
+		name, line string
+	}{
+		"empty": {},
+		"no-name": {
+			cmd: "tab",
+		},
+		"name": {
+			cmd:  "tab prod",
+			ok:   true,
+			name: "prod",
+		},
+		"cmd": {
+			cmd:  "tab prod pods -n fred",
+			ok:   true,
+			name: "prod",
+			line: "pods -n fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			name, line, ok := p.TabArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.name, name)
+			assert.Equal(t, u.line, line)
+		})
+	}
+}
+
+func TestResourceArgs(t *testing.T) {
+	uu := map[string]struct {
+		cmd                string
+		ok                 bool
+		kind, path, action string
+	}{
+		"empty": {},
+		"plain": {
+			cmd: "deploy",
+		},
+		"blank-name": {
+			cmd: "deploy/",
+		},
+		"name": {
+			cmd:  "deploy/web",
+			ok:   true,
+			kind: "deploy",
+			path: "web",
+		},
+		"action": {
+			cmd:    "deploy/web LOGS",
+			ok:     true,
+			kind:   "deploy",
+			path:   "web",
+			action: "logs",
+		},
+		"namespaced": {
+			cmd:    "deploy/prod/web describe",
+			ok:     true,
+			kind:   "deploy",
+			path:   "prod/web",
+			action: "describe",
+		},
+		"too-many": {
+			cmd: "deploy/web logs yaml",
+		},
+		"too-deep": {
+			cmd: "apps/v1/deployments/web",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			kind, path, action, ok := cmd.NewInterpreter(u.cmd).ResourceArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.kind, kind)
+			assert.Equal(t, u.path, path)
+			assert.Equal(t, u.action, action)
+		})
+	}
+}
@@ -6,13 +6,40 @@ package cmd
 import "regexp"
 
 const (
-	cowCmd      = "cow"
-	canCmd      = "can"
-	nsFlag      = "-n"
-	filterFlag  = "/"
-	labelFlag   = "="
-	fuzzyFlag   = "-f"
-	contextFlag = "@"
+	cowCmd       = "cow"
+	canCmd       = "can"
+	asCmd        = "as"
+	snapshotCmd  = "snapshot"
+	configCmd    = "config"
+	macroCmd     = "macro"
+	recentCmd    = "recent"
+	tabCmd       = "tab"
+	tabCloseCmd  = "tabclose"
+	skinCmd      = "skin"
+	paletteCmd   = "palette"
+	filtersCmd   = "filter"
+	groupByCmd   = "groupby"
+	newCmd       = "new"
+	applyCmd     = "apply"
+	setCmd       = "set"
+	findCmd      = "find"
+	fwdCmd       = "fwd"
+	logsCmd      = "logs"
+	listFlag     = "-l"
+	saveAction   = "save"
+	delAction    = "delete"
+	exportAction = "export"
+	importAction = "import"
+	nsFlag       = "-n"
+	nsLongFlag   = "--namespace"
+	selectorFlag = "-l"
+	selectorLong = "--selector"
+	filterFlag   = "/"
+	labelFlag    = "="
+	fuzzyFlag    = "-f"
+	contextFlag  = "@"
+	chainSep     = ";"
+	pathSep      = "/"
 )
 
 var (
@@ -50,4 +77,15 @@ var (
 		"xr":   {},
 		"xray": {},
 	}
+	topCmd = map[string]struct{}{
+		"top": {},
+	}
+	splitCmd = map[string]bool{
+		"split":  false,
+		"vsplit": true,
+	}
+	unsplitCmd = map[string]struct{}{
+		"unsplit": {},
+		"only":    {},
+	}
 )
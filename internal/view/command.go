@@ -4,6 +4,7 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -14,8 +15,15 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/tracing"
 	"github.com/derailed/k9s/internal/view/cmd"
-	"github.com/rs/zerolog/log"
+)
+
+const (
+	describeResAction = "describe"
+	yamlResAction     = "yaml"
+	logsResAction     = "logs"
+	editResAction     = "edit"
 )
 
 var (
@@ -28,6 +36,8 @@ type Command struct {
 	app   *App
 	alias *dao.Alias
 	mx    sync.Mutex
+	// chain tracks the compound prompt being run so history records it whole.
+	chain string
 }
 
 // NewCommand returns a new command.
@@ -83,6 +93,17 @@ func allowedXRay(gvr client.GVR) bool {
 	return ok
 }
 
+func topGVR(gvr client.GVR) (string, bool) {
+	gg := map[string]string{
+		"v1/pods":       "top-pods",
+		"v1/nodes":      "top-nodes",
+		"v1/namespaces": "top-namespaces",
+	}
+	top, ok := gg[gvr.String()]
+
+	return top, ok
+}
+
 func (c *Command) contextCmd(p *cmd.Interpreter) error {
 	ct, ok := p.ContextArg()
 	if !ok {
@@ -137,11 +158,126 @@ func (c *Command) xrayCmd(p *cmd.Interpreter) error {
 	return c.exec(p, client.NewGVR("xrays"), NewXray(gvr), true)
 }
 
+func (c *Command) topCmd(p *cmd.Interpreter) error {
+	arg, ns, ok := p.TopArgs()
+	if !ok {
+		return errors.New("invalid command. use `top pods|nodes|namespaces`")
+	}
+	gvr, _, ok := c.alias.AsGVR(arg)
+	if !ok {
+		return fmt.Errorf("invalid resource name: %q", arg)
+	}
+	top, ok := topGVR(gvr)
+	if !ok {
+		return fmt.Errorf("unsupported resource %q", arg)
+	}
+
+	return c.run(cmd.NewInterpreter(strings.TrimSpace(top+" "+ns)), "", true)
+}
+
+// runChain execs a compound prompt ie `ns prod; pods app=web` one command at a time.
+func (c *Command) runChain(line, fqn string, clearStack bool) error {
+	cc := cmd.SplitChain(line)
+	if len(cc) < 2 {
+		return c.run(cmd.NewInterpreter(line), fqn, clearStack)
+	}
+
+	c.chain = strings.Join(cc, "; ")
+	defer func() { c.chain = "" }()
+	for _, l := range cc {
+		if err := c.run(cmd.NewInterpreter(l), fqn, clearStack); err != nil {
+			return fmt.Errorf("%q failed: %w", l, err)
+		}
+	}
+
+	return nil
+}
+
+// pushHistory records a command or the compound prompt it belongs to.
+func (c *Command) pushHistory(line string) {
+	if c.chain != "" {
+		line = c.chain
+	}
+	c.app.cmdHistory.Push(line)
+}
+
+// resourceCmd shows a given resource and optionally runs an action on it
+// ie `deploy/web logs`.
+func (c *Command) resourceCmd(p *cmd.Interpreter, kind, path, action string, clearStack bool) error {
+	gvr, _, ok := c.alias.AsGVR(kind)
+	if !ok {
+		return fmt.Errorf("`%s` command not found", kind)
+	}
+	switch action {
+	case "", describeResAction, yamlResAction, logsResAction, editResAction:
+	default:
+		return fmt.Errorf("invalid action %q. Expecting one of %s|%s|%s|%s", action, describeResAction, yamlResAction, logsResAction, editResAction)
+	}
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(path)
+	switch {
+	case meta.Namespaced && ns == "":
+		ns = c.app.Config.ActiveNamespace()
+		if client.IsAllNamespaces(ns) {
+			return fmt.Errorf("a namespace is required. Use `%s/ns/%s`", kind, n)
+		}
+	case !meta.Namespaced && ns != "":
+		return fmt.Errorf("%s are not namespaced. Use `%s/%s`", gvr.R(), kind, n)
+	}
+	path = client.FQN(ns, n)
+
+	if c.chain == "" {
+		c.chain = p.GetLine()
+		defer func() { c.chain = "" }()
+	}
+	if err := c.run(cmd.NewInterpreter(strings.TrimSpace(kind+" "+ns+" /"+n)), "", clearStack); err != nil {
+		return err
+	}
+
+	switch action {
+	case describeResAction:
+		describeResource(c.app, nil, gvr, path)
+	case yamlResAction:
+		return c.app.inject(NewLiveView(c.app, yamlAction, model.NewYAML(gvr, path)), false)
+	case logsResAction:
+		res, err := dao.AccessorFor(c.app.factory, gvr)
+		if err != nil {
+			return err
+		}
+		if _, ok := res.(dao.Loggable); !ok {
+			return fmt.Errorf("logs are not supported for %s", gvr.R())
+		}
+		cfg := c.app.Config.K9s.Logger
+		return c.app.inject(NewLog(gvr, &dao.LogOptions{
+			Path:          path,
+			Lines:         int64(cfg.TailCount),
+			SinceSeconds:  cfg.SinceSeconds,
+			ShowTimestamp: cfg.ShowTime,
+			AllContainers: true,
+		}), false)
+	case editResAction:
+		return editRes(c.app, gvr, path)
+	}
+
+	return nil
+}
+
 // Run execs the command by showing associated display.
-func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) error {
+func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) (err error) {
+	_, span := tracing.Start(context.Background(), "command", tracing.CmdKey.String(p.GetLine()))
+	defer func() { tracing.End(span, err) }()
+
 	if c.specialCmd(p) {
 		return nil
 	}
+	if kind, path, action, ok := p.ResourceArgs(); ok {
+		if _, _, known := c.alias.AsGVR(p.Cmd()); !known {
+			return c.resourceCmd(p, kind, path, action, clearStack)
+		}
+	}
 	gvr, v, err := c.viewMetaFor(p)
 	if err != nil {
 		return err
@@ -157,6 +293,7 @@ func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) error {
 
 	if context, ok := p.HasContext(); ok {
 		if context != c.app.Config.ActiveContextName() {
+			c.app.saveSession()
 			if err := c.app.Config.Save(true); err != nil {
 				log.Error().Err(err).Msg("config save failed!")
 			} else {
@@ -193,7 +330,13 @@ func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) error {
 		co.SetLabelFilter(ll)
 	}
 
-	return c.exec(p, gvr, co, clearStack)
+	c.app.syncNavFilter()
+	if err := c.exec(p, gvr, co, clearStack); err != nil {
+		return err
+	}
+	c.app.trackNav(p.GetLine(), fqn, ns, gvr.String())
+
+	return nil
 }
 
 func (c *Command) defaultCmd() error {
@@ -234,12 +377,112 @@ func (c *Command) specialCmd(p *cmd.Interpreter) bool {
 		if err := c.xrayCmd(p); err != nil {
 			c.app.Flash().Err(err)
 		}
+	case p.IsTopCmd():
+		if err := c.topCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
 	case p.IsRBACCmd():
 		if cat, sub, ok := p.RBACArgs(); !ok {
 			c.app.Flash().Errf("Invalid command. Use `can [u|g|s]:xxx`")
 		} else if err := c.app.inject(NewPolicy(c.app, cat, sub), true); err != nil {
 			c.app.Flash().Err(err)
 		}
+	case p.IsImpersonateCmd():
+		user, gg, _ := p.ImpersonateArgs()
+		if err := c.app.impersonate(user, gg); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsSnapshotCmd():
+		if file, ok := p.SnapshotArgs(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `snapshot save [file]`")
+		} else {
+			c.app.saveSnapshot(file)
+		}
+	case p.IsConfigCmd():
+		if action, file, ok := p.ConfigArgs(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `config export|import file`")
+		} else if err := c.app.configBundle(action, file); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsMacroCmd():
+		if name, ok := p.MacroArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `macro xxx`")
+		} else if err := c.app.runMacro(name); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsRecentCmd():
+		if err := c.app.recentCmd(); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsSkinCmd():
+		if name, ok := p.SkinArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `skin [xxx]`")
+		} else if err := c.app.skinCmd(name); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsFiltersCmd():
+		if action, name, ok := p.FiltersArgs(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `filter [save|delete] [xxx]`")
+		} else if err := c.app.filtersCmd(action, name); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsGroupByCmd():
+		if col, ok := p.GroupByArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `groupby [column]`")
+		} else if err := c.app.groupByCmd(col); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsNewCmd():
+		if err := c.newCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsSetCmd():
+		if key, val, ok := p.SetArgs(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `set key value`")
+		} else if err := c.app.setCmd(key, val); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsFindCmd():
+		if q, list, ok := p.FindArgs(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `find [-l] text`")
+		} else if err := c.app.findCmd(q, list); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsFwdCmd():
+		if sel, ns, ok := p.FwdArgs(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `fwd selector [namespace]`")
+		} else if err := c.app.fwdCmd(sel, ns); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsLogsCmd():
+		if target, ok := p.LogsArg(); !ok || target != k9sLogsTarget {
+			c.app.Flash().Errf("Invalid command. Use `logs k9s`")
+		} else {
+			c.app.gotoResource("k9slogs", "", false)
+		}
+	case p.IsApplyCmd():
+		if src, ok := p.ApplyArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `apply url|clipboard`")
+		} else if err := c.app.applyCmd(src); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsPaletteCmd():
+		c.app.paletteCmd(nil)
+	case p.IsTabCmd():
+		if name, line, ok := p.TabArgs(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `tab xxx [cmd]`")
+		} else if err := c.app.newTab(name, line); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsTabCloseCmd():
+		c.app.closeTab()
+	case p.IsSplitCmd():
+		vertical, line, _ := p.SplitArgs()
+		if err := c.app.splitBody(vertical, line); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsUnsplitCmd():
+		c.app.unsplit()
 	case p.IsContextCmd():
 		if err := c.contextCmd(p); err != nil {
 			c.app.Flash().Err(err)
@@ -264,11 +507,10 @@ func (c *Command) viewMetaFor(p *cmd.Interpreter) (client.GVR, *MetaViewer, erro
 	}
 	gvr := agvr
 	if exp != "" {
-		ff := strings.Fields(exp)
-		ff[0] = agvr.String()
-		ap := cmd.NewInterpreter(strings.Join(ff, " "))
-		gvr = client.NewGVR(ap.Cmd())
-		p.Amend(ap)
+		if err := p.ExpandAlias(agvr.String(), exp); err != nil {
+			return client.NoGVR, nil, err
+		}
+		gvr = client.NewGVR(p.Cmd())
 	}
 
 	v := MetaViewer{viewerFn: NewBrowser}
@@ -323,7 +565,7 @@ func (c *Command) exec(p *cmd.Interpreter, gvr client.GVR, comp model.Component,
 		return err
 	}
 
-	c.app.cmdHistory.Push(p.GetLine())
+	c.pushHistory(p.GetLine())
 
 	return
 }
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui/dialog"
+)
+
+// configBundle exports or imports the K9s setup to or from a bundle file.
+func (a *App) configBundle(action, file string) error {
+	if rest, ok := strings.CutPrefix(file, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return err
+		}
+		file = filepath.Join(home, rest)
+	}
+
+	if action == "export" {
+		b, err := config.NewBundle()
+		if err != nil {
+			return err
+		}
+		if err := b.Save(file); err != nil {
+			return err
+		}
+		a.Flash().Infof("Exported %d config files to %s", len(b.Files), file)
+		return nil
+	}
+
+	b, err := config.LoadBundle(file)
+	if err != nil {
+		return err
+	}
+	a.resolveConflicts(b, b.Conflicts(), make(map[string]struct{}))
+
+	return nil
+}
+
+// resolveConflicts prompts for each bundled file differing from its local
+// version, then imports the bundle. Declined files are kept as is.
+func (a *App) resolveConflicts(b *config.Bundle, cc []string, keep map[string]struct{}) {
+	if len(cc) == 0 {
+		a.importBundle(b, keep)
+		return
+	}
+
+	n, overwrite := cc[0], false
+	msg := fmt.Sprintf("Local %s differs from the bundled one. Overwrite it?", n)
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Import Conflict", msg, func() {
+		overwrite = true
+	}, func() {
+		if !overwrite {
+			keep[n] = struct{}{}
+		}
+		a.resolveConflicts(b, cc[1:], keep)
+	})
+}
+
+func (a *App) importBundle(b *config.Bundle, keep map[string]struct{}) {
+	n, err := b.Import(keep)
+	if err != nil {
+		a.Flash().Errf("Config import failed: %s", err)
+		return
+	}
+	if len(keep) > 0 {
+		a.Flash().Infof("Imported %d config files, kept %d local ones. Restart K9s to apply them", n, len(keep))
+		return
+	}
+	a.Flash().Infof("Imported %d config files. Restart K9s to apply them", n)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/port"
 	"github.com/derailed/k9s/internal/render"
@@ -21,6 +22,22 @@ import (
 
 const containerTitle = "Containers"
 
+// containerDoc documents the container view.
+var containerDoc = ViewDoc{
+	Summary: "Containers of the selected pod",
+	Columns: model.MenuHints{
+		{Mnemonic: "PF", Description: "Active port-forwards"},
+		{Mnemonic: "STATE", Description: "Container state"},
+		{Mnemonic: "INIT", Description: "Init container"},
+		{Mnemonic: "PROBES(L:R)", Description: "Liveness and readiness probes"},
+		{Mnemonic: "CPU/R:L", Description: "CPU requests and limits"},
+		{Mnemonic: "MEM/R:L", Description: "Memory requests and limits"},
+	},
+	Related: model.MenuHints{
+		{Mnemonic: ":pods", Description: "Pods"},
+	},
+}
+
 // Container represents a container view.
 type Container struct {
 	ResourceViewer
@@ -28,6 +45,7 @@ type Container struct {
 
 // NewContainer returns a new container view.
 func NewContainer(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), containerDoc)
 	c := Container{}
 	c.ResourceViewer = NewLogsExtender(NewBrowser(gvr), c.logOptions)
 	c.SetEnvFn(c.k9sEnv)
@@ -91,9 +109,27 @@ func (c *Container) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", c.GetTable().SortColCmd("RESTARTS", false), false),
 	})
+	if c.App().Config.K9s.ImageScans.Scanner != "" {
+		aa.Add(ui.KeyAltV, ui.NewKeyAction("Scan Image", c.scanCmd, true))
+	}
 	aa.Merge(resourceSorters(c.GetTable()))
 }
 
+func (c *Container) scanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	co := c.GetTable().GetSelectedItem()
+	if co == "" {
+		return evt
+	}
+	refs, err := podImageRefs(c.App(), c.GetTable().Path, co)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	scanImages(c.App(), refs)
+
+	return nil
+}
+
 func (c *Container) k9sEnv() Env {
 	path := c.GetTable().GetSelectedItem()
 	row := c.GetTable().GetSelectedRow(path)
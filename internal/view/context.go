@@ -4,21 +4,29 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync/atomic"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/view/cmd"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 )
 
 const (
 	renamePage = "rename"
 	inputField = "New name:"
+	nsPage     = "namespace"
+	nsField    = "Namespace:"
+
+	preflightTitle = "Context Preflight"
 )
 
 // Context presents a context viewer.
@@ -40,6 +48,7 @@ func NewContext(gvr client.GVR) ResourceViewer {
 func (c *Context) bindKeys(aa *ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
 	aa.Add(ui.KeyR, ui.NewKeyAction("Rename", c.renameCmd, true))
+	aa.Add(ui.KeyN, ui.NewKeyAction("Set Namespace", c.namespaceCmd, true))
 }
 
 func (c *Context) renameCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -48,44 +57,53 @@ func (c *Context) renameCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	app := c.App()
-	c.showRenameModal(app, contextName, c.renameDialogCallback)
+	c.showInputModal(renamePage, "<Rename>", fmt.Sprintf("Rename context %q?", contextName), inputField, contextName, func(name string) error {
+		return c.App().factory.Client().Config().RenameContext(contextName, name)
+	})
 
 	return nil
 }
 
-func (c *Context) renameDialogCallback(form *tview.Form, contextName string) error {
-	app := c.App()
-	input := form.GetFormItemByLabel(inputField).(*tview.InputField)
-	if err := app.factory.Client().Config().RenameContext(contextName, input.GetText()); err != nil {
-		c.App().Flash().Err(err)
-		return nil
+func (c *Context) namespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	contextName := c.GetTable().GetSelectedItem()
+	if contextName == "" {
+		return evt
 	}
-	c.Refresh()
+	var ns string
+	if ct, err := c.App().factory.Client().Config().GetContext(contextName); err == nil {
+		ns = ct.Namespace
+	}
+
+	c.showInputModal(nsPage, "<Set Namespace>", fmt.Sprintf("Set context %q default namespace?", contextName), nsField, ns, func(ns string) error {
+		return c.App().factory.Client().Config().SetContextNamespace(contextName, ns)
+	})
+
 	return nil
 }
 
-func (c *Context) showRenameModal(a *App, name string, ok func(form *tview.Form, contextName string) error) {
-	p := a.Content.Pages
+func (c *Context) showInputModal(page, title, msg, label, value string, ok func(string) error) {
+	p := c.App().Content.Pages
 	f := c.makeStyledForm()
-	f.AddInputField(inputField, name, 0, nil, nil).
-		AddButton("OK", func() {
-			if err := ok(f, name); err != nil {
+	f.AddInputField(label, value, 0, nil, nil).
+		AddButton(i18n.T("OK"), func() {
+			input := f.GetFormItemByLabel(label).(*tview.InputField)
+			if err := ok(input.GetText()); err != nil {
 				c.App().Flash().Err(err)
 				return
 			}
-			p.RemovePage(renamePage)
+			p.RemovePage(page)
+			c.Refresh()
 		}).
-		AddButton("Cancel", func() {
-			p.RemovePage(renamePage)
+		AddButton(i18n.T("Cancel"), func() {
+			p.RemovePage(page)
 		})
-	m := tview.NewModalForm("<Rename>", f)
-	m.SetText(fmt.Sprintf("Rename context %q?", name))
+	m := tview.NewModalForm(title, f)
+	m.SetText(msg)
 	m.SetDoneFunc(func(int, string) {
-		p.RemovePage(renamePage)
+		p.RemovePage(page)
 	})
-	p.AddPage(renamePage, m, false, false)
-	p.ShowPage(renamePage)
+	p.AddPage(page, m, false, false)
+	p.ShowPage(page)
 }
 
 func (c *Context) makeStyledForm() *tview.Form {
@@ -110,10 +128,54 @@ func (c *Context) useCtx(app *App, model ui.Tabular, gvr client.GVR, path string
 	c.GetTable().Select(1, 0)
 }
 
+// useContext switches to a given context once it passes its preflight checks.
 func useContext(app *App, name string) error {
+	if name == app.Config.ActiveContextName() {
+		return switchToContext(app, name)
+	}
+	if _, err := app.Conn().Config().GetContext(name); err != nil {
+		return err
+	}
+	if !atomic.CompareAndSwapInt32(&app.preflights, 0, 1) {
+		return errors.New("context preflight already in progress")
+	}
+	app.Flash().Infof("Checking context %q...", name)
+	go preflightContext(app, name)
+
+	return nil
+}
+
+// preflightContext checks a context off the ui thread so an unreachable
+// cluster won't hang the ui mid-switch and confirms the switch.
+func preflightContext(app *App, name string) {
+	defer atomic.StoreInt32(&app.preflights, 0)
+
+	pp := dao.Preflight(context.Background(), app.Conn().Config(), name)
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		ss = append(ss, p.String())
+	}
+	msg := fmt.Sprintf("Switch to context %q?\n\n%s", name, strings.Join(ss, "\n"))
+	app.QueueUpdateDraw(func() {
+		app.Flash().Clear()
+		dialog.ShowPreflight(app.Styles.Dialog(), app.Content.Pages, preflightTitle, msg, pp.Passed(), func() {
+			if err := switchToContext(app, name); err != nil {
+				app.Flash().Err(err)
+			}
+		}, func() {})
+	})
+}
+
+func switchToContext(app *App, name string) error {
 	if app.Content.Top() != nil {
 		app.Content.Top().Stop()
 	}
+	if name != app.Config.ActiveContextName() {
+		app.saveSession()
+		if err := app.Config.Save(true); err != nil {
+			log.Error().Err(err).Msg("config save failed!")
+		}
+	}
 	res, err := dao.AccessorFor(app.factory, client.NewGVR("contexts"))
 	if err != nil {
 		return err
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const copyToTitle = "Copy Preview"
+
+// CopyPreview previews a resources copy server-side diff prior to creating them.
+type CopyPreview struct {
+	*Details
+
+	manifest []byte
+	args     dialog.CopyArgs
+}
+
+// NewCopyPreview returns a new resources copy preview.
+func NewCopyPreview(app *App, manifest []byte, args dialog.CopyArgs, diff string) *CopyPreview {
+	p := CopyPreview{
+		Details:  NewDetails(app, copyToTitle, copyTarget(args), contentTXT, true),
+		manifest: manifest,
+		args:     args,
+	}
+	if diff == "" {
+		diff = string(manifest)
+	}
+	p.Update(tview.Escape(diff))
+
+	return &p
+}
+
+// Init initializes the viewer.
+func (p *CopyPreview) Init(ctx context.Context) error {
+	if err := p.Details.Init(ctx); err != nil {
+		return err
+	}
+	p.Actions().Add(ui.KeyA, ui.NewKeyActionWithOpts("Apply", p.applyCmd, ui.ActionOpts{
+		Visible:   true,
+		Dangerous: true,
+	}))
+
+	return nil
+}
+
+func (p *CopyPreview) applyCmd(*tcell.EventKey) *tcell.EventKey {
+	msg := fmt.Sprintf("Create resources in %s?", copyTarget(p.args))
+	if p.args.Overwrite {
+		msg = fmt.Sprintf("Create or overwrite resources in %s?", copyTarget(p.args))
+	}
+	dialog.ShowConfirm(p.app.Styles.Dialog(), p.app.Content.Pages, "Confirm Copy", msg, p.apply, func() {})
+
+	return nil
+}
+
+// apply creates the copies. Existing resources are reported as conflicts
+// unless overwrite is on.
+func (p *CopyPreview) apply() {
+	args := []string{"create", "-f", "-"}
+	if p.args.Overwrite {
+		args = []string{"apply", "-f", "-", "--server-side", "--force-conflicts"}
+	}
+	res, err := runKu(p.app, shellOpts{args: append(args, copyContextArgs(p.args)...), input: p.manifest})
+	if err != nil {
+		res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
+	} else {
+		res = "message:\n" + fmtResults(res)
+	}
+	p.app.Content.Pop()
+	details := NewDetails(p.app, "Copied Resources", copyTarget(p.args), contentYAML, true).Update(res)
+	if err := p.app.inject(details, false); err != nil {
+		p.app.Flash().Err(err)
+	}
+}
+
+func (b *Browser) copyToCmd(evt *tcell.EventKey) *tcell.EventKey {
+	selections := b.GetSelectedItems()
+	if len(selections) == 0 {
+		return evt
+	}
+
+	cc, err := b.app.Conn().Config().ContextNames()
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	ctxs := make([]string, 0, len(cc))
+	for c := range cc {
+		ctxs = append(ctxs, c)
+	}
+	sort.Strings(ctxs)
+
+	msg := fmt.Sprintf("Copy %s %s to:", b.GVR().R(), selections[0])
+	if len(selections) > 1 {
+		msg = fmt.Sprintf("Copy %d marked %s to:", len(selections), b.GVR())
+	}
+	ns, _ := client.Namespaced(selections[0])
+	dialog.ShowCopy(b.app.Styles.Dialog(), b.app.Content.Pages, dialog.CopyDialogOpts{
+		Title:      "Copy To",
+		Message:    msg,
+		Namespaced: b.meta.Namespaced,
+		Args:       dialog.CopyArgs{Namespace: ns, Context: b.app.Config.ActiveContextName()},
+		Contexts:   ctxs,
+		Ack: func(args dialog.CopyArgs) {
+			b.copyTo(selections, args)
+		},
+		Cancel: func() {},
+	})
+
+	return nil
+}
+
+// copyTo previews a server-side dry-run diff of the selected resources copies.
+func (b *Browser) copyTo(selections []string, args dialog.CopyArgs) {
+	oo := make([]*unstructured.Unstructured, 0, len(selections))
+	for _, sel := range selections {
+		o, err := b.app.factory.Get(b.GVR().String(), sel, true, labels.Everything())
+		if err != nil {
+			b.app.Flash().Err(err)
+			return
+		}
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			b.app.Flash().Errf("expecting unstructured but got %T", o)
+			return
+		}
+		oo = append(oo, u)
+	}
+	manifest, err := dao.CopyManifest(oo, args.Namespace)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+
+	res, err := runKu(b.app, shellOpts{
+		args:  append([]string{"diff", "-f", "-", "--server-side"}, copyContextArgs(args)...),
+		input: manifest,
+	})
+	diff, _, err := diffResult(res, err)
+	if err != nil {
+		b.app.Flash().Errf("Copy dry-run failed: %s", err)
+		return
+	}
+	if err := b.app.inject(NewCopyPreview(b.app, manifest, args, diff), false); err != nil {
+		b.app.Flash().Err(err)
+	}
+}
+
+// copyContextArgs returns the kubectl args targeting the copy destination.
+// These override the active context set by runKu.
+func copyContextArgs(args dialog.CopyArgs) []string {
+	if args.Context == "" {
+		return nil
+	}
+
+	return []string{"--context", args.Context}
+}
+
+func copyTarget(args dialog.CopyArgs) string {
+	if args.Namespace == "" {
+		return args.Context
+	}
+
+	return args.Context + "/" + args.Namespace
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const crEditTitle = "Edit Preview"
+
+// CRUpdater represents a custom resource that can be edited in place.
+type CRUpdater interface {
+	// ToYAML dumps a resource to YAML.
+	ToYAML(path string, showManaged bool) (string, error)
+
+	// Update replaces a resource with the given manifest.
+	Update(ctx context.Context, path string, raw []byte) error
+}
+
+// CREdit previews a custom resource edit along with its schema violations prior
+// to applying it.
+type CREdit struct {
+	*Details
+
+	res    CRUpdater
+	path   string
+	raw    []byte
+	issues []dao.SchemaIssue
+}
+
+// NewCREdit returns a new custom resource edit preview.
+func NewCREdit(app *App, res CRUpdater, path string, raw []byte, diff string, ii []dao.SchemaIssue) *CREdit {
+	e := CREdit{
+		Details: NewDetails(app, crEditTitle, path, contentTXT, true),
+		res:     res,
+		path:    path,
+		raw:     raw,
+		issues:  ii,
+	}
+	e.Update(crEditContent(diff, ii))
+
+	return &e
+}
+
+// Init initializes the viewer.
+func (e *CREdit) Init(ctx context.Context) error {
+	if err := e.Details.Init(ctx); err != nil {
+		return err
+	}
+	e.Actions().Add(ui.KeyA, ui.NewKeyActionWithOpts("Apply", e.applyCmd, ui.ActionOpts{
+		Visible:   true,
+		Dangerous: true,
+	}))
+
+	return nil
+}
+
+func (e *CREdit) applyCmd(*tcell.EventKey) *tcell.EventKey {
+	msg := fmt.Sprintf("Apply changes to %s?", e.path)
+	if n := len(e.issues); n > 0 {
+		msg = fmt.Sprintf("[orangered::b]%d[-::-] schema violation(s) detected. Apply changes to %s anyway?", n, e.path)
+	}
+	dialog.ShowConfirm(e.app.Styles.Dialog(), e.app.Content.Pages, "Confirm Edit", msg, e.apply, func() {})
+
+	return nil
+}
+
+func (e *CREdit) apply() {
+	e.app.Flash().Infof("Updating %s...", e.path)
+	go func() {
+		err := e.res.Update(context.Background(), e.path, e.raw)
+		e.app.QueueUpdateDraw(func() {
+			if err != nil {
+				e.app.Flash().Errf("Update failed for %s: %s", e.path, err)
+				return
+			}
+			e.app.Flash().Infof("%s updated successfully", e.path)
+			e.app.Content.Pop()
+		})
+	}()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func editCR(app *App, gvr client.GVR, path string) error {
+	acc, err := dao.AccessorFor(app.factory, gvr)
+	if err != nil {
+		return err
+	}
+	res, ok := acc.(CRUpdater)
+	if !ok {
+		return fmt.Errorf("resource %s is not editable", gvr)
+	}
+	raw, err := res.ToYAML(path, false)
+	if err != nil {
+		return err
+	}
+	edited, err := editBuffer(app, "k9s-cr-*.yaml", []byte(raw))
+	if err != nil {
+		return err
+	}
+	if edited == nil || bytes.Equal(edited, []byte(raw)) {
+		app.Flash().Info("No changes detected")
+		return nil
+	}
+	diff, ii, err := dao.CREditPreview(app.factory, gvr, path, []byte(raw), edited)
+	if err != nil {
+		return err
+	}
+
+	return app.inject(NewCREdit(app, res, path, edited, diff, ii), false)
+}
+
+func crEditContent(diff string, ii []dao.SchemaIssue) string {
+	var b strings.Builder
+	ff := make(map[string]struct{}, len(ii))
+	if len(ii) > 0 {
+		fmt.Fprintf(&b, "[orangered::b]%d schema violation(s) detected[-::-]\n", len(ii))
+		for _, i := range ii {
+			fmt.Fprintf(&b, "[orangered::]  ✗ %s[-::]\n", tview.Escape(i.String()))
+			ff[i.Field] = struct{}{}
+		}
+		b.WriteString("\n")
+	}
+	for _, l := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		if isIssueLine(l, ff) {
+			fmt.Fprintf(&b, "[orangered::b]%s[-::-]\n", tview.Escape(l))
+			continue
+		}
+		b.WriteString(tview.Escape(l) + "\n")
+	}
+
+	return b.String()
+}
+
+// isIssueLine checks if an added diff line sets a field flagged by the schema validation.
+func isIssueLine(l string, ff map[string]struct{}) bool {
+	if !strings.HasPrefix(l, "+") || strings.HasPrefix(l, "+++") {
+		return false
+	}
+	s := strings.TrimPrefix(strings.TrimSpace(l[1:]), "- ")
+	k, _, ok := strings.Cut(s, ":")
+	if !ok {
+		return false
+	}
+	_, hit := ff[strings.Trim(k, `"'`)]
+
+	return hit
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsIssueLine(t *testing.T) {
+	ff := map[string]struct{}{"replica": {}, "hosts": {}}
+	uu := map[string]struct {
+		l string
+		e bool
+	}{
+		"added": {
+			l: "+  replica: 2",
+			e: true,
+		},
+		"list-item": {
+			l: "+  - hosts: a",
+			e: true,
+		},
+		"quoted": {
+			l: `+  "replica": 2`,
+			e: true,
+		},
+		"removed": {
+			l: "-  replica: 2",
+		},
+		"header": {
+			l: "+++ fred",
+		},
+		"other": {
+			l: "+  replicas: 2",
+		},
+		"no-key": {
+			l: "+  - a.com",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isIssueLine(u.l, ff))
+		})
+	}
+}
+
+func TestCREditContent(t *testing.T) {
+	diff := "--- fred\n+++ fred\n@@ -1,2 +1,2 @@\n spec:\n-  replicas: 2\n+  replica: 2\n"
+	ii := []dao.SchemaIssue{{Path: "spec.replica", Field: "replica", Message: "unknown field"}}
+
+	assert.Equal(t, diff, crEditContent(diff, nil))
+	assert.Equal(t,
+		"[orangered::b]1 schema violation(s) detected[-::-]\n"+
+			"[orangered::]  ✗ spec.replica: unknown field[-::]\n\n"+
+			"--- fred\n+++ fred\n@@ -1,2 +1,2 @@\n spec:\n-  replicas: 2\n"+
+			"[orangered::b]+  replica: 2[-::-]\n",
+		crEditContent(diff, ii),
+	)
+}
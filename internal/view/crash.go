@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/crash"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Crash presents k9s crash reports.
+type Crash struct {
+	ResourceViewer
+}
+
+// NewCrash returns a new viewer.
+func NewCrash(gvr client.GVR) ResourceViewer {
+	c := Crash{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorOrangeRed)
+	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorOrangeRed).Attributes(tcell.AttrNone))
+	c.GetTable().SetSortCol(ageCol, true)
+	c.GetTable().SetEnterFn(c.viewReport)
+	c.SetContextFn(c.dirContext)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *Crash) dirContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyDir, config.AppCrashesDir())
+}
+
+func (c *Crash) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyL: ui.NewKeyAction("Logs", c.logsCmd, true),
+		ui.KeyS: ui.NewKeyAction("Stack", c.stackCmd, true),
+	})
+}
+
+func (c *Crash) viewReport(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	c.show("Report", path, contentYAML, string(raw))
+}
+
+func (c *Crash) logsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	r, path, ok := c.selectedReport()
+	if !ok {
+		return evt
+	}
+	if len(r.Logs) == 0 {
+		c.App().Flash().Warn("No logs recorded for this crash")
+		return nil
+	}
+	c.show("Logs", path, contentTXT, strings.Join(r.Logs, "\n"))
+
+	return nil
+}
+
+func (c *Crash) stackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	r, path, ok := c.selectedReport()
+	if !ok {
+		return evt
+	}
+	c.show("Stack", path, contentTXT, r.Reason+"\n\n"+r.Stack)
+
+	return nil
+}
+
+func (c *Crash) selectedReport() (crash.Report, string, bool) {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return crash.Report{}, "", false
+	}
+	r, err := crash.Load(path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return crash.Report{}, "", false
+	}
+
+	return r, path, true
+}
+
+func (c *Crash) show(title, path, ctype, content string) {
+	details := NewDetails(c.App(), title, filepath.Base(path), ctype, true).Update(content)
+	if err := c.App().inject(details, false); err != nil {
+		c.App().Flash().Err(err)
+	}
+}
@@ -11,11 +11,11 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -149,10 +149,10 @@ func (c *CronJob) makeSuspendForm(sel string, suspend bool) *tview.Form {
 		action = "resumed"
 	}
 
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		c.dismissDialog()
 	})
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
 		defer c.dismissDialog()
 
 		ctx, cancel := context.WithTimeout(context.Background(), c.App().Conn().Config().CallTimeout())
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const deprecationsTitle = "Deprecations"
+
+// deprecationDoc documents the deprecations view.
+var deprecationDoc = ViewDoc{
+	Summary: "API deprecation warnings issued by the api server for the resources visited so far",
+	Columns: model.MenuHints{
+		{Mnemonic: "DEPRECATED", Description: "Version the api was deprecated in"},
+		{Mnemonic: "REMOVED", Description: "Version the api is no longer served in"},
+		{Mnemonic: "REPLACEMENT", Description: "Api to migrate to"},
+		{Mnemonic: "WARNINGS", Description: "Warnings received from the api server"},
+	},
+}
+
+// Deprecations represents a view of the api deprecation warnings.
+type Deprecations struct {
+	ResourceViewer
+}
+
+// NewDeprecations returns a new deprecations view.
+func NewDeprecations(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), deprecationDoc)
+	d := Deprecations{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetSortCol("RESOURCE", true)
+	d.AddBindKeysFn(d.bindKeys)
+	d.GetTable().SetEnterFn(d.showResource)
+
+	return &d
+}
+
+// Name returns the component name.
+func (*Deprecations) Name() string { return deprecationsTitle }
+
+func (d *Deprecations) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Removed", d.GetTable().SortColCmd("REMOVED", true), false),
+		ui.KeyShiftW: ui.NewKeyAction("Sort Warnings", d.GetTable().SortColCmd("WARNINGS", false), false),
+	})
+}
+
+// showResource navigates to the deprecated resource.
+func (d *Deprecations) showResource(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	app.gotoResource(path, "", false)
+}
@@ -34,6 +34,8 @@ type Details struct {
 	cmdBuff                   *model.FishBuff
 	model                     *model.Text
 	currentRegion, maxRegions int
+	matchLines                []string
+	highlightAll              bool
 	searchable                bool
 	fullScreen                bool
 	contentType               string
@@ -107,7 +109,8 @@ func (d *Details) TextChanged(lines []string) {
 // TextFiltered notifies when the filter changed.
 func (d *Details) TextFiltered(lines []string, matches fuzzy.Matches) {
 	d.currentRegion, d.maxRegions = 0, len(matches)
-	ll := linesWithRegions(lines, matches)
+	d.matchLines = matchingLines(lines, matches)
+	ll := linesWithRegions(lines, matches, d.highlightAll)
 
 	d.text.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(ll, "\n")))
 	d.text.Highlight()
@@ -118,7 +121,13 @@ func (d *Details) TextFiltered(lines []string, matches fuzzy.Matches) {
 }
 
 // BufferChanged indicates the buffer was changed.
-func (d *Details) BufferChanged(_, _ string) {}
+func (d *Details) BufferChanged(text, _ string) {
+	if !d.cmdBuff.IsActive() {
+		return
+	}
+	d.model.Filter(text)
+	d.updateTitle()
+}
 
 // BufferCompleted indicates input was accepted.
 func (d *Details) BufferCompleted(text, _ string) {
@@ -140,12 +149,14 @@ func (d *Details) bindKeys() {
 		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", d.toggleFullScreenCmd, true),
 		ui.KeyN:         ui.NewKeyAction("Next Match", d.nextCmd, true),
 		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", d.prevCmd, true),
+		ui.KeyShiftH:    ui.NewKeyAction("Toggle Highlight All", d.toggleHighlightAllCmd, true),
+		ui.KeyShiftC:    ui.NewKeyAction("Copy Matches", copyMatchesCmd(d.app.Flash(), d.matchingLines), true),
 		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", d.activateCmd, false),
 		tcell.KeyDelete: ui.NewSharedKeyAction("Erase", d.eraseCmd, false),
 	})
 
 	if !d.searchable {
-		d.actions.Delete(ui.KeyN, ui.KeyShiftN)
+		d.actions.Delete(ui.KeyN, ui.KeyShiftN, ui.KeyShiftH, ui.KeyShiftC)
 	}
 }
 
@@ -207,6 +218,25 @@ func (d *Details) ExtraHints() map[string]string {
 	return nil
 }
 
+func (d *Details) matchingLines() []string {
+	return d.matchLines
+}
+
+func (d *Details) toggleHighlightAllCmd(evt *tcell.EventKey) *tcell.EventKey {
+	d.highlightAll = !d.highlightAll
+	if q := d.cmdBuff.GetText(); q != "" {
+		d.model.Filter(q)
+		d.updateTitle()
+	}
+	if d.highlightAll {
+		d.app.Flash().Info("Highlighting all matches")
+	} else {
+		d.app.Flash().Info("Highlighting current match")
+	}
+
+	return nil
+}
+
 func (d *Details) nextCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if d.cmdBuff.Empty() {
 		return evt
@@ -303,7 +333,7 @@ func (d *Details) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (d *Details) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if path, err := saveYAML(d.app.Config.K9s.ContextScreenDumpDir(), d.title, d.text.GetText(true)); err != nil {
+	if path, err := saveYAML(d.app.Config.K9s.ContextScreenDumpDir(), d.title, d.text.GetText(true), d.app.Config.K9s.ScreenDumps); err != nil {
 		d.app.Flash().Err(err)
 	} else {
 		d.app.Flash().Infof("Log %s saved successfully!", path)
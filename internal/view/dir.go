@@ -12,21 +12,12 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
-	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 )
 
-const (
-	kustomize      = "kustomization"
-	kustomizeNoExt = "Kustomization"
-	kustomizeYAML  = kustomize + extYAML
-	kustomizeYML   = kustomize + extYML
-	extYAML        = ".yaml"
-	extYML         = ".yml"
-)
-
 // Dir represents a command directory view.
 type Dir struct {
 	ResourceViewer
@@ -74,6 +65,10 @@ func (d *Dir) bindDangerousKeys(aa *ui.KeyActions) {
 			Visible:   true,
 			Dangerous: true,
 		}),
+		ui.KeyShiftK: ui.NewKeyActionWithOpts("Kustomize", d.kustomizeCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}),
 	})
 }
 
@@ -167,18 +162,7 @@ func isKustomized(sel string) bool {
 		return false
 	}
 
-	ff, err := os.ReadDir(sel)
-	if err != nil {
-		return false
-	}
-	kk := []string{kustomizeNoExt, kustomizeYAML, kustomizeYML}
-	for _, f := range ff {
-		if data.InList(kk, f.Name()) {
-			return true
-		}
-	}
-
-	return false
+	return dao.IsKustomized(sel)
 }
 
 func containsDir(sel string) bool {
@@ -235,6 +219,34 @@ func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (d *Dir) kustomizeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := d.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	if !isKustomized(sel) {
+		d.App().Flash().Errf("you must select a kustomization directory")
+		return nil
+	}
+
+	d.Stop()
+	defer d.Start()
+	diff, changed, err := kustomizeDiff(d.App(), sel)
+	if err != nil {
+		d.App().Flash().Errf("Kustomize dry-run failed: %s", err)
+		return nil
+	}
+	if !changed {
+		d.App().Flash().Infof("Kustomization %s is up to date", sel)
+		return nil
+	}
+	if err := d.App().inject(NewKustomizePreview(d.App(), sel, diff), false); err != nil {
+		d.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (d *Dir) delCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sel := d.GetTable().GetSelectedItem()
 	if sel == "" {
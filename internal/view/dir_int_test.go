@@ -4,6 +4,7 @@
 package view
 
 import (
+	"os/exec"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -45,3 +46,41 @@ func TestIsKustomized(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffResult(t *testing.T) {
+	uu := map[string]struct {
+		cmd     string
+		res     string
+		changed bool
+		err     string
+	}{
+		"same": {
+			cmd: "exit 0",
+		},
+		"changed": {
+			cmd:     "exit 1",
+			res:     "+ blee",
+			changed: true,
+		},
+		"failed": {
+			cmd: "exit 2",
+			res: "error: boom",
+			err: "error: boom",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := exec.Command("sh", "-c", u.cmd).Run()
+			res, changed, err := diffResult(u.res, err)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.changed, changed)
+			assert.Equal(t, u.res, res)
+		})
+	}
+}
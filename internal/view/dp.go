@@ -8,6 +8,7 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +16,22 @@ import (
 
 const scaleDialogKey = "scale"
 
+// deployDoc documents the deployment view.
+var deployDoc = ViewDoc{
+	Summary: "Deployments and their rollout state",
+	Columns: model.MenuHints{
+		{Mnemonic: "READY", Description: "Ready/desired replicas"},
+		{Mnemonic: "UP-TO-DATE", Description: "Replicas running the latest template"},
+		{Mnemonic: "AVAILABLE", Description: "Replicas available to serve"},
+		{Mnemonic: "VALID", Description: "Deployment sanitizer findings"},
+	},
+	Related: model.MenuHints{
+		{Mnemonic: ":pods", Description: "Deployment pods via enter"},
+		{Mnemonic: ":rs", Description: "Replica sets"},
+		{Mnemonic: ":hpa", Description: "Horizontal pod autoscalers"},
+	},
+}
+
 // Deploy represents a deployment view.
 type Deploy struct {
 	ResourceViewer
@@ -22,6 +39,7 @@ type Deploy struct {
 
 // NewDeploy returns a new deployment view.
 func NewDeploy(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), deployDoc)
 	var d Deploy
 	d.ResourceViewer = NewPortForwardExtender(
 		NewVulnerabilityExtender(
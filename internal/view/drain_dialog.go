@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 )
@@ -59,10 +60,10 @@ func ShowDrain(view ResourceViewer, sels []string, opts dao.DrainOptions, okFn D
 	})
 
 	pages := view.App().Content.Pages
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		DismissDrain(view, pages)
 	})
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
 		DismissDrain(view, pages)
 		okFn(view, sels, opts)
 	})
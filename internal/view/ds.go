@@ -4,11 +4,15 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
 	appsv1 "k8s.io/api/apps/v1"
 )
 
@@ -43,6 +47,72 @@ func (d *DaemonSet) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(uptodateCol, true), false),
 		ui.KeyShiftL: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(availCol, true), false),
 	})
+	if d.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftM: ui.NewKeyActionWithOpts("Max Unavailable", d.maxUnavailableCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftO: ui.NewKeyActionWithOpts("Restart Nodes", d.restartNodesCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+	})
+}
+
+func (d *DaemonSet) maxUnavailableCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ds, err := d.getInstance(path)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	dialog.ShowInput(d.App().Styles.Dialog(), d.App().Content.Pages, dialog.InputDialogOpts{
+		Title:   "Max Unavailable",
+		Message: fmt.Sprintf("Number or percentage of %s pods that can be unavailable during rollouts ie 2 or 25%%", path),
+		Label:   "Max Unavailable:",
+		Value:   dao.MaxUnavailable(ds),
+		Ack: func(v string) {
+			ctx, cancel := context.WithTimeout(context.Background(), d.App().Conn().Config().CallTimeout())
+			defer cancel()
+			if err := d.resource().SetMaxUnavailable(ctx, path, v); err != nil {
+				d.App().Flash().Err(err)
+				return
+			}
+			d.App().Flash().Infof("Max unavailable set to %s for %s", v, path)
+		},
+		Cancel: func() {},
+	})
+
+	return nil
+}
+
+func (d *DaemonSet) restartNodesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	res := d.resource()
+	nodes, err := res.Nodes(path)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	msg := fmt.Sprintf("Restart %s pods node by node on %d nodes?\nEach pod must be ready before moving on to the next node.", path, len(nodes))
+	dialog.ShowConfirm(d.App().Styles.Dialog(), d.App().Content.Pages, "Confirm Restart", msg, func() {
+		runOrdered(context.Background(), d.App(), "Restart Nodes", nodes, func(ctx context.Context, node string) error {
+			return res.RestartNode(ctx, path, node)
+		}, d.Refresh)
+	}, func() {})
+
+	return nil
 }
 
 func (d *DaemonSet) showPods(app *App, model ui.Tabular, _ client.GVR, path string) {
@@ -72,8 +142,12 @@ func (d *DaemonSet) logOptions(prev bool) (*dao.LogOptions, error) {
 }
 
 func (d *DaemonSet) getInstance(fqn string) (*appsv1.DaemonSet, error) {
+	return d.resource().GetInstance(fqn)
+}
+
+func (d *DaemonSet) resource() *dao.DaemonSet {
 	var ds dao.DaemonSet
 	ds.Init(d.App().factory, client.NewGVR("apps/v1/daemonsets"))
 
-	return ds.GetInstance(fqn)
+	return &ds
 }
@@ -5,6 +5,7 @@ package view
 
 import (
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 )
@@ -12,15 +13,19 @@ import (
 // Event represents a command alias view.
 type Event struct {
 	ResourceViewer
+
+	dedup bool
 }
 
 // NewEvent returns a new alias view.
 func NewEvent(gvr client.GVR) ResourceViewer {
 	e := Event{
 		ResourceViewer: NewBrowser(gvr),
+		dedup:          true,
 	}
 	e.AddBindKeysFn(e.bindKeys)
 	e.GetTable().SetSortCol("LAST SEEN", false)
+	e.GetTable().SetDecorateFn(e.decorate)
 
 	return &e
 }
@@ -28,11 +33,31 @@ func NewEvent(gvr client.GVR) ResourceViewer {
 func (e *Event) bindKeys(aa *ui.KeyActions) {
 	aa.Delete(tcell.KeyCtrlD, ui.KeyE, ui.KeyA)
 	aa.Bulk(ui.KeyMap{
+		ui.KeyZ:      ui.NewKeyAction("Toggle Dedup", e.toggleDedupCmd, true),
 		ui.KeyShiftL: ui.NewKeyAction("Sort LastSeen", e.GetTable().SortColCmd("LAST SEEN", false), false),
 		ui.KeyShiftF: ui.NewKeyAction("Sort FirstSeen", e.GetTable().SortColCmd("FIRST SEEN", false), false),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Type", e.GetTable().SortColCmd("TYPE", true), false),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", e.GetTable().SortColCmd("REASON", true), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Source", e.GetTable().SortColCmd("SOURCE", true), false),
 		ui.KeyShiftC: ui.NewKeyAction("Sort Count", e.GetTable().SortColCmd("COUNT", true), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Rate", e.GetTable().SortColCmd(eventRateCol, false), false),
 	})
 }
+
+func (e *Event) decorate(data *model1.TableData) {
+	if e.dedup {
+		dedupEvents(data)
+	}
+}
+
+func (e *Event) toggleDedupCmd(evt *tcell.EventKey) *tcell.EventKey {
+	e.dedup = !e.dedup
+	if e.dedup {
+		e.App().Flash().Info("Events deduped")
+	} else {
+		e.App().Flash().Info("Events dedup off")
+	}
+	e.GetTable().Refresh()
+
+	return nil
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+)
+
+const eventRateCol = "RATE"
+
+// eventCols tracks the event columns used to dedup events.
+type eventCols struct {
+	ns, reason, object, message, count, last, first int
+}
+
+func newEventCols(data *model1.TableData) (eventCols, bool) {
+	cols := eventCols{ns: -1, first: -1}
+	for _, c := range []struct {
+		name string
+		idx  *int
+	}{
+		{"REASON", &cols.reason},
+		{"OBJECT", &cols.object},
+		{"MESSAGE", &cols.message},
+		{"COUNT", &cols.count},
+		{"LAST SEEN", &cols.last},
+	} {
+		idx, ok := data.IndexOfHeader(c.name)
+		if !ok {
+			return cols, false
+		}
+		*c.idx = idx
+	}
+	if idx, ok := data.IndexOfHeader("NAMESPACE"); ok {
+		cols.ns = idx
+	}
+	if idx, ok := data.IndexOfHeader("FIRST SEEN"); ok {
+		cols.first = idx
+	}
+
+	return cols, true
+}
+
+func (c eventCols) key(ff model1.Fields) string {
+	var ns string
+	if c.ns >= 0 {
+		ns = ff[c.ns]
+	}
+
+	return strings.Join([]string{ns, ff[c.reason], ff[c.object], ff[c.message]}, "|")
+}
+
+// eventGroup tracks events sharing the same reason, involved object and message.
+type eventGroup struct {
+	re          model1.RowEvent
+	count       int
+	last, first int64
+}
+
+// dedupEvents collapses events sharing the same reason, involved object and
+// message into a single row carrying the total count, the last seen and first
+// seen ages. A rate column tracks the events per minute of each reason.
+func dedupEvents(data *model1.TableData) {
+	if _, ok := data.IndexOfHeader(eventRateCol); ok {
+		return
+	}
+	cols, ok := newEventCols(data)
+	if !ok {
+		return
+	}
+
+	gg, index := make([]*eventGroup, 0, data.RowCount()), make(map[string]*eventGroup, data.RowCount())
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		ff := re.Row.Fields
+		count, err := strconv.Atoi(strings.TrimSpace(ff[cols.count]))
+		if err != nil {
+			count = 1
+		}
+		last := eventAge(ff[cols.last])
+		first := last
+		if cols.first >= 0 {
+			first = max(eventAge(ff[cols.first]), last)
+		}
+
+		k := cols.key(ff)
+		g, ok := index[k]
+		if !ok {
+			index[k] = &eventGroup{re: re, count: count, last: last, first: first}
+			gg = append(gg, index[k])
+			return true
+		}
+		g.count += count
+		if last < g.last {
+			fv := g.re.Row.Fields
+			g.re, g.last = re, last
+			if cols.first >= 0 {
+				g.re.Row.Fields[cols.first] = fv[cols.first]
+			}
+		}
+		if first > g.first {
+			g.first = first
+			if cols.first >= 0 {
+				g.re.Row.Fields[cols.first] = ff[cols.first]
+			}
+		}
+
+		return true
+	})
+
+	rates := eventRates(gg, cols)
+	h := data.Header()
+	hh := make(model1.Header, 0, len(h)+1)
+	hh = append(hh, h[:cols.count+1]...)
+	hh = append(hh, model1.HeaderColumn{Name: eventRateCol, Align: h[cols.count].Align})
+	hh = append(hh, h[cols.count+1:]...)
+
+	rr := model1.NewRowEvents(len(gg))
+	for _, g := range gg {
+		re := g.re
+		re.Row.Fields[cols.count] = strconv.Itoa(g.count)
+		re.Row.Fields = insertAt(re.Row.Fields, cols.count+1, rates[re.Row.Fields[cols.reason]])
+		if len(re.Deltas) > 0 {
+			re.Deltas = insertAt(re.Deltas, cols.count+1, "")
+		}
+		rr.Add(re)
+	}
+	data.SetHeader(data.GetNamespace(), hh)
+	data.SetRowEvents(rr)
+}
+
+// eventRates computes the events per minute of each reason over the span
+// of the reason events.
+func eventRates(gg []*eventGroup, cols eventCols) map[string]string {
+	counts, spans := make(map[string]int), make(map[string]int64)
+	for _, g := range gg {
+		r := g.re.Row.Fields[cols.reason]
+		counts[r] += g.count
+		spans[r] = max(spans[r], g.first)
+	}
+
+	rates := make(map[string]string, len(counts))
+	for r, c := range counts {
+		mins := max(float64(spans[r])/60, 1)
+		rates[r] = fmt.Sprintf("%.1f/m", float64(c)/mins)
+	}
+
+	return rates
+}
+
+// eventAge returns an event age in seconds or 0 if the age is unknown.
+func eventAge(s string) int64 {
+	s = strings.TrimSpace(s)
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0
+	}
+	if i := strings.Index(s, " "); i > 0 {
+		s = s[:i]
+	}
+
+	return model1.DurationToSeconds(s)
+}
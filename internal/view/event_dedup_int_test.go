@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedupEvents(t *testing.T) {
+	data := model1.NewTableDataWithRows(
+		client.NewGVR("v1/events"),
+		model1.Header{
+			model1.HeaderColumn{Name: "NAMESPACE"},
+			model1.HeaderColumn{Name: "LAST SEEN", Time: true},
+			model1.HeaderColumn{Name: "REASON"},
+			model1.HeaderColumn{Name: "OBJECT"},
+			model1.HeaderColumn{Name: "MESSAGE"},
+			model1.HeaderColumn{Name: "FIRST SEEN", Time: true},
+			model1.HeaderColumn{Name: "COUNT"},
+		},
+		model1.NewRowEventsWithEvts(
+			model1.RowEvent{Row: model1.Row{ID: "default/e1", Fields: model1.Fields{"default", "5m", "BackOff", "pod/p1", "Back-off", "10m", "3"}}},
+			model1.RowEvent{Row: model1.Row{ID: "default/e2", Fields: model1.Fields{"default", "1m", "BackOff", "pod/p1", "Back-off", "4m", "2"}}},
+			model1.RowEvent{Row: model1.Row{ID: "default/e3", Fields: model1.Fields{"default", "2m", "BackOff", "pod/p2", "Back-off", "2m", "5"}}},
+			model1.RowEvent{Row: model1.Row{ID: "default/e4", Fields: model1.Fields{"default", "30s", "Pulled", "pod/p1", "Pulled", "30s", ""}}},
+		),
+	)
+
+	dedupEvents(data)
+
+	assert.Equal(t, []string{"NAMESPACE", "LAST SEEN", "REASON", "OBJECT", "MESSAGE", "FIRST SEEN", "COUNT", eventRateCol}, data.ColumnNames(true))
+	assert.Equal(t, 3, data.RowCount())
+	re, ok := data.RowAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, "default/e2", re.Row.ID)
+	assert.Equal(t, model1.Fields{"default", "1m", "BackOff", "pod/p1", "Back-off", "10m", "5", "1.0/m"}, re.Row.Fields)
+	re, ok = data.RowAt(2)
+	assert.True(t, ok)
+	assert.Equal(t, model1.Fields{"default", "30s", "Pulled", "pod/p1", "Pulled", "30s", "1", "1.0/m"}, re.Row.Fields)
+
+	dedupEvents(data)
+	assert.Equal(t, 8, data.HeaderCount())
+}
+
+func TestEventAge(t *testing.T) {
+	uu := map[string]struct {
+		s string
+		e int64
+	}{
+		"empty":   {},
+		"unknown": {s: "<unknown>"},
+		"plain":   {s: "2m5s", e: 125},
+		"series":  {s: "3m (x4 over 10m)", e: 180},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, eventAge(u.s))
+		})
+	}
+}
@@ -23,7 +23,6 @@ import (
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/fatih/color"
-	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -47,12 +46,22 @@ type shellOpts struct {
 	binary            string
 	banner            string
 	args              []string
+	input             []byte
 }
 
 func (s shellOpts) String() string {
 	return fmt.Sprintf("%s %s", s.binary, strings.Join(s.args, " "))
 }
 
+// stdin returns the command input, defaults to the terminal.
+func (s shellOpts) stdin() io.Reader {
+	if s.input == nil {
+		return os.Stdin
+	}
+
+	return bytes.NewReader(s.input)
+}
+
 func runK(a *App, opts shellOpts) error {
 	bin, err := exec.LookPath("kubectl")
 	if errors.Is(err, exec.ErrDot) {
@@ -242,13 +251,30 @@ func oneShoot(opts shellOpts) (string, error) {
 
 	var err error
 	buff := bytes.NewBufferString("")
-	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, buff, buff
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = opts.stdin(), buff, buff
 	_, _ = cmd.Stdout.Write([]byte(opts.banner))
 	err = cmd.Run()
 
 	return strings.Trim(buff.String(), "\n"), err
 }
 
+// capture runs a command and returns its output.
+func capture(opts shellOpts) (string, error) {
+	log.Debug().Msgf("Capturing command> %s", opts)
+	cmd := exec.Command(opts.binary, opts.args...)
+
+	var o, e bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &o, &e
+	if opts.input != nil {
+		cmd.Stdin = bytes.NewReader(opts.input)
+	}
+	if err := cmd.Run(); err != nil {
+		return "", errors.Join(err, fmt.Errorf("%s", strings.TrimSpace(e.String())))
+	}
+
+	return o.String(), nil
+}
+
 func clearScreen() {
 	fmt.Print("\033[H\033[2J")
 }
@@ -487,7 +513,7 @@ func pipe(_ context.Context, opts shellOpts, statusChan chan<- string, w, e *byt
 		cmd := cmds[0]
 		if opts.background {
 			go func() {
-				cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, w, e
+				cmd.Stdin, cmd.Stdout, cmd.Stderr = opts.stdin(), w, e
 				if err := cmd.Run(); err != nil {
 					log.Error().Err(err).Msgf("Command failed: %s", err)
 				} else {
@@ -503,7 +529,7 @@ func pipe(_ context.Context, opts shellOpts, statusChan chan<- string, w, e *byt
 			}()
 			return nil
 		}
-		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = opts.stdin(), os.Stdout, os.Stderr
 		_, _ = cmd.Stdout.Write([]byte(opts.banner))
 
 		log.Debug().Msgf("Running Start")
@@ -518,6 +544,9 @@ func pipe(_ context.Context, opts shellOpts, statusChan chan<- string, w, e *byt
 	}
 
 	last := len(cmds) - 1
+	if opts.input != nil {
+		cmds[0].Stdin = bytes.NewReader(opts.input)
+	}
 	for i := 0; i < len(cmds); i++ {
 		cmds[i].Stderr = os.Stderr
 		if i+1 < len(cmds) {
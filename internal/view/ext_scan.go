@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/vul"
+)
+
+// scanImages scans images with the configured scanner binary in the background
+// and shows their vulnerabilities once done.
+func scanImages(app *App, refs []dao.ImageRef) {
+	bin := app.Config.K9s.ImageScans.Scanner
+	if len(refs) == 0 {
+		app.Flash().Warn("No images to scan")
+		return
+	}
+	app.Flash().Infof("Scanning %d image(s) with %s...", len(refs), filepath.Base(bin))
+	go func() {
+		ss, errs := make([]*vul.Scan, 0, len(refs)), make([]error, 0, len(refs))
+		for _, r := range refs {
+			s, err := vul.ExtScanner.Scan(context.Background(), bin, r.Image, r.Digest)
+			if err != nil {
+				log.Error().Err(err).Msgf("Image scan failed: %s", r.Image)
+				errs = append(errs, err)
+				continue
+			}
+			ss = append(ss, s)
+		}
+		app.QueueUpdateDraw(func() {
+			if len(ss) == 0 {
+				app.Flash().Err(errors.Join(errs...))
+				return
+			}
+			v := NewImageScan(client.NewGVR("scans"))
+			v.SetContextFn(func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, internal.KeyImageScans, ss)
+			})
+			if err := app.inject(v, false); err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			if len(errs) > 0 {
+				app.Flash().Warnf("%d image scan(s) failed: %s", len(errs), errors.Join(errs...))
+			}
+		})
+	}()
+}
+
+// podImageRefs returns the images of a pod or of one of its containers.
+func podImageRefs(app *App, path, co string) ([]dao.ImageRef, error) {
+	pod, err := fetchPod(app.factory, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return dao.PodImageRefs(pod, co), nil
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const filtersTitle = "Filters"
+
+// filtersCmd applies, saves or deletes a named filter of the current view.
+// Without a name, a picker toggles the view saved filters.
+func (a *App) filtersCmd(action, name string) error {
+	v, ok := a.Content.Top().(ResourceViewer)
+	if !ok {
+		return errors.New("saved filters are only available on resource views")
+	}
+	ff := config.NewFilters()
+	if err := ff.Load(config.AppFiltersFile); err != nil {
+		return err
+	}
+	view := v.GVR().String()
+
+	switch action {
+	case "save":
+		filter := v.GetTable().CmdBuff().GetText()
+		if filter == "" {
+			return errors.New("no active filter to save")
+		}
+		ff.Add(view, name, filter)
+		if err := ff.Save(config.AppFiltersFile); err != nil {
+			return err
+		}
+		a.Flash().Infof("Filter %q saved", name)
+	case "delete":
+		if err := ff.Delete(view, name); err != nil {
+			return err
+		}
+		if err := ff.Save(config.AppFiltersFile); err != nil {
+			return err
+		}
+		a.Flash().Infof("Filter %q deleted", name)
+	default:
+		if name == "" {
+			return a.filtersPicker(v, ff.For(view))
+		}
+		filter, err := ff.Get(view, name)
+		if err != nil {
+			return err
+		}
+		toggleFilter(v, filter)
+	}
+
+	return nil
+}
+
+func (a *App) filtersPicker(v ResourceViewer, vf config.ViewFilters) error {
+	nn := vf.Names()
+	if len(nn) == 0 {
+		return fmt.Errorf("no saved filters for %s", v.GVR())
+	}
+
+	picker := NewPicker()
+	picker.Clear()
+	for i, n := range nn {
+		picker.AddItem(n, vf[n], rune('a'+i), nil)
+	}
+	picker.SetSelectedFunc(func(idx int, _, _ string, _ rune) {
+		a.Content.Pop()
+		toggleFilter(v, vf[nn[idx]])
+	})
+	if err := a.inject(picker, false); err != nil {
+		return err
+	}
+	picker.ShowSecondaryText(true)
+	picker.SetTitle(" [aqua::b]" + filtersTitle + " ")
+
+	return nil
+}
+
+// toggleFilter applies a filter to the view or clears it when already active.
+func toggleFilter(v ResourceViewer, filter string) {
+	if v.GetTable().CmdBuff().GetText() == filter {
+		filter = ""
+	}
+	v.SetFilter(filter)
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const findTitle = "Find"
+
+// findDoc documents the find view.
+var findDoc = ViewDoc{
+	Summary: "Resources matching a search query by name, labels or well known fields ranked by relevance",
+	Columns: model.MenuHints{
+		{Mnemonic: "MATCH", Description: "Resource attribute matching the query"},
+		{Mnemonic: "VALUE", Description: "Matching attribute value"},
+		{Mnemonic: "SCORE", Description: "Match relevance. Name matches rank first"},
+		{Mnemonic: "SOURCE", Description: "Informer cache or api server list call"},
+	},
+}
+
+// Find represents a cluster wide resources search view.
+type Find struct {
+	ResourceViewer
+
+	query string
+	list  bool
+}
+
+// NewFind returns a new search view.
+func NewFind(q string, list bool) ResourceViewer {
+	gvr := client.NewGVR("find")
+	RegisterViewDoc(gvr.R(), findDoc)
+	f := Find{
+		ResourceViewer: NewBrowser(gvr),
+		query:          q,
+		list:           list,
+	}
+	f.GetTable().SetSortCol("SCORE", false)
+	f.AddBindKeysFn(f.bindKeys)
+	f.SetContextFn(f.findContext)
+	f.GetTable().SetEnterFn(f.showResource)
+
+	return &f
+}
+
+// Name returns the component name.
+func (*Find) Name() string { return findTitle }
+
+func (f *Find) findContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyQuery, f.query)
+
+	return context.WithValue(ctx, internal.KeyListUncached, f.list)
+}
+
+func (f *Find) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", f.GetTable().SortColCmd("RESOURCE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Score", f.GetTable().SortColCmd("SCORE", false), false),
+	})
+}
+
+// showResource navigates to the matching resource owning view.
+func (f *Find) showResource(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	gvr, fqn, ok := strings.Cut(path, " ")
+	if !ok {
+		app.Flash().Err(fmt.Errorf("invalid search result %q", path))
+		return
+	}
+	c := gvr
+	if ns, _ := client.Namespaced(fqn); ns != "" {
+		c += " " + ns
+	}
+	app.gotoResource(c, fqn, false)
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/model"
+)
+
+// flashSink surfaces notifications in the app flash area.
+type flashSink struct {
+	app *App
+}
+
+func newFlashSink(app *App) *flashSink {
+	return &flashSink{app: app}
+}
+
+// Notify delivers a notification.
+func (f *flashSink) Notify(_ context.Context, n model.Notification) error {
+	f.app.QueueUpdateDraw(func() {
+		f.app.Flash().Warn(n.String())
+	})
+
+	return nil
+}
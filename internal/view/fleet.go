@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// fleetRefresh tracks the fleet health checks interval.
+const fleetRefresh = 30 * time.Second
+
+// fleetDoc documents the fleet view.
+var fleetDoc = ViewDoc{
+	Summary: "Health summary of several contexts checked side by side. Enter switches to a context",
+	Columns: model.MenuHints{
+		{Mnemonic: "CONTEXT", Description: "Kubeconfig context. (*) marks the active context"},
+		{Mnemonic: "VERSION", Description: "Api server version"},
+		{Mnemonic: "NODES", Description: "Ready nodes over total nodes"},
+		{Mnemonic: "FAILING", Description: "Pods neither running nor completed"},
+		{Mnemonic: "CSRS", Description: "Certificate signing requests pending approval"},
+		{Mnemonic: "VALID", Description: "Unreachable context, not ready nodes or failing pods"},
+	},
+}
+
+// Fleet represents a multi contexts health dashboard.
+type Fleet struct {
+	ResourceViewer
+}
+
+// NewFleet returns a new fleet view.
+func NewFleet(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), fleetDoc)
+	f := Fleet{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.GetTable().SetSortCol("CONTEXT", true)
+	f.GetTable().SetEnterFn(f.useCtx)
+	f.AddBindKeysFn(f.bindKeys)
+	f.SetContextFn(f.fleetContext)
+
+	return &f
+}
+
+// Init initializes the view.
+func (f *Fleet) Init(ctx context.Context) error {
+	if err := f.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	f.GetTable().GetModel().SetNamespace(client.NotNamespaced)
+	f.GetTable().GetModel().SetRefreshRate(fleetRefresh)
+
+	return nil
+}
+
+func (f *Fleet) fleetContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyFleet, f.App().Config.K9s.Fleet.Contexts)
+}
+
+func (f *Fleet) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftF: ui.NewKeyAction("Sort Failing", f.GetTable().SortColCmd("FAILING", false), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Valid", f.GetTable().SortColCmd("VALID", true), false),
+	})
+}
+
+func (f *Fleet) useCtx(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	if err := useContext(app, path); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/model1"
+)
+
+// groupByCmd groups the current view rows by a given column. A blank column
+// ungroups the rows.
+func (a *App) groupByCmd(col string) error {
+	v, ok := a.Content.Top().(ResourceViewer)
+	if !ok {
+		return errors.New("grouping is only available on resource views")
+	}
+	col = model1.ExprColName(col)
+	if col != "" {
+		if _, ok := v.GetTable().GetModel().Peek().Header().IndexOf(col, true); !ok {
+			return fmt.Errorf("unknown group column %q", col)
+		}
+	}
+	v.GetTable().GroupBy(col)
+	if col == "" {
+		a.Flash().Info("Rows ungrouped")
+		return nil
+	}
+	a.Flash().Infof("Rows grouped by %s. Press <enter> on a group to collapse/expand it", col)
+
+	return nil
+}
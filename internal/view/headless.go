@@ -0,0 +1,442 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/k9s/internal/watch"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	scriptComment = "#"
+	dumpScriptCmd = "dump"
+	plugScriptCmd = "plugin"
+	dumpCSV       = "csv"
+	dumpJSON      = "json"
+	dumpStdout    = "-"
+)
+
+// scriptCmd represents a script command along with its source line.
+type scriptCmd struct {
+	line int
+	text string
+}
+
+// Headless runs prompt commands without a terminal ui.
+type Headless struct {
+	Config *config.Config
+
+	factory *watch.Factory
+	alias   *dao.Alias
+	out     io.Writer
+	errOut  io.Writer
+	ns      string
+	gvr     client.GVR
+	data    *model1.TableData
+	filter  string
+}
+
+// NewHeadless returns a new headless runner writing commands output to out.
+func NewHeadless(cfg *config.Config, out, errOut io.Writer) *Headless {
+	return &Headless{
+		Config: cfg,
+		out:    out,
+		errOut: errOut,
+	}
+}
+
+// Init initializes the runner.
+func (h *Headless) Init() error {
+	conn := h.Config.GetConnection()
+	if conn == nil || !conn.ConnectionOK() {
+		return errors.New("no client connection detected")
+	}
+	dao.SetReadOnlyFn(func() bool { return h.Config.K9s.IsReadOnly() })
+	h.ns = h.Config.ActiveNamespace()
+	h.factory = watch.NewFactory(conn)
+	h.factory.Start(h.ns)
+	h.alias = dao.NewAlias(h.factory)
+	if _, err := h.alias.Ensure(h.Config.ContextAliasesPath()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Stop terminates the runner.
+func (h *Headless) Stop() {
+	if h.factory != nil {
+		h.factory.Terminate()
+	}
+}
+
+// Run executes a script, stopping at the first failed command.
+func (h *Headless) Run(r io.Reader) error {
+	cc, err := readScript(r)
+	if err != nil {
+		return err
+	}
+	for _, c := range cc {
+		log.Debug().Msgf("Script line %d: %s", c.line, c.text)
+		for _, t := range cmd.SplitChain(c.text) {
+			quit, err := h.exec(t)
+			if err != nil {
+				return fmt.Errorf("line %d: %q failed: %w", c.line, t, err)
+			}
+			if quit {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *Headless) exec(text string) (bool, error) {
+	if strings.HasPrefix(text, "/") {
+		return false, h.filterCmd(strings.TrimSpace(text[1:]))
+	}
+
+	p := cmd.NewInterpreter(text)
+	switch {
+	case p.IsBailCmd():
+		return true, nil
+	case p.IsContextCmd():
+		ct, _ := p.ContextArg()
+		if ct == "" {
+			return false, errors.New("invalid command use `context xxx`")
+		}
+		return false, h.switchContext(ct)
+	case p.Cmd() == dumpScriptCmd:
+		return false, h.dumpCmd(strings.Fields(text)[1:])
+	case p.Cmd() == plugScriptCmd:
+		return false, h.pluginCmd(strings.Fields(text)[1:])
+	default:
+		return false, h.resourceCmd(p)
+	}
+}
+
+func (h *Headless) switchContext(name string) error {
+	if name == h.Config.ActiveContextName() {
+		return nil
+	}
+	if err := h.factory.Client().SwitchContext(name); err != nil {
+		return err
+	}
+	h.Config.Reset()
+	if _, err := h.Config.K9s.ActivateContext(name); err != nil {
+		return err
+	}
+	h.ns = h.Config.ActiveNamespace()
+	h.factory.Terminate()
+	h.factory.Start(h.ns)
+	h.alias.Clear()
+	if _, err := h.alias.Ensure(h.Config.ContextAliasesPath()); err != nil {
+		return err
+	}
+	h.gvr, h.data, h.filter = client.NoGVR, nil, ""
+	log.Debug().Msgf("Script switched to context %q::%q", name, h.ns)
+
+	return nil
+}
+
+func (h *Headless) resourceCmd(p *cmd.Interpreter) error {
+	agvr, exp, ok := h.alias.AsGVR(p.Cmd())
+	if !ok {
+		return fmt.Errorf("`%s` command not found", p.Cmd())
+	}
+	gvr := agvr
+	if exp != "" {
+		if err := p.ExpandAlias(agvr.String(), exp); err != nil {
+			return err
+		}
+		gvr = client.NewGVR(p.Cmd())
+	}
+	if ct, ok := p.HasContext(); ok {
+		if err := h.switchContext(ct); err != nil {
+			return err
+		}
+	}
+	if ns, ok := p.NSArg(); ok {
+		h.ns = client.CleanseNamespace(ns)
+		if err := h.factory.SetActiveNS(h.ns); err != nil {
+			return err
+		}
+	}
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return err
+	}
+	ns := h.ns
+	if !meta.Namespaced {
+		ns = client.ClusterScope
+	}
+
+	// Unlike the ui, a script won't wait for the next refresh so the resource
+	// cache must be primed before listing.
+	if !dao.IsK9sMeta(meta) {
+		if _, err := h.factory.List(gvr.String(), ns, true, labels.Everything()); err != nil {
+			return err
+		}
+	}
+
+	t := model.NewTable(gvr)
+	t.SetNamespace(ns)
+	if ll, ok := p.LabelsArg(); ok {
+		t.SetLabelFilter(toLabelsStr(ll))
+	}
+	if err := t.Refresh(h.context(gvr)); err != nil {
+		return err
+	}
+	h.gvr, h.data, h.filter = gvr, t.Peek(), ""
+	if f, ok := p.FilterArg(); ok {
+		h.filter = f
+	}
+	if f, ok := p.FuzzyArg(); ok {
+		h.filter = "-f " + f
+	}
+
+	return nil
+}
+
+func (h *Headless) context(gvr client.GVR) context.Context {
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, h.factory)
+	ctx = context.WithValue(ctx, internal.KeyGVR, gvr)
+	ctx = context.WithValue(ctx, internal.KeyNamespace, h.ns)
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, h.factory.Client().HasMetrics())
+
+	return ctx
+}
+
+func (h *Headless) filterCmd(f string) error {
+	if h.data == nil {
+		return errors.New("no resource loaded")
+	}
+	h.filter = f
+
+	return nil
+}
+
+// current returns the loaded resource rows once filtered and sorted.
+func (h *Headless) current() (*model1.TableData, error) {
+	if h.data == nil {
+		return nil, errors.New("no resource loaded")
+	}
+	data := h.data.Filter(model1.FilterOpts{Filter: h.filter})
+	if _, ok := data.IndexOfHeader("NAMESPACE"); ok {
+		data.Sort(model1.SortColumn{Name: "NAMESPACE", ASC: true, Secondary: []model1.SortColumn{{Name: "NAME", ASC: true}}})
+	} else {
+		data.Sort(model1.SortColumn{Name: "NAME", ASC: true})
+	}
+
+	return data, nil
+}
+
+func (h *Headless) dumpCmd(args []string) error {
+	format, file := dumpCSV, dumpStdout
+	if len(args) > 0 {
+		format = strings.ToLower(args[0])
+	}
+	if len(args) > 1 {
+		file = args[1]
+	}
+	if len(args) > 2 {
+		return errors.New("invalid command use `dump [csv|json] [file]`")
+	}
+	if format != dumpCSV && format != dumpJSON {
+		return fmt.Errorf("invalid dump format %q. Expecting one of %s|%s", format, dumpCSV, dumpJSON)
+	}
+	data, err := h.current()
+	if err != nil {
+		return err
+	}
+
+	w := h.out
+	if file != dumpStdout {
+		f, err := os.Create(file)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Error().Err(err).Msgf("Closing dump file %q", file)
+			}
+		}()
+		w = f
+	}
+	if format == dumpJSON {
+		return dumpTableJSON(w, data)
+	}
+
+	return dumpTableCSV(w, data)
+}
+
+func (h *Headless) pluginCmd(args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return errors.New("invalid command use `plugin name [path]`")
+	}
+	pp := config.NewPlugins()
+	path, err := h.Config.ContextPluginsPath()
+	if err != nil {
+		return err
+	}
+	if err := pp.Load(path); err != nil {
+		log.Warn().Err(err).Msg("Plugins load failed")
+	}
+	p, ok := pp.Plugins[args[0]]
+	if !ok {
+		return fmt.Errorf("no plugin named %q", args[0])
+	}
+	if h.Config.K9s.IsReadOnly() && !p.AllowedInReadOnly() {
+		return fmt.Errorf("plugin %q is not allowed in read-only mode", args[0])
+	}
+	if len(p.Pipes) > 0 {
+		return fmt.Errorf("piped plugin %q is not supported in scripts", args[0])
+	}
+	data, err := h.current()
+	if err != nil {
+		return err
+	}
+	meta, err := dao.MetaAccess.MetaFor(h.gvr)
+	if err != nil {
+		return err
+	}
+	if !inScope(p.Scopes, aliasesFor(meta, h.alias.AliasesFor(meta.Name))) {
+		return fmt.Errorf("plugin %q does not apply to %s", args[0], h.gvr)
+	}
+	// An explicit path may target rows hidden by the current filter.
+	if len(args) > 1 {
+		data = h.data
+	}
+	row, err := pickRow(data, args[1:])
+	if err != nil {
+		return err
+	}
+
+	env := defaultEnv(h.factory.Client().Config(), row.ID, data.Header(), &row)
+	env["FILTER"] = h.filter
+	env["RESOURCE_GROUP"], env["RESOURCE_VERSION"], env["RESOURCE_NAME"] = h.gvr.G(), h.gvr.V(), h.gvr.R()
+	aa := make([]string, len(p.Args))
+	for i, a := range p.Args {
+		if aa[i], err = env.Substitute(a); err != nil {
+			return err
+		}
+	}
+
+	c := exec.Command(p.Command, aa...)
+	c.Stdout, c.Stderr = h.out, h.errOut
+	if p.IsV2() {
+		pc := pluginContext{
+			APIVersion: config.PluginAPIv2,
+			Context:    h.Config.K9s.ActiveContextName(),
+			Namespace:  h.ns,
+			GVR:        h.gvr.String(),
+			ReadOnly:   h.Config.K9s.IsReadOnly(),
+			Rows:       []pluginRow{toPluginRow(row.ID, data.Header(), &row)},
+		}
+		if n, err := h.factory.Client().Config().CurrentClusterName(); err == nil {
+			pc.Cluster = n
+		}
+		bb, err := json.Marshal(pc)
+		if err != nil {
+			return err
+		}
+		c.Stdin = bytes.NewReader(bb)
+	}
+	log.Debug().Msgf("Running plugin %q: %s %s", args[0], p.Command, strings.Join(aa, " "))
+
+	return c.Run()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// readScript reads script commands, skipping blank lines and comments.
+// Commands may be prefixed with a colon as entered in the prompt.
+func readScript(r io.Reader) ([]scriptCmd, error) {
+	var (
+		cc []scriptCmd
+		s  = bufio.NewScanner(r)
+	)
+	for l := 1; s.Scan(); l++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, scriptComment) {
+			continue
+		}
+		text = strings.TrimSpace(strings.TrimPrefix(text, ":"))
+		if text == "" {
+			continue
+		}
+		cc = append(cc, scriptCmd{line: l, text: text})
+	}
+
+	return cc, s.Err()
+}
+
+// pickRow returns the row matching the given path or the only table row.
+func pickRow(data *model1.TableData, args []string) (model1.Row, error) {
+	if len(args) > 0 {
+		re, ok := data.FindRow(args[0])
+		if !ok {
+			return model1.Row{}, fmt.Errorf("no resource matching %q", args[0])
+		}
+		return re.Row, nil
+	}
+	if n := data.RowCount(); n != 1 {
+		return model1.Row{}, fmt.Errorf("expecting a single resource but got %d. Filter or specify a path", n)
+	}
+	re, _ := data.RowAt(0)
+
+	return re.Row, nil
+}
+
+func dumpTableCSV(w io.Writer, data *model1.TableData) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write(data.ColumnNames(true))
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		_ = cw.Write(re.Row.Fields)
+		return true
+	})
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func dumpTableJSON(w io.Writer, data *model1.TableData) error {
+	cols := data.ColumnNames(true)
+	rr := make([]map[string]string, 0, data.RowCount())
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		r := make(map[string]string, len(cols))
+		for i, c := range cols {
+			if i < len(re.Row.Fields) {
+				r[c] = re.Row.Fields[i]
+			}
+		}
+		rr = append(rr, r)
+		return true
+	})
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(rr)
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadScript(t *testing.T) {
+	s := `# Daily report
+ctx fred
+
+:pods kube-system
+/coredns
+  dump json /tmp/pods.json
+:
+`
+	cc, err := readScript(strings.NewReader(s))
+	require.NoError(t, err)
+	assert.Equal(t, []scriptCmd{
+		{line: 2, text: "ctx fred"},
+		{line: 4, text: "pods kube-system"},
+		{line: 5, text: "/coredns"},
+		{line: 6, text: "dump json /tmp/pods.json"},
+	}, cc)
+}
+
+func TestHeadlessCurrent(t *testing.T) {
+	h := NewHeadless(nil, nil, nil)
+	_, err := h.current()
+	assert.Error(t, err)
+
+	h.data = makeHeadlessData()
+	data, err := h.current()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fred/p1", "fred/p2", "zorg/p0"}, rowIDs(data))
+
+	require.NoError(t, h.filterCmd("p[12]"))
+	data, err = h.current()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fred/p1", "fred/p2"}, rowIDs(data))
+}
+
+func TestPickRow(t *testing.T) {
+	data := makeHeadlessData()
+
+	_, err := pickRow(data, nil)
+	assert.Error(t, err)
+	r, err := pickRow(data, []string{"zorg/p0"})
+	require.NoError(t, err)
+	assert.Equal(t, "zorg/p0", r.ID)
+	_, err = pickRow(data, []string{"zorg/p1"})
+	assert.Error(t, err)
+
+	r, err = pickRow(data.Filter(model1.FilterOpts{Filter: "p0"}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "zorg/p0", r.ID)
+}
+
+func TestDumpTable(t *testing.T) {
+	data := makeHeadlessData().Filter(model1.FilterOpts{Filter: "p0"})
+
+	var csv bytes.Buffer
+	require.NoError(t, dumpTableCSV(&csv, data))
+	assert.Equal(t, "NAMESPACE,NAME,STATUS\nzorg,p0,Running\n", csv.String())
+
+	var json bytes.Buffer
+	require.NoError(t, dumpTableJSON(&json, data))
+	assert.JSONEq(t, `[{"NAMESPACE":"zorg","NAME":"p0","STATUS":"Running"}]`, json.String())
+}
+
+// Helpers...
+
+func makeHeadlessData() *model1.TableData {
+	return model1.NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		model1.Header{
+			model1.HeaderColumn{Name: "NAMESPACE"},
+			model1.HeaderColumn{Name: "NAME"},
+			model1.HeaderColumn{Name: "STATUS"},
+		},
+		model1.NewRowEventsWithEvts(
+			model1.RowEvent{Row: model1.Row{ID: "zorg/p0", Fields: model1.Fields{"zorg", "p0", "Running"}}},
+			model1.RowEvent{Row: model1.Row{ID: "fred/p2", Fields: model1.Fields{"fred", "p2", "Pending"}}},
+			model1.RowEvent{Row: model1.Row{ID: "fred/p1", Fields: model1.Fields{"fred", "p1", "Running"}}},
+		),
+	)
+}
+
+func rowIDs(data *model1.TableData) []string {
+	ids := make([]string, 0, data.RowCount())
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		ids = append(ids, re.Row.ID)
+		return true
+	})
+
+	return ids
+}
@@ -4,10 +4,12 @@
 package view
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 )
@@ -39,6 +41,12 @@ func (c *HelmChart) chartContext(ctx context.Context) context.Context {
 
 func (c *HelmChart) bindKeys(aa *ui.KeyActions) {
 	aa.Delete(tcell.KeyCtrlS)
+	if !c.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyE, ui.NewKeyActionWithOpts("Edit Values", c.editValuesCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}))
+	}
 	aa.Bulk(ui.KeyMap{
 		ui.KeyR:      ui.NewKeyAction("Releases", c.historyCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(statusCol, true), false),
@@ -63,6 +71,57 @@ func (c *HelmChart) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (c *HelmChart) editValuesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	chart, ok := res.(*dao.HelmChart)
+	if !ok {
+		c.App().Flash().Errf("expecting a helm chart accessor but got %T", res)
+		return nil
+	}
+	vals, err := chart.GetValues(path, false)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	edited, err := editBuffer(c.App(), "k9s-values-*.yaml", vals)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if edited == nil || bytes.Equal(edited, vals) {
+		c.App().Flash().Info("No values changes detected")
+		return nil
+	}
+
+	c.App().Flash().Infof("Computing upgrade preview for release %s...", path)
+	go func() {
+		diff, err := chart.UpgradeDiff(context.Background(), path, edited)
+		c.App().QueueUpdateDraw(func() {
+			if err != nil {
+				c.App().Flash().Errf("Upgrade dry-run failed: %s", err)
+				return
+			}
+			if diff == "" {
+				c.App().Flash().Info("Upgrade yields no changes")
+				return
+			}
+			if err := c.App().inject(NewHelmUpgrade(c.App(), chart, path, edited, diff), false); err != nil {
+				c.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
 func (c *HelmChart) helmContext(ctx context.Context) context.Context {
 	path := c.GetTable().GetSelectedItem()
 	if path == "" {
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const helmUpgradeTitle = "Upgrade Preview"
+
+// HelmUpgrade previews a release upgrade diff prior to applying it.
+type HelmUpgrade struct {
+	*Details
+
+	chart  *dao.HelmChart
+	path   string
+	values []byte
+}
+
+// NewHelmUpgrade returns a new release upgrade preview.
+func NewHelmUpgrade(app *App, chart *dao.HelmChart, path string, values []byte, diff string) *HelmUpgrade {
+	u := HelmUpgrade{
+		Details: NewDetails(app, helmUpgradeTitle, path, contentTXT, true),
+		chart:   chart,
+		path:    path,
+		values:  values,
+	}
+	u.Update(tview.Escape(diff))
+
+	return &u
+}
+
+// Init initializes the viewer.
+func (u *HelmUpgrade) Init(ctx context.Context) error {
+	if err := u.Details.Init(ctx); err != nil {
+		return err
+	}
+	u.Actions().Add(ui.KeyA, ui.NewKeyActionWithOpts("Apply", u.applyCmd, ui.ActionOpts{
+		Visible:   true,
+		Dangerous: true,
+	}))
+
+	return nil
+}
+
+func (u *HelmUpgrade) applyCmd(*tcell.EventKey) *tcell.EventKey {
+	msg := fmt.Sprintf("Upgrade release %s with the new values?", u.path)
+	dialog.ShowConfirm(u.app.Styles.Dialog(), u.app.Content.Pages, "Confirm Upgrade", msg, u.upgrade, func() {})
+
+	return nil
+}
+
+func (u *HelmUpgrade) upgrade() {
+	u.app.Flash().Infof("Upgrading release %s...", u.path)
+	go func() {
+		err := u.chart.Upgrade(context.Background(), u.path, u.values)
+		u.app.QueueUpdateDraw(func() {
+			if err != nil {
+				u.app.Flash().Errf("Upgrade failed for release %s: %s", u.path, err)
+				return
+			}
+			u.app.Flash().Infof("Release %s upgraded successfully", u.path)
+			u.app.Content.Pop()
+		})
+	}()
+}
@@ -19,8 +19,9 @@ import (
 )
 
 const (
-	helpTitle    = "Help"
-	helpTitleFmt = " [aqua::b]%s "
+	helpTitle       = "Help"
+	helpTitleFmt    = " [aqua::b]%s "
+	helpDocTitleFmt = " [aqua::b]%s([fuchsia::b]%s[aqua::-]) [gray::-]%s "
 )
 
 // HelpFunc processes menu hints.
@@ -32,15 +33,21 @@ type Help struct {
 
 	styles                   *config.Styles
 	hints                    HelpFunc
+	view                     string
+	doc                      ViewDoc
 	maxKey, maxDesc, maxRows int
 }
 
 // NewHelp returns a new help viewer.
 func NewHelp(app *App) *Help {
-	return &Help{
+	h := Help{
 		Table: NewTable(client.NewGVR("help")),
 		hints: app.Content.Top().Hints,
+		view:  viewKey(app.Content.Top()),
 	}
+	h.doc, _ = ViewDocFor(h.view)
+
+	return &h
 }
 
 func (h *Help) SetFilter(string)                 {}
@@ -135,6 +142,16 @@ func (h *Help) build() {
 	if hh, err := h.showHotKeys(); err == nil {
 		h.computeMaxes(hh)
 		h.addSection(col, "HOTKEYS", hh)
+		col += 2
+	}
+	if len(h.doc.Columns) > 0 {
+		h.computeMaxes(h.doc.Columns)
+		h.addDocSection(col, "COLUMNS", h.doc.Columns)
+		col += 2
+	}
+	if len(h.doc.Related) > 0 {
+		h.computeMaxes(h.doc.Related)
+		h.addDocSection(col, "RELATED", h.doc.Related)
 	}
 }
 
@@ -280,7 +297,11 @@ func (h *Help) showGeneral() model.MenuHints {
 }
 
 func (h *Help) resetTitle() {
-	h.SetTitle(fmt.Sprintf(helpTitleFmt, helpTitle))
+	if h.doc.Summary == "" {
+		h.SetTitle(fmt.Sprintf(helpTitleFmt, helpTitle))
+		return
+	}
+	h.SetTitle(fmt.Sprintf(helpDocTitleFmt, helpTitle, h.view, h.doc.Summary))
 }
 
 func (h *Help) addSpacer(c int) {
@@ -290,6 +311,15 @@ func (h *Help) addSpacer(c int) {
 }
 
 func (h *Help) addSection(c int, title string, hh model.MenuHints) {
+	h.addSectionWith(c, title, hh, ui.ToMnemonic)
+}
+
+// addDocSection adds a view doc section whose keys are shown verbatim.
+func (h *Help) addDocSection(c int, title string, hh model.MenuHints) {
+	h.addSectionWith(c, title, hh, func(s string) string { return s })
+}
+
+func (h *Help) addSectionWith(c int, title string, hh model.MenuHints, keyFn func(string) string) {
 	if len(hh) > h.maxRows {
 		h.maxRows = len(hh)
 	}
@@ -300,7 +330,7 @@ func (h *Help) addSection(c int, title string, hh model.MenuHints) {
 
 	for _, hint := range hh {
 		col := c
-		h.SetCell(row, col, padCellWithRef(ui.ToMnemonic(hint.Mnemonic), h.maxKey, hint.Mnemonic))
+		h.SetCell(row, col, padCellWithRef(keyFn(hint.Mnemonic), h.maxKey, hint.Mnemonic))
 		col++
 		h.SetCell(row, col, padCell(hint.Description, h.maxDesc))
 		row++
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"sync"
+
+	"github.com/derailed/k9s/internal/model"
+)
+
+// ViewDoc documents a view in the help pane.
+type ViewDoc struct {
+	// Summary describes what the view shows.
+	Summary string
+
+	// Columns explains the view columns.
+	Columns model.MenuHints
+
+	// Related lists commands related to the view.
+	Related model.MenuHints
+}
+
+var viewDocs = struct {
+	docs map[string]ViewDoc
+	mx   sync.RWMutex
+}{
+	docs: make(map[string]ViewDoc),
+}
+
+// RegisterViewDoc registers the documentation of a view keyed by its
+// resource or component name.
+func RegisterViewDoc(view string, d ViewDoc) {
+	viewDocs.mx.Lock()
+	defer viewDocs.mx.Unlock()
+
+	viewDocs.docs[view] = d
+}
+
+// ViewDocFor returns the documentation of a view if any.
+func ViewDocFor(view string) (ViewDoc, bool) {
+	viewDocs.mx.RLock()
+	defer viewDocs.mx.RUnlock()
+
+	d, ok := viewDocs.docs[view]
+
+	return d, ok
+}
@@ -24,8 +24,28 @@ func TestHelp(t *testing.T) {
 	v := view.NewHelp(app)
 
 	assert.Nil(t, v.Init(ctx))
-	assert.Equal(t, 28, v.GetRowCount())
-	assert.Equal(t, 8, v.GetColumnCount())
+	assert.Equal(t, 33, v.GetRowCount())
+	assert.Equal(t, 12, v.GetColumnCount())
 	assert.Equal(t, "<a>", strings.TrimSpace(v.GetCell(1, 0).Text))
 	assert.Equal(t, "Attach", strings.TrimSpace(v.GetCell(1, 1).Text))
+	assert.Equal(t, "COLUMNS", v.GetCell(0, 8).Text)
+	assert.Equal(t, "PF", strings.TrimSpace(v.GetCell(1, 8).Text))
+	assert.Equal(t, "RELATED", v.GetCell(0, 10).Text)
+	assert.Equal(t, ":containers", strings.TrimSpace(v.GetCell(1, 10).Text))
+	assert.Contains(t, v.GetTitle(), "Pods running in the active namespace")
+}
+
+func TestHelpNoDoc(t *testing.T) {
+	ctx := makeCtx()
+
+	app := ctx.Value(internal.KeyApp).(*view.App)
+	v := view.NewBrowser(client.NewGVR("v1/configmaps"))
+	assert.NoError(t, v.Init(ctx))
+	app.Content.Push(v)
+
+	h := view.NewHelp(app)
+
+	assert.Nil(t, h.Init(ctx))
+	assert.Equal(t, 8, h.GetColumnCount())
+	assert.Equal(t, " [aqua::b]Help ", h.GetTitle())
 }
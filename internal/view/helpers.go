@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -22,11 +23,37 @@ import (
 	"github.com/derailed/k9s/internal/view/cmd"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 	"github.com/sahilm/fuzzy"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
+// editBuffer edits a buffer in an external editor via a temp file. It returns
+// nil when the edit was aborted.
+func editBuffer(app *App, pattern string, raw []byte) ([]byte, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.Remove(f.Name()); err != nil {
+			log.Error().Err(err).Msgf("Unable to remove temp file %s", f.Name())
+		}
+	}()
+	if _, err := f.Write(raw); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	if !edit(app, shellOpts{clear: true, args: []string{f.Name()}}) {
+		return nil, nil
+	}
+
+	return os.ReadFile(f.Name())
+}
+
 func aliasesFor(m v1.APIResource, aa []string) map[string]struct{} {
 	rr := make(map[string]struct{})
 	rr[m.Name] = struct{}{}
@@ -92,7 +119,7 @@ func k8sEnv(c *client.Config) Env {
 		groups = []string{render.NAValue}
 	}
 
-	var cfg string
+	cfg := os.Getenv(clientcmd.RecommendedConfigPathEnvVar)
 	kcfg := c.Flags().KubeConfig
 	if kcfg != nil && *kcfg != "" {
 		cfg = *kcfg
@@ -209,7 +236,7 @@ func urlFor(cfg config.BenchConfig, port string) string {
 		path = cfg.HTTP.Path
 	}
 
-	return "http://" + host + ":" + port + path
+	return cfg.HTTP.URLScheme() + "://" + host + ":" + port + path
 }
 
 func fqn(ns, n string) string {
@@ -260,7 +287,45 @@ func matchTag(i int, s string) string {
 	return `<<<"search_` + strconv.Itoa(i) + `">>>` + s + `<<<"">>>`
 }
 
-func linesWithRegions(lines []string, matches fuzzy.Matches) []string {
+// markTag paints a match background so it stands out while not selected.
+func markTag(s string) string {
+	return `<<<:` + searchMarkColor + `>>>` + s + `<<<:->>>`
+}
+
+// matchingLines returns the lines containing matches.
+func matchingLines(lines []string, matches fuzzy.Matches) []string {
+	ll := make([]string, 0, len(matches))
+	last := -1
+	for _, m := range matches {
+		if m.Index == last || m.Index >= len(lines) {
+			continue
+		}
+		last = m.Index
+		ll = append(ll, lines[m.Index])
+	}
+
+	return ll
+}
+
+// copyMatchesCmd copies the lines matching the current search to the clipboard.
+func copyMatchesCmd(flash *model.Flash, lines func() []string) func(*tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		ll := lines()
+		if len(ll) == 0 {
+			flash.Warn("No matching lines to copy")
+			return nil
+		}
+		if err := clipboardWrite(strings.Join(ll, "\n")); err != nil {
+			flash.Err(err)
+			return evt
+		}
+		flash.Infof("%d matching lines copied to clipboard...", len(ll))
+
+		return nil
+	}
+}
+
+func linesWithRegions(lines []string, matches fuzzy.Matches, markAll bool) []string {
 	ll := make([]string, len(lines))
 	copy(ll, lines)
 	offsetForLine := make(map[int]int)
@@ -271,7 +336,11 @@ func linesWithRegions(lines []string, matches fuzzy.Matches) []string {
 			if end > len(line) {
 				end = len(line)
 			}
-			regionStr := matchTag(i, line[start:end])
+			match := line[start:end]
+			if markAll {
+				match = markTag(match)
+			}
+			regionStr := matchTag(i, match)
 			ll[m.Index] = line[:start] + regionStr + line[end:]
 			offsetForLine[m.Index] += len(regionStr) - (end - start)
 		}
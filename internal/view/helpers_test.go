@@ -236,6 +236,17 @@ func TestUrlFor(t *testing.T) {
 			"9000",
 			"http://zorg:9000/fred/blee",
 		},
+		"https": {
+			config.BenchConfig{
+				HTTP: config.HTTP{
+					Scheme: "https",
+					Path:   "/fred",
+				},
+			},
+			"c1",
+			"9000",
+			"https://localhost:9000/fred",
+		},
 	}
 
 	for k := range uu {
@@ -246,6 +257,27 @@ func TestUrlFor(t *testing.T) {
 	}
 }
 
+func TestPfLocalPort(t *testing.T) {
+	uu := map[string]struct {
+		path, e string
+		err     bool
+	}{
+		"pod":     {path: "default/nginx-6b866d578b-c6tcn|nginx|8080:80", e: "8080"},
+		"dots":    {path: "default/web.v1|web|9090:http", e: "9090"},
+		"service": {path: "default/nginx|svc|nginx|7070:80", e: "7070"},
+		"toast":   {path: "default/nginx", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			port, err := pfLocalPort(u.path)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, port)
+		})
+	}
+}
+
 func TestContainerID(t *testing.T) {
 	uu := map[string]struct {
 		path, co string
@@ -274,6 +306,7 @@ func Test_linesWithRegions(t *testing.T) {
 	uu := map[string]struct {
 		lines   []string
 		matches fuzzy.Matches
+		markAll bool
 		e       []string
 	}{
 		"empty-lines": {
@@ -317,13 +350,51 @@ func Test_linesWithRegions(t *testing.T) {
 				"dfbar" + matchTag(2, "foo") + "s bar",
 			},
 		},
+		"mark-all": {
+			lines: []string{"foo", "bar", "baz"},
+			matches: fuzzy.Matches{
+				{Index: 1, MatchedIndexes: []int{0, 1, 2}},
+				{Index: 2, MatchedIndexes: []int{1}},
+			},
+			markAll: true,
+			e:       []string{"foo", matchTag(0, markTag("bar")), "b" + matchTag(1, markTag("a")) + "z"},
+		},
 	}
 
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
 			t.Parallel()
-			assert.Equal(t, u.e, linesWithRegions(u.lines, u.matches))
+			assert.Equal(t, u.e, linesWithRegions(u.lines, u.matches, u.markAll))
+		})
+	}
+}
+
+func Test_matchingLines(t *testing.T) {
+	uu := map[string]struct {
+		lines   []string
+		matches fuzzy.Matches
+		e       []string
+	}{
+		"none": {
+			lines: []string{"foo", "bar"},
+			e:     []string{},
+		},
+		"dedup": {
+			lines: []string{"foosfoo baz", "bar", "dfoo"},
+			matches: fuzzy.Matches{
+				{Index: 0, MatchedIndexes: []int{0, 1, 2}},
+				{Index: 0, MatchedIndexes: []int{4, 5, 6}},
+				{Index: 2, MatchedIndexes: []int{1, 2, 3}},
+			},
+			e: []string{"foosfoo baz", "dfoo"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, matchingLines(u.lines, u.matches))
 		})
 	}
 }
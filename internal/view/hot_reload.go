@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// hotReloadDelay debounces bursts of file events emitted while saving.
+const hotReloadDelay = 250 * time.Millisecond
+
+type reloadKind int
+
+const (
+	reloadAliases reloadKind = 1 << iota
+	reloadKeys
+)
+
+// hotReloadWatcher reloads aliases, hotkeys and plugins as their files change.
+func (a *App) hotReloadWatcher(ctx context.Context) error {
+	files := a.hotReloadFiles()
+	ff := make([]string, 0, len(files))
+	for f := range files {
+		ff = append(ff, f)
+	}
+
+	return ui.WatchFiles(ctx, ff, hotReloadDelay, func(changed []string) {
+		var kinds reloadKind
+		for _, f := range changed {
+			kinds |= files[f]
+		}
+		a.QueueUpdateDraw(func() {
+			a.hotReload(kinds)
+		})
+	})
+}
+
+// hotReloadFiles returns the global and context specific files to watch.
+func (a *App) hotReloadFiles() map[string]reloadKind {
+	files := map[string]reloadKind{
+		config.AppAliasesFile:         reloadAliases,
+		a.Config.ContextAliasesPath(): reloadAliases,
+		config.AppHotKeysFile:         reloadKeys,
+		a.Config.ContextHotkeysPath(): reloadKeys,
+		config.AppPluginsFile:         reloadKeys,
+	}
+	if p, err := a.Config.ContextPluginsPath(); err == nil {
+		files[p] = reloadKeys
+	}
+	delete(files, "")
+	clean := make(map[string]reloadKind, len(files))
+	for f, k := range files {
+		clean[filepath.Clean(f)] |= k
+	}
+
+	return clean
+}
+
+func (a *App) hotReload(kinds reloadKind) {
+	if kinds&reloadAliases != 0 {
+		if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
+			log.Warn().Err(err).Msg("Aliases reload failed")
+			a.Flash().Warn("Aliases reload failed. Check k9s logs!")
+			return
+		}
+		log.Debug().Msg("Aliases reloaded")
+		a.Flash().Info("Aliases reloaded")
+	}
+	if kinds&reloadKeys != 0 {
+		if v, ok := a.Content.Top().(ResourceViewer); ok {
+			v.RefreshActions()
+		}
+		log.Debug().Msg("Hotkeys and plugins reloaded")
+		a.Flash().Info("Hotkeys and plugins reloaded")
+	}
+}
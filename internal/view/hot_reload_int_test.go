@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHotReloadFiles(t *testing.T) {
+	dir := t.TempDir()
+	aa, hh, pp := config.AppAliasesFile, config.AppHotKeysFile, config.AppPluginsFile
+	defer func() {
+		config.AppAliasesFile, config.AppHotKeysFile, config.AppPluginsFile = aa, hh, pp
+	}()
+	config.AppAliasesFile = filepath.Join(dir, "aliases.yaml")
+	config.AppHotKeysFile = filepath.Join(dir, "hotkeys.yaml")
+	config.AppPluginsFile = filepath.Join(dir, "..", filepath.Base(dir), "plugins.yaml")
+
+	ff := NewApp(mock.NewMockConfig()).hotReloadFiles()
+
+	assert.Equal(t, reloadAliases, ff[filepath.Join(dir, "aliases.yaml")])
+	assert.Equal(t, reloadKeys, ff[filepath.Join(dir, "hotkeys.yaml")])
+	assert.Equal(t, reloadKeys, ff[filepath.Join(dir, "plugins.yaml")])
+	_, ok := ff[""]
+	assert.False(t, ok)
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const imagesTitle = "Images"
+
+// Images represents a view of the images in use.
+type Images struct {
+	ResourceViewer
+}
+
+// NewImages returns a new images view.
+func NewImages(gvr client.GVR) ResourceViewer {
+	i := Images{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.GetTable().SetSortCol("IMAGE", true)
+	i.AddBindKeysFn(i.bindKeys)
+	i.GetTable().SetEnterFn(i.showConsumers)
+
+	return &i
+}
+
+// Name returns the component name.
+func (*Images) Name() string { return imagesTitle }
+
+func (i *Images) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	if !i.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyR, ui.NewKeyActionWithOpts("Restart Stale", i.restartCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		))
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Drift", i.GetTable().SortColCmd("DRIFT", false), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Pods", i.GetTable().SortColCmd("PODS", false), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Stale", i.GetTable().SortColCmd("STALE", false), false),
+	})
+}
+
+// showConsumers lists the workloads and pods running the selected image.
+func (i *Images) showConsumers(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	img, err := i.image(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, "Consumers", path, contentYAML, true).Update(imageConsumers(img))
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// restartCmd restarts the workloads running an outdated digest of the selected image.
+func (i *Images) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := i.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	img, err := i.image(path)
+	if err != nil {
+		i.App().Flash().Err(err)
+		return nil
+	}
+	ww := img.StaleWorkloads()
+	if len(ww) == 0 {
+		i.App().Flash().Infof("No stale workloads found for image %s", path)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Restart %s %s?", client.NewGVR(ww[0].GVR).R(), ww[0].FQN)
+	if len(ww) > 1 {
+		msg = fmt.Sprintf("Restart %d stale workloads?", len(ww))
+	}
+	dialog.ShowConfirm(i.App().Styles.Dialog(), i.App().Content.Pages, "Confirm Restart", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), i.App().Conn().Config().CallTimeout())
+		defer cancel()
+		for _, w := range ww {
+			if err := i.restart(ctx, w); err != nil {
+				i.App().Flash().Err(err)
+				return
+			}
+		}
+		i.App().Flash().Infof("Restart in progress for %d stale workload(s)", len(ww))
+	}, func() {})
+
+	return nil
+}
+
+func (i *Images) restart(ctx context.Context, w render.ImageConsumer) error {
+	res, err := dao.AccessorFor(i.App().factory, client.NewGVR(w.GVR))
+	if err != nil {
+		return err
+	}
+	r, ok := res.(dao.Restartable)
+	if !ok {
+		return errors.New("resource is not restartable")
+	}
+
+	return r.Restart(ctx, w.FQN)
+}
+
+func (i *Images) image(path string) (render.ImageRes, error) {
+	var acc dao.Image
+	acc.Init(i.App().factory, i.GVR())
+	ctx := context.WithValue(context.Background(), internal.KeyNamespace, client.CleanseNamespace(i.App().Config.ActiveNamespace()))
+	o, err := acc.Get(ctx, path)
+	if err != nil {
+		return render.ImageRes{}, err
+	}
+	img, ok := o.(render.ImageRes)
+	if !ok {
+		return render.ImageRes{}, fmt.Errorf("expecting ImageRes but got %T", o)
+	}
+
+	return img, nil
+}
+
+func imageConsumers(img render.ImageRes) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "image: %s\n", img.Image)
+	if img.Drifted() {
+		fmt.Fprintf(&b, "currentDigest: %s\n", img.CurrentDigest())
+	}
+	stale := img.StaleWorkloads()
+	b.WriteString("workloads:\n")
+	seen := make(map[render.ImageConsumer]struct{}, len(img.Workloads))
+	for _, w := range img.Workloads {
+		w.Digest = ""
+		if _, ok := seen[w]; ok {
+			continue
+		}
+		seen[w] = struct{}{}
+		fmt.Fprintf(&b, "  - %s %s", client.NewGVR(w.GVR).R(), w.FQN)
+		if slices.Contains(stale, w) {
+			b.WriteString(" (stale)")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("pods:\n")
+	for _, p := range img.Pods {
+		fmt.Fprintf(&b, "  - %s %s\n", p.FQN, p.Digest)
+	}
+
+	return b.String()
+}
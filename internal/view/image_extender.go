@@ -9,10 +9,10 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -114,7 +114,7 @@ func (s *ImageExtender) makeSetImageForm(sel string) (*tview.Form, error) {
 		})
 	}
 
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
 		defer s.dismissDialog()
 		var imageSpecsModified dao.ImageSpecs
 		for _, v := range formContainerLines {
@@ -131,7 +131,7 @@ func (s *ImageExtender) makeSetImageForm(sel string) (*tview.Form, error) {
 		}
 		s.App().Flash().Infof("Resource %s:%s image updated successfully", s.GVR(), sel)
 	})
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		s.dismissDialog()
 	})
 
@@ -9,8 +9,10 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/vul"
 	"github.com/derailed/tcell/v2"
 )
 
@@ -22,9 +24,14 @@ const (
 	ghsaURL      = "https://github.com/advisories/"
 )
 
+// sevFilters tracks the severity filters cycled through by the scans view.
+var sevFilters = []string{"", vul.Sev1, vul.Sev2, vul.Sev3, vul.Sev4}
+
 // ImageScan represents an image vulnerability scan view.
 type ImageScan struct {
 	ResourceViewer
+
+	sevFilter int
 }
 
 // NewImageScan returns a new scans view.
@@ -34,6 +41,7 @@ func NewImageScan(gvr client.GVR) ResourceViewer {
 	v.AddBindKeysFn(v.bindKeys)
 	v.GetTable().SetEnterFn(v.viewCVE)
 	v.GetTable().SetSortCol("SEVERITY", true)
+	v.GetTable().SetDecorateFn(v.filterSeverity)
 
 	return &v
 }
@@ -45,6 +53,7 @@ func (c *ImageScan) bindKeys(aa *ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlZ, tcell.KeyCtrlW)
 
 	aa.Bulk(ui.KeyMap{
+		ui.KeyF:      ui.NewKeyAction("Filter Severity", c.severityCmd, true),
 		ui.KeyShiftL: ui.NewKeyAction("Sort Lib", c.GetTable().SortColCmd("LIBRARY", false), true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Severity", c.GetTable().SortColCmd("SEVERITY", false), true),
 		ui.KeyShiftF: ui.NewKeyAction("Sort Fixed-in", c.GetTable().SortColCmd("FIXED-IN", false), true),
@@ -52,6 +61,39 @@ func (c *ImageScan) bindKeys(aa *ui.KeyActions) {
 	})
 }
 
+// severityCmd cycles the minimum severity of the listed vulnerabilities.
+func (c *ImageScan) severityCmd(evt *tcell.EventKey) *tcell.EventKey {
+	c.sevFilter = (c.sevFilter + 1) % len(sevFilters)
+	if sev := sevFilters[c.sevFilter]; sev == "" {
+		c.App().Flash().Info("Showing all vulnerabilities")
+	} else {
+		c.App().Flash().Infof("Showing %s vulnerabilities and up", sev)
+	}
+	c.GetTable().Refresh()
+
+	return nil
+}
+
+// filterSeverity drops vulnerabilities below the current severity filter.
+func (c *ImageScan) filterSeverity(data *model1.TableData) {
+	sev := sevFilters[c.sevFilter]
+	if sev == "" {
+		return
+	}
+	idx, ok := data.IndexOfHeader("SEVERITY")
+	if !ok {
+		return
+	}
+	rr := model1.NewRowEvents(data.RowCount())
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		if s := re.Row.Fields[idx]; s <= sev {
+			rr.Add(re)
+		}
+		return true
+	})
+	data.SetRowEvents(rr)
+}
+
 func (s *ImageScan) viewCVE(app *App, _ ui.Tabular, _ client.GVR, path string) {
 	bin := browseLinux
 	if runtime.GOOS == "darwin" {
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/vul"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageScanFilterSeverity(t *testing.T) {
+	uu := map[string]struct {
+		filter int
+		e      []string
+	}{
+		"all": {
+			e: []string{"i|1", "i|2", "i|3", "i|u"},
+		},
+		"critical": {
+			filter: 1,
+			e:      []string{"i|1"},
+		},
+		"medium": {
+			filter: 3,
+			e:      []string{"i|1", "i|2", "i|3"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			data := model1.NewTableDataWithRows(
+				client.NewGVR("scans"),
+				model1.Header{
+					model1.HeaderColumn{Name: "SEVERITY"},
+					model1.HeaderColumn{Name: "VULNERABILITY"},
+				},
+				model1.NewRowEventsWithEvts(
+					model1.RowEvent{Row: model1.Row{ID: "i|1", Fields: model1.Fields{vul.Sev1, "CVE-1"}}},
+					model1.RowEvent{Row: model1.Row{ID: "i|2", Fields: model1.Fields{vul.Sev2, "CVE-2"}}},
+					model1.RowEvent{Row: model1.Row{ID: "i|3", Fields: model1.Fields{vul.Sev3, "CVE-3"}}},
+					model1.RowEvent{Row: model1.Row{ID: "i|u", Fields: model1.Fields{vul.SevU, "CVE-4"}}},
+				),
+			)
+			v := ImageScan{sevFilter: u.filter}
+			v.filterSeverity(data)
+
+			ids := make([]string, 0, data.RowCount())
+			data.RowsRange(func(_ int, re model1.RowEvent) bool {
+				ids = append(ids, re.Row.ID)
+				return true
+			})
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Ingress represents an ingress viewer.
+type Ingress struct {
+	ResourceViewer
+}
+
+// NewIngress returns a new viewer.
+func NewIngress(gvr client.GVR) ResourceViewer {
+	i := Ingress{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.GetTable().SetEnterFn(i.showRoutes)
+
+	return &i
+}
+
+func (i *Ingress) showRoutes(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	v := NewIngressRoute(client.NewGVR("ingroutes"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// IngressRoute represents an ingress routes explorer.
+type IngressRoute struct {
+	ResourceViewer
+}
+
+// NewIngressRoute returns a new ingress routes view.
+func NewIngressRoute(gvr client.GVR) ResourceViewer {
+	i := IngressRoute{
+		ResourceViewer: NewPortForwardExtender(NewBrowser(gvr)),
+	}
+	i.AddBindKeysFn(i.bindKeys)
+
+	return &i
+}
+
+func (i *IngressRoute) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyEnter: ui.NewKeyAction("Backend Health", i.showPodsCmd, true),
+		ui.KeyShiftH:   ui.NewKeyAction("Sort Host", i.GetTable().SortColCmd("HOST", true), false),
+		ui.KeyShiftE:   ui.NewKeyAction("Sort Endpoints", i.GetTable().SortColCmd("ENDPOINTS", true), false),
+		ui.KeyShiftD:   ui.NewKeyAction("Sort Days Left", i.GetTable().SortColCmd("DAYS-LEFT", false), false),
+	})
+}
+
+func (i *IngressRoute) showPodsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := i.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	svc, _, _ := strings.Cut(path, "@")
+	if svc == "" {
+		i.App().Flash().Warn("No service backend for this route")
+		return nil
+	}
+	v := NewServiceHealth(client.NewGVR("svchealth"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, svc)
+	})
+	if err := i.App().inject(v, false); err != nil {
+		i.App().Flash().Err(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog"
+	"sigs.k8s.io/yaml"
+)
+
+const k9sLogsTarget = "k9s"
+
+// k9sLogDoc documents the k9s logs view.
+var k9sLogDoc = ViewDoc{
+	Summary: "K9s own recent log entries, refreshed live",
+	Columns: model.MenuHints{
+		{Mnemonic: "TIME", Description: "When the entry was logged"},
+		{Mnemonic: "LEVEL", Description: "Entry severity"},
+		{Mnemonic: "MESSAGE", Description: "Log message"},
+		{Mnemonic: "FIELDS", Description: "Structured entry fields ie errors"},
+	},
+}
+
+// k9sLogLevels tracks the level filters bound to number keys.
+var k9sLogLevels = []zerolog.Level{
+	zerolog.TraceLevel,
+	zerolog.DebugLevel,
+	zerolog.InfoLevel,
+	zerolog.WarnLevel,
+	zerolog.ErrorLevel,
+}
+
+// K9sLog presents k9s own logs.
+type K9sLog struct {
+	ResourceViewer
+
+	level atomic.Int32
+}
+
+// NewK9sLog returns a new viewer.
+func NewK9sLog(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), k9sLogDoc)
+	l := K9sLog{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	l.level.Store(int32(zerolog.TraceLevel))
+	l.GetTable().SetSortCol("TIME", false)
+	l.GetTable().SetEnterFn(l.viewEntry)
+	l.SetContextFn(l.levelContext)
+	l.AddBindKeysFn(l.bindKeys)
+
+	return &l
+}
+
+func (l *K9sLog) levelContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyLogLevel, zerolog.Level(l.level.Load()))
+}
+
+func (l *K9sLog) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlD, tcell.KeyCtrlZ)
+	for i, lvl := range k9sLogLevels {
+		name := strings.ToLower(lvl.String())
+		if lvl == zerolog.TraceLevel {
+			name = "all"
+		}
+		aa.Add(ui.NumKeys[i], ui.NewKeyAction(name, l.levelCmd(lvl), true))
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftL: ui.NewKeyAction("Sort Level", l.GetTable().SortColCmd("LEVEL", true), false),
+	})
+}
+
+func (l *K9sLog) levelCmd(lvl zerolog.Level) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		l.level.Store(int32(lvl))
+		l.App().Flash().Infof("Showing %s logs", l.levelName())
+		l.Start()
+
+		return nil
+	}
+}
+
+func (l *K9sLog) levelName() string {
+	lvl := zerolog.Level(l.level.Load())
+	if lvl == zerolog.TraceLevel {
+		return "all"
+	}
+
+	return lvl.String() + "+"
+}
+
+func (l *K9sLog) viewEntry(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	var acc dao.K9sLog
+	acc.Init(app.factory, l.GVR())
+	o, err := acc.Get(context.Background(), path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	raw, err := k9sLogEntryYAML(o)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, "Log", path, contentYAML, true).Update(raw)
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func k9sLogEntryYAML(o interface{}) (string, error) {
+	res, ok := o.(render.K9sLogRes)
+	if !ok {
+		return "", fmt.Errorf("expecting a log entry but got %T", o)
+	}
+	e := res.Entry
+	raw, err := yaml.Marshal(map[string]interface{}{
+		"time":    e.Time,
+		"level":   e.Level.String(),
+		"message": e.Message,
+		"fields":  e.Fields,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
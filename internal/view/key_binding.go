@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// keyBinder represents a view with key bindings.
+type keyBinder interface {
+	Name() string
+	Actions() *ui.KeyActions
+}
+
+// KeyBindings presents the effective key bindings of a view.
+type KeyBindings struct {
+	ResourceViewer
+
+	subject string
+	actions *ui.KeyActions
+	aliases map[string]struct{}
+}
+
+// NewKeyBindings returns a new key bindings view.
+func NewKeyBindings(gvr client.GVR) ResourceViewer {
+	k := KeyBindings{
+		ResourceViewer: NewBrowser(gvr),
+		actions:        ui.NewKeyActions(),
+	}
+	k.GetTable().SetEnterFn(func(*App, ui.Tabular, client.GVR, string) {})
+	k.SetContextFn(k.bindingsContext)
+
+	return &k
+}
+
+// Init initializes the view.
+func (k *KeyBindings) Init(ctx context.Context) error {
+	app, err := extractApp(ctx)
+	if err != nil {
+		return err
+	}
+	if b, ok := app.Content.Top().(keyBinder); ok {
+		k.subject, k.actions = b.Name(), b.Actions()
+	}
+	if r, ok := app.Content.Top().(Runner); ok {
+		k.aliases = r.Aliases()
+	}
+	if err := k.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	k.GetTable().Extras = k.subject
+
+	return nil
+}
+
+func (k *KeyBindings) bindingsContext(ctx context.Context) context.Context {
+	km := config.NewKeyMaps()
+	if err := km.Load(config.AppKeyMapFile); err != nil {
+		k.App().Flash().Err(err)
+	}
+
+	return context.WithValue(ctx, internal.KeyBindings, keyBindingsFor(k.actions, km.BindingsFor(k.aliases)))
+}
+
+// keyBindingsFor lists the effective bindings along with keymap entries
+// that could not be applied.
+func keyBindingsFor(aa *ui.KeyActions, kb config.KeyBindings) []render.KeyBindingRes {
+	rr := make([]render.KeyBindingRes, 0, aa.Len())
+	aa.Range(func(k tcell.Key, a ui.KeyAction) {
+		rr = append(rr, render.KeyBindingRes{
+			Key:     tcell.KeyNames[k],
+			Action:  a.Description,
+			Source:  actionSource(a.Opts),
+			Visible: a.Opts.Visible,
+		})
+	})
+
+	for action, shortCut := range kb {
+		key, err := asKey(shortCut)
+		if err != nil {
+			rr = append(rr, render.KeyBindingRes{Key: shortCut, Action: action, Source: render.KeyBindingKeymap, Err: err.Error()})
+			continue
+		}
+		a, ok := aa.Get(key)
+		if ok && strings.EqualFold(a.Description, action) {
+			continue
+		}
+		msg := fmt.Sprintf("no action found for %q", action)
+		if ok {
+			msg = fmt.Sprintf("conflicts with action %q", a.Description)
+		}
+		rr = append(rr, render.KeyBindingRes{Key: shortCut, Action: action, Source: render.KeyBindingKeymap, Err: msg})
+	}
+
+	return rr
+}
+
+func actionSource(opts ui.ActionOpts) string {
+	switch {
+	case opts.Remapped:
+		return render.KeyBindingKeymap
+	case opts.Plugin:
+		return "plugin"
+	case opts.HotKey:
+		return "hotkey"
+	default:
+		return "k9s"
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyBindingsFor(t *testing.T) {
+	aa := ui.NewKeyActionsFromMap(ui.KeyMap{
+		ui.KeyD: ui.NewKeyAction("Describe", nil, true),
+		ui.KeyL: ui.NewKeyAction("Logs", nil, true),
+		ui.KeyZ: ui.NewKeyActionWithOpts("Zorg", nil, ui.ActionOpts{Plugin: true}),
+	})
+	kb := config.KeyBindings{
+		"Logs":     "Shift-L",
+		"Describe": "z",
+		"Blee":     "Shift-B",
+		"Duh":      "Zorg",
+	}
+	for a, s := range kb {
+		k, err := asKey(s)
+		if err == nil {
+			_ = aa.Remap(a, k)
+		}
+	}
+
+	rr := keyBindingsFor(aa, kb)
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Key+rr[i].Action < rr[j].Key+rr[j].Action
+	})
+	assert.Equal(t, []render.KeyBindingRes{
+		{Key: "Shift-B", Action: "Blee", Source: "keymap", Err: `no action found for "Blee"`},
+		{Key: "Shift-L", Action: "Logs", Source: "keymap", Visible: true},
+		{Key: "Zorg", Action: "Duh", Source: "keymap", Err: `invalid key specified: "Zorg"`},
+		{Key: "d", Action: "Describe", Source: "k9s", Visible: true},
+		{Key: "z", Action: "Describe", Source: "keymap", Err: `conflicts with action "Zorg"`},
+		{Key: "z", Action: "Zorg", Source: "plugin"},
+	}, rr)
+}
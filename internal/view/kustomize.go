@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const kustomizeTitle = "Kustomize Preview"
+
+// KustomizePreview previews a kustomization server-side diff prior to applying it.
+type KustomizePreview struct {
+	*Details
+
+	dir string
+}
+
+// NewKustomizePreview returns a new kustomization preview.
+func NewKustomizePreview(app *App, dir, diff string) *KustomizePreview {
+	k := KustomizePreview{
+		Details: NewDetails(app, kustomizeTitle, dir, contentTXT, true),
+		dir:     dir,
+	}
+	k.Update(tview.Escape(diff))
+
+	return &k
+}
+
+// Init initializes the viewer.
+func (k *KustomizePreview) Init(ctx context.Context) error {
+	if err := k.Details.Init(ctx); err != nil {
+		return err
+	}
+	k.Actions().Add(ui.KeyA, ui.NewKeyActionWithOpts("Apply", k.applyCmd, ui.ActionOpts{
+		Visible:   true,
+		Dangerous: true,
+	}))
+
+	return nil
+}
+
+func (k *KustomizePreview) applyCmd(*tcell.EventKey) *tcell.EventKey {
+	ll, err := dao.KustomizeLabels(k.dir)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to read kustomization labels")
+	}
+	var sel string
+	if len(ll) > 0 {
+		sel = labels.SelectorFromSet(ll).String()
+	}
+	dialog.ShowKustomizeApply(k.app.Styles.Dialog(), k.app.Content.Pages, k.dir, sel, k.apply, func() {})
+
+	return nil
+}
+
+func (k *KustomizePreview) apply(prune bool, sel string) {
+	args := []string{"apply", "-k", k.dir, "--server-side"}
+	if prune {
+		args = append(args, "--prune", "-l", sel)
+	}
+	res, err := runKu(k.app, shellOpts{clear: false, args: args})
+	if err != nil {
+		res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
+	} else {
+		res = "message:\n" + fmtResults(res)
+	}
+	k.app.Content.Pop()
+	details := NewDetails(k.app, "Applied Kustomization", k.dir, contentYAML, true).Update(res)
+	if err := k.app.inject(details, false); err != nil {
+		k.app.Flash().Err(err)
+	}
+}
+
+// kustomizeDiff builds a kustomization and diffs it against the cluster
+// using a server-side dry-run.
+func kustomizeDiff(app *App, dir string) (string, bool, error) {
+	res, err := runKu(app, shellOpts{clear: false, args: []string{"diff", "-k", dir, "--server-side"}})
+
+	return diffResult(res, err)
+}
+
+// diffResult interprets kubectl diff exit codes: 0 no changes, 1 changes.
+func diffResult(res string, err error) (string, bool, error) {
+	if err == nil {
+		return res, false, nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return res, true, nil
+	}
+	if res != "" {
+		return "", false, errors.New(res)
+	}
+
+	return "", false, err
+}
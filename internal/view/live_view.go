@@ -15,13 +15,13 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 	"github.com/sahilm/fuzzy"
 )
 
 const (
 	liveViewTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
 	yamlAction       = "YAML"
+	searchMarkColor  = "darkslategray"
 )
 
 // LiveView represents a live text viewer.
@@ -35,6 +35,8 @@ type LiveView struct {
 	app                       *App
 	cmdBuff                   *model.FishBuff
 	currentRegion, maxRegions int
+	matchLines                []string
+	highlightAll              bool
 	cancel                    context.CancelFunc
 	fullScreen                bool
 	managedField              bool
@@ -114,7 +116,8 @@ func (v *LiveView) ResourceChanged(lines []string, matches fuzzy.Matches) {
 			v.text.ScrollToBeginning()
 		}
 
-		lines = linesWithRegions(lines, matches)
+		v.matchLines = matchingLines(lines, matches)
+		lines = linesWithRegions(lines, matches, v.highlightAll)
 		v.text.SetText(colorizeYAML(v.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
 		v.text.Highlight()
 		if v.currentRegion < v.maxRegions {
@@ -126,7 +129,12 @@ func (v *LiveView) ResourceChanged(lines []string, matches fuzzy.Matches) {
 }
 
 // BufferChanged indicates the buffer was changed.
-func (v *LiveView) BufferChanged(_, _ string) {}
+func (v *LiveView) BufferChanged(text, _ string) {
+	if !v.cmdBuff.IsActive() || v.model == nil {
+		return
+	}
+	v.model.Filter(text)
+}
 
 // BufferCompleted indicates input was accepted.
 func (v *LiveView) BufferCompleted(text, _ string) {
@@ -148,6 +156,8 @@ func (v *LiveView) bindKeys() {
 		ui.KeyR:         ui.NewKeyAction("Toggle Auto-Refresh", v.toggleRefreshCmd, true),
 		ui.KeyN:         ui.NewKeyAction("Next Match", v.nextCmd, true),
 		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", v.prevCmd, true),
+		ui.KeyShiftH:    ui.NewKeyAction("Toggle Highlight All", v.toggleHighlightAllCmd, true),
+		ui.KeyShiftC:    ui.NewKeyAction("Copy Matches", copyMatchesCmd(v.app.Flash(), v.matchingLines), true),
 		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", v.activateCmd, false),
 		tcell.KeyDelete: ui.NewSharedKeyAction("Erase", v.eraseCmd, false),
 	})
@@ -297,6 +307,24 @@ func (v *LiveView) setFullScreen(isFullScreen bool) {
 	}
 }
 
+func (v *LiveView) matchingLines() []string {
+	return v.matchLines
+}
+
+func (v *LiveView) toggleHighlightAllCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v.highlightAll = !v.highlightAll
+	if q := v.cmdBuff.GetText(); q != "" {
+		v.model.Filter(q)
+	}
+	if v.highlightAll {
+		v.app.Flash().Info("Highlighting all matches")
+	} else {
+		v.app.Flash().Info("Highlighting current match")
+	}
+
+	return nil
+}
+
 func (v *LiveView) nextCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if v.cmdBuff.Empty() {
 		return evt
@@ -373,7 +401,7 @@ func (v *LiveView) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 func (v *LiveView) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
 	name := fmt.Sprintf("%s--%s", strings.Replace(v.model.GetPath(), "/", "-", 1), strings.ToLower(v.title))
-	if _, err := saveYAML(v.app.Config.K9s.ContextScreenDumpDir(), name, sanitizeEsc(v.text.GetText(true))); err != nil {
+	if _, err := saveYAML(v.app.Config.K9s.ContextScreenDumpDir(), name, sanitizeEsc(v.text.GetText(true)), v.app.Config.K9s.ScreenDumps); err != nil {
 		v.app.Flash().Err(err)
 	} else {
 		v.app.Flash().Infof("File %q saved successfully!", name)
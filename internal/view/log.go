@@ -22,7 +22,6 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 )
 
 const (
@@ -41,6 +40,7 @@ type Log struct {
 	logs              *Logger
 	indicator         *LogIndicator
 	ansiWriter        io.Writer
+	styles            *config.Styles
 	model             *model.Log
 	cancelFn          context.CancelFunc
 	cancelUpdates     bool
@@ -91,7 +91,6 @@ func (l *Log) Init(ctx context.Context) (err error) {
 	l.logs.SetWrap(l.app.Config.K9s.Logger.TextWrap)
 	l.logs.SetMaxLines(l.app.Config.K9s.Logger.BufferSize)
 
-	l.ansiWriter = tview.ANSIWriter(l.logs, l.app.Styles.Views().Log.FgColor.String(), l.app.Styles.Views().Log.BgColor.String())
 	l.AddItem(l.logs, 0, 1, true)
 	l.bindKeys()
 
@@ -180,6 +179,9 @@ func (l *Log) BufferActive(state bool, k model.BufferKind) {
 
 // StylesChanged reports skin changes.
 func (l *Log) StylesChanged(s *config.Styles) {
+	s = s.For(logTitle)
+	l.styles = s
+	l.ansiWriter = tview.ANSIWriter(l.logs, s.Views().Log.FgColor.String(), s.Views().Log.BgColor.String())
 	l.SetBackgroundColor(s.Views().Log.BgColor.Color())
 	l.logs.SetTextColor(s.Views().Log.FgColor.Color())
 	l.logs.SetBackgroundColor(s.Views().Log.BgColor.Color())
@@ -258,6 +260,7 @@ func (l *Log) bindKeys() {
 		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", l.toggleFullScreenCmd, true),
 		ui.KeyT:         ui.NewKeyAction("Toggle Timestamp", l.toggleTimestampCmd, true),
 		ui.KeyW:         ui.NewKeyAction("Toggle Wrap", l.toggleTextWrapCmd, true),
+		ui.KeyP:         ui.NewKeyAction("Pin", l.pinCmd, true),
 		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.SaveCmd, true),
 		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(l.app.Flash(), l.logs.TextView), true),
 	})
@@ -316,14 +319,14 @@ func (l *Log) updateTitle() {
 	}
 	path, co := l.model.GetPath(), l.model.GetContainer()
 	if co == "" {
-		title += ui.SkinTitle(fmt.Sprintf(logFmt, path, since), l.app.Styles.Frame())
+		title += ui.SkinTitle(fmt.Sprintf(logFmt, path, since), l.styles.Frame())
 	} else {
-		title += ui.SkinTitle(fmt.Sprintf(logCoFmt, path, co, since), l.app.Styles.Frame())
+		title += ui.SkinTitle(fmt.Sprintf(logCoFmt, path, co, since), l.styles.Frame())
 	}
 
 	buff := l.logs.cmdBuff.GetText()
 	if buff != "" {
-		title += ui.SkinTitle(fmt.Sprintf(ui.SearchFmt, buff), l.app.Styles.Frame())
+		title += ui.SkinTitle(fmt.Sprintf(ui.SearchFmt, buff), l.styles.Frame())
 	}
 	l.SetTitle(title)
 }
@@ -405,7 +408,7 @@ func (l *Log) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 // SaveCmd dumps the logs to file.
 func (l *Log) SaveCmd(*tcell.EventKey) *tcell.EventKey {
-	path, err := saveData(l.app.Config.K9s.ContextScreenDumpDir(), l.model.GetPath(), l.logs.GetText(true))
+	path, err := saveData(l.app.Config.K9s.ContextScreenDumpDir(), l.model.GetPath(), l.logs.GetText(true), l.app.Config.K9s.ScreenDumps)
 	if err != nil {
 		l.app.Flash().Err(err)
 		return nil
@@ -419,7 +422,7 @@ func ensureDir(dir string) error {
 	return os.MkdirAll(dir, 0744)
 }
 
-func saveData(dir, fqn, logs string) (string, error) {
+func saveData(dir, fqn, logs string, rp config.ScreenDumps) (string, error) {
 	if err := ensureDir(dir); err != nil {
 		return "", err
 	}
@@ -440,6 +443,7 @@ func saveData(dir, fqn, logs string) (string, error) {
 	if _, err := file.WriteString(logs); err != nil {
 		return "", err
 	}
+	pruneDumps(dir, rp)
 
 	return path, nil
 }
@@ -451,7 +455,7 @@ func (l *Log) clearCmd(*tcell.EventKey) *tcell.EventKey {
 
 func (l *Log) markCmd(*tcell.EventKey) *tcell.EventKey {
 	_, _, w, _ := l.GetRect()
-	fmt.Fprintf(l.ansiWriter, "\n[%s:-:b]%s[-:-:-]", l.app.Styles.Views().Log.FgColor.String(), strings.Repeat("─", w-4))
+	fmt.Fprintf(l.ansiWriter, "\n[%s:-:b]%s[-:-:-]", l.styles.Views().Log.FgColor.String(), strings.Repeat("─", w-4))
 	l.follow = true
 
 	return nil
@@ -505,6 +509,15 @@ func (l *Log) toggleFullScreenCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (l *Log) pinCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+	l.app.pinLogs(l.model.GVR(), l.model.LogOptions())
+
+	return nil
+}
+
 func (l *Log) toggleFullScreen() {
 	l.SetFullScreen(l.indicator.FullScreen())
 	l.Box.SetBorder(!l.indicator.FullScreen())
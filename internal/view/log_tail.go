@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	logTailTitle  = "tail"
+	logTailLines  = 50
+	logTailHeight = 8
+)
+
+// LogTail represents a small log tail pinned at the bottom of the screen.
+type LogTail struct {
+	*tview.TextView
+
+	app        *App
+	model      *model.Log
+	ansiWriter io.Writer
+	cancelFn   context.CancelFunc
+}
+
+var _ model.LogsListener = (*LogTail)(nil)
+
+// NewLogTail returns a new log tail.
+func NewLogTail(app *App, gvr client.GVR, opts *dao.LogOptions) *LogTail {
+	opts.Lines, opts.SinceSeconds, opts.Head = logTailLines, -1, false
+	t := LogTail{
+		TextView: tview.NewTextView(),
+		app:      app,
+		model:    model.NewLog(gvr, opts, defaultFlushTimeout),
+	}
+	t.SetBorder(true)
+	t.SetBorderPadding(0, 0, 1, 1)
+	t.SetDynamicColors(true)
+	t.SetWrap(false)
+	t.SetMaxLines(logTailLines)
+	t.model.Init(app.factory)
+	t.StylesChanged(app.Styles)
+
+	return &t
+}
+
+// Path returns the tailed resource path.
+func (t *LogTail) Path() string {
+	return t.model.LogOptions().Info()
+}
+
+// Start starts tailing logs.
+func (t *LogTail) Start() {
+	t.Stop()
+	t.Clear()
+
+	var ctx context.Context
+	ctx, t.cancelFn = context.WithCancel(context.Background())
+	t.model.AddListener(t)
+	t.app.Styles.AddListener(t)
+	t.model.Restart(ctx)
+}
+
+// Stop stops tailing logs.
+func (t *LogTail) Stop() {
+	if t.cancelFn == nil {
+		return
+	}
+	t.model.RemoveListener(t)
+	t.model.Stop()
+	t.app.Styles.RemoveListener(t)
+	t.cancelFn()
+	t.cancelFn = nil
+}
+
+// StylesChanged reports skin changes.
+func (t *LogTail) StylesChanged(s *config.Styles) {
+	t.SetBackgroundColor(s.Views().Log.BgColor.Color())
+	t.SetTextColor(s.Views().Log.FgColor.Color())
+	t.SetBorderColor(s.Frame().Border.FgColor.Color())
+	t.SetTitle(ui.SkinTitle(fmt.Sprintf(" [aqua::b]%s([fuchsia::b]%s[aqua::-]) ", logTailTitle, t.Path()), s.Frame()))
+	t.ansiWriter = tview.ANSIWriter(t, s.Views().Log.FgColor.String(), s.Views().Log.BgColor.String())
+}
+
+// LogChanged updates the logs.
+func (t *LogTail) LogChanged(lines [][]byte) {
+	t.app.QueueUpdateDraw(func() {
+		for _, l := range lines {
+			_, _ = t.ansiWriter.Write(l)
+		}
+		t.ScrollToEnd()
+	})
+}
+
+// LogCleared clears the logs.
+func (t *LogTail) LogCleared() {
+	t.app.QueueUpdateDraw(func() {
+		t.Clear()
+	})
+}
+
+// LogFailed notifies an error occurred.
+func (t *LogTail) LogFailed(err error) {
+	t.app.QueueUpdateDraw(func() {
+		_, _ = t.ansiWriter.Write([]byte(tview.Escape(color.Colorize(err.Error(), color.Red))))
+	})
+}
+
+// LogStop disables log flushes.
+func (*LogTail) LogStop() {}
+
+// LogResume resume log flushes.
+func (*LogTail) LogResume() {}
+
+// LogCanceled indicates no more logs are coming.
+func (t *LogTail) LogCanceled() {
+	t.LogChanged([][]byte{[]byte("\n🏁 [red::b]Stream exited! No more logs...")})
+}
+
+// pinLogs pins a log tail at the bottom of the screen. Pinning the logs
+// already tailed unpins them.
+func (a *App) pinLogs(gvr client.GVR, opts *dao.LogOptions) {
+	if a.tail != nil {
+		same := a.tail.Path() == opts.Info()
+		a.unpinLogs()
+		if same {
+			a.Flash().Info("Log tail unpinned")
+			return
+		}
+	}
+
+	a.tail = NewLogTail(a, gvr, opts.Clone())
+	a.showTail(true)
+	a.Flash().Infof("Pinned logs of %s", a.tail.Path())
+}
+
+// unpinLogs removes the log tail if any.
+func (a *App) unpinLogs() {
+	if a.tail == nil {
+		return
+	}
+	a.showTail(false)
+	a.tail = nil
+}
+
+func (a *App) isTailShown() bool {
+	return a.tail != nil && indexOf(a.workspace, a.tail) != -1
+}
+
+// showTail toggles the log tail display. Hidden tails stop streaming.
+func (a *App) showTail(show bool) {
+	if a.tail == nil || show == a.isTailShown() {
+		return
+	}
+	if show {
+		a.workspace.AddItem(a.tail, logTailHeight, 1, false)
+		a.tail.Start()
+		return
+	}
+	a.tail.Stop()
+	a.workspace.RemoveItem(a.tail)
+}
+
+func (a *App) toggleTailCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.tail == nil {
+		a.Flash().Info("No logs pinned. Use `p` in a logs view to pin them")
+		return nil
+	}
+	a.showTail(!a.isTailShown())
+
+	return nil
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/mock"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogTailNew(t *testing.T) {
+	opts := dao.LogOptions{
+		Path:      "fred/p1",
+		Container: "blee",
+		Lines:     1000,
+		Head:      true,
+	}
+	tail := NewLogTail(NewApp(mock.NewMockConfig()), client.NewGVR("v1/pods"), opts.Clone())
+
+	assert.Equal(t, "fred/p1 (blee)", tail.Path())
+	assert.Equal(t, int64(logTailLines), tail.model.LogOptions().Lines)
+	assert.False(t, tail.model.IsHead())
+	assert.Equal(t, int64(1000), opts.Lines)
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	// macroKeyTimeout tracks how long a key step waits for its action to
+	// be bound, views only bind their actions once data is loaded.
+	macroKeyTimeout = 5 * time.Second
+
+	macroKeyRetry = 100 * time.Millisecond
+)
+
+var errNoAction = errors.New("no action bound")
+
+// runMacro plays the steps of a named macro in order. Execution stops on
+// the first failing step.
+func (a *App) runMacro(name string) error {
+	mm := config.NewMacros()
+	if err := mm.Load(config.AppMacrosFile); err != nil {
+		return err
+	}
+	m, err := mm.Get(name)
+	if err != nil {
+		return err
+	}
+
+	a.Flash().Infof("Running macro %q...", name)
+	go a.playMacro(name, m)
+
+	return nil
+}
+
+func (a *App) playMacro(name string, m config.Macro) {
+	for i, s := range m.Steps {
+		log.Debug().Msgf("Macro %q step #%d: %s", name, i, s)
+		if err := a.playMacroStep(s); err != nil {
+			a.QueueUpdateDraw(func() {
+				a.Flash().Errf("Macro %q step #%d (%s) failed: %s", name, i, s, err)
+			})
+			return
+		}
+	}
+}
+
+func (a *App) playMacroStep(s config.MacroStep) error {
+	deadline := time.Now().Add(macroKeyTimeout)
+	for {
+		errChan := make(chan error, 1)
+		a.QueueUpdateDraw(func() {
+			errChan <- a.runMacroStep(s)
+		})
+		err := <-errChan
+		if !errors.Is(err, errNoAction) || time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(macroKeyRetry)
+	}
+}
+
+func (a *App) runMacroStep(s config.MacroStep) error {
+	switch {
+	case s.Command != "":
+		return a.command.run(cmd.NewInterpreter(s.Command), "", true)
+	case s.Filter != "":
+		top := a.Content.Top()
+		if top == nil {
+			return errors.New("no view to filter")
+		}
+		top.SetFilter(s.Filter)
+		return nil
+	default:
+		key, err := asKey(s.Key)
+		if err != nil {
+			return err
+		}
+		return a.runMacroKey(key)
+	}
+}
+
+func (a *App) runMacroKey(key tcell.Key) error {
+	evt := tcell.NewEventKey(key, 0, tcell.ModNone)
+	if key >= ' ' && key < tcell.KeyDEL {
+		evt = tcell.NewEventKey(tcell.KeyRune, rune(key), tcell.ModNone)
+	}
+	if b, ok := a.Content.Top().(keyBinder); ok {
+		if ka, ok := b.Actions().Get(key); ok {
+			ka.Action(evt)
+			return nil
+		}
+	}
+	if ka, ok := a.HasAction(key); ok {
+		ka.Action(evt)
+		return nil
+	}
+
+	return fmt.Errorf("%w to %q", errNoAction, tcell.KeyNames[key])
+}
+
+func macroCmd(r Runner, name string) func(*tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if err := r.App().runMacro(name); err != nil {
+			r.App().Flash().Err(err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const manifestsTitle = "Manifests"
+
+// Manifests represents the documents of a manifest pending apply.
+type Manifests struct {
+	ResourceViewer
+
+	source string
+	docs   []render.ManifestRes
+}
+
+// NewManifests returns a new manifest documents view.
+func NewManifests(gvr client.GVR) ResourceViewer {
+	m := Manifests{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	m.GetTable().SetSortCol("DOC", true)
+	m.SetContextFn(m.docsContext)
+	m.AddBindKeysFn(m.bindKeys)
+	m.GetTable().SetEnterFn(m.showDiff)
+
+	return &m
+}
+
+// Name returns the component name.
+func (*Manifests) Name() string { return manifestsTitle }
+
+// SetDocs sets the manifest source and its documents.
+func (m *Manifests) SetDocs(source string, dd []render.ManifestRes) {
+	m.source, m.docs = source, dd
+}
+
+func (m *Manifests) docsContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyManifests, m.docs)
+}
+
+func (m *Manifests) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	if !m.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyA, ui.NewKeyActionWithOpts("Apply", m.applyCmd, ui.ActionOpts{Visible: true, Dangerous: true}))
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", m.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", m.GetTable().SortColCmd("STATUS", true), false),
+	})
+}
+
+func (m *Manifests) showDiff(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	d, ok := m.doc(path)
+	if !ok {
+		app.Flash().Errf("No manifest document found for %q", path)
+		return
+	}
+	diff := d.Diff
+	switch {
+	case d.Err != nil:
+		diff = d.Err.Error()
+	case diff == "":
+		diff = "No changes"
+	}
+	title := client.FQN(d.Namespace, d.Name)
+	if err := app.inject(NewDetails(app, "Diff", d.Kind+" "+title, contentTXT, true).Update(tview.Escape(diff)), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (m *Manifests) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	selections := m.GetTable().GetSelectedItems()
+	if len(selections) == 0 {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Apply document #%s?", selections[0])
+	if len(selections) > 1 {
+		msg = fmt.Sprintf("Apply %d marked documents?", len(selections))
+	}
+	dialog.ShowConfirm(m.App().Styles.Dialog(), m.App().Content.Pages, "Apply", msg, func() {
+		m.apply(selections)
+	}, func() {})
+
+	return nil
+}
+
+// apply applies the selected documents in manifest order.
+func (m *Manifests) apply(ids []string) {
+	var buff bytes.Buffer
+	for _, d := range m.docs {
+		if !slices.Contains(ids, d.ID()) {
+			continue
+		}
+		buff.WriteString("---\n")
+		buff.Write(d.Raw)
+	}
+	res, err := runKu(m.App(), shellOpts{args: []string{"apply", "-f", "-", "--server-side"}, input: buff.Bytes()})
+	if err != nil {
+		res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
+	} else {
+		res = "message:\n" + fmtResults(res)
+	}
+	m.App().Content.Pop()
+	details := NewDetails(m.App(), "Applied Manifest", m.source, contentYAML, true).Update(res)
+	if err := m.App().inject(details, false); err != nil {
+		m.App().Flash().Err(err)
+	}
+}
+
+func (m *Manifests) doc(id string) (render.ManifestRes, bool) {
+	for _, d := range m.docs {
+		if d.ID() == id {
+			return d, true
+		}
+	}
+
+	return render.ManifestRes{}, false
+}
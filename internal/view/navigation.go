@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	recentTitle    = "Recent"
+	recentResTitle = "Recent Resources"
+)
+
+// trackNav records a location reached via a command.
+func (a *App) trackNav(line, fqn, ns, gvr string) {
+	if a.navigating {
+		return
+	}
+	a.nav.Push(model.NavEntry{
+		Command:   line,
+		FQN:       fqn,
+		Namespace: ns,
+		GVR:       gvr,
+	})
+}
+
+// syncNavFilter records the filter of the current view before leaving it.
+func (a *App) syncNavFilter() {
+	if a.navigating {
+		return
+	}
+	if v, ok := a.Content.Top().(ResourceViewer); ok {
+		a.nav.SetFilter(v.GetTable().CmdBuff().GetText())
+	}
+}
+
+// navigateTo restores a previously visited location.
+func (a *App) navigateTo(e model.NavEntry) error {
+	a.navigating = true
+	defer func() { a.navigating = false }()
+
+	if e.Namespace != "" {
+		if err := a.switchNS(e.Namespace); err != nil {
+			return err
+		}
+	}
+	if err := a.command.run(cmd.NewInterpreter(e.Command), e.FQN, true); err != nil {
+		return err
+	}
+	if e.Filter != "" {
+		a.Content.Top().SetFilter(e.Filter)
+	}
+
+	return nil
+}
+
+func (a *App) navBackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	a.syncNavFilter()
+	e, ok := a.nav.Back()
+	if !ok {
+		a.Flash().Info("No previous location")
+		return nil
+	}
+	if err := a.navigateTo(e); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (a *App) navForwardCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	a.syncNavFilter()
+	e, ok := a.nav.Forward()
+	if !ok {
+		a.Flash().Info("No next location")
+		return nil
+	}
+	if err := a.navigateTo(e); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// recentCmd shows a picker of recently visited locations.
+func (a *App) recentCmd() error {
+	a.syncNavFilter()
+	ee := a.nav.Recent()
+	if len(ee) == 0 {
+		a.Flash().Info("No recent locations")
+		return nil
+	}
+	if len(ee) > model.MaxHistory {
+		ee = ee[:model.MaxHistory]
+	}
+	ss := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ss = append(ss, e.String())
+	}
+
+	return a.pickRecent(" [aqua::b]"+recentTitle+" ", ee, ss)
+}
+
+// recentResCmd shows a quick switcher of the resources recently visited in the
+// active namespace.
+func (a *App) recentResCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	a.syncNavFilter()
+	ns := a.Config.ActiveNamespace()
+	ee := a.nav.RecentResources(ns)
+	if len(ee) == 0 {
+		a.Flash().Infof("No recent resources in namespace %q", ns)
+		return nil
+	}
+	ss := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ss = append(ss, e.GVR)
+	}
+	title := fmt.Sprintf(" [aqua::b]%s([fuchsia::b]%s[aqua::-]) ", recentResTitle, ns)
+	if err := a.pickRecent(title, ee, ss); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// pickRecent shows a picker restoring the selected location.
+func (a *App) pickRecent(title string, ee []model.NavEntry, ss []string) error {
+	picker := NewPicker()
+	picker.populate(ss)
+	picker.SetSelectedFunc(func(idx int, _, _ string, _ rune) {
+		a.syncNavFilter()
+		a.trackNav(ee[idx].Command, ee[idx].FQN, ee[idx].Namespace, ee[idx].GVR)
+		a.nav.SetFilter(ee[idx].Filter)
+		if err := a.navigateTo(ee[idx]); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+	if err := a.inject(picker, false); err != nil {
+		return err
+	}
+	picker.SetTitle(title)
+
+	return nil
+}
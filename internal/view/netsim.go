@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const netpolsCol = "POLICY"
+
+// NetSim represents a network policies simulator view.
+type NetSim struct {
+	ResourceViewer
+}
+
+// NewNetSim returns a new network policies simulator view.
+func NewNetSim(gvr client.GVR) ResourceViewer {
+	n := NetSim{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.AddBindKeysFn(n.bindKeys)
+
+	return &n
+}
+
+func (n *NetSim) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyEnter: ui.NewKeyAction("Goto Policy", n.gotoCmd, true),
+		ui.KeyShiftP:   ui.NewKeyAction("Sort Pod", n.GetTable().SortColCmd("POD", true), false),
+		ui.KeyShiftD:   ui.NewKeyAction("Sort Direction", n.GetTable().SortColCmd("DIRECTION", true), false),
+		ui.KeyShiftL:   ui.NewKeyAction("Sort Policy", n.GetTable().SortColCmd(netpolsCol, true), false),
+	})
+}
+
+func (n *NetSim) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	row := n.GetTable().GetSelectedRow(path)
+	if row == nil {
+		return nil
+	}
+	idx, ok := n.GetTable().GetModel().Peek().Header().IndexOf(netpolsCol, true)
+	if !ok || row.Fields[idx] == "" {
+		n.App().Flash().Warn("No network policy covers this flow")
+		return nil
+	}
+	n.App().gotoResource("networkpolicies", client.FQN(row.Fields[0], row.Fields[idx]), false)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view/cmd"
+)
+
+const newTitle = "New Resource"
+
+// newCmd scaffolds a resource from a template, opens it in the editor and
+// applies it once saved.
+func (c *Command) newCmd(p *cmd.Interpreter) error {
+	kind, name, ok := p.NewArgs()
+	if !ok {
+		return errors.New("invalid command. use `new kind [name]`")
+	}
+	if c.app.Config.K9s.IsReadOnly() {
+		return fmt.Errorf("new %s denied: %w", kind, dao.ErrReadOnly)
+	}
+	kind = c.templateKind(kind)
+	if name == "" {
+		name = "my-" + kind
+	}
+	ns := c.app.Config.ActiveNamespace()
+	if client.IsAllNamespaces(ns) {
+		ns = client.DefaultNamespace
+	}
+
+	raw, err := config.ResourceTemplate(kind, config.TemplateVars{Namespace: ns, Name: name})
+	if err != nil {
+		return fmt.Errorf("%w. available templates: %s", err, strings.Join(config.ResourceTemplateNames(), ", "))
+	}
+	bb, err := editBuffer(c.app, "k9s-new-*.yaml", raw)
+	if err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(string(bb))) == 0 {
+		c.app.Flash().Warn("Empty manifest. New resource canceled")
+		return nil
+	}
+
+	res, err := runKu(c.app, shellOpts{args: []string{"apply", "-f", "-"}, input: bb})
+	if err != nil {
+		res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
+	} else {
+		res = "message:\n" + fmtResults(res)
+	}
+	details := NewDetails(c.app, newTitle, client.FQN(ns, name), contentYAML, true).Update(res)
+
+	return c.app.inject(details, false)
+}
+
+// templateKind resolves a resource alias to its template kind ie deploy -> deployment.
+func (c *Command) templateKind(kind string) string {
+	kind = strings.ToLower(kind)
+	if slices.Contains(config.ResourceTemplateNames(), kind) {
+		return kind
+	}
+	gvr, _, ok := c.alias.AsGVR(kind)
+	if !ok {
+		return kind
+	}
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil || meta.SingularName == "" {
+		return kind
+	}
+
+	return meta.SingularName
+}
@@ -11,30 +11,75 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
-	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// nodeDoc documents the node view.
+var nodeDoc = ViewDoc{
+	Summary: "Cluster nodes and their allocations",
+	Columns: model.MenuHints{
+		{Mnemonic: "STATUS", Description: "Node readiness and scheduling state"},
+		{Mnemonic: "ROLE", Description: "Node roles from labels"},
+		{Mnemonic: "TAINTS", Description: "Taints count"},
+		{Mnemonic: "PODS", Description: "Pods scheduled on the node"},
+		{Mnemonic: "CPU", Description: "CPU usage in millicores"},
+		{Mnemonic: "MEM", Description: "Memory usage in MiB"},
+		{Mnemonic: "%CPU", Description: "CPU usage over allocatable"},
+		{Mnemonic: "%MEM", Description: "Memory usage over allocatable"},
+		{Mnemonic: "CPU/A", Description: "Allocatable CPU"},
+		{Mnemonic: "MEM/A", Description: "Allocatable memory"},
+	},
+	Related: model.MenuHints{
+		{Mnemonic: ":pods", Description: "Pods on the selected node via enter"},
+		{Mnemonic: ":events", Description: "Cluster events"},
+	},
+}
+
 // Node represents a node view.
 type Node struct {
 	ResourceViewer
+
+	trends *usageTrends
 }
 
 // NewNode returns a new node view.
 func NewNode(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), nodeDoc)
 	n := Node{
-		ResourceViewer: NewBrowser(gvr),
+		ResourceViewer: NewWatchExtender(NewBrowser(gvr)),
 	}
 	n.AddBindKeysFn(n.bindKeys)
 	n.GetTable().SetEnterFn(n.showPods)
 	n.SetContextFn(n.nodeContext)
+	n.GetTable().SetDecorateFn(n.decorate)
 
 	return &n
 }
 
+// Start starts the view.
+func (n *Node) Start() {
+	stopTrends(n, n.trends)
+	n.trends = startTrends(n)
+	n.ResourceViewer.Start()
+}
+
+// Stop stops the view.
+func (n *Node) Stop() {
+	stopTrends(n, n.trends)
+	n.ResourceViewer.Stop()
+}
+
+func (n *Node) decorate(data *model1.TableData) {
+	if n.trends != nil {
+		n.trends.decorate(data)
+	}
+}
+
 func (n *Node) nodeContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyPodCounting, !n.App().Config.K9s.DisablePodCounting)
 }
@@ -88,6 +133,19 @@ func (n *Node) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("PODS", false), false),
 	})
+	if _, ok := promDatasource(n.App()); ok {
+		aa.Add(ui.KeyShiftG, ui.NewKeyAction("Graphs", n.graphsCmd, true))
+	}
+}
+
+func (n *Node) graphsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showPromGraphs(n.App(), path, client.NodePromGraphs(path))
+
+	return nil
 }
 
 func (n *Node) showPods(a *App, _ ui.Tabular, _ client.GVR, path string) {
@@ -166,12 +224,13 @@ func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.Eve
 				n.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", n.GVR()))
 				return
 			}
-			for _, s := range sels {
-				if err := m.ToggleCordon(s, cordon); err != nil {
-					n.App().Flash().Err(err)
-				}
+			action := "Uncordon"
+			if cordon {
+				action = "Cordon"
 			}
-			n.Refresh()
+			runBulk(context.Background(), n.App(), action, sels, func(_ context.Context, path string) error {
+				return m.ToggleCordon(path, cordon)
+			}, n.Refresh)
 		}, func() {})
 
 		return nil
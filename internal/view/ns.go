@@ -4,10 +4,17 @@
 package view
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
+	v1 "k8s.io/api/core/v1"
 )
 
 const (
@@ -15,6 +22,18 @@ const (
 	defaultNSIndicator = "(*)"
 )
 
+// namespaceDoc documents the namespace view.
+var namespaceDoc = ViewDoc{
+	Summary: "Namespaces, favorites are pinned to the menu",
+	Columns: model.MenuHints{
+		{Mnemonic: "STATUS", Description: "Namespace phase"},
+	},
+	Related: model.MenuHints{
+		{Mnemonic: ":pods", Description: "Namespace pods via enter"},
+		{Mnemonic: ":ctx", Description: "Contexts"},
+	},
+}
+
 // Namespace represents a namespace viewer.
 type Namespace struct {
 	ResourceViewer
@@ -22,6 +41,7 @@ type Namespace struct {
 
 // NewNamespace returns a new viewer.
 func NewNamespace(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), namespaceDoc)
 	n := Namespace{
 		ResourceViewer: NewBrowser(gvr),
 	}
@@ -35,6 +55,8 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 func (n *Namespace) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyU:      ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyShiftW: ui.NewKeyAction("NetPol Sim", n.netSimCmd, true),
+		ui.KeyShiftQ: ui.NewKeyAction("Quotas", n.quotasCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd(statusCol, true), false),
 	})
 }
@@ -54,6 +76,37 @@ func (n *Namespace) useNsCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// quotasCmd shows the selected namespace quotas consumption and limit range defaults.
+func (n *Namespace) quotasCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	qq, ll, err := dao.NamespaceQuotas(n.App().factory, ns)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(n.App(), "Quotas", ns, contentTXT, true).Update(quotaPanel(qq, ll))
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (n *Namespace) netSimCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	n.App().gotoResource("netsim "+ns, "", false)
+
+	return nil
+}
+
 func (n *Namespace) useNamespace(fqn string) {
 	_, ns := client.Namespaced(fqn)
 	if client.CleanseNamespace(n.App().Config.ActiveNamespace()) == ns {
@@ -103,3 +156,55 @@ func (n *Namespace) decorate(td *model1.TableData) {
 		return true
 	})
 }
+
+// quotaPanel renders a namespace quotas consumption and limit range defaults.
+func quotaPanel(qq []v1.ResourceQuota, ll []v1.LimitRange) string {
+	var b strings.Builder
+	b.WriteString("Resource Quotas\n")
+	if len(qq) == 0 {
+		b.WriteString("  none\n")
+	}
+	for _, u := range dao.QuotaImpact(qq, nil) {
+		pct := "n/a"
+		if h := u.Hard.MilliValue(); h > 0 {
+			pct = fmt.Sprintf("%d%%", u.Used.MilliValue()*100/h)
+		}
+		fmt.Fprintf(&b, "  %s: %s [%s]\n", u.Quota, u, pct)
+	}
+
+	b.WriteString("\nLimit Ranges\n")
+	if len(ll) == 0 {
+		b.WriteString("  none\n")
+	}
+	for _, l := range ll {
+		for _, item := range l.Spec.Limits {
+			fmt.Fprintf(&b, "  %s: %s", l.Name, item.Type)
+			for _, r := range []struct {
+				label string
+				rl    v1.ResourceList
+			}{
+				{"default request", item.DefaultRequest},
+				{"default limit", item.Default},
+				{"min", item.Min},
+				{"max", item.Max},
+			} {
+				if len(r.rl) > 0 {
+					fmt.Fprintf(&b, " %s(%s)", r.label, resourceListStr(r.rl))
+				}
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+func resourceListStr(rl v1.ResourceList) string {
+	ss := make([]string, 0, len(rl))
+	for n, q := range rl {
+		ss = append(ss, fmt.Sprintf("%s=%s", n, q.String()))
+	}
+	sort.Strings(ss)
+
+	return strings.Join(ss, ",")
+}
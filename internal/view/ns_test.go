@@ -16,5 +16,5 @@ func TestNSCleanser(t *testing.T) {
 
 	assert.Nil(t, ns.Init(makeCtx()))
 	assert.Equal(t, "Namespaces", ns.Name())
-	assert.Equal(t, 7, len(ns.Hints()))
+	assert.Equal(t, 11, len(ns.Hints()))
 }
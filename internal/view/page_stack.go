@@ -5,6 +5,7 @@ package view
 
 import (
 	"context"
+	"strings"
 
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
@@ -38,11 +39,15 @@ func (p *PageStack) Init(ctx context.Context) (err error) {
 func (p *PageStack) StackPushed(c model.Component) {
 	c.Start()
 	p.app.SetFocus(c)
+	p.app.Prompt().SetView(viewKey(c))
 }
 
 // StackPopped notifies a page was removed.
 func (p *PageStack) StackPopped(o, top model.Component) {
 	o.Stop()
+	if v, ok := o.(ResourceViewer); ok {
+		v.ReleaseInformers()
+	}
 	p.StackTop(top)
 }
 
@@ -53,4 +58,14 @@ func (p *PageStack) StackTop(top model.Component) {
 	}
 	top.Start()
 	p.app.SetFocus(top)
+	p.app.Prompt().SetView(viewKey(top))
+}
+
+// viewKey returns the name used to look up a view skin overrides.
+func viewKey(c model.Component) string {
+	if v, ok := c.(ResourceViewer); ok && v.GetTable() != nil {
+		return v.GVR().R()
+	}
+
+	return strings.ToLower(c.Name())
 }
@@ -0,0 +1,315 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	paletteTitle    = "Palette"
+	paletteTitleFmt = " [aqua::b]%s[aqua::-]([fuchsia::b]%d[aqua::-]) [seagreen::b]> %s[gray::-]%s "
+)
+
+// paletteCommands lists the k9s commands available from the palette.
+// Commands expecting arguments are prefilled in the command prompt.
+var paletteCommands = []paletteEntry{
+	{kind: "command", name: "alias", desc: "List all aliases"},
+	{kind: "command", name: "apply", desc: "Preview and apply a manifest from a url or the clipboard", args: true},
+	{kind: "command", name: "ctx", desc: "Switch context", args: true},
+	{kind: "command", name: "dir", desc: "Browse a local directory", args: true},
+	{kind: "command", name: "filter", desc: "Toggle a saved filter of the current view"},
+	{kind: "command", name: "filter save", desc: "Save the current view filter", args: true},
+	{kind: "command", name: "groupby", desc: "Group the current view rows by a column", args: true},
+	{kind: "command", name: "help", desc: "Show help"},
+	{kind: "command", name: "macro", desc: "Replay a recorded macro", args: true},
+	{kind: "command", name: "new", desc: "Scaffold a new resource from a template", args: true},
+	{kind: "command", name: "quit", desc: "Exit k9s"},
+	{kind: "command", name: "recent", desc: "List recently visited views"},
+	{kind: "command", name: "skin", desc: "Pick a skin"},
+	{kind: "command", name: "snapshot save", desc: "Save a snapshot of the current view"},
+	{kind: "command", name: "split", desc: "Split the body horizontally", args: true},
+	{kind: "command", name: "tab", desc: "Open a new named tab", args: true},
+	{kind: "command", name: "tabclose", desc: "Close the current tab"},
+	{kind: "command", name: "unsplit", desc: "Close all split panes"},
+	{kind: "command", name: "vsplit", desc: "Split the body vertically", args: true},
+	{kind: "command", name: "xray", desc: "Show resources as a tree", args: true},
+}
+
+type paletteEntry struct {
+	kind, name, desc string
+	args             bool
+	key              tcell.Key
+}
+
+func (e paletteEntry) String() string {
+	return e.name + " " + e.desc
+}
+
+type paletteEntries []paletteEntry
+
+// String returns the searchable text of the entry at the given index.
+func (ee paletteEntries) String(i int) string {
+	return ee[i].String()
+}
+
+// Len returns the entries count.
+func (ee paletteEntries) Len() int {
+	return len(ee)
+}
+
+// Palette lists all commands, views and key actions with fuzzy search.
+type Palette struct {
+	*tview.List
+
+	app     *App
+	actions ui.KeyActions
+	cmdBuff *model.FishBuff
+	entries paletteEntries
+	matches paletteEntries
+}
+
+// NewPalette returns a new command palette.
+func NewPalette(app *App) *Palette {
+	return &Palette{
+		List:    tview.NewList(),
+		app:     app,
+		actions: *ui.NewKeyActions(),
+		cmdBuff: model.NewFishBuff('>', model.FilterBuffer),
+	}
+}
+
+func (*Palette) SetFilter(string)                 {}
+func (*Palette) SetLabelFilter(map[string]string) {}
+
+// Init initializes the view.
+func (p *Palette) Init(context.Context) error {
+	pickerView := p.app.Styles.Views().Picker
+	p.SetBorder(true)
+	p.SetMainTextColor(pickerView.MainColor.Color())
+	p.ShowSecondaryText(false)
+	p.SetShortcutColor(pickerView.ShortcutColor.Color())
+	p.SetSelectedBackgroundColor(pickerView.FocusColor.Color())
+	p.entries = p.collect()
+	p.cmdBuff.SetSuggestionFn(p.suggest)
+	p.bindKeys()
+	p.SetInputCapture(p.keyboard)
+	p.refresh("")
+	p.updateTitle("", "")
+
+	return nil
+}
+
+func (p *Palette) bindKeys() {
+	p.actions.Bulk(ui.KeyMap{
+		tcell.KeyEnter:      ui.NewKeyAction("Run", p.runCmd, true),
+		tcell.KeyEscape:     ui.NewKeyAction("Back", p.resetCmd, true),
+		tcell.KeyBackspace2: ui.NewSharedKeyAction("Erase", p.eraseCmd, false),
+		tcell.KeyBackspace:  ui.NewSharedKeyAction("Erase", p.eraseCmd, false),
+		tcell.KeyDelete:     ui.NewSharedKeyAction("Erase", p.eraseCmd, false),
+	})
+}
+
+func (p *Palette) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := p.actions.Get(evt.Key()); ok {
+		return a.Action(evt)
+	}
+	if evt.Key() == tcell.KeyRune {
+		p.cmdBuff.Add(evt.Rune())
+		return nil
+	}
+
+	return evt
+}
+
+// InCmdMode checks if prompt is active.
+func (p *Palette) InCmdMode() bool {
+	return p.cmdBuff.IsActive()
+}
+
+// Start starts the view.
+func (p *Palette) Start() {
+	p.cmdBuff.AddListener(p)
+	p.app.Prompt().SetModel(p.cmdBuff)
+	p.cmdBuff.SetActive(true)
+}
+
+// Stop stops the view.
+func (p *Palette) Stop() {
+	p.cmdBuff.Reset()
+	p.cmdBuff.RemoveListener(p)
+}
+
+// Name returns the component name.
+func (*Palette) Name() string { return "palette" }
+
+// Hints returns the view hints.
+func (p *Palette) Hints() model.MenuHints {
+	return p.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*Palette) ExtraHints() map[string]string {
+	return nil
+}
+
+// BufferChanged indicates the buffer was changed.
+func (p *Palette) BufferChanged(text, suggestion string) {
+	p.refresh(text)
+	p.updateTitle(text, suggestion)
+}
+
+// BufferCompleted indicates input was accepted.
+func (p *Palette) BufferCompleted(text, suggestion string) {
+	p.updateTitle(text, suggestion)
+}
+
+// BufferActive indicates the buff activity changed.
+func (*Palette) BufferActive(bool, model.BufferKind) {}
+
+// suggest completes the query with the names of the matching entries.
+func (p *Palette) suggest(text string) sort.StringSlice {
+	var ss sort.StringSlice
+	for _, e := range filterPalette(text, p.entries) {
+		if s, ok := cmd.ShouldAddSuggest(text, e.name); ok {
+			ss = append(ss, s)
+		}
+	}
+
+	return ss
+}
+
+func (p *Palette) refresh(q string) {
+	p.matches = filterPalette(q, p.entries)
+	p.Clear()
+	for _, e := range p.matches {
+		p.AddItem(fmt.Sprintf("[gray]%-8s[-] %-30s [gray]%s", e.kind, e.name, e.desc), "", 0, nil)
+	}
+}
+
+func (p *Palette) updateTitle(text, suggestion string) {
+	p.SetTitle(fmt.Sprintf(paletteTitleFmt, paletteTitle, len(p.matches), text, suggestion))
+}
+
+func (p *Palette) collect() paletteEntries {
+	ee := make(paletteEntries, 0, len(paletteCommands))
+	ee = append(ee, paletteCommands...)
+
+	var vv paletteEntries
+	for gvr, aliases := range p.app.command.alias.ShortNames() {
+		sort.Strings(aliases)
+		vv = append(vv, paletteEntry{
+			kind: "view",
+			name: gvr,
+			desc: client.NewGVR(gvr).R() + " (" + strings.Join(aliases, ",") + ")",
+		})
+	}
+	sort.Slice(vv, func(i, j int) bool { return vv[i].name < vv[j].name })
+	ee = append(ee, vv...)
+
+	kk, seen := make(paletteEntries, 0, p.app.GetActions().Len()), make(map[tcell.Key]struct{})
+	if b, ok := p.app.Content.Top().(keyBinder); ok {
+		kk = appendActions(kk, b.Actions(), seen)
+	}
+	kk = appendActions(kk, p.app.GetActions(), seen)
+	sort.Slice(kk, func(i, j int) bool { return kk[i].name < kk[j].name })
+
+	return append(ee, kk...)
+}
+
+func appendActions(ee paletteEntries, aa *ui.KeyActions, seen map[tcell.Key]struct{}) paletteEntries {
+	aa.Range(func(k tcell.Key, a ui.KeyAction) {
+		if _, ok := seen[k]; ok || k == ui.KeyAltP || a.Description == "" {
+			return
+		}
+		seen[k] = struct{}{}
+		ee = append(ee, paletteEntry{
+			kind: actionSource(a.Opts),
+			name: a.Description,
+			desc: "<" + tcell.KeyNames[k] + ">",
+			key:  k,
+		})
+	})
+
+	return ee
+}
+
+// filterPalette returns the entries fuzzy matching the query, best first.
+func filterPalette(q string, ee paletteEntries) paletteEntries {
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return ee
+	}
+	mm := fuzzy.FindFrom(q, ee)
+	rr := make(paletteEntries, 0, len(mm))
+	for _, m := range mm {
+		rr = append(rr, ee[m.Index])
+	}
+
+	return rr
+}
+
+func (p *Palette) runCmd(evt *tcell.EventKey) *tcell.EventKey {
+	idx := p.GetCurrentItem()
+	if idx < 0 || idx >= len(p.matches) {
+		return nil
+	}
+	e := p.matches[idx]
+	p.app.Content.Pop()
+	p.exec(e)
+
+	return nil
+}
+
+func (p *Palette) exec(e paletteEntry) {
+	switch {
+	case e.key != 0:
+		if err := p.app.runMacroKey(e.key); err != nil {
+			p.app.Flash().Err(err)
+		}
+	case e.args:
+		p.app.ResetPrompt(p.app.CmdBuff())
+		p.app.CmdBuff().SetText(e.name+" ", "")
+	default:
+		p.app.gotoResource(e.name, "", e.kind == "view")
+	}
+}
+
+func (p *Palette) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !p.cmdBuff.Empty() {
+		p.cmdBuff.ClearText(true)
+		p.BufferChanged("", "")
+		return nil
+	}
+
+	return p.app.PrevCmd(evt)
+}
+
+func (p *Palette) eraseCmd(*tcell.EventKey) *tcell.EventKey {
+	p.cmdBuff.Delete()
+
+	return nil
+}
+
+func (a *App) paletteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if top := a.Content.Top(); top != nil && top.Name() == "palette" {
+		return a.PrevCmd(evt)
+	}
+	if err := a.inject(NewPalette(a), false); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
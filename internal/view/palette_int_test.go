@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterPalette(t *testing.T) {
+	ee := paletteEntries{
+		{kind: "command", name: "xray", desc: "Show resources as a tree"},
+		{kind: "view", name: "v1/pods", desc: "pods (po,pod)"},
+		{kind: "k9s", name: "Logs", desc: "<l>"},
+	}
+
+	uu := map[string]struct {
+		q string
+		e []string
+	}{
+		"empty": {
+			e: []string{"xray", "v1/pods", "Logs"},
+		},
+		"name": {
+			q: "pods",
+			e: []string{"v1/pods"},
+		},
+		"fuzzy": {
+			q: "xry",
+			e: []string{"xray"},
+		},
+		"desc": {
+			q: "tree",
+			e: []string{"xray"},
+		},
+		"none": {
+			q: "zorg",
+			e: []string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ss := []string{}
+			for _, e := range filterPalette(u.q, ee) {
+				ss = append(ss, e.name)
+			}
+			assert.Equal(t, u.e, ss)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// pdbDoc documents the pod disruption budget view.
+var pdbDoc = ViewDoc{
+	Summary: "Pod disruption budgets and whether they block node drains",
+	Columns: model.MenuHints{
+		{Mnemonic: "ALLOWED DISRUPTIONS", Description: "Pods that can be evicted right now"},
+		{Mnemonic: "CURRENT", Description: "Healthy pods covered by the budget"},
+		{Mnemonic: "DESIRED", Description: "Healthy pods required by the budget"},
+		{Mnemonic: "DRAINS", Description: "Blocked when no covered pod can be evicted"},
+	},
+	Related: model.MenuHints{
+		{Mnemonic: ":pods", Description: "Covered pods via enter"},
+		{Mnemonic: ":nodes", Description: "Nodes to drain"},
+	},
+}
+
+// PodDisruptionBudget represents a pod disruption budget viewer.
+type PodDisruptionBudget struct {
+	ResourceViewer
+}
+
+// NewPodDisruptionBudget returns a new viewer.
+func NewPodDisruptionBudget(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), pdbDoc)
+	p := PodDisruptionBudget{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetEnterFn(p.showPods)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PodDisruptionBudget) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Drains", p.GetTable().SortColCmd("DRAINS", true), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Allowed", p.GetTable().SortColCmd("ALLOWED DISRUPTIONS", true), false),
+	})
+}
+
+// showPods lists the pods covered by the budget.
+func (p *PodDisruptionBudget) showPods(app *App, _ ui.Tabular, gvr client.GVR, path string) {
+	o, err := app.factory.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		app.Flash().Errf("expecting unstructured but got %T", o)
+		return
+	}
+	var pdb policyv1.PodDisruptionBudget
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pdb); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if pdb.Spec.Selector == nil {
+		app.Flash().Warnf("No pod selector defined on %s", path)
+		return
+	}
+
+	showPodsFromSelector(app, path, pdb.Spec.Selector)
+}
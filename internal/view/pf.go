@@ -7,17 +7,15 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"time"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
-	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 )
 
 const promptPage = "prompt"
@@ -26,7 +24,7 @@ const promptPage = "prompt"
 type PortForward struct {
 	ResourceViewer
 
-	bench *perf.Benchmark
+	benches benchRuns
 }
 
 // NewPortForward returns a new viewer.
@@ -82,71 +80,38 @@ func (p *PortForward) getContext(ctx context.Context) context.Context {
 }
 
 func (p *PortForward) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if p.bench != nil {
-		p.App().Status(model.FlashErr, "Benchmark Canceled!")
-		p.bench.Cancel()
-		p.App().ClearStatus(true)
-		return nil
-	}
-
-	path := p.GetTable().GetSelectedItem()
-	if path == "" {
+	sels := p.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
 		return nil
 	}
-	cfg := dao.BenchConfigFor(p.App().BenchFile, path)
-	cfg.Name = path
-
-	r, _ := p.GetTable().GetSelection()
-	log.Debug().Msgf("PF NS %q", p.GetTable().GetModel().GetNamespace())
-	col := 3
-	if client.IsAllNamespaces(p.GetTable().GetModel().GetNamespace()) {
-		col = 4
-	}
-	base := ui.TrimCell(p.GetTable().SelectTable, r, col)
-	var err error
-	p.bench, err = perf.NewBenchmark(base, p.App().version, cfg)
-	if err != nil {
-		p.App().Flash().Errf("Bench failed %v", err)
-		p.App().ClearStatus(false)
+	if p.benches.cancel(sels) > 0 {
+		p.App().Status(model.FlashErr, "Benchmark Canceled!")
+		p.App().ClearStatus(true)
 		return nil
 	}
 
-	p.App().Status(model.FlashWarn, "Benchmark in progress...")
-	go func() {
-		if err := p.runBenchmark(); err != nil {
-			log.Error().Err(err).Msgf("Benchmark run failed")
+	for _, path := range sels {
+		if err := p.runBenchmark(path); err != nil {
+			p.App().Flash().Errf("Bench failed %v", err)
+			p.App().ClearStatus(false)
 		}
-	}()
+	}
 
 	return nil
 }
 
-func (p *PortForward) runBenchmark() error {
-	log.Debug().Msg("Bench starting...")
-
-	ct, err := p.App().Config.K9s.ActiveContext()
+func (p *PortForward) runBenchmark(path string) error {
+	cfg, err := dao.BenchConfigFor(p.App().BenchFile, path)
+	if err != nil {
+		return err
+	}
+	cfg.Name = path
+	port, err := pfLocalPort(path)
 	if err != nil {
 		return err
 	}
-	name := p.App().Config.K9s.ActiveContextName()
-	p.bench.Run(ct.ClusterName, name, func() {
-		log.Debug().Msgf("Benchmark %q Completed!", name)
-		p.App().QueueUpdate(func() {
-			if p.bench.Canceled() {
-				p.App().Status(model.FlashInfo, "Benchmark canceled")
-			} else {
-				p.App().Status(model.FlashInfo, "Benchmark Completed!")
-				p.bench.Cancel()
-			}
-			p.bench = nil
-			go func() {
-				<-time.After(2 * time.Second)
-				p.App().QueueUpdate(func() { p.App().ClearStatus(true) })
-			}()
-		})
-	})
 
-	return nil
+	return p.benches.run(p.App(), path, urlFor(cfg, port), cfg)
 }
 
 func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -204,6 +169,17 @@ func pfToHuman(s string) (string, error) {
 	return fmt.Sprintf("%s::%s %s->%s", mm[2], mm[3], mm[4], mm[5]), nil
 }
 
+// pfLocalPort extracts a port-forward local port from its id.
+func pfLocalPort(path string) (string, error) {
+	tokens := strings.Split(path, "|")
+	pp := strings.Split(tokens[len(tokens)-1], ":")
+	if len(tokens) < 2 || len(pp) != 2 || pp[0] == "" {
+		return "", fmt.Errorf("unable to parse selection %s", path)
+	}
+
+	return pp[0], nil
+}
+
 func showModal(a *App, msg string, ok func()) {
 	p := a.Content.Pages
 	styles := a.Styles.Dialog()
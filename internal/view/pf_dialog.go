@@ -9,10 +9,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/port"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 )
 
 const portForwardKey = "portforward"
@@ -72,7 +72,7 @@ func ShowPortForwards(v ResourceViewer, path string, ports port.ContainerPortSpe
 		}
 	}
 
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
 		if coField.GetText() == "" || loField.GetText() == "" {
 			v.App().Flash().Err(fmt.Errorf("container to local port mismatch"))
 			return
@@ -87,7 +87,7 @@ func ShowPortForwards(v ResourceViewer, path string, ports port.ContainerPortSpe
 		}
 	})
 	pages := v.App().Content.Pages
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		DismissPortForwards(v, pages)
 	})
 	for i := 0; i < 2; i++ {
@@ -5,6 +5,7 @@ package view
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -15,7 +16,6 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tcell/v2"
-	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -58,13 +58,35 @@ func (p *PortForwardExtender) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
 		p.App().Flash().Err(err)
 		return nil
 	}
-	if err := showFwdDialog(p, podName, startFwdCB); err != nil {
+	cb := startFwdCB
+	if sel, ok := p.podSelector(path); ok {
+		cb = selectorFwdCB(sel)
+	}
+	if err := showFwdDialog(p, podName, cb); err != nil {
 		p.App().Flash().Err(err)
 	}
 
 	return nil
 }
 
+// podSelector returns the selector of the pods backing a resource if any.
+func (p *PortForwardExtender) podSelector(path string) (labels.Selector, bool) {
+	res, err := dao.AccessorFor(p.App().factory, p.GVR())
+	if err != nil {
+		return nil, false
+	}
+	s, ok := res.(dao.PodSelector)
+	if !ok {
+		return nil, false
+	}
+	_, sel, err := s.PodSelector(path)
+	if err != nil {
+		return nil, false
+	}
+
+	return sel, true
+}
+
 func (p *PortForwardExtender) showPFCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := p.GetTable().GetSelectedItem()
 	if path == "" {
@@ -112,6 +134,31 @@ func (p *PortForwardExtender) portForwardContext(ctx context.Context) context.Co
 	return context.WithValue(ctx, internal.KeyPath, p.GetTable().GetSelectedItem())
 }
 
+// fwdCmd port-forwards to a healthy pod matching a selector, moving to
+// another matching pod should it go away.
+func (a *App) fwdCmd(sel, ns string) error {
+	v, ok := a.Content.Top().(ResourceViewer)
+	if !ok {
+		return errors.New("port-forwards must be started from a resource view")
+	}
+	if ns == "" {
+		ns = a.Config.ActiveNamespace()
+	}
+	if client.IsAllNamespaces(ns) {
+		return errors.New("a namespace is required to forward to a selector")
+	}
+	s, err := labels.Parse(sel)
+	if err != nil {
+		return err
+	}
+	path, err := dao.HealthyPod(a.factory, ns, s, "")
+	if err != nil {
+		return err
+	}
+
+	return showFwdDialog(v, path, selectorFwdCB(s))
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -135,16 +182,50 @@ func runForward(v ResourceViewer, pf watch.Forwarder, f *portforward.PortForward
 	})
 
 	pf.SetActive(true)
-	if err := f.ForwardPorts(); err != nil {
+	err := f.ForwardPorts()
+	if err != nil {
 		v.App().Flash().Err(err)
 	}
+	// A still active forward lost its pod rather than being stopped.
+	lost := err != nil && pf.Active()
 	v.App().QueueUpdateDraw(func() {
 		v.App().factory.DeleteForwarder(pf.ID())
 		pf.SetActive(false)
+		if lost {
+			go repickForward(v, pf)
+		}
 	})
 }
 
+// repickForward moves a selector backed forward to another healthy pod.
+func repickForward(v ResourceViewer, pf watch.Forwarder) {
+	rp, ok := pf.(*dao.PortForwarder)
+	if !ok || !rp.Retargetable() {
+		return
+	}
+	fwd, err := rp.Repick()
+	if err != nil {
+		v.App().Flash().Errf("PortForward %s lost: %s", rp.LocalPort(), err)
+		return
+	}
+	log.Debug().Msgf(">>> Repicked port forward %q", rp.ID())
+	v.App().Flash().Infof("PortForward %s moved to %s", rp.LocalPort(), rp.FQN())
+	runForward(v, rp, fwd)
+}
+
+// selectorFwdCB returns a callback starting port forwards backed by any
+// healthy pod matching a selector.
+func selectorFwdCB(sel labels.Selector) PortForwardCB {
+	return func(v ResourceViewer, path string, pts port.PortTunnels) error {
+		return startFwds(v, path, pts, sel)
+	}
+}
+
 func startFwdCB(v ResourceViewer, path string, pts port.PortTunnels) error {
+	return startFwds(v, path, pts, nil)
+}
+
+func startFwds(v ResourceViewer, path string, pts port.PortTunnels, sel labels.Selector) error {
 	if err := pts.CheckAvailable(); err != nil {
 		return err
 	}
@@ -155,6 +236,9 @@ func startFwdCB(v ResourceViewer, path string, pts port.PortTunnels) error {
 			return fmt.Errorf("port-forward is already active on pod %s", path)
 		}
 		pf := dao.NewPortForwarder(v.App().factory)
+		if sel != nil {
+			pf.SetSelector(sel)
+		}
 		fwd, err := pf.Start(path, pt)
 		if err != nil {
 			return err
@@ -201,7 +285,7 @@ func showFwdDialog(v ResourceViewer, path string, cb PortForwardCB) error {
 			return err
 		}
 
-		return startFwdCB(v, path, pts)
+		return cb(v, path, pts)
 	}
 
 	ShowPortForwards(v, path, ports, anns, cb)
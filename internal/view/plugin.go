@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const pluginRowsTitle = "Plugin"
+
+// PluginRows represents a view of rows returned by a plugin.
+type PluginRows struct {
+	ResourceViewer
+}
+
+// NewPluginRows returns a new plugin rows view.
+func NewPluginRows(gvr client.GVR) ResourceViewer {
+	v := PluginRows{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.AddBindKeysFn(v.bindKeys)
+	v.GetTable().SetEnterFn(func(*App, ui.Tabular, client.GVR, string) {})
+
+	return &v
+}
+
+// Name returns the component name.
+func (*PluginRows) Name() string { return pluginRowsTitle }
+
+func (*PluginRows) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlZ, tcell.KeyCtrlW)
+}
+
+// pluginContext represents the context v2 plugins receive on stdin.
+type pluginContext struct {
+	APIVersion string      `json:"apiVersion"`
+	Context    string      `json:"context"`
+	Cluster    string      `json:"cluster"`
+	Namespace  string      `json:"namespace"`
+	GVR        string      `json:"gvr"`
+	ReadOnly   bool        `json:"readOnly"`
+	Rows       []pluginRow `json:"rows"`
+}
+
+// pluginRow represents a selected row.
+type pluginRow struct {
+	Path      string            `json:"path"`
+	Namespace string            `json:"namespace,omitempty"`
+	Name      string            `json:"name"`
+	Fields    map[string]string `json:"fields,omitempty"`
+}
+
+// pluginTable represents the rows returned by a table output plugin.
+type pluginTable struct {
+	Columns []string        `json:"columns"`
+	Rows    [][]interface{} `json:"rows"`
+}
+
+// rowSelector represents a viewer with selectable rows.
+type rowSelector interface {
+	GVR() client.GVR
+	GetSelectedItems() []string
+	GetSelectedRow(string) *model1.Row
+	GetModel() ui.Tabular
+}
+
+func newPluginContext(r Runner) pluginContext {
+	a := r.App()
+	pc := pluginContext{
+		APIVersion: config.PluginAPIv2,
+		Context:    a.Config.K9s.ActiveContextName(),
+		Namespace:  a.Config.ActiveNamespace(),
+		ReadOnly:   a.Config.K9s.IsReadOnly(),
+	}
+	if n, err := a.Conn().Config().CurrentClusterName(); err == nil {
+		pc.Cluster = n
+	}
+
+	s, ok := r.(rowSelector)
+	if !ok {
+		pc.Rows = []pluginRow{toPluginRow(r.GetSelectedItem(), nil, nil)}
+		return pc
+	}
+	pc.GVR = s.GVR().String()
+	h := s.GetModel().Peek().Header()
+	for _, path := range s.GetSelectedItems() {
+		pc.Rows = append(pc.Rows, toPluginRow(path, h, s.GetSelectedRow(path)))
+	}
+
+	return pc
+}
+
+func toPluginRow(path string, h model1.Header, row *model1.Row) pluginRow {
+	pr := pluginRow{Path: path}
+	pr.Namespace, pr.Name = client.Namespaced(path)
+	if row == nil {
+		return pr
+	}
+	pr.Fields = make(map[string]string, len(h))
+	for i, c := range h {
+		if i < len(row.Fields) {
+			pr.Fields[c.Name] = row.Fields[i]
+		}
+	}
+
+	return pr
+}
+
+func toPluginTable(bb []byte) (*metav1.Table, error) {
+	var pt pluginTable
+	if err := json.Unmarshal(bb, &pt); err != nil {
+		return nil, fmt.Errorf("invalid plugin table output: %w", err)
+	}
+	if len(pt.Columns) == 0 {
+		return nil, errors.New("invalid plugin table output: no columns found")
+	}
+
+	t := metav1.Table{
+		ColumnDefinitions: make([]metav1.TableColumnDefinition, 0, len(pt.Columns)),
+		Rows:              make([]metav1.TableRow, 0, len(pt.Rows)),
+	}
+	for _, c := range pt.Columns {
+		t.ColumnDefinitions = append(t.ColumnDefinitions, metav1.TableColumnDefinition{Name: c})
+	}
+	for i, cells := range pt.Rows {
+		if len(cells) > len(pt.Columns) {
+			return nil, fmt.Errorf("invalid plugin table output: row #%d has %d cells but only %d columns", i, len(cells), len(pt.Columns))
+		}
+		t.Rows = append(t.Rows, metav1.TableRow{Cells: cells})
+	}
+
+	return &t, nil
+}
+
+// runCapture runs a plugin in the background and renders its output.
+func runCapture(a *App, p config.Plugin, opts shellOpts) {
+	a.Flash().Infof("Running plugin %q...", p.Description)
+	go func() {
+		out, err := capture(opts)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Errf("Plugin command failed: %s", err)
+				return
+			}
+			if err := showPluginOutput(a, p, out); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+func showPluginOutput(a *App, p config.Plugin, out string) error {
+	if p.Output == config.PluginOutputText {
+		return a.inject(NewDetails(a, pluginRowsTitle, p.Description, contentTXT, true).Update(out), false)
+	}
+
+	t, err := toPluginTable([]byte(out))
+	if err != nil {
+		return err
+	}
+	v := NewPluginRows(client.NewGVR("pluginrows"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPluginRows, t)
+	})
+
+	return a.inject(v, false)
+}
+
+// runJob runs a background plugin as a job and captures its output.
+func runJob(a *App, p config.Plugin, opts shellOpts) {
+	j, err := a.jobs.Start(p.Description, opts.binary, opts.args, opts.input, func(j *dao.BackgroundJob) {
+		a.QueueUpdateDraw(func() {
+			switch j.Status() {
+			case dao.JobFailed:
+				a.Flash().Errf("Plugin job #%s %q failed: %s", j.ID(), j.Name(), j.Err())
+			case dao.JobKilled:
+				a.Flash().Warnf("Plugin job #%s %q killed", j.ID(), j.Name())
+			default:
+				if msg := firstLine(j.Output()); p.OverwriteOutput && msg != "" {
+					a.Flash().Info(msg)
+					return
+				}
+				a.Flash().Infof("Plugin job #%s %q completed", j.ID(), j.Name())
+			}
+		})
+	})
+	if err != nil {
+		a.Flash().Errf("Plugin command failed: %s", err)
+		return
+	}
+	a.Flash().Infof("Plugin job #%s launched: %q", j.ID(), p.Description)
+}
+
+func firstLine(s string) string {
+	for _, l := range strings.Split(s, "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			return l
+		}
+	}
+
+	return ""
+}
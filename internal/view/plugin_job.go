@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const pluginJobsTitle = "PluginJobs"
+
+// PluginJobs represents a view of background plugin jobs.
+type PluginJobs struct {
+	ResourceViewer
+}
+
+// NewPluginJobs returns a new plugin jobs view.
+func NewPluginJobs(gvr client.GVR) ResourceViewer {
+	v := PluginJobs{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.GetTable().SetSortCol(ageCol, true)
+	v.SetContextFn(v.jobsContext)
+	v.AddBindKeysFn(v.bindKeys)
+	v.GetTable().SetEnterFn(v.showOutput)
+
+	return &v
+}
+
+// Name returns the component name.
+func (*PluginJobs) Name() string { return pluginJobsTitle }
+
+func (v *PluginJobs) jobsContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPluginJobs, v.App().jobs)
+}
+
+func (v *PluginJobs) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyCtrlK: ui.NewKeyAction("Kill", v.killCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", v.deleteCmd, true),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Status", v.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftD:   ui.NewKeyAction("Sort Duration", v.GetTable().SortColCmd("DURATION", true), false),
+	})
+}
+
+func (v *PluginJobs) showOutput(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	j, ok := app.jobs.Get(path)
+	if !ok {
+		app.Flash().Errf("No plugin job found with id %q", path)
+		return
+	}
+
+	out := j.Output()
+	if err := j.Err(); err != nil {
+		out += "\n" + err.Error()
+	}
+	title := fmt.Sprintf("%s [%s]", j.Name(), j.Status())
+	if err := app.inject(NewDetails(app, "Output", title, contentTXT, true).Update(out), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (v *PluginJobs) killCmd(evt *tcell.EventKey) *tcell.EventKey {
+	selections := v.GetTable().GetSelectedItems()
+	if len(selections) == 0 {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Kill job %s?", selections[0])
+	if len(selections) > 1 {
+		msg = fmt.Sprintf("Kill %d marked jobs?", len(selections))
+	}
+	showModal(v.App(), msg, func() {
+		for _, s := range selections {
+			if err := v.App().jobs.Kill(s); err != nil {
+				v.App().Flash().Err(err)
+				return
+			}
+		}
+		v.App().Flash().Infof("Killed %d plugin job(s)", len(selections))
+		v.GetTable().Refresh()
+	})
+
+	return nil
+}
+
+func (v *PluginJobs) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.GetTable().CmdBuff().Empty() {
+		v.GetTable().CmdBuff().Reset()
+		return nil
+	}
+
+	selections := v.GetTable().GetSelectedItems()
+	if len(selections) == 0 {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Delete job %s?", selections[0])
+	if len(selections) > 1 {
+		msg = fmt.Sprintf("Delete %d marked jobs?", len(selections))
+	}
+	showModal(v.App(), msg, func() {
+		var j dao.PluginJob
+		j.Init(v.App().factory, v.GVR())
+		ctx := v.jobsContext(context.Background())
+		for _, s := range selections {
+			if err := j.Delete(ctx, s, nil, dao.DefaultGrace); err != nil {
+				v.App().Flash().Err(err)
+				return
+			}
+		}
+		v.App().Flash().Infof("Successfully deleted %d plugin job(s)", len(selections))
+		v.GetTable().Refresh()
+	})
+
+	return nil
+}
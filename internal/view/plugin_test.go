@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ rowSelector = (*Browser)(nil)
+
+func TestToPluginRow(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+	}
+	uu := map[string]struct {
+		path string
+		row  *model1.Row
+		e    pluginRow
+	}{
+		"no-row": {
+			path: "ns1/fred",
+			e:    pluginRow{Path: "ns1/fred", Namespace: "ns1", Name: "fred"},
+		},
+		"cluster": {
+			path: "fred",
+			row:  &model1.Row{ID: "fred", Fields: model1.Fields{"fred", "Running"}},
+			e: pluginRow{
+				Path:   "fred",
+				Name:   "fred",
+				Fields: map[string]string{"NAME": "fred", "STATUS": "Running"},
+			},
+		},
+		"short": {
+			path: "ns1/fred",
+			row:  &model1.Row{ID: "ns1/fred", Fields: model1.Fields{"fred"}},
+			e: pluginRow{
+				Path:      "ns1/fred",
+				Namespace: "ns1",
+				Name:      "fred",
+				Fields:    map[string]string{"NAME": "fred"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, toPluginRow(u.path, h, u.row))
+		})
+	}
+}
+
+func TestToPluginTable(t *testing.T) {
+	uu := map[string]struct {
+		out  string
+		cols int
+		rows int
+		err  error
+	}{
+		"happy": {
+			out:  `{"columns": ["NAME", "COUNT"], "rows": [["fred", 1], ["blee"]]}`,
+			cols: 2,
+			rows: 2,
+		},
+		"empty": {
+			out:  `{"columns": ["NAME"]}`,
+			cols: 1,
+		},
+		"no-cols": {
+			out: `{"rows": [["fred"]]}`,
+			err: errors.New("invalid plugin table output: no columns found"),
+		},
+		"too-many-cells": {
+			out: `{"columns": ["NAME"], "rows": [["fred", 1]]}`,
+			err: errors.New("invalid plugin table output: row #0 has 2 cells but only 1 columns"),
+		},
+		"toast": {
+			out: `blee`,
+			err: errors.New("invalid plugin table output: invalid character 'b' looking for beginning of value"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			table, err := toPluginTable([]byte(u.out))
+			if u.err != nil {
+				assert.Equal(t, u.err.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.cols, len(table.ColumnDefinitions))
+			assert.Equal(t, u.rows, len(table.Rows))
+		})
+	}
+}
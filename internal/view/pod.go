@@ -21,7 +21,6 @@ import (
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 	"github.com/fatih/color"
-	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -41,28 +40,76 @@ const (
 	magicPrompt      = "Yes Please!"
 )
 
+// podDoc documents the pod view.
+var podDoc = ViewDoc{
+	Summary: "Pods running in the active namespace",
+	Columns: model.MenuHints{
+		{Mnemonic: "PF", Description: "Active port-forwards"},
+		{Mnemonic: "READY", Description: "Ready/total containers"},
+		{Mnemonic: "STATUS", Description: "Pod phase or container reason"},
+		{Mnemonic: "RESTARTS", Description: "Container restarts count"},
+		{Mnemonic: "CPU", Description: "CPU usage in millicores"},
+		{Mnemonic: "MEM", Description: "Memory usage in MiB"},
+		{Mnemonic: "%CPU/R", Description: "CPU usage over requests"},
+		{Mnemonic: "%CPU/L", Description: "CPU usage over limits"},
+		{Mnemonic: "%MEM/R", Description: "Memory usage over requests"},
+		{Mnemonic: "%MEM/L", Description: "Memory usage over limits"},
+		{Mnemonic: "NODE", Description: "Node the pod is scheduled on"},
+		{Mnemonic: "VALID", Description: "Pod sanitizer findings"},
+	},
+	Related: model.MenuHints{
+		{Mnemonic: ":containers", Description: "Pod containers"},
+		{Mnemonic: ":deploy", Description: "Owning deployments"},
+		{Mnemonic: ":events", Description: "Namespace events"},
+		{Mnemonic: ":pulses", Description: "Cluster pulses"},
+	},
+}
+
 // Pod represents a pod viewer.
 type Pod struct {
 	ResourceViewer
+
+	trends *usageTrends
 }
 
 // NewPod returns a new viewer.
 func NewPod(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), podDoc)
 	var p Pod
 	p.ResourceViewer = NewPortForwardExtender(
 		NewVulnerabilityExtender(
 			NewImageExtender(
-				NewLogsExtender(NewBrowser(gvr), p.logOptions),
+				NewLogsExtender(NewWatchExtender(NewBrowser(gvr)), p.logOptions),
 			),
 		),
 	)
 	p.AddBindKeysFn(p.bindKeys)
 	p.GetTable().SetEnterFn(p.showContainers)
-	p.GetTable().SetDecorateFn(p.portForwardIndicator)
+	p.GetTable().SetDecorateFn(p.decorate)
 
 	return &p
 }
 
+// Start starts the view.
+func (p *Pod) Start() {
+	stopTrends(p, p.trends)
+	p.trends = startTrends(p)
+	p.ResourceViewer.Start()
+}
+
+// Stop stops the view.
+func (p *Pod) Stop() {
+	stopTrends(p, p.trends)
+	p.ResourceViewer.Stop()
+}
+
+func (p *Pod) decorate(data *model1.TableData) {
+	p.portForwardIndicator(data)
+	if p.trends != nil {
+		p.trends.decorate(data)
+	}
+}
+
 func (p *Pod) portForwardIndicator(data *model1.TableData) {
 	ff := p.App().factory.Forwarders()
 
@@ -127,12 +174,20 @@ func (p *Pod) bindKeys(aa *ui.KeyActions) {
 
 	aa.Bulk(ui.KeyMap{
 		ui.KeyO:      ui.NewKeyAction("Show Node", p.showNode, true),
+		ui.KeyShiftW: ui.NewKeyAction("NetPol Sim", p.netSimCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Diagnose", p.diagCmd, true),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
 		ui.KeyShiftI: ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd("IP", true), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd("NODE", true), false),
 	})
+	if _, ok := promDatasource(p.App()); ok {
+		aa.Add(ui.KeyShiftG, ui.NewKeyAction("Graphs", p.graphsCmd, true))
+	}
+	if p.App().Config.K9s.ImageScans.Scanner != "" {
+		aa.Add(ui.KeyAltV, ui.NewKeyAction("Scan Images", p.scanCmd, true))
+	}
 	aa.Merge(resourceSorters(p.GetTable()))
 }
 
@@ -164,6 +219,16 @@ func (p *Pod) coContext(ctx context.Context) context.Context {
 
 // Handlers...
 
+func (p *Pod) graphsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showPromGraphs(p.App(), path, client.PodPromGraphs(path))
+
+	return nil
+}
+
 func (p *Pod) showNode(evt *tcell.EventKey) *tcell.EventKey {
 	path := p.GetTable().GetSelectedItem()
 	if path == "" {
@@ -188,6 +253,53 @@ func (p *Pod) showNode(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) netSimCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	v := NewNetSim(client.NewGVR("netsim"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := p.App().inject(v, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) diagCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	v := NewPodDiag(client.NewGVR("poddiag"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := p.App().inject(v, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) scanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	refs, err := podImageRefs(p.App(), path, "")
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	scanImages(p.App(), refs)
+
+	return nil
+}
+
 func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	selections := p.GetTable().GetSelectedItems()
 	if len(selections) == 0 {
@@ -204,6 +316,26 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 		p.App().Flash().Err(fmt.Errorf("expecting a nuker for %q", p.GVR()))
 		return nil
 	}
+	for _, sel := range selections {
+		if !isProtected(p.App(), p.GVR(), sel) {
+			continue
+		}
+		msg := fmt.Sprintf("Kill %s %s?", p.GVR().R(), selections[0])
+		if len(selections) > 1 {
+			msg = fmt.Sprintf("Kill %d marked %s?", len(selections), p.GVR())
+		}
+		accept := protectedAccept(selections)
+		dialog.ShowConfirmAck(p.App().App, p.App().Content.Pages, accept, true, "Confirm Kill", protectedMsg(msg, accept), func() {
+			p.kill(nuker, selections)
+		}, func() {})
+		return nil
+	}
+	p.kill(nuker, selections)
+
+	return nil
+}
+
+func (p *Pod) kill(nuker dao.Nuker, selections []string) {
 	if len(selections) > 1 {
 		p.App().Flash().Infof("Delete %d marked %s", len(selections), p.GVR())
 	} else {
@@ -219,8 +351,6 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 		p.GetTable().DeleteMark(path)
 	}
 	p.Refresh()
-
-	return nil
 }
 
 func (p *Pod) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
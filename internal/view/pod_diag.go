@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const diagNextCol = "NEXT"
+
+// PodDiag represents a pod troubleshooting view.
+type PodDiag struct {
+	ResourceViewer
+}
+
+// NewPodDiag returns a new pod troubleshooting view.
+func NewPodDiag(gvr client.GVR) ResourceViewer {
+	p := PodDiag{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PodDiag) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyEnter: ui.NewKeyAction("Run Next", p.nextCmd, true),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Check", p.GetTable().SortColCmd("CHECK", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Result", p.GetTable().SortColCmd("RESULT", true), false),
+	})
+}
+
+// nextCmd runs the suggested k9s command of the selected finding.
+func (p *PodDiag) nextCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	row := p.GetTable().GetSelectedRow(path)
+	if row == nil {
+		return nil
+	}
+	idx, ok := p.GetTable().GetModel().Peek().Header().IndexOf(diagNextCol, true)
+	if !ok {
+		return nil
+	}
+	next := row.Fields[idx]
+	if !strings.HasPrefix(next, ":") {
+		p.App().Flash().Warn("No command to run for this finding")
+		return nil
+	}
+	p.App().gotoResource(strings.TrimPrefix(next, ":"), "", false)
+
+	return nil
+}
@@ -19,7 +19,7 @@ func TestPodNew(t *testing.T) {
 
 	assert.Nil(t, po.Init(makeCtx()))
 	assert.Equal(t, "Pods", po.Name())
-	assert.Equal(t, 27, len(po.Hints()))
+	assert.Equal(t, 32, len(po.Hints()))
 }
 
 // Helpers...
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// PopeyeScore represents a popeye scores trend view.
+type PopeyeScore struct {
+	ResourceViewer
+}
+
+// NewPopeyeScore returns a new scores view.
+func NewPopeyeScore(gvr client.GVR) ResourceViewer {
+	p := PopeyeScore{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetSortCol("TIME", false)
+	p.GetTable().SetEnterFn(blankEnterFn)
+	p.SetContextFn(p.scoresContext)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PopeyeScore) scoresContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, p.App().Config.K9s.ContextPopeyeScoresFile())
+}
+
+func (p *PopeyeScore) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyS, ui.NewKeyAction("Scan Now", p.scanCmd, true))
+}
+
+func (p *PopeyeScore) scanCmd(*tcell.EventKey) *tcell.EventKey {
+	p.App().Flash().Info("Popeye scan in progress...")
+	go p.App().popeyeScan()
+
+	return nil
+}
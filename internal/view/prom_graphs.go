@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const promGraphsTitle = "Graphs"
+
+// PromGraphs presents prometheus time-series graphs for a resource.
+type PromGraphs struct {
+	*tview.Flex
+
+	app      *App
+	subject  string
+	cfg      *data.Prometheus
+	prom     *client.Prometheus
+	graphs   []client.PromGraph
+	charts   []*tchart.SparkLine
+	actions  *ui.KeyActions
+	cancelFn context.CancelFunc
+}
+
+// NewPromGraphs returns a new graphs viewer.
+func NewPromGraphs(app *App, subject string, cfg *data.Prometheus, gg []client.PromGraph) *PromGraphs {
+	return &PromGraphs{
+		Flex:    tview.NewFlex(),
+		app:     app,
+		subject: subject,
+		cfg:     cfg,
+		prom:    client.NewPrometheus(cfg.URL, cfg.InsecureSkipVerify),
+		graphs:  gg,
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func (p *PromGraphs) SetFilter(string)                 {}
+func (p *PromGraphs) SetLabelFilter(map[string]string) {}
+
+// Init initializes the viewer.
+func (p *PromGraphs) Init(context.Context) error {
+	p.SetBorder(true)
+	p.SetBorderPadding(0, 0, 1, 1)
+	p.SetDirection(tview.FlexRow)
+	p.SetTitle(ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, promGraphsTitle, p.subject), p.app.Styles.Frame()))
+
+	p.charts = make([]*tchart.SparkLine, 0, len(p.graphs))
+	for i, g := range p.graphs {
+		s := tchart.NewSparkLine(g.Title)
+		s.SetBorderPadding(0, 1, 0, 1)
+		s.SetMultiSeries(g.S2 != nil)
+		s.SetLegend(fmt.Sprintf(" %s ", g.Title))
+		p.charts = append(p.charts, s)
+		p.AddItem(s, 0, 1, i == 0)
+	}
+	p.bindKeys()
+	p.SetInputCapture(p.keyboard)
+	p.app.Styles.AddListener(p)
+	p.StylesChanged(p.app.Styles)
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*PromGraphs) InCmdMode() bool {
+	return false
+}
+
+// StylesChanged notifies the skin changed.
+func (p *PromGraphs) StylesChanged(s *config.Styles) {
+	p.SetBackgroundColor(s.Charts().BgColor.Color())
+	for _, c := range p.charts {
+		c.SetBackgroundColor(s.Charts().ChartBgColor.Color())
+		c.SetSeriesColors(s.Charts().DefaultChartColors.Colors()...)
+	}
+}
+
+func (p *PromGraphs) bindKeys() {
+	p.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", p.app.PrevCmd, false),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", p.refreshCmd, true),
+	})
+}
+
+func (p *PromGraphs) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := p.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (p *PromGraphs) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	go p.refresh(context.Background())
+
+	return nil
+}
+
+// Name returns the component name.
+func (p *PromGraphs) Name() string { return promGraphsTitle }
+
+// Start starts the graphs updater.
+func (p *PromGraphs) Start() {
+	p.Stop()
+
+	var ctx context.Context
+	ctx, p.cancelFn = context.WithCancel(context.Background())
+	go p.updater(ctx)
+}
+
+// Stop terminates the graphs updater.
+func (p *PromGraphs) Stop() {
+	if p.cancelFn == nil {
+		return
+	}
+	p.cancelFn()
+	p.cancelFn = nil
+	p.app.Styles.RemoveListener(p)
+}
+
+// Hints returns menu hints.
+func (p *PromGraphs) Hints() model.MenuHints {
+	return p.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (p *PromGraphs) ExtraHints() map[string]string {
+	return nil
+}
+
+func (p *PromGraphs) updater(ctx context.Context) {
+	p.refresh(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.cfg.QueryStep()):
+			p.refresh(ctx)
+		}
+	}
+}
+
+func (p *PromGraphs) refresh(ctx context.Context) {
+	r := client.NewPromRange(p.cfg.QueryRange(), p.cfg.QueryStep())
+	for i, g := range p.graphs {
+		s1, s2, err := p.fetch(ctx, g, r)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Warn().Err(err).Msgf("Prometheus query failed for %s", g.Title)
+			p.app.Flash().Errf("Prometheus query failed: %s", err)
+			return
+		}
+		p.charts[i].SetMetrics(toPromMetrics(s1, s2))
+		p.charts[i].SetLegend(promLegend(g, s1, s2))
+	}
+	p.app.QueueUpdateDraw(func() {})
+}
+
+func (p *PromGraphs) fetch(ctx context.Context, g client.PromGraph, r client.PromRange) ([]float64, []float64, error) {
+	s1, err := p.query(ctx, g.S1, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	s2, err := p.query(ctx, g.S2, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s1, s2, nil
+}
+
+func (p *PromGraphs) query(ctx context.Context, s *client.PromSeries, r client.PromRange) ([]float64, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	return p.prom.QueryRange(ctx, s.Query, r)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// promDatasource returns the active context prometheus datasource if any.
+func promDatasource(app *App) (*data.Prometheus, bool) {
+	ct, err := app.Config.K9s.ActiveContext()
+	if err != nil || !ct.Prometheus.IsSet() {
+		return nil, false
+	}
+
+	return ct.Prometheus, true
+}
+
+func showPromGraphs(app *App, subject string, gg []client.PromGraph) {
+	cfg, ok := promDatasource(app)
+	if !ok {
+		app.Flash().Warn("No prometheus datasource configured for this context")
+		return
+	}
+	if err := app.inject(NewPromGraphs(app, subject, cfg, gg), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// toPromMetrics zips two series into chart metrics, aligning them on their most
+// recent samples.
+func toPromMetrics(s1, s2 []float64) []tchart.Metric {
+	n := max(len(s1), len(s2))
+	mm := make([]tchart.Metric, n)
+	for i, v := range s1 {
+		mm[n-len(s1)+i].S1 = int64(v)
+	}
+	for i, v := range s2 {
+		mm[n-len(s2)+i].S2 = int64(v)
+	}
+
+	return mm
+}
+
+func promLegend(g client.PromGraph, s1, s2 []float64) string {
+	legend := " " + g.Title
+	if g.S1 != nil {
+		legend += " " + promSample(g.S1, s1)
+	}
+	if g.S2 != nil {
+		legend += " " + promSample(g.S2, s2)
+	}
+
+	return legend + " "
+}
+
+func promSample(s *client.PromSeries, vv []float64) string {
+	if len(vv) == 0 {
+		return fmt.Sprintf("%s:n/a", s.Name)
+	}
+
+	return fmt.Sprintf("%s:%.0f%s", s.Name, vv[len(vv)-1], s.Unit)
+}
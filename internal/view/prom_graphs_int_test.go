@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToPromMetrics(t *testing.T) {
+	uu := map[string]struct {
+		s1, s2 []float64
+		e      []tchart.Metric
+	}{
+		"empty": {
+			e: []tchart.Metric{},
+		},
+		"single": {
+			s1: []float64{1.2, 2.8},
+			e:  []tchart.Metric{{S1: 1}, {S1: 2}},
+		},
+		"aligned": {
+			s1: []float64{1, 2, 3},
+			s2: []float64{5, 6},
+			e:  []tchart.Metric{{S1: 1}, {S1: 2, S2: 5}, {S1: 3, S2: 6}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, toPromMetrics(u.s1, u.s2))
+		})
+	}
+}
+
+func TestPromLegend(t *testing.T) {
+	gg := client.PodPromGraphs("ns1/p1")
+
+	assert.Equal(t, " CPU cpu:12m ", promLegend(gg[0], []float64{10, 12.2}, nil))
+	assert.Equal(t, " NET rx:3KiB/s tx:n/a ", promLegend(gg[2], []float64{3}, nil))
+}
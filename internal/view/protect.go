@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+// isProtected checks if a resource is guarded against accidental deletions.
+func isProtected(app *App, gvr client.GVR, path string) bool {
+	pp := app.Config.K9s.Protect
+	if len(pp) == 0 {
+		return false
+	}
+	rr := []string{gvr.R()}
+	if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil {
+		rr = append(rr, meta.SingularName)
+		rr = append(rr, meta.ShortNames...)
+	}
+	ns, n := client.Namespaced(path)
+
+	return pp.IsProtected(rr, ns, n)
+}
+
+// protectedAccept returns the text to type in to confirm a protected deletion.
+func protectedAccept(paths []string) string {
+	if len(paths) > 1 {
+		return strconv.Itoa(len(paths))
+	}
+	_, n := client.Namespaced(paths[0])
+
+	return n
+}
+
+func protectedMsg(msg, accept string) string {
+	return fmt.Sprintf("%s\nProtected resource! Type %q to confirm.", msg, accept)
+}
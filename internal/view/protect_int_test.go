@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsProtected(t *testing.T) {
+	uu := map[string]struct {
+		pp   config.Protections
+		gvr  string
+		path string
+		e    bool
+	}{
+		"none": {
+			gvr:  "v1/namespaces",
+			path: "fred",
+		},
+		"resource": {
+			pp:   config.Protections{"namespaces"},
+			gvr:  "v1/namespaces",
+			path: "fred",
+			e:    true,
+		},
+		"glob": {
+			pp:   config.Protections{"prod-*"},
+			gvr:  "v1/pods",
+			path: "prod-eu/fred",
+			e:    true,
+		},
+		"unprotected": {
+			pp:   config.Protections{"nodes", "prod-*"},
+			gvr:  "v1/pods",
+			path: "default/fred",
+		},
+	}
+
+	a := NewApp(mock.NewMockConfig())
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a.Config.K9s.Protect = u.pp
+			assert.Equal(t, u.e, isProtected(a, client.NewGVR(u.gvr), u.path))
+		})
+	}
+}
+
+func TestProtectedAccept(t *testing.T) {
+	assert.Equal(t, "fred", protectedAccept([]string{"default/fred"}))
+	assert.Equal(t, "n1", protectedAccept([]string{"n1"}))
+	assert.Equal(t, "2", protectedAccept([]string{"default/fred", "default/blee"}))
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// defaultProxyPort tracks the default proxy local port.
+const defaultProxyPort = "1080"
+
+// proxyDoc documents the proxies view.
+var proxyDoc = ViewDoc{
+	Summary: "Local SOCKS5/HTTP proxies relaying connections from within the cluster. Hosts are resolved by the cluster DNS",
+	Columns: model.MenuHints{
+		{Mnemonic: "PORT", Description: "Local proxy port"},
+		{Mnemonic: "PROXY", Description: "Proxy url to configure your client with"},
+		{Mnemonic: "POD", Description: "Pod relaying the proxied connections"},
+		{Mnemonic: "CONNS", Description: "Active proxied connections"},
+		{Mnemonic: "IN", Description: "Bytes received from the cluster"},
+		{Mnemonic: "OUT", Description: "Bytes sent to the cluster"},
+		{Mnemonic: "VALID", Description: "Last proxied connection error"},
+	},
+}
+
+// Proxy represents the cluster proxies view.
+type Proxy struct {
+	ResourceViewer
+}
+
+// NewProxy returns a new cluster proxies view.
+func NewProxy(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), proxyDoc)
+	p := Proxy{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetSortCol("PORT", true)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+// Init initializes the view.
+func (p *Proxy) Init(ctx context.Context) error {
+	if err := p.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	p.GetTable().GetModel().SetNamespace(client.NotNamespaced)
+
+	return nil
+}
+
+func (p *Proxy) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	if p.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyA, ui.NewKeyActionWithOpts("Start Proxy", p.startCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}))
+}
+
+func (p *Proxy) startCmd(evt *tcell.EventKey) *tcell.EventKey {
+	app := p.App()
+	dialog.ShowInput(app.Styles.Dialog(), app.Content.Pages, dialog.InputDialogOpts{
+		Title:   "Start Proxy",
+		Message: "Relays SOCKS5 and HTTP connections through a pod in namespace " + app.Config.K9s.ShellPod.Namespace,
+		Label:   "Local Port:",
+		Value:   defaultProxyPort,
+		Ack: func(port string) {
+			if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+				app.Flash().Errf("Invalid proxy port %q", port)
+				return
+			}
+			startProxy(app, port)
+		},
+		Cancel: func() {},
+	})
+
+	return nil
+}
+
+func startProxy(app *App, port string) {
+	msg := fmt.Sprintf("Launching proxy pod for port %s...", port)
+	dialog.ShowPrompt(app.Styles.Dialog(), app.Content.Pages, "Launching", msg, func(ctx context.Context) {
+		cp, err := dao.StartProxy(ctx, app.factory, app.Config.K9s.ShellPod, port)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				app.Flash().Errf("Starting proxy failed: %s", err)
+			}
+			return
+		}
+		app.Flash().Infof("SOCKS5/HTTP proxy listening on %s via pod %s", cp.Address(), cp.Pod)
+	}, func() {})
+}
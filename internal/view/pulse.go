@@ -52,7 +52,14 @@ type Graphable interface {
 	IsDial() bool
 }
 
-const pulseTitle = "Pulses"
+const (
+	pulseTitle = "Pulses"
+
+	pulseMinCols     = 8
+	pulseGaugeHeight = 2
+	pulseChartHeight = 3
+	pulseMxHeight    = 2
+)
 
 var _ ResourceViewer = (*Pulse)(nil)
 
@@ -66,6 +73,7 @@ type Pulse struct {
 	cancelFn context.CancelFunc
 	actions  *ui.KeyActions
 	charts   []Graphable
+	panels   map[string]config.PulsePanel
 }
 
 // NewPulse returns a new alias view.
@@ -91,22 +99,12 @@ func (p *Pulse) Init(ctx context.Context) error {
 		return err
 	}
 
-	p.charts = []Graphable{
-		p.makeGA(image.Point{X: 0, Y: 0}, image.Point{X: 2, Y: 2}, "apps/v1/deployments"),
-		p.makeGA(image.Point{X: 0, Y: 2}, image.Point{X: 2, Y: 2}, "apps/v1/replicasets"),
-		p.makeGA(image.Point{X: 0, Y: 4}, image.Point{X: 2, Y: 2}, "apps/v1/statefulsets"),
-		p.makeGA(image.Point{X: 0, Y: 6}, image.Point{X: 2, Y: 2}, "apps/v1/daemonsets"),
-		p.makeSP(image.Point{X: 2, Y: 0}, image.Point{X: 3, Y: 2}, "v1/pods"),
-		p.makeSP(image.Point{X: 2, Y: 2}, image.Point{X: 3, Y: 2}, "v1/events"),
-		p.makeSP(image.Point{X: 2, Y: 4}, image.Point{X: 3, Y: 2}, "batch/v1/jobs"),
-		p.makeSP(image.Point{X: 2, Y: 6}, image.Point{X: 3, Y: 2}, "v1/persistentvolumes"),
-	}
-	if p.app.Conn().HasMetrics() {
-		p.charts = append(p.charts,
-			p.makeSP(image.Point{X: 5, Y: 0}, image.Point{X: 2, Y: 4}, "cpu"),
-			p.makeSP(image.Point{X: 5, Y: 4}, image.Point{X: 2, Y: 4}, "mem"),
-		)
+	pp := config.NewPulses()
+	if err := pp.Load(p.app.Config.ContextPulsesPath()); err != nil {
+		p.app.Flash().Err(err)
 	}
+	p.model.SetPanels(pp.Panels)
+	p.layout(pp.Panels)
 	p.bindKeys()
 	p.model.AddListener(p)
 	p.app.SetFocus(p.charts[0])
@@ -133,12 +131,80 @@ func (p *Pulse) StylesChanged(s *config.Styles) {
 			c.SetBackgroundColor(s.Charts().ChartBgColor.Color())
 			c.SetSeriesColors(s.Charts().DefaultChartColors.Colors()...)
 		}
-		if ss, ok := s.Charts().ResourceColors[c.ID()]; ok {
+		if ss, ok := p.seriesColors(s, c.ID()); ok {
 			c.SetSeriesColors(ss.Colors()...)
 		}
 	}
 }
 
+// layout lays out gauges, charts and metrics panels in bands.
+func (p *Pulse) layout(pp []config.PulsePanel) {
+	var gg, cc []config.PulsePanel
+	p.panels = make(map[string]config.PulsePanel, len(pp))
+	for _, panel := range pp {
+		p.panels[panel.ID()] = panel
+		if panel.IsGauge() {
+			gg = append(gg, panel)
+		} else {
+			cc = append(cc, panel)
+		}
+	}
+	cols := max(pulseMinCols, 2*len(gg), 2*len(cc))
+
+	var row int
+	p.charts = make([]Graphable, 0, len(pp)+2)
+	for i, l := range pulseLayout(len(gg), cols) {
+		p.charts = append(p.charts, p.makeGA(image.Point{X: row, Y: l.X}, image.Point{X: pulseGaugeHeight, Y: l.Y}, gg[i].ID()))
+	}
+	if len(gg) > 0 {
+		row += pulseGaugeHeight
+	}
+	for i, l := range pulseLayout(len(cc), cols) {
+		p.charts = append(p.charts, p.makeSP(image.Point{X: row, Y: l.X}, image.Point{X: pulseChartHeight, Y: l.Y}, cc[i].ID()))
+	}
+	if len(cc) > 0 {
+		row += pulseChartHeight
+	}
+	if p.app.Conn().HasMetrics() {
+		ll := pulseLayout(2, cols)
+		p.charts = append(p.charts,
+			p.makeSP(image.Point{X: row, Y: ll[0].X}, image.Point{X: pulseMxHeight, Y: ll[0].Y}, "cpu"),
+			p.makeSP(image.Point{X: row, Y: ll[1].X}, image.Point{X: pulseMxHeight, Y: ll[1].Y}, "mem"),
+		)
+	}
+}
+
+// seriesColors returns custom series colors for a given panel if any.
+func (p *Pulse) seriesColors(s *config.Styles, id string) (config.Colors, bool) {
+	panel, ok := p.panels[id]
+	if ok && len(panel.Colors) > 0 {
+		cc := make(config.Colors, 0, len(panel.Colors))
+		for _, c := range panel.Colors {
+			cc = append(cc, config.NewColor(c))
+		}
+		return cc, true
+	}
+	if ok {
+		id = panel.GVR
+	}
+	cc, ok := s.Charts().ResourceColors[id]
+
+	return cc, ok
+}
+
+// panelTitle returns a panel display title.
+func (p *Pulse) panelTitle(id string) string {
+	panel, ok := p.panels[id]
+	if ok && panel.Title != "" {
+		return panel.Title
+	}
+	if ok {
+		id = panel.GVR
+	}
+
+	return cases.Title(language.Und, cases.NoLower).String(client.NewGVR(id).R())
+}
+
 const (
 	genFmat = " %s([%s::]%d[white::]:[%s::b]%d[-::])"
 	cpuFmt  = " %s [%s::b]%s[white::-]([%s::]%sm[white::]/[%s::]%sm[-::])"
@@ -165,12 +231,12 @@ func (p *Pulse) PulseChanged(c *health.Check) {
 		nn[1] = "gray"
 	}
 
-	gvr := client.NewGVR(c.GVR)
+	title := p.panelTitle(c.GVR)
 	switch c.GVR {
 	case "cpu":
 		perc := client.ToPercentage(c.Tally(health.S1), c.Tally(health.S2))
 		v.SetLegend(fmt.Sprintf(cpuFmt,
-			cases.Title(language.Und, cases.NoLower).String(gvr.R()),
+			title,
 			p.app.Config.K9s.Thresholds.SeverityColor("cpu", perc),
 			render.PrintPerc(perc),
 			nn[0],
@@ -181,7 +247,7 @@ func (p *Pulse) PulseChanged(c *health.Check) {
 	case "mem":
 		perc := client.ToPercentage(c.Tally(health.S1), c.Tally(health.S2))
 		v.SetLegend(fmt.Sprintf(memFmt,
-			cases.Title(language.Und, cases.NoLower).String(gvr.R()),
+			title,
 			p.app.Config.K9s.Thresholds.SeverityColor("memory", perc),
 			render.PrintPerc(perc),
 			nn[0],
@@ -190,8 +256,12 @@ func (p *Pulse) PulseChanged(c *health.Check) {
 			render.AsThousands(c.Tally(health.S2)),
 		))
 	default:
+		perc := client.ToPercentage(c.Tally(health.S2), c.Tally(health.Corpus))
+		if color := p.panels[c.GVR].SeverityColor(perc); color != "" {
+			title = fmt.Sprintf("[%s::b]%s[-::-]", color, title)
+		}
 		v.SetLegend(fmt.Sprintf(genFmat,
-			cases.Title(language.Und, cases.NoLower).String(gvr.R()),
+			title,
 			nn[0],
 			c.Tally(health.S1),
 			nn[1],
@@ -214,8 +284,10 @@ func (p *Pulse) bindKeys() {
 	}))
 
 	for i, v := range p.charts {
-		t := cases.Title(language.Und, cases.NoLower).String(client.NewGVR(v.ID()).R())
-		p.actions.Add(ui.NumKeys[i], ui.NewKeyAction(t, p.sparkFocusCmd(i), true))
+		if i >= len(ui.NumKeys) {
+			break
+		}
+		p.actions.Add(ui.NumKeys[i], ui.NewKeyAction(p.panelTitle(v.ID()), p.sparkFocusCmd(i), true))
 	}
 }
 
@@ -274,6 +346,15 @@ func (p *Pulse) App() *App {
 // SetInstance sets specific resource instance.
 func (p *Pulse) SetInstance(string) {}
 
+// AcquireInformers leases the viewer informers.
+func (p *Pulse) AcquireInformers() {}
+
+// ReleaseInformers releases the viewer informers.
+func (p *Pulse) ReleaseInformers() {}
+
+// RefreshActions rebinds the viewer key actions.
+func (p *Pulse) RefreshActions() {}
+
 // SetEnvFn sets the custom environment function.
 func (p *Pulse) SetEnvFn(EnvFunc) {}
 
@@ -316,11 +397,20 @@ func (p *Pulse) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !ok {
 		return nil
 	}
-	res := client.NewGVR(s.ID()).R()
-	if res == "cpu" || res == "mem" {
-		res = "pod"
+	panel, ok := p.panels[s.ID()]
+	if !ok {
+		p.App().gotoResource("pod all", "", false)
+		return nil
+	}
+	cmd, ns := panel.GVR, client.NamespaceAll
+	if panel.Namespace != "" {
+		ns = panel.Namespace
+	}
+	cmd += " " + ns
+	if panel.Selector != "" {
+		cmd += " " + panel.Selector
 	}
-	p.App().gotoResource(res+" all", "", false)
+	p.App().gotoResource(cmd, "", false)
 
 	return nil
 }
@@ -339,16 +429,16 @@ func (p *Pulse) nextFocusCmd(direction int) func(evt *tcell.EventKey) *tcell.Eve
 	}
 }
 
-func (p *Pulse) makeSP(loc image.Point, span image.Point, gvr string) *tchart.SparkLine {
-	s := tchart.NewSparkLine(gvr)
+func (p *Pulse) makeSP(loc image.Point, span image.Point, id string) *tchart.SparkLine {
+	s := tchart.NewSparkLine(id)
 	s.SetBackgroundColor(p.app.Styles.Charts().BgColor.Color())
 	s.SetBorderPadding(0, 1, 0, 1)
-	if cc, ok := p.app.Styles.Charts().ResourceColors[gvr]; ok {
+	if cc, ok := p.seriesColors(p.app.Styles, id); ok {
 		s.SetSeriesColors(cc.Colors()...)
 	} else {
 		s.SetSeriesColors(p.app.Styles.Charts().DefaultChartColors.Colors()...)
 	}
-	s.SetLegend(fmt.Sprintf(" %s ", cases.Title(language.Und, cases.NoLower).String(client.NewGVR(gvr).R())))
+	s.SetLegend(fmt.Sprintf(" %s ", p.panelTitle(id)))
 	s.SetInputCapture(p.keyboard)
 	s.SetMultiSeries(true)
 	p.AddItem(s, loc.X, loc.Y, span.X, span.Y, 0, 0, true)
@@ -356,17 +446,17 @@ func (p *Pulse) makeSP(loc image.Point, span image.Point, gvr string) *tchart.Sp
 	return s
 }
 
-func (p *Pulse) makeGA(loc image.Point, span image.Point, gvr string) *tchart.Gauge {
-	g := tchart.NewGauge(gvr)
+func (p *Pulse) makeGA(loc image.Point, span image.Point, id string) *tchart.Gauge {
+	g := tchart.NewGauge(id)
 	// g.SetResolution(3)
 	g.SetBackgroundColor(p.app.Styles.Charts().BgColor.Color())
 	// g.SetBorderPadding(0, 1, 0, 1)
-	if cc, ok := p.app.Styles.Charts().ResourceColors[gvr]; ok {
+	if cc, ok := p.seriesColors(p.app.Styles, id); ok {
 		g.SetSeriesColors(cc.Colors()...)
 	} else {
 		g.SetSeriesColors(p.app.Styles.Charts().DefaultDialColors.Colors()...)
 	}
-	g.SetLegend(fmt.Sprintf(" %s ", cases.Title(language.Und, cases.NoLower).String(client.NewGVR(gvr).R())))
+	g.SetLegend(fmt.Sprintf(" %s ", p.panelTitle(id)))
 	g.SetInputCapture(p.keyboard)
 	p.AddItem(g, loc.X, loc.Y, span.X, span.Y, 0, 0, true)
 
@@ -376,6 +466,27 @@ func (p *Pulse) makeGA(loc image.Point, span image.Point, gvr string) *tchart.Ga
 // ----------------------------------------------------------------------------
 // Helpers
 
+// pulseLayout distributes n panels across cols columns, returning each panel
+// column (X) and span (Y).
+func pulseLayout(n, cols int) []image.Point {
+	if n == 0 {
+		return nil
+	}
+	ll := make([]image.Point, 0, n)
+	span, extra := cols/n, cols%n
+	var col int
+	for i := 0; i < n; i++ {
+		s := span
+		if i < extra {
+			s++
+		}
+		ll = append(ll, image.Point{X: col, Y: s})
+		col += s
+	}
+
+	return ll
+}
+
 func nextFocus(pp []Graphable, index int) (int, tview.Primitive) {
 	if index >= len(pp) {
 		return 0, pp[0]
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPulseLayout(t *testing.T) {
+	uu := map[string]struct {
+		n, cols int
+		e       []image.Point
+	}{
+		"none": {
+			cols: 8,
+		},
+		"even": {
+			n:    4,
+			cols: 8,
+			e:    []image.Point{{X: 0, Y: 2}, {X: 2, Y: 2}, {X: 4, Y: 2}, {X: 6, Y: 2}},
+		},
+		"uneven": {
+			n:    3,
+			cols: 8,
+			e:    []image.Point{{X: 0, Y: 3}, {X: 3, Y: 3}, {X: 6, Y: 2}},
+		},
+		"single": {
+			n:    1,
+			cols: 8,
+			e:    []image.Point{{X: 0, Y: 8}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, pulseLayout(u.n, u.cols))
+		})
+	}
+}
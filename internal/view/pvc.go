@@ -4,12 +4,20 @@
 package view
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
+const expandDialogKey = "expand"
+
 // PersistentVolumeClaim represents a PVC custom viewer.
 type PersistentVolumeClaim struct {
 	ResourceViewer
@@ -25,16 +33,114 @@ func NewPersistentVolumeClaim(gvr client.GVR) ResourceViewer {
 	return &v
 }
 
+func (p *PersistentVolumeClaim) bindDangerousKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyX, ui.NewKeyActionWithOpts("Expand", p.expandCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		},
+	))
+}
+
 func (p *PersistentVolumeClaim) bindKeys(aa *ui.KeyActions) {
+	if !p.App().Config.K9s.IsReadOnly() {
+		p.bindDangerousKeys(aa)
+	}
 	aa.Bulk(ui.KeyMap{
 		ui.KeyU:      ui.NewKeyAction("UsedBy", p.refCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd("STATUS", true), false),
 		ui.KeyShiftV: ui.NewKeyAction("Sort Volume", p.GetTable().SortColCmd("VOLUME", true), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort StorageClass", p.GetTable().SortColCmd("STORAGECLASS", true), false),
 		ui.KeyShiftC: ui.NewKeyAction("Sort Capacity", p.GetTable().SortColCmd("CAPACITY", true), false),
+		ui.KeyShiftU: ui.NewKeyAction("Sort Used", p.GetTable().SortColCmd("%USED", false), false),
 	})
 }
 
 func (p *PersistentVolumeClaim) refCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return scanRefs(evt, p.App(), p.GetTable(), dao.PvcGVR)
 }
+
+func (p *PersistentVolumeClaim) expandCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	p.showExpandDialog(path)
+
+	return nil
+}
+
+func (p *PersistentVolumeClaim) showExpandDialog(path string) {
+	styles := p.App().Styles.Dialog()
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	size, _ := p.valueOf("CAPACITY")
+	f.AddInputField("Size:", size, 10, nil, func(changed string) {
+		size = changed
+	})
+	f.AddButton(i18n.T("OK"), func() {
+		if _, err := resource.ParseQuantity(size); err != nil {
+			p.App().Flash().Errf("Invalid size %q", size)
+			return
+		}
+		p.dismissDialog()
+		if err := p.expand(path, size); err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.App().Flash().Infof("PVC %s expansion to %s requested", path, size)
+		p.Refresh()
+	})
+	f.AddButton(i18n.T("Cancel"), func() {
+		p.dismissDialog()
+	})
+	for i := 0; i < 2; i++ {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	modal := tview.NewModalForm("<Expand>", f)
+	modal.SetText(fmt.Sprintf("Expand pvc %s?", path))
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		p.dismissDialog()
+	})
+	p.App().Content.AddPage(expandDialogKey, modal, false, false)
+	p.App().Content.ShowPage(expandDialogKey)
+}
+
+func (p *PersistentVolumeClaim) dismissDialog() {
+	p.App().Content.RemovePage(expandDialogKey)
+}
+
+func (p *PersistentVolumeClaim) valueOf(col string) (string, error) {
+	colIdx, ok := p.GetTable().HeaderIndex(col)
+	if !ok {
+		return "", fmt.Errorf("no column index for %s", col)
+	}
+
+	return p.GetTable().GetSelectedCell(colIdx), nil
+}
+
+func (p *PersistentVolumeClaim) expand(path, size string) error {
+	res, err := dao.AccessorFor(p.App().factory, p.GVR())
+	if err != nil {
+		return err
+	}
+	e, ok := res.(dao.Expandable)
+	if !ok {
+		return fmt.Errorf("expecting an expandable resource for %q", p.GVR())
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.App().Conn().Config().CallTimeout())
+	defer cancel()
+
+	return e.Expand(ctx, path, size)
+}
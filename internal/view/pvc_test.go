@@ -16,5 +16,5 @@ func TestPVCNew(t *testing.T) {
 
 	assert.Nil(t, v.Init(makeCtx()))
 	assert.Equal(t, "PersistentVolumeClaims", v.Name())
-	assert.Equal(t, 10, len(v.Hints()))
+	assert.Equal(t, 14, len(v.Hints()))
 }
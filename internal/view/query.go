@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const queryTitle = "Query"
+
+var columnNameRX = regexp.MustCompile(`[A-Za-z0-9]+`)
+
+// queryDoc documents the query view.
+var queryDoc = ViewDoc{
+	Summary: "JSONPath or yq like query results for the selected resources",
+	Columns: model.MenuHints{
+		{Mnemonic: "RESULT", Description: "Query result. Multiple values are comma separated"},
+		{Mnemonic: "VALID", Description: "Query evaluation error if any"},
+	},
+}
+
+// Query represents a resources query results view.
+type Query struct {
+	ResourceViewer
+
+	target   client.GVR
+	expr     string
+	paths    []string
+	defaults []string
+}
+
+// NewQuery returns a new query results view. Defaults lists the queried resource
+// view columns used to seed its custom view when the query is saved as a column.
+func NewQuery(target client.GVR, expr string, paths, defaults []string) ResourceViewer {
+	gvr := client.NewGVR("queries")
+	RegisterViewDoc(gvr.R(), queryDoc)
+	q := Query{
+		ResourceViewer: NewBrowser(gvr),
+		target:         target,
+		expr:           expr,
+		paths:          paths,
+		defaults:       defaults,
+	}
+	q.AddBindKeysFn(q.bindKeys)
+	q.SetContextFn(q.queryContext)
+
+	return &q
+}
+
+// Name returns the component name.
+func (*Query) Name() string { return queryTitle }
+
+func (q *Query) queryContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyQuery, q.expr)
+	ctx = context.WithValue(ctx, internal.KeyQueryGVR, q.target)
+
+	return context.WithValue(ctx, internal.KeyQueryPaths, q.paths)
+}
+
+func (q *Query) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyS: ui.NewKeyAction("Save Column", q.saveColumnCmd, true),
+	})
+}
+
+func (q *Query) saveColumnCmd(evt *tcell.EventKey) *tcell.EventKey {
+	dialog.ShowInput(q.App().Styles.Dialog(), q.App().Content.Pages, dialog.InputDialogOpts{
+		Title:   "Save Column",
+		Message: fmt.Sprintf("Add %q as a custom column to the %s view", q.expr, q.target.R()),
+		Label:   "Column:",
+		Value:   queryColumnName(q.expr),
+		Ack: func(name string) {
+			if err := q.saveColumn(strings.ToUpper(name)); err != nil {
+				q.App().Flash().Err(err)
+				return
+			}
+			q.App().Flash().Infof("Column %s added to the %s view", strings.ToUpper(name), q.target.R())
+		},
+		Cancel: func() {},
+	})
+
+	return nil
+}
+
+func (q *Query) saveColumn(name string) error {
+	if strings.Contains(name, ":") {
+		return fmt.Errorf("invalid column name %q", name)
+	}
+	cv := q.App().CustomView
+	cv.AddColumn(q.target.String(), q.defaults, name+":"+q.expr)
+	if err := cv.Save(config.AppViewsFile); err != nil {
+		return err
+	}
+
+	return q.App().RefreshCustomViews()
+}
+
+// queryColumnName derives a column name from a query last field ie .spec.nodeName -> NODENAME.
+func queryColumnName(expr string) string {
+	mm := columnNameRX.FindAllString(expr, -1)
+	if len(mm) == 0 {
+		return ""
+	}
+
+	return strings.ToUpper(mm[len(mm)-1])
+}
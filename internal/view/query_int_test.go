@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryColumnName(t *testing.T) {
+	uu := map[string]struct {
+		expr, e string
+	}{
+		"empty": {},
+		"field": {
+			expr: ".spec.nodeName",
+			e:    "NODENAME",
+		},
+		"jsonpath": {
+			expr: "{.status.podIP}",
+			e:    "PODIP",
+		},
+		"array": {
+			expr: ".spec.containers[*].image",
+			e:    "IMAGE",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, queryColumnName(u.expr))
+		})
+	}
+}
@@ -16,5 +16,5 @@ func TestRbacNew(t *testing.T) {
 
 	assert.Nil(t, v.Init(makeCtx()))
 	assert.Equal(t, "Rbac", v.Name())
-	assert.Equal(t, 5, len(v.Hints()))
+	assert.Equal(t, 7, len(v.Hints()))
 }
@@ -16,5 +16,5 @@ func TestReferenceNew(t *testing.T) {
 
 	assert.Nil(t, s.Init(makeCtx()))
 	assert.Equal(t, "References", s.Name())
-	assert.Equal(t, 4, len(s.Hints()))
+	assert.Equal(t, 6, len(s.Hints()))
 }
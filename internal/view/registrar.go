@@ -15,6 +15,7 @@ func loadCustomViewers() MetaViewers {
 	rbacViewers(m)
 	batchViewers(m)
 	crdViewers(m)
+	policyViewers(m)
 	helmViewers(m)
 
 	return m
@@ -57,6 +58,9 @@ func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/persistentvolumeclaims")] = MetaViewer{
 		viewerFn: NewPersistentVolumeClaim,
 	}
+	vv[client.NewGVR("networking.k8s.io/v1/ingresses")] = MetaViewer{
+		viewerFn: NewIngress,
+	}
 }
 
 func miscViewers(vv MetaViewers) {
@@ -72,6 +76,15 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("scans")] = MetaViewer{
 		viewerFn: NewImageScan,
 	}
+	vv[client.NewGVR("pluginrows")] = MetaViewer{
+		viewerFn: NewPluginRows,
+	}
+	vv[client.NewGVR("keys")] = MetaViewer{
+		viewerFn: NewKeyBindings,
+	}
+	vv[client.NewGVR("pluginjobs")] = MetaViewer{
+		viewerFn: NewPluginJobs,
+	}
 	vv[client.NewGVR("portforwards")] = MetaViewer{
 		viewerFn: NewPortForward,
 	}
@@ -81,12 +94,63 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("benchmarks")] = MetaViewer{
 		viewerFn: NewBenchmark,
 	}
+	vv[client.NewGVR("crashes")] = MetaViewer{
+		viewerFn: NewCrash,
+	}
+	vv[client.NewGVR("k9slogs")] = MetaViewer{
+		viewerFn: NewK9sLog,
+	}
 	vv[client.NewGVR("aliases")] = MetaViewer{
 		viewerFn: NewAlias,
 	}
 	vv[client.NewGVR("references")] = MetaViewer{
 		viewerFn: NewReference,
 	}
+	vv[client.NewGVR("netsim")] = MetaViewer{
+		viewerFn: NewNetSim,
+	}
+	vv[client.NewGVR("svchealth")] = MetaViewer{
+		viewerFn: NewServiceHealth,
+	}
+	vv[client.NewGVR("poddiag")] = MetaViewer{
+		viewerFn: NewPodDiag,
+	}
+	vv[client.NewGVR("images")] = MetaViewer{
+		viewerFn: NewImages,
+	}
+	vv[client.NewGVR("deprecations")] = MetaViewer{
+		viewerFn: NewDeprecations,
+	}
+	vv[client.NewGVR("manifests")] = MetaViewer{
+		viewerFn: NewManifests,
+	}
+	vv[client.NewGVR("ingroutes")] = MetaViewer{
+		viewerFn: NewIngressRoute,
+	}
+	vv[client.NewGVR("whoami")] = MetaViewer{
+		viewerFn: NewWhoAmI,
+	}
+	vv[client.NewGVR("fleet")] = MetaViewer{
+		viewerFn: NewFleet,
+	}
+	vv[client.NewGVR("proxies")] = MetaViewer{
+		viewerFn: NewProxy,
+	}
+	vv[client.NewGVR("certs")] = MetaViewer{
+		viewerFn: NewCert,
+	}
+	vv[client.NewGVR("scores")] = MetaViewer{
+		viewerFn: NewPopeyeScore,
+	}
+	vv[client.NewGVR("top-pods")] = MetaViewer{
+		viewerFn: NewTopPod,
+	}
+	vv[client.NewGVR("top-namespaces")] = MetaViewer{
+		viewerFn: NewTopNamespace,
+	}
+	vv[client.NewGVR("top-nodes")] = MetaViewer{
+		viewerFn: NewTopNode,
+	}
 	vv[client.NewGVR("pulses")] = MetaViewer{
 		viewerFn: NewPulse,
 	}
@@ -153,6 +217,15 @@ func batchViewers(vv MetaViewers) {
 	}
 }
 
+func policyViewers(vv MetaViewers) {
+	vv[client.NewGVR("policy/v1/poddisruptionbudgets")] = MetaViewer{
+		viewerFn: NewPodDisruptionBudget,
+	}
+	vv[client.NewGVR("policy/v1beta1/poddisruptionbudgets")] = MetaViewer{
+		viewerFn: NewPodDisruptionBudget,
+	}
+}
+
 func crdViewers(vv MetaViewers) {
 	vv[client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions")] = MetaViewer{
 		viewerFn: NewCRD,
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/remote"
+	"github.com/derailed/k9s/internal/view/cmd"
+)
+
+const (
+	remoteViewCmd   = "view"
+	remoteFilterCmd = "filter"
+	remoteDumpCmd   = "dump"
+
+	remoteTimeout = 10 * time.Second
+)
+
+// RemoteCommand executes a remote control command on the ui thread.
+func (a *App) RemoteCommand(c remote.Command) (string, error) {
+	switch c.Name {
+	case remoteViewCmd:
+		line, err := remoteViewLine(c.Args)
+		if err != nil {
+			return "", err
+		}
+		return "", a.onUI(func() error {
+			return a.command.run(cmd.NewInterpreter(line), "", true)
+		})
+	case remoteFilterCmd:
+		return "", a.onUI(func() error {
+			v, err := a.topResourceViewer()
+			if err != nil {
+				return err
+			}
+			v.SetFilter(strings.Join(c.Args, " "))
+			return nil
+		})
+	case remoteDumpCmd:
+		var path string
+		err := a.onUI(func() error {
+			v, err := a.topResourceViewer()
+			if err != nil {
+				return err
+			}
+			t := v.GetTable()
+			path, err = saveTable(a.Config.K9s.ContextScreenDumpDir(), v.GVR().R(), t.Path, t.GetFilteredData(), a.Config.K9s.ScreenDumps)
+			return err
+		})
+		return path, err
+	default:
+		return "", fmt.Errorf("unknown command %q. Expecting one of %s|%s|%s", c.Name, remoteViewCmd, remoteFilterCmd, remoteDumpCmd)
+	}
+}
+
+// onUI runs a function on the ui thread and waits for its outcome.
+func (a *App) onUI(f func() error) error {
+	errs := make(chan error, 1)
+	a.QueueUpdateDraw(func() {
+		errs <- f()
+	})
+	select {
+	case err := <-errs:
+		return err
+	case <-time.After(remoteTimeout):
+		return errors.New("timed out waiting on the ui")
+	}
+}
+
+func (a *App) topResourceViewer() (ResourceViewer, error) {
+	v, ok := a.Content.Top().(ResourceViewer)
+	if !ok {
+		return nil, errors.New("current view is not a resource view")
+	}
+
+	return v, nil
+}
+
+// remoteViewLine converts kubectl style view arguments to a prompt command,
+// ie `pods -n prod --context dev -l app=web` -> `pods prod @dev app=web`.
+func remoteViewLine(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", errors.New("invalid command use `view resource [-n ns|-A] [--context ctx] [/filter] [-l labels]`")
+	}
+	ff := []string{args[0]}
+	for i := 1; i < len(args); i++ {
+		switch a := args[i]; a {
+		case "-n", "--namespace", "--context", "-l", "--selector":
+			if i+1 >= len(args) {
+				return "", fmt.Errorf("missing %s value", a)
+			}
+			i++
+			if a == "--context" {
+				ff = append(ff, "@"+args[i])
+			} else {
+				ff = append(ff, args[i])
+			}
+		case "-A", "--all-namespaces":
+			ff = append(ff, client.NamespaceAll)
+		default:
+			ff = append(ff, a)
+		}
+	}
+
+	return strings.Join(ff, " "), nil
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteViewLine(t *testing.T) {
+	uu := map[string]struct {
+		args []string
+		e    string
+		err  bool
+	}{
+		"empty": {
+			err: true,
+		},
+		"plain": {
+			args: []string{"pods"},
+			e:    "pods",
+		},
+		"prompt": {
+			args: []string{"pods", "prod", "/web"},
+			e:    "pods prod /web",
+		},
+		"flags": {
+			args: []string{"pods", "-n", "prod", "--context", "dev", "-l", "app=web"},
+			e:    "pods prod @dev app=web",
+		},
+		"all": {
+			args: []string{"dp", "-A"},
+			e:    "dp all",
+		},
+		"missing-ns": {
+			args: []string{"pods", "--namespace"},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			line, err := remoteViewLine(u.args)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, line)
+		})
+	}
+}
@@ -17,7 +17,6 @@ import (
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -109,6 +108,17 @@ func (s *Sanitizer) ExtraHints() map[string]string {
 // SetInstance sets specific resource instance.
 func (s *Sanitizer) SetInstance(string) {}
 
+// AcquireInformers leases the viewer informers.
+func (s *Sanitizer) AcquireInformers() {}
+
+// ReleaseInformers releases the viewer informers.
+func (s *Sanitizer) ReleaseInformers() {}
+
+// RefreshActions rebinds the viewer key actions.
+func (s *Sanitizer) RefreshActions() {
+	s.refreshActions()
+}
+
 func (s *Sanitizer) bindKeys() {
 	s.Actions().Bulk(ui.KeyMap{
 		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", s.activateCmd, false),
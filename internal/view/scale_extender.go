@@ -9,13 +9,13 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
-
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 )
 
 // ScaleExtender adds scaling extensions.
@@ -57,17 +57,24 @@ func (s *ScaleExtender) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (s *ScaleExtender) showScaleDialog(paths []string) {
-	form, err := s.makeScaleForm(paths)
-	if err != nil {
-		s.App().Flash().Err(err)
-		return
-	}
-	confirm := tview.NewModalForm("<Scale>", form)
+	var confirm *tview.ModalForm
 	msg := fmt.Sprintf("Scale %s %s?", singularize(s.GVR().R()), paths[0])
 	if len(paths) > 1 {
 		msg = fmt.Sprintf("Scale [%d] %s?", len(paths), s.GVR().R())
 	}
-	confirm.SetText(msg)
+	text := msg
+	form, err := s.makeScaleForm(paths, func(impact string) {
+		text = strings.TrimSpace(msg + "\n\n" + impact)
+		if confirm != nil {
+			confirm.SetText(text)
+		}
+	})
+	if err != nil {
+		s.App().Flash().Err(err)
+		return
+	}
+	confirm = tview.NewModalForm("<Scale>", form)
+	confirm.SetText(text)
 	confirm.SetDoneFunc(func(int, string) {
 		s.dismissDialog()
 	})
@@ -83,11 +90,12 @@ func (s *ScaleExtender) valueOf(col string) (string, error) {
 	return s.GetTable().GetSelectedCell(colIdx), nil
 }
 
-func (s *ScaleExtender) makeScaleForm(sels []string) (*tview.Form, error) {
+func (s *ScaleExtender) makeScaleForm(sels []string, impactFn func(string)) (*tview.Form, error) {
 	styles := s.App().Styles.Dialog()
 	f := s.makeStyledForm(styles)
 
 	factor := "0"
+	var quotaFn func(int) string
 	if len(sels) == 1 {
 		replicas, err := s.valueOf("READY")
 		if err != nil {
@@ -98,37 +106,37 @@ func (s *ScaleExtender) makeScaleForm(sels []string) (*tview.Form, error) {
 			return nil, fmt.Errorf("unable to locate replicas from %s", replicas)
 		}
 		factor = strings.TrimRight(tokens[1], ui.DeltaSign)
+		if current, err := strconv.Atoi(factor); err == nil {
+			if quotaFn = s.quotaImpactFn(sels[0], current); quotaFn != nil {
+				impactFn(quotaFn(current))
+			}
+		}
 	}
 	f.AddInputField("Replicas:", factor, 4, func(textToCheck string, lastChar rune) bool {
 		_, err := strconv.Atoi(textToCheck)
 		return err == nil
 	}, func(changed string) {
 		factor = changed
+		if count, err := strconv.Atoi(changed); err == nil && quotaFn != nil {
+			impactFn(quotaFn(count))
+		}
 	})
 
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
 		defer s.dismissDialog()
 		count, err := strconv.Atoi(factor)
 		if err != nil {
 			s.App().Flash().Err(err)
 			return
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
-		defer cancel()
-		for _, sel := range sels {
-			if err := s.scale(ctx, sel, count); err != nil {
-				log.Error().Err(err).Msgf("DP %s scaling failed", sel)
-				s.App().Flash().Err(err)
-				return
-			}
-		}
-		if len(sels) == 1 {
-			s.App().Flash().Infof("[%d] %s scaled successfully", len(sels), singularize(s.GVR().R()))
-		} else {
-			s.App().Flash().Infof("%s %s scaled successfully", s.GVR().R(), sels[0])
-		}
+		runBulk(context.Background(), s.App(), "Scale", sels, func(ctx context.Context, path string) error {
+			ctx, cancel := context.WithTimeout(ctx, s.App().Conn().Config().CallTimeout())
+			defer cancel()
+
+			return s.scale(ctx, path, count)
+		}, s.Refresh)
 	})
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		s.dismissDialog()
 	})
 	for i := 0; i < 2; i++ {
@@ -157,6 +165,41 @@ func (s *ScaleExtender) makeStyledForm(styles config.Dialog) *tview.Form {
 	return f
 }
 
+// quotaImpactFn returns a function reporting the namespace quotas consumption
+// once a workload is scaled to a given number of replicas. It returns nil when
+// the namespace has no quotas.
+func (s *ScaleExtender) quotaImpactFn(path string, current int) func(int) string {
+	res, err := dao.AccessorFor(s.App().factory, s.GVR())
+	if err != nil {
+		return nil
+	}
+	ps, ok := res.(dao.ContainsPodSpec)
+	if !ok {
+		return nil
+	}
+	spec, err := ps.GetPodSpec(path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to get pod spec for %s", path)
+		return nil
+	}
+	ns, _ := client.Namespaced(path)
+	qq, ll, err := dao.NamespaceQuotas(s.App().factory, ns)
+	if err != nil || len(qq) == 0 {
+		return nil
+	}
+
+	return func(replicas int) string {
+		uu := dao.QuotaImpact(qq, dao.ScaleQuotaUsage(spec, ll, int64(replicas-current)))
+		for _, u := range uu {
+			if u.Exceeds() {
+				return "[red::b]Exceeds quota![-::-]\n" + dao.QuotaReport(uu)
+			}
+		}
+
+		return dao.QuotaReport(uu)
+	}
+}
+
 func (s *ScaleExtender) scale(ctx context.Context, path string, replicas int) error {
 	res, err := dao.AccessorFor(s.App().factory, s.GVR())
 	if err != nil {
@@ -4,16 +4,28 @@
 package view
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
-	"github.com/rs/zerolog/log"
 )
 
+// maxPreviewSize tracks the largest dump content previewed inline.
+const maxPreviewSize = 1 << 20
+
 // ScreenDump presents a directory listing viewer.
 type ScreenDump struct {
 	ResourceViewer
@@ -30,6 +42,7 @@ func NewScreenDump(gvr client.GVR) ResourceViewer {
 	s.GetTable().SelectRow(1, 0, true)
 	s.GetTable().SetEnterFn(s.edit)
 	s.SetContextFn(s.dirContext)
+	s.AddBindKeysFn(s.bindKeys)
 
 	return &s
 }
@@ -53,3 +66,117 @@ func (s *ScreenDump) edit(app *App, _ ui.Tabular, _ client.GVR, path string) {
 		app.Flash().Errf("Failed to launch editor")
 	}
 }
+
+func (s *ScreenDump) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyP, ui.NewKeyAction("Preview", s.previewCmd, true))
+	aa.Add(ui.KeyShiftP, ui.NewKeyActionWithOpts("Prune", s.pruneCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}))
+}
+
+func (s *ScreenDump) previewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	content, ctype, err := previewDump(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(s.App(), "Preview", filepath.Base(path), ctype, true).Update(content)
+	if err := s.App().inject(details, false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (s *ScreenDump) pruneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rp := s.App().Config.K9s.ScreenDumps
+	if !rp.IsSet() {
+		s.App().Flash().Warn("No screen dumps retention policy set")
+		return nil
+	}
+
+	n, err := dao.PruneScreenDumps(s.App().Config.K9s.ContextScreenDumpDir(), rp)
+	if err != nil {
+		s.App().Flash().Err(err)
+	} else {
+		s.App().Flash().Infof("Pruned %d screen dumps", n)
+	}
+	s.GetTable().Refresh()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// previewDump returns a dump content and content type. CSV dumps are
+// rendered as aligned columns.
+func previewDump(path string) (string, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing dump %q", path)
+		}
+	}()
+
+	raw, err := io.ReadAll(io.LimitReader(f, maxPreviewSize+1))
+	if err != nil {
+		return "", "", err
+	}
+	var trailer string
+	if len(raw) > maxPreviewSize {
+		raw = raw[:maxPreviewSize]
+		trailer = fmt.Sprintf("\n... truncated to the first %d bytes", maxPreviewSize)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		txt, err := csvToText(raw)
+		if err != nil {
+			return "", "", err
+		}
+		return txt + trailer, contentTXT, nil
+	case ".yaml", ".yml":
+		return string(raw) + trailer, contentYAML, nil
+	default:
+		return string(raw) + trailer, contentTXT, nil
+	}
+}
+
+func csvToText(raw []byte) (string, error) {
+	r := csv.NewReader(bytes.NewReader(raw))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	var buff bytes.Buffer
+	w := tabwriter.NewWriter(&buff, 0, 4, 3, ' ', 0)
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var perr *csv.ParseError
+			if errors.As(err, &perr) && errors.Is(perr.Err, csv.ErrQuote) {
+				break
+			}
+			return "", err
+		}
+		fmt.Fprintln(w, strings.Join(rec, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewDump(t *testing.T) {
+	uu := map[string]struct {
+		file, data string
+		ctype, e   string
+	}{
+		"csv": {
+			file:  "pods.csv",
+			data:  "NAME,READY\nfred,1/1\nblee-longer,0/1\n",
+			ctype: contentTXT,
+			e:     "NAME          READY\nfred          1/1\nblee-longer   0/1\n",
+		},
+		"yaml": {
+			file:  "pod.yaml",
+			data:  "kind: Pod\n",
+			ctype: contentYAML,
+			e:     "kind: Pod\n",
+		},
+		"log": {
+			file:  "fred.log",
+			data:  "a,b\n",
+			ctype: contentTXT,
+			e:     "a,b\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), u.file)
+			require.NoError(t, os.WriteFile(path, []byte(u.data), 0600))
+
+			txt, ctype, err := previewDump(path)
+			require.NoError(t, err)
+			assert.Equal(t, u.ctype, ctype)
+			assert.Equal(t, u.e, txt)
+		})
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/derailed/tcell/v2"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
-	"sigs.k8s.io/yaml"
 )
 
 // Secret presents a secret viewer.
@@ -57,13 +56,7 @@ func (s *Secret) decodeCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	raw, err := yaml.Marshal(d)
-	if err != nil {
-		s.App().Flash().Errf("Error decoding secret %s", err)
-		return nil
-	}
-
-	details := NewDetails(s.App(), "Secret Decoder", path, contentYAML, true).Update(string(raw))
+	details := NewSecretDecoder(s.App(), path, d)
 	if err := s.App().inject(details, false); err != nil {
 		s.App().Flash().Err(err)
 	}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const secretDecoderTitle = "Secret Decoder"
+
+// SecretDecoder presents decoded secret values, masked by default.
+type SecretDecoder struct {
+	*Details
+
+	path   string
+	data   map[string]string
+	reveal bool
+}
+
+// NewSecretDecoder returns a new secret decoder.
+func NewSecretDecoder(app *App, path string, data map[string]string) *SecretDecoder {
+	return &SecretDecoder{
+		Details: NewDetails(app, secretDecoderTitle, path, contentYAML, true),
+		path:    path,
+		data:    data,
+	}
+}
+
+// Init initializes the viewer.
+func (s *SecretDecoder) Init(ctx context.Context) error {
+	if err := s.Details.Init(ctx); err != nil {
+		return err
+	}
+	s.bindKeys()
+	s.refresh()
+
+	return nil
+}
+
+func (s *SecretDecoder) bindKeys() {
+	s.Actions().Add(ui.KeyR, ui.NewKeyAction("Toggle Reveal", s.toggleRevealCmd, true))
+	if !s.app.Config.K9s.IsReadOnly() {
+		s.Actions().Add(ui.KeyE, ui.NewKeyAction("Edit", s.editCmd, true))
+	}
+}
+
+func (s *SecretDecoder) refresh() {
+	data := s.data
+	if !s.reveal {
+		data = dao.MaskSecrets(s.data)
+	}
+	raw, err := yaml.Marshal(data)
+	if err != nil {
+		s.app.Flash().Errf("Error decoding secret %s", err)
+		return
+	}
+	s.Update(string(raw))
+}
+
+func (s *SecretDecoder) toggleRevealCmd(*tcell.EventKey) *tcell.EventKey {
+	if s.reveal {
+		s.reveal = false
+		s.refresh()
+		return nil
+	}
+	if !s.app.Config.K9s.IsReadOnly() {
+		s.revealValues()
+		return nil
+	}
+
+	msg := fmt.Sprintf("Reveal secret %s values? This action will be logged.", s.path)
+	dialog.ShowConfirm(s.app.Styles.Dialog(), s.app.Content.Pages, "Confirm Reveal", msg, s.revealValues, func() {})
+
+	return nil
+}
+
+func (s *SecretDecoder) revealValues() {
+	log.Info().Msgf("Secret %s values revealed on context %q", s.path, s.app.Config.ActiveContextName())
+	s.reveal = true
+	s.refresh()
+}
+
+func (s *SecretDecoder) editCmd(*tcell.EventKey) *tcell.EventKey {
+	data, err := s.editData()
+	if err != nil {
+		s.app.Flash().Err(err)
+		return nil
+	}
+	if data == nil || reflect.DeepEqual(data, s.data) {
+		s.app.Flash().Info("No secret changes detected")
+		return nil
+	}
+
+	res, err := dao.AccessorFor(s.app.factory, dao.SecGVR)
+	if err != nil {
+		s.app.Flash().Err(err)
+		return nil
+	}
+	sec, ok := res.(*dao.Secret)
+	if !ok {
+		s.app.Flash().Errf("expecting a secret accessor but got %T", res)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.app.Conn().Config().CallTimeout())
+	defer cancel()
+	if err := sec.UpdateData(ctx, s.path, data); err != nil {
+		s.app.Flash().Err(err)
+		return nil
+	}
+	s.data = data
+	s.refresh()
+	s.app.Flash().Infof("Secret %s updated successfully", s.path)
+
+	return nil
+}
+
+// editData edits the decoded secret values in an external editor.
+func (s *SecretDecoder) editData() (map[string]string, error) {
+	raw, err := yaml.Marshal(s.data)
+	if err != nil {
+		return nil, err
+	}
+	bb, err := editBuffer(s.app, "k9s-secret-*.yaml", raw)
+	if err != nil || bb == nil {
+		return nil, err
+	}
+	var data map[string]string
+	if err := yaml.Unmarshal(bb, &data); err != nil {
+		return nil, fmt.Errorf("invalid secret data: %w", err)
+	}
+
+	return data, nil
+}
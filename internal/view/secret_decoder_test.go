@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretDecoderNew(t *testing.T) {
+	ctx := makeCtx()
+	app := ctx.Value(internal.KeyApp).(*view.App)
+
+	v := view.NewSecretDecoder(app, "default/fred", map[string]string{"pwd": "blee"})
+
+	assert.Nil(t, v.Init(ctx))
+	assert.Equal(t, "Secret Decoder", v.Name())
+	_, ok := v.Actions().Get(ui.KeyR)
+	assert.True(t, ok)
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/k9s/internal/view/cmd"
+)
+
+// sessionSkips tracks views that can not be restored.
+var sessionSkips = map[string]struct{}{
+	"contexts":   {},
+	"keys":       {},
+	"manifests":  {},
+	"pluginrows": {},
+}
+
+// newSession captures the resource views on the stack.
+func newSession(ns string, cc []model.Component) *data.Session {
+	s := data.Session{Namespace: ns}
+	for _, c := range cc {
+		v, ok := c.(ResourceViewer)
+		if !ok {
+			continue
+		}
+		if _, ok := sessionSkips[v.GVR().String()]; ok {
+			continue
+		}
+		sc := v.GetTable().GetSortCol()
+		s.Views = append(s.Views, data.SessionView{
+			Command:    v.GVR().String(),
+			Filter:     v.GetTable().CmdBuff().GetText(),
+			SortColumn: sc.Name,
+			SortAsc:    sc.ASC,
+		})
+	}
+
+	return &s
+}
+
+// saveSession records the current workspace in the active context state.
+func (a *App) saveSession() {
+	if _, err := a.Config.K9s.ActiveContext(); err != nil {
+		log.Warn().Err(err).Msg("Unable to save session")
+		return
+	}
+	path := a.Config.K9s.ContextStateFile()
+	st, err := config.LoadState(path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to load state %q", path)
+		st = new(config.State)
+	}
+	s := newSession(a.Config.ActiveNamespace(), a.body.Main().Peek())
+	if p, ok := a.body.Secondary(); ok {
+		s.Split = &data.SessionSplit{
+			Vertical: a.body.IsVertical(),
+			Views:    newSession("", p.Peek()).Views,
+		}
+	}
+	st.Session = s
+	if err := st.Save(path); err != nil {
+		log.Warn().Err(err).Msgf("Unable to save state %q", path)
+	}
+}
+
+// offerSession prompts to restore the session saved on the active context.
+func (a *App) offerSession() {
+	if _, err := a.Config.K9s.ActiveContext(); err != nil {
+		return
+	}
+	st, err := config.LoadState(a.Config.K9s.ContextStateFile())
+	if err != nil || !st.Session.IsCustomized() {
+		return
+	}
+	s := st.Session
+	msg := fmt.Sprintf("Restore previous session?\n%s", s)
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Restore Session", msg, func() {
+		if err := a.restoreSession(s); err != nil {
+			a.Flash().Errf("Session restore failed: %s", err)
+		}
+	}, func() {})
+}
+
+// loadHistory restores the commands and filters history.
+func (a *App) loadHistory() {
+	h, err := config.LoadHistory(config.AppHistoryFile)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to load history %q", config.AppHistoryFile)
+		return
+	}
+	for i := len(h.Commands) - 1; i >= 0; i-- {
+		a.cmdHistory.Push(h.Commands[i])
+	}
+	for i := len(h.Filters) - 1; i >= 0; i-- {
+		a.filterHistory.Push(h.Filters[i])
+	}
+}
+
+// saveHistory persists the commands and filters history.
+func (a *App) saveHistory() {
+	h := config.History{
+		Commands: a.cmdHistory.List(),
+		Filters:  a.filterHistory.List(),
+	}
+	if err := h.Save(config.AppHistoryFile); err != nil {
+		log.Warn().Err(err).Msgf("Unable to save history %q", config.AppHistoryFile)
+	}
+}
+
+// restoreSession rebuilds the saved view stacks.
+func (a *App) restoreSession(s *data.Session) error {
+	if s.Namespace != "" {
+		if err := a.switchNS(s.Namespace); err != nil {
+			return err
+		}
+	}
+	if err := a.restoreViews(s.Views); err != nil {
+		return err
+	}
+	if s.Split == nil || len(s.Split.Views) == 0 {
+		return nil
+	}
+	if err := a.splitBody(s.Split.Vertical, s.Split.Views[0].Command); err != nil {
+		return err
+	}
+	defer a.focusPane(0)
+
+	return a.restoreViews(s.Split.Views)
+}
+
+// restoreViews rebuilds a view stack on the active pane.
+func (a *App) restoreViews(vv []data.SessionView) error {
+	for i, sv := range vv {
+		if err := a.command.run(cmd.NewInterpreter(sv.Command), "", i == 0); err != nil {
+			return err
+		}
+		v, ok := a.Content.Top().(ResourceViewer)
+		if !ok {
+			continue
+		}
+		if sv.SortColumn != "" {
+			v.GetTable().SetSortCol(sv.SortColumn, sv.SortAsc)
+		}
+		if sv.Filter != "" {
+			v.SetFilter(sv.Filter)
+		}
+	}
+
+	return nil
+}
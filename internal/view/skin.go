@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const skinTitle = "Skins"
+
+// skinCmd switches to the named skin. Without a name, a picker previews
+// skins as they get selected.
+func (a *App) skinCmd(name string) error {
+	if name != "" {
+		return a.useSkin(name)
+	}
+
+	ss, err := config.SkinNames()
+	if err != nil {
+		return err
+	}
+	if len(ss) == 0 {
+		return errors.New("no skins found in " + config.AppSkinsDir)
+	}
+
+	picker := NewPicker()
+	picker.populate(ss)
+	picker.SetChangedFunc(func(idx int, _, _ string, _ rune) {
+		if err := a.ApplySkin(ss[idx]); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+	picker.SetSelectedFunc(func(idx int, _, _ string, _ rune) {
+		if err := a.useSkin(ss[idx]); err != nil {
+			a.RefreshStyles(a)
+			a.Flash().Err(err)
+		}
+		a.Content.Pop()
+	})
+	if err := a.inject(picker, false); err != nil {
+		return err
+	}
+	picker.SetTitle(" [aqua::b]" + skinTitle + " ")
+	picker.actions.Add(tcell.KeyEscape, ui.NewKeyAction("Back", func(evt *tcell.EventKey) *tcell.EventKey {
+		a.RefreshStyles(a)
+		return a.PrevCmd(evt)
+	}, true))
+
+	return nil
+}
+
+// useSkin applies the named skin and saves it as the active context skin.
+func (a *App) useSkin(name string) error {
+	if err := a.ApplySkin(name); err != nil {
+		return err
+	}
+	ct, err := a.Config.K9s.ActiveContext()
+	if err != nil {
+		return err
+	}
+	ct.Skin = name
+	if err := a.Config.Save(true); err != nil {
+		log.Error().Err(err).Msg("config save failed!")
+	}
+	a.Flash().Infof("Skin %q applied", name)
+
+	return nil
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+)
+
+const (
+	cpuTrendCol = "CPU~"
+	memTrendCol = "MEM~"
+)
+
+// usageTrends samples resources usage on each refresh and renders their
+// recent trends as sparklines.
+type usageTrends struct {
+	history *model.UsageHistory
+}
+
+func newUsageTrends(h *model.UsageHistory) *usageTrends {
+	return &usageTrends{history: h}
+}
+
+// startTrends hooks usage sampling on the viewer model when sparklines are enabled.
+func startTrends(v ResourceViewer) *usageTrends {
+	cfg := v.App().Config.K9s.UI
+	if !cfg.Sparklines {
+		return nil
+	}
+	t := newUsageTrends(v.App().usageHistory(v.GVR(), cfg.GetSparklineSamples()))
+	v.GetTable().GetModel().AddListener(t)
+
+	return t
+}
+
+// stopTrends unhooks usage sampling from the viewer model.
+func stopTrends(v ResourceViewer, t *usageTrends) {
+	if t != nil {
+		v.GetTable().GetModel().RemoveListener(t)
+	}
+}
+
+// usageHistory returns the usage samples of the given resource.
+func (a *App) usageHistory(gvr client.GVR, limit int) *model.UsageHistory {
+	h, ok := a.usage[gvr.String()]
+	if !ok {
+		h = model.NewUsageHistory(limit)
+		a.usage[gvr.String()] = h
+	}
+
+	return h
+}
+
+// TableDataChanged samples the usage of all rows.
+func (u *usageTrends) TableDataChanged(data *model1.TableData) {
+	cpu, ok := data.IndexOfHeader("CPU")
+	if !ok {
+		return
+	}
+	mem, ok := data.IndexOfHeader("MEM")
+	if !ok {
+		return
+	}
+
+	fqns := make(map[string]struct{}, data.RowCount())
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		fqns[re.Row.ID] = struct{}{}
+		c, err := strconv.Atoi(strings.TrimSpace(re.Row.Fields[cpu]))
+		if err != nil {
+			return true
+		}
+		m, err := strconv.Atoi(strings.TrimSpace(re.Row.Fields[mem]))
+		if err != nil {
+			return true
+		}
+		u.history.Add(re.Row.ID, model.UsageSample{CPU: c, MEM: m})
+
+		return true
+	})
+	u.history.Retain(fqns)
+}
+
+// TableLoadFailed notifies the load failed.
+func (*usageTrends) TableLoadFailed(error) {}
+
+// decorate inserts the cpu and memory trend columns after the usage columns.
+func (u *usageTrends) decorate(data *model1.TableData) {
+	if _, ok := data.IndexOfHeader(cpuTrendCol); ok {
+		return
+	}
+	idx, ok := data.IndexOfHeader("MEM")
+	if !ok {
+		return
+	}
+
+	h := data.Header()
+	hh := make(model1.Header, 0, len(h)+2)
+	hh = append(hh, h[:idx+1]...)
+	hh = append(hh,
+		model1.HeaderColumn{Name: cpuTrendCol, MX: true},
+		model1.HeaderColumn{Name: memTrendCol, MX: true},
+	)
+	hh = append(hh, h[idx+1:]...)
+	data.SetHeader(data.GetNamespace(), hh)
+
+	data.RowsRange(func(i int, re model1.RowEvent) bool {
+		cpu, mem := trends(u.history.Samples(re.Row.ID))
+		re.Row.Fields = insertAt(re.Row.Fields, idx+1, cpu, mem)
+		if len(re.Deltas) > 0 {
+			re.Deltas = insertAt(re.Deltas, idx+1, "", "")
+		}
+		data.SetRow(i, re)
+
+		return true
+	})
+}
+
+// trends renders the cpu and memory sparklines of the given samples.
+func trends(ss []model.UsageSample) (string, string) {
+	cc, mm := make([]int, 0, len(ss)), make([]int, 0, len(ss))
+	var maxCPU, maxMEM int
+	for _, s := range ss {
+		cc, mm = append(cc, s.CPU), append(mm, s.MEM)
+		maxCPU, maxMEM = max(maxCPU, s.CPU), max(maxMEM, s.MEM)
+	}
+
+	return render.Sparkline(cc, max(maxCPU, 1)), render.Sparkline(mm, max(maxMEM, 1))
+}
+
+func insertAt(ss []string, idx int, vv ...string) []string {
+	out := make([]string, 0, len(ss)+len(vv))
+	out = append(out, ss[:idx]...)
+	out = append(out, vv...)
+
+	return append(out, ss[idx:]...)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageTrends(t *testing.T) {
+	u := newUsageTrends(model.NewUsageHistory(3))
+	for _, s := range []model.UsageSample{{CPU: 10, MEM: 100}, {CPU: 20, MEM: 50}, {CPU: 40, MEM: 100}, {CPU: 80, MEM: 200}} {
+		u.TableDataChanged(makeUsageData(s))
+	}
+
+	data := makeUsageData(model.UsageSample{CPU: 80, MEM: 200})
+	u.decorate(data)
+
+	assert.Equal(t, []string{"NAME", "CPU", "MEM", cpuTrendCol, memTrendCol, "AGE"}, data.ColumnNames(true))
+	re, ok := data.RowAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, model1.Fields{"p1", "80", "200", "▂▄█", "▂▄█", "1m"}, re.Row.Fields)
+	re, ok = data.RowAt(1)
+	assert.True(t, ok)
+	assert.Equal(t, model1.Fields{"p2", "n/a", "n/a", "", "", "1m"}, re.Row.Fields)
+
+	u.decorate(data)
+	assert.Equal(t, 6, data.HeaderCount())
+}
+
+func TestTrends(t *testing.T) {
+	uu := map[string]struct {
+		ss       []model.UsageSample
+		cpu, mem string
+	}{
+		"empty": {},
+		"flat": {
+			ss:  []model.UsageSample{{CPU: 0, MEM: 0}, {CPU: 0, MEM: 0}},
+			cpu: "▁▁",
+			mem: "▁▁",
+		},
+		"trend": {
+			ss:  []model.UsageSample{{CPU: 1, MEM: 4}, {CPU: 2, MEM: 2}, {CPU: 4, MEM: 1}},
+			cpu: "▂▄█",
+			mem: "█▄▂",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cpu, mem := trends(u.ss)
+			assert.Equal(t, u.cpu, cpu)
+			assert.Equal(t, u.mem, mem)
+		})
+	}
+}
+
+func makeUsageData(s model.UsageSample) *model1.TableData {
+	return model1.NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		model1.Header{
+			model1.HeaderColumn{Name: "NAME"},
+			model1.HeaderColumn{Name: "CPU", MX: true},
+			model1.HeaderColumn{Name: "MEM", MX: true},
+			model1.HeaderColumn{Name: "AGE", Time: true},
+		},
+		model1.NewRowEventsWithEvts(
+			model1.RowEvent{Row: model1.Row{ID: "default/p1", Fields: model1.Fields{"p1", strconv.Itoa(s.CPU), strconv.Itoa(s.MEM), "1m"}}},
+			model1.RowEvent{Row: model1.Row{ID: "default/p2", Fields: model1.Fields{"p2", "n/a", "n/a", "1m"}}},
+		),
+	)
+}
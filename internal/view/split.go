@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// Split represents the application body, possibly split in two panes each
+// hosting its own view stack.
+type Split struct {
+	*tview.Flex
+
+	panes    []*PageStack
+	active   int
+	vertical bool
+}
+
+// NewSplit returns a new body hosting a main pane.
+func NewSplit(main *PageStack) *Split {
+	s := Split{
+		Flex:  tview.NewFlex().SetDirection(tview.FlexRow),
+		panes: []*PageStack{main},
+	}
+	s.AddItem(main, 0, 1, true)
+
+	return &s
+}
+
+// IsSplit returns true if the body has a secondary pane.
+func (s *Split) IsSplit() bool {
+	return len(s.panes) > 1
+}
+
+// IsVertical returns true if panes are laid out side by side.
+func (s *Split) IsVertical() bool {
+	return s.vertical
+}
+
+// Main returns the main pane.
+func (s *Split) Main() *PageStack {
+	return s.panes[0]
+}
+
+// Secondary returns the secondary pane if any.
+func (s *Split) Secondary() (*PageStack, bool) {
+	if !s.IsSplit() {
+		return nil, false
+	}
+
+	return s.panes[1], true
+}
+
+func (s *Split) setDirection(vertical bool) {
+	s.vertical = vertical
+	if vertical {
+		s.SetDirection(tview.FlexColumn)
+		return
+	}
+	s.SetDirection(tview.FlexRow)
+}
+
+// splitBody opens a secondary pane and runs the given command in it. The
+// current view is duplicated when no command is given.
+func (a *App) splitBody(vertical bool, line string) error {
+	if line == "" {
+		v, ok := a.Content.Top().(ResourceViewer)
+		if !ok {
+			return errors.New("no resource view to split")
+		}
+		line = v.GVR().String()
+	}
+
+	a.body.setDirection(vertical)
+	created := !a.body.IsSplit()
+	if created {
+		p, err := a.newPageStack()
+		if err != nil {
+			return err
+		}
+		a.body.panes = append(a.body.panes, p)
+		a.body.AddItem(p, 0, 1, false)
+	}
+	a.focusPane(1)
+	if err := a.command.run(cmd.NewInterpreter(line), "", true); err != nil {
+		if created {
+			a.unsplit()
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) newPageStack() (*PageStack, error) {
+	p := NewPageStack()
+	if err := p.Init(context.WithValue(context.Background(), internal.KeyApp, a)); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// unsplit closes the secondary pane if any.
+func (a *App) unsplit() {
+	p, ok := a.body.Secondary()
+	if !ok {
+		return
+	}
+	a.focusPane(0)
+	if top := p.Top(); top != nil {
+		top.Stop()
+	}
+	a.body.RemoveItem(p)
+	a.body.panes = a.body.panes[:1]
+}
+
+// focusPane activates the given pane. Navigation and commands apply to the
+// active pane only.
+func (a *App) focusPane(idx int) {
+	if idx == a.body.active || idx >= len(a.body.panes) {
+		return
+	}
+	a.body.active = idx
+	a.activate(a.body.panes[idx])
+}
+
+// activate makes the given page stack the target of navigation and commands.
+func (a *App) activate(p *PageStack) {
+	a.Content.Stack.RemoveListener(a.Crumbs())
+	a.Content.Stack.RemoveListener(a.Menu())
+
+	a.Content = p
+	a.Content.Stack.AddListener(a.Crumbs())
+	a.Content.Stack.AddListener(a.Menu())
+	a.Crumbs().Reset(a.Content.Peek())
+	if top := a.Content.Top(); top != nil {
+		a.SetFocus(top)
+		a.Prompt().SetView(viewKey(top))
+	}
+}
+
+func (a *App) switchPaneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !a.body.IsSplit() {
+		return evt
+	}
+	a.focusPane((a.body.active + 1) % len(a.body.panes))
+
+	return nil
+}
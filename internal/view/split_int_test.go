@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitNew(t *testing.T) {
+	m := NewPageStack()
+	s := NewSplit(m)
+
+	assert.False(t, s.IsSplit())
+	assert.Equal(t, m, s.Main())
+	_, ok := s.Secondary()
+	assert.False(t, ok)
+}
+
+func TestSplitDirection(t *testing.T) {
+	s := NewSplit(NewPageStack())
+	s.panes = append(s.panes, NewPageStack())
+
+	s.setDirection(true)
+	assert.True(t, s.IsVertical())
+	assert.True(t, s.IsSplit())
+	p, ok := s.Secondary()
+	assert.True(t, ok)
+	assert.Equal(t, s.panes[1], p)
+
+	s.setDirection(false)
+	assert.False(t, s.IsVertical())
+}
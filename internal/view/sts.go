@@ -4,11 +4,18 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
 	appsv1 "k8s.io/api/apps/v1"
 )
 
@@ -52,6 +59,98 @@ func (s *StatefulSet) logOptions(prev bool) (*dao.LogOptions, error) {
 
 func (s *StatefulSet) bindKeys(aa *ui.KeyActions) {
 	aa.Add(ui.KeyShiftR, ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd(readyCol, true), false))
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftT: ui.NewKeyActionWithOpts("Partition", s.partitionCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftO: ui.NewKeyActionWithOpts("Restart Ordinals", s.restartOrdinalsCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+	})
+}
+
+func (s *StatefulSet) partitionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	sts, err := s.getInstance(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	oo := dao.Ordinals(sts)
+	dialog.ShowInput(s.App().Styles.Dialog(), s.App().Content.Pages, dialog.InputDialogOpts{
+		Title:   "Partition",
+		Message: fmt.Sprintf("Only %s pods with an ordinal greater or equal to the partition are updated on rollouts (ordinals %s)", path, ordinalsRange(oo)),
+		Label:   "Partition:",
+		Value:   strconv.Itoa(int(dao.Partition(sts))),
+		Ack: func(v string) {
+			partition, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				s.App().Flash().Errf("Invalid partition %q", v)
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+			defer cancel()
+			if err := s.resource().SetPartition(ctx, path, int32(partition)); err != nil {
+				s.App().Flash().Err(err)
+				return
+			}
+			s.App().Flash().Infof("Partition set to %d for %s", partition, path)
+		},
+		Cancel: func() {},
+	})
+
+	return nil
+}
+
+func (s *StatefulSet) restartOrdinalsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	sts, err := s.getInstance(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	oo := dao.Ordinals(sts)
+	if len(oo) == 0 {
+		s.App().Flash().Warnf("No pods to restart for %s", path)
+		return nil
+	}
+	dialog.ShowInput(s.App().Styles.Dialog(), s.App().Content.Pages, dialog.InputDialogOpts{
+		Title:   "Restart Ordinals",
+		Message: fmt.Sprintf("Restart %s pods one at a time in the given order, each pod being ready before moving on ie 2,0 or 4-2", path),
+		Label:   "Ordinals:",
+		Value:   fmt.Sprintf("%d-%d", oo[len(oo)-1], oo[0]),
+		Ack: func(v string) {
+			ords, err := parseOrdinals(v, oo[0], oo[len(oo)-1])
+			if err != nil {
+				s.App().Flash().Errf("Invalid ordinals for %s: %s", path, err)
+				return
+			}
+			pods := make([]string, 0, len(ords))
+			for _, o := range ords {
+				pods = append(pods, fmt.Sprintf("%s-%d", sts.Name, o))
+			}
+			res := s.resource()
+			runOrdered(context.Background(), s.App(), "Restart Ordinals", pods, func(ctx context.Context, pod string) error {
+				return res.RestartOrdinal(ctx, path, ords[slices.Index(pods, pod)])
+			}, s.Refresh)
+		},
+		Cancel: func() {},
+	})
+
+	return nil
 }
 
 func (s *StatefulSet) showPods(app *App, _ ui.Tabular, _ client.GVR, path string) {
@@ -69,3 +168,70 @@ func (s *StatefulSet) getInstance(path string) (*appsv1.StatefulSet, error) {
 
 	return sts.GetInstance(s.App().factory, path)
 }
+
+func (s *StatefulSet) resource() *dao.StatefulSet {
+	var sts dao.StatefulSet
+	sts.Init(s.App().factory, s.GVR())
+
+	return &sts
+}
+
+// Helpers...
+
+// parseOrdinals parses a list of ordinals and ordinals ranges ie 3,1 or 4-2,0.
+// Ordinals must be within the given min and max bounds.
+func parseOrdinals(s string, min, max int) ([]int, error) {
+	oo := make([]int, 0, max-min+1)
+	seen := make(map[int]struct{}, max-min+1)
+	add := func(o int) {
+		if _, ok := seen[o]; !ok {
+			seen[o] = struct{}{}
+			oo = append(oo, o)
+		}
+	}
+	check := func(o int) error {
+		if o < min || o > max {
+			return fmt.Errorf("no ordinal %d (ordinals %d-%d)", o, min, max)
+		}
+		return nil
+	}
+	for _, t := range strings.Split(s, ",") {
+		t = strings.TrimSpace(t)
+		from, to, isRange := strings.Cut(t, "-")
+		start, err := strconv.Atoi(strings.TrimSpace(from))
+		if err != nil || start < 0 {
+			return nil, fmt.Errorf("invalid ordinal %q", t)
+		}
+		if err := check(start); err != nil {
+			return nil, err
+		}
+		if !isRange {
+			add(start)
+			continue
+		}
+		end, err := strconv.Atoi(strings.TrimSpace(to))
+		if err != nil || end < 0 {
+			return nil, fmt.Errorf("invalid ordinals range %q", t)
+		}
+		if err := check(end); err != nil {
+			return nil, err
+		}
+		step := 1
+		if end < start {
+			step = -1
+		}
+		for o := start; o != end+step; o += step {
+			add(o)
+		}
+	}
+
+	return oo, nil
+}
+
+func ordinalsRange(oo []int) string {
+	if len(oo) == 0 {
+		return "none"
+	}
+
+	return fmt.Sprintf("%d-%d", oo[0], oo[len(oo)-1])
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOrdinals(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   []int
+		err bool
+	}{
+		"single": {
+			s: "2",
+			e: []int{2},
+		},
+		"list": {
+			s: "2, 0,1",
+			e: []int{2, 0, 1},
+		},
+		"range": {
+			s: "1-3",
+			e: []int{1, 2, 3},
+		},
+		"reverse-range": {
+			s: "4-2,0,3",
+			e: []int{4, 3, 2, 0},
+		},
+		"toast": {
+			s:   "fred",
+			err: true,
+		},
+		"toast-range": {
+			s:   "2-",
+			err: true,
+		},
+		"negative": {
+			s:   "-1",
+			err: true,
+		},
+		"out-of-bounds": {
+			s:   "2,6",
+			err: true,
+		},
+		"huge-range": {
+			s:   "0-100000000",
+			err: true,
+		},
+		"dups": {
+			s: "1,0-2,1",
+			e: []int{1, 0, 2},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			oo, err := parseOrdinals(u.s, 0, 5)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, oo)
+		})
+	}
+}
@@ -4,35 +4,52 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
-	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
-	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// serviceDoc documents the service view.
+var serviceDoc = ViewDoc{
+	Summary: "Services exposing pods",
+	Columns: model.MenuHints{
+		{Mnemonic: "TYPE", Description: "Service type"},
+		{Mnemonic: "CLUSTER-IP", Description: "Virtual IP inside the cluster"},
+		{Mnemonic: "EXTERNAL-IP", Description: "Load balancer or external address"},
+		{Mnemonic: "PORTS", Description: "Service ports and node ports"},
+	},
+	Related: model.MenuHints{
+		{Mnemonic: ":pods", Description: "Selected pods via enter"},
+		{Mnemonic: ":ep", Description: "Service endpoints"},
+		{Mnemonic: ":ing", Description: "Ingresses"},
+	},
+}
+
 // Service represents a service viewer.
 type Service struct {
 	ResourceViewer
 
-	bench *perf.Benchmark
+	benches benchRuns
 }
 
 // NewService returns a new viewer.
 func NewService(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), serviceDoc)
 	s := Service{
 		ResourceViewer: NewPortForwardExtender(
 			NewLogsExtender(NewBrowser(gvr), nil),
@@ -49,6 +66,7 @@ func NewService(gvr client.GVR) ResourceViewer {
 func (s *Service) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyB:      ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
+		ui.KeyShiftH: ui.NewKeyAction("Health", s.healthCmd, true),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("TYPE", true), false),
 	})
 }
@@ -74,6 +92,22 @@ func (s *Service) showPods(a *App, _ ui.Tabular, _ client.GVR, path string) {
 	showPods(a, path, toLabelsStr(svc.Spec.Selector), "")
 }
 
+func (s *Service) healthCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	v := NewServiceHealth(client.NewGVR("svchealth"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := s.App().inject(v, false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (s *Service) checkSvc(svc *v1.Service) error {
 	if svc.Spec.Type != "NodePort" && svc.Spec.Type != "LoadBalancer" {
 		return errors.New("you must select a reachable service")
@@ -97,62 +131,58 @@ func (s *Service) getExternalPort(svc *v1.Service) (string, error) {
 }
 
 func (s *Service) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if s.bench != nil {
+	sels := s.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+	if s.benches.cancel(sels) > 0 {
 		log.Debug().Msg(">>> Benchmark canceled!!")
 		s.App().Status(model.FlashErr, "Benchmark Canceled!")
-		s.bench.Cancel()
 		s.App().ClearStatus(true)
 		return nil
 	}
 
-	path := s.GetTable().GetSelectedItem()
-	if path == "" || s.bench != nil {
-		return evt
-	}
-
 	cust, err := config.NewBench(s.App().BenchFile)
 	if err != nil {
 		log.Debug().Msgf("No bench config file found %s", s.App().BenchFile)
 	}
+	for _, path := range sels {
+		if err := s.runBenchmark(cust.Benchmarks, path); err != nil {
+			s.App().Flash().Errf("Benchmark failed %v", err)
+			s.App().ClearStatus(false)
+		}
+	}
+
+	return nil
+}
 
-	cfg, ok := cust.Benchmarks.Services[path]
+func (s *Service) runBenchmark(bb *config.Benchmarks, path string) error {
+	cfg, ok := bb.Services[path]
 	if !ok {
-		s.App().Flash().Errf("No bench config found for service %s in %s", path, s.App().BenchFile)
-		return nil
+		return fmt.Errorf("no bench config found for service %s in %s", path, s.App().BenchFile)
+	}
+	cfg, err := bb.Resolve(cfg)
+	if err != nil {
+		return err
 	}
 	cfg.Name = path
 	log.Debug().Msgf("Benchmark config %#v", cfg)
+	if cfg.HTTP.Host == "" {
+		return fmt.Errorf("invalid benchmark host %q", cfg.HTTP.Host)
+	}
 
 	svc, err := fetchService(s.App().factory, path)
 	if err != nil {
-		s.App().Flash().Err(err)
-		return nil
+		return err
 	}
-	if e := s.checkSvc(svc); e != nil {
-		s.App().Flash().Err(e)
-		return nil
+	if err := s.checkSvc(svc); err != nil {
+		return err
 	}
 	port, err := s.getExternalPort(svc)
 	if err != nil {
-		s.App().Flash().Err(err)
-		return nil
-	}
-	if err := s.runBenchmark(port, cfg); err != nil {
-		s.App().Flash().Errf("Benchmark failed %v", err)
-		s.App().ClearStatus(false)
-		s.bench = nil
-	}
-
-	return nil
-}
-
-// BOZO!! Refactor used by forwards.
-func (s *Service) runBenchmark(port string, cfg config.BenchConfig) error {
-	if cfg.HTTP.Host == "" {
-		return fmt.Errorf("invalid benchmark host %q", cfg.HTTP.Host)
+		return err
 	}
 
-	var err error
 	base := cfg.HTTP.Host
 	if !strings.Contains(base, ":") {
 		base += ":" + port + cfg.HTTP.Path
@@ -160,39 +190,10 @@ func (s *Service) runBenchmark(port string, cfg config.BenchConfig) error {
 		base += cfg.HTTP.Path
 	}
 	if strings.Index(base, "http") != 0 {
-		base = "http://" + base
-	}
-
-	if s.bench, err = perf.NewBenchmark(base, s.App().version, cfg); err != nil {
-		return err
+		base = cfg.HTTP.URLScheme() + "://" + base
 	}
 
-	s.App().Status(model.FlashWarn, "Benchmark in progress...")
-	log.Debug().Msg("Benchmark starting...")
-
-	ct, err := s.App().Config.K9s.ActiveContext()
-	if err != nil {
-		return err
-	}
-	name := s.App().Config.K9s.ActiveContextName()
-
-	go s.bench.Run(ct.ClusterName, name, s.benchDone)
-
-	return nil
-}
-
-func (s *Service) benchDone() {
-	log.Debug().Msg("Bench Completed!")
-	s.App().QueueUpdate(func() {
-		if s.bench.Canceled() {
-			s.App().Status(model.FlashInfo, "Benchmark canceled")
-		} else {
-			s.App().Status(model.FlashInfo, "Benchmark Completed!")
-			s.bench.Cancel()
-		}
-		s.bench = nil
-		go clearStatus(s.App())
-	})
+	return s.benches.run(s.App(), path, base, cfg)
 }
 
 // ----------------------------------------------------------------------------
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// ServiceHealth represents a service pods and endpoints health view.
+type ServiceHealth struct {
+	ResourceViewer
+}
+
+// NewServiceHealth returns a new service health view.
+func NewServiceHealth(gvr client.GVR) ResourceViewer {
+	s := ServiceHealth{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *ServiceHealth) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyEnter: ui.NewKeyAction("Goto Pod", s.gotoCmd, true),
+		ui.KeyShiftP:   ui.NewKeyAction("Sort Pod", s.GetTable().SortColCmd("POD", true), false),
+		ui.KeyShiftE:   ui.NewKeyAction("Sort Endpoint", s.GetTable().SortColCmd("ENDPOINT", true), false),
+	})
+}
+
+func (s *ServiceHealth) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	row := s.GetTable().GetSelectedRow(path)
+	if row == nil || row.Fields[1] == "" {
+		s.App().Flash().Warn("No pod matched by this service")
+		return nil
+	}
+	s.App().gotoResource("pods", client.FQN(row.Fields[0], row.Fields[1]), false)
+
+	return nil
+}
@@ -173,5 +173,5 @@ func TestServiceNew(t *testing.T) {
 
 	assert.Nil(t, s.Init(makeCtx()))
 	assert.Equal(t, "Services", s.Name())
-	assert.Equal(t, 11, len(s.Hints()))
+	assert.Equal(t, 14, len(s.Hints()))
 }
@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	mainTab = "main"
+	maxTabs = 9
+)
+
+// Tab represents a named workspace with its own views and namespace.
+type Tab struct {
+	name string
+	ns   string
+	body *Split
+}
+
+// Name returns the tab name.
+func (t *Tab) Name() string {
+	return t.name
+}
+
+// Tabs represents a collection of tabs along with their tab bar.
+type Tabs struct {
+	*tview.TextView
+
+	tabs   []*Tab
+	active int
+	styles *config.Styles
+}
+
+// NewTabs returns a new tabs collection.
+func NewTabs(styles *config.Styles, main *Split) *Tabs {
+	t := Tabs{
+		TextView: tview.NewTextView(),
+		tabs:     []*Tab{{name: mainTab, body: main}},
+		styles:   styles,
+	}
+	t.SetDynamicColors(true)
+	t.SetTextAlign(tview.AlignLeft)
+	t.SetBorderPadding(0, 0, 1, 1)
+	t.StylesChanged(styles)
+	styles.AddListener(&t)
+
+	return &t
+}
+
+// StylesChanged notifies skin changed.
+func (t *Tabs) StylesChanged(s *config.Styles) {
+	t.styles = s
+	t.SetBackgroundColor(s.BgColor())
+	t.refresh()
+}
+
+// Len returns the number of tabs.
+func (t *Tabs) Len() int {
+	return len(t.tabs)
+}
+
+// Current returns the active tab.
+func (t *Tabs) Current() *Tab {
+	return t.tabs[t.active]
+}
+
+// IndexOf returns the index of a named tab.
+func (t *Tabs) IndexOf(name string) (int, bool) {
+	for i, tab := range t.tabs {
+		if strings.EqualFold(tab.name, name) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+func (t *Tabs) refresh() {
+	t.Clear()
+	for i, tab := range t.tabs {
+		bg := t.styles.Frame().Crumb.BgColor
+		if i == t.active {
+			bg = t.styles.Frame().Crumb.ActiveColor
+		}
+		fmt.Fprintf(t, "[%s:%s:b] %d:%s [-:%s:-] ",
+			t.styles.Frame().Crumb.FgColor, bg, i+1, tab.name, t.styles.Body().BgColor)
+	}
+}
+
+// newTab opens a named tab and runs the given command in it. Opening an
+// existing tab switches to it.
+func (a *App) newTab(name, line string) error {
+	if name == "" {
+		return errors.New("a tab name is required")
+	}
+	if idx, ok := a.tabs.IndexOf(name); ok {
+		a.switchTab(idx)
+		return nil
+	}
+	if a.tabs.Len() >= maxTabs {
+		return fmt.Errorf("no more than %d tabs allowed", maxTabs)
+	}
+	if line == "" {
+		line = a.Config.ActiveView()
+	}
+
+	p, err := a.newPageStack()
+	if err != nil {
+		return err
+	}
+	a.tabs.tabs = append(a.tabs.tabs, &Tab{
+		name: name,
+		ns:   a.Config.ActiveNamespace(),
+		body: NewSplit(p),
+	})
+	a.switchTab(a.tabs.Len() - 1)
+	if err := a.command.run(cmd.NewInterpreter(line), "", true); err != nil {
+		a.closeTab()
+		return err
+	}
+
+	return nil
+}
+
+// switchTab activates the tab at the given index. Views of inactive tabs
+// are stopped.
+func (a *App) switchTab(idx int) {
+	if idx == a.tabs.active || idx < 0 || idx >= a.tabs.Len() {
+		return
+	}
+	cur := a.tabs.Current()
+	cur.ns = a.Config.ActiveNamespace()
+	for _, p := range cur.body.panes {
+		if top := p.Top(); top != nil {
+			top.Stop()
+		}
+	}
+
+	a.tabs.active = idx
+	next := a.tabs.Current()
+	idx = indexOf(a.workspace, cur.body)
+	a.workspace.RemoveItem(cur.body)
+	a.workspace.AddItemAtIndex(idx, next.body, 0, 1, true)
+	a.body = next.body
+	if err := a.switchNS(next.ns); err != nil {
+		a.Flash().Err(err)
+	}
+	for _, p := range next.body.panes {
+		if top := p.Top(); top != nil {
+			top.Start()
+		}
+	}
+	a.activate(next.body.panes[next.body.active])
+	a.showTabs()
+}
+
+// closeTab closes the active tab. The main tab can not be closed.
+func (a *App) closeTab() {
+	if a.tabs.active == 0 {
+		return
+	}
+	victim := a.tabs.active
+	a.switchTab(victim - 1)
+	a.tabs.tabs = append(a.tabs.tabs[:victim], a.tabs.tabs[victim+1:]...)
+	a.showTabs()
+}
+
+// closeTabs closes all tabs but the active one.
+func (a *App) closeTabs() {
+	a.tabs.tabs, a.tabs.active = []*Tab{a.tabs.Current()}, 0
+	a.showTabs()
+}
+
+// showTabs displays the tab bar when more than one tab is opened.
+func (a *App) showTabs() {
+	a.tabs.refresh()
+	shown := indexOf(a.workspace, a.tabs) != -1
+	switch {
+	case a.tabs.Len() > 1 && !shown:
+		a.workspace.AddItemAtIndex(0, a.tabs, 1, 1, false)
+	case a.tabs.Len() == 1 && shown:
+		a.workspace.RemoveItem(a.tabs)
+	}
+}
+
+func (a *App) tabCmd(idx int) func(*tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if idx >= a.tabs.Len() {
+			return evt
+		}
+		a.switchTab(idx)
+
+		return nil
+	}
+}
+
+func (a *App) bindTabKeys() {
+	m := make(ui.KeyMap, maxTabs)
+	for i := 0; i < maxTabs; i++ {
+		m[ui.AltNumKeys[i+1]] = ui.NewSharedKeyAction(fmt.Sprintf("Tab %d", i+1), a.tabCmd(i), false)
+	}
+	a.AddActions(ui.NewKeyActionsFromMap(m))
+}
+
+// indexOf returns the index of a primitive in a flex or -1 if not found.
+func indexOf(f *tview.Flex, p tview.Primitive) int {
+	for i := 0; f.ItemAt(i) != nil; i++ {
+		if f.ItemAt(i) == p {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTabsNew(t *testing.T) {
+	tt := NewTabs(config.NewStyles(), NewSplit(NewPageStack()))
+	tt.tabs = append(tt.tabs, &Tab{name: "Prod", body: NewSplit(NewPageStack())})
+
+	assert.Equal(t, 2, tt.Len())
+	assert.Equal(t, mainTab, tt.Current().Name())
+	idx, ok := tt.IndexOf("prod")
+	assert.True(t, ok)
+	assert.Equal(t, 1, idx)
+	_, ok = tt.IndexOf("staging")
+	assert.False(t, ok)
+
+	tt.refresh()
+	assert.Equal(t, " 1:main   2:Prod  ", tt.GetText(true))
+}
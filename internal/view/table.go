@@ -11,11 +11,12 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
-	"github.com/rs/zerolog/log"
 )
 
 // Table represents a table viewer.
@@ -56,7 +57,11 @@ func (t *Table) Init(ctx context.Context) (err error) {
 
 	ctx = context.WithValue(ctx, internal.KeyViewConfig, t.app.CustomView)
 	t.Table.Init(ctx)
+	t.SetHighlightChanges(t.app.Config.K9s.UI.HighlightChanges)
+	t.SetChangesWindow(t.app.Config.K9s.UI.GetChangesWindow())
 	t.SetInputCapture(t.keyboard)
+	t.SetSortChangedFn(t.saveSort)
+	t.restoreSort()
 	t.bindKeys()
 	t.GetModel().SetRefreshRate(time.Duration(t.app.Config.K9s.GetRefreshRate()) * time.Second)
 	t.CmdBuff().AddListener(t)
@@ -92,6 +97,12 @@ func (t *Table) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	if key == tcell.KeyUp || key == tcell.KeyDown {
 		return evt
 	}
+	if key == tcell.KeyEnter && !t.app.Content.IsTopDialog() {
+		if g, ok := t.SelectedGroup(); ok {
+			t.ToggleGroup(g)
+			return nil
+		}
+	}
 
 	if a, ok := t.Actions().Get(ui.AsKey(evt)); ok && !t.app.Content.IsTopDialog() {
 		return a.Action(evt)
@@ -176,7 +187,7 @@ func (t *Table) BufferActive(state bool, k model.BufferKind) {
 }
 
 func (t *Table) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if path, err := saveTable(t.app.Config.K9s.ContextScreenDumpDir(), t.GVR().R(), t.Path, t.GetFilteredData()); err != nil {
+	if path, err := saveTable(t.app.Config.K9s.ContextScreenDumpDir(), t.GVR().R(), t.Path, t.GetFilteredData(), t.app.Config.K9s.ScreenDumps); err != nil {
 		t.app.Flash().Err(err)
 	} else {
 		t.app.Flash().Infof("File saved successfully: %q", render.Truncate(filepath.Base(path), 50))
@@ -195,16 +206,60 @@ func (t *Table) bindKeys() {
 		ui.KeySlash:            ui.NewSharedKeyAction("Filter Mode", t.activateCmd, false),
 		tcell.KeyCtrlZ:         ui.NewKeyAction("Toggle Faults", t.toggleFaultCmd, false),
 		tcell.KeyCtrlW:         ui.NewKeyAction("Toggle Wide", t.toggleWideCmd, false),
+		tcell.KeyCtrlY:         ui.NewKeyAction("Toggle Changes", t.toggleChangesCmd, false),
 		ui.KeyShiftN:           ui.NewKeyAction("Sort Name", t.SortColCmd(nameCol, true), false),
 		ui.KeyShiftA:           ui.NewKeyAction("Sort Age", t.SortColCmd(ageCol, true), false),
+		ui.KeyAltS:             ui.NewKeyAction("Sort Then", t.thenSortCmd, false),
 	})
 }
 
+func (t *Table) thenSortCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.ThenSort()
+	t.app.Flash().Info("Pick a secondary sort column...")
+
+	return nil
+}
+
+// restoreSort applies the sort columns saved for the view.
+func (t *Table) restoreSort() {
+	ct, err := t.app.Config.K9s.ActiveContext()
+	if err != nil || ct.View == nil {
+		return
+	}
+	spec := ct.View.SortFor(t.GVR().String())
+	if spec == "" {
+		return
+	}
+	sc, err := model1.ParseSortColumn(spec)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Skipping saved sort for %q", t.GVR())
+		return
+	}
+	t.SetSortColumn(sc)
+}
+
+// saveSort saves the view sort columns on the active context.
+func (t *Table) saveSort(sc model1.SortColumn) {
+	ct, err := t.app.Config.K9s.ActiveContext()
+	if err != nil {
+		return
+	}
+	if ct.View == nil {
+		ct.View = data.NewView()
+	}
+	ct.View.SetSort(t.GVR().String(), sc.String())
+}
+
 func (t *Table) toggleFaultCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.ToggleToast()
 	return nil
 }
 
+func (t *Table) toggleChangesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.ToggleChanges()
+	return nil
+}
+
 func (t *Table) toggleWideCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.ToggleWide()
 	return nil
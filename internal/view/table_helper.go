@@ -12,10 +12,11 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/ui"
-	"github.com/rs/zerolog/log"
 )
 
 func computeFilename(dumpPath, ns, title, path string) (string, error) {
@@ -41,7 +42,7 @@ func computeFilename(dumpPath, ns, title, path string) (string, error) {
 	return strings.ToLower(filepath.Join(dir, fName)), nil
 }
 
-func saveTable(dir, title, path string, data *model1.TableData) (string, error) {
+func saveTable(dir, title, path string, data *model1.TableData, rp config.ScreenDumps) (string, error) {
 	ns := data.GetNamespace()
 	if client.IsClusterWide(ns) {
 		ns = client.NamespaceAll
@@ -75,6 +76,18 @@ func saveTable(dir, title, path string, data *model1.TableData) (string, error)
 	if err := w.Error(); err != nil {
 		return "", err
 	}
+	pruneDumps(dir, rp)
 
 	return fPath, nil
 }
+
+// pruneDumps enforces the screen dumps retention policies.
+func pruneDumps(dir string, rp config.ScreenDumps) {
+	n, err := dao.PruneScreenDumps(dir, rp)
+	if err != nil {
+		log.Error().Err(err).Msgf("Pruning screen dumps in %q", dir)
+	}
+	if n > 0 {
+		log.Debug().Msgf("Pruned %d screen dumps in %q", n, dir)
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Top represents a live resource usage view.
+type Top struct {
+	ResourceViewer
+
+	tracker   *dao.UsageTracker
+	pctSuffix string
+}
+
+// NewTopPod returns a new pods usage view.
+func NewTopPod(gvr client.GVR) ResourceViewer {
+	t := newTop(gvr, "/R")
+	t.AddBindKeysFn(func(aa *ui.KeyActions) {
+		aa.Add(ui.KeyShiftP, ui.NewKeyAction("Namespaces", t.namespacesCmd, true))
+	})
+	t.GetTable().SetEnterFn(topPodEnterFn)
+
+	return t
+}
+
+// NewTopNamespace returns a new namespaces usage view.
+func NewTopNamespace(gvr client.GVR) ResourceViewer {
+	t := newTop(gvr, "/R")
+	t.GetTable().SetEnterFn(topNamespaceEnterFn)
+
+	return t
+}
+
+// NewTopNode returns a new nodes usage view.
+func NewTopNode(gvr client.GVR) ResourceViewer {
+	t := newTop(gvr, "")
+	t.GetTable().SetEnterFn(topNodeEnterFn)
+
+	return t
+}
+
+func newTop(gvr client.GVR, pctSuffix string) *Top {
+	t := Top{
+		ResourceViewer: NewBrowser(gvr),
+		tracker:        dao.NewUsageTracker(),
+		pctSuffix:      pctSuffix,
+	}
+	t.GetTable().SetSortCol(cpuCol, false)
+	t.SetContextFn(t.topContext)
+	t.AddBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+func (t *Top) topContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyUsageTracker, t.tracker)
+}
+
+func (t *Top) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", t.GetTable().SortColCmd(cpuCol, false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", t.GetTable().SortColCmd(memCol, false), false),
+		ui.KeyShiftX: ui.NewKeyAction("Sort %CPU", t.GetTable().SortColCmd("%CPU"+t.pctSuffix, false), false),
+		ui.KeyShiftZ: ui.NewKeyAction("Sort %MEM", t.GetTable().SortColCmd("%MEM"+t.pctSuffix, false), false),
+		ui.KeyShiftD: ui.NewKeyAction("Sort ΔCPU", t.GetTable().SortColCmd("ΔCPU", false), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort ΔMEM", t.GetTable().SortColCmd("ΔMEM", false), false),
+	})
+}
+
+func (t *Top) namespacesCmd(*tcell.EventKey) *tcell.EventKey {
+	t.App().gotoResource("top-namespaces", "", false)
+
+	return nil
+}
+
+func topPodEnterFn(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	app.gotoResource("pods", path, false)
+}
+
+func topNamespaceEnterFn(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	app.gotoResource("top-pods "+path, "", false)
+}
+
+func topNodeEnterFn(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	app.gotoResource("nodes", path, false)
+}
@@ -95,6 +95,15 @@ type ResourceViewer interface {
 
 	// SetInstance sets a parent FQN
 	SetInstance(string)
+
+	// AcquireInformers leases the informers backing the viewer.
+	AcquireInformers()
+
+	// ReleaseInformers releases the informers backing the viewer.
+	ReleaseInformers()
+
+	// RefreshActions rebinds the viewer key actions.
+	RefreshActions()
 }
 
 // LogViewer represents a log viewer.
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// WatchExtender adds row status watch notifications to a resource viewer.
+type WatchExtender struct {
+	ResourceViewer
+}
+
+// NewWatchExtender returns a new extender.
+func NewWatchExtender(v ResourceViewer) ResourceViewer {
+	w := WatchExtender{ResourceViewer: v}
+	v.AddBindKeysFn(w.bindKeys)
+
+	return &w
+}
+
+func (w *WatchExtender) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyW, ui.NewKeyAction("Watch", w.watchCmd, true))
+}
+
+func (w *WatchExtender) watchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := w.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	rw := w.App().rowWatcher
+	if rw == nil {
+		w.App().Flash().Err(errors.New("row watcher is not available"))
+		return nil
+	}
+	if rw.IsWatched(w.GVR(), path) {
+		rw.Unwatch(w.GVR(), path)
+		w.App().Flash().Infof("Stopped watching %s %s", singularize(w.GVR().R()), path)
+		return nil
+	}
+	if err := rw.Watch(w.GVR(), path); err != nil {
+		w.App().Flash().Err(err)
+		return nil
+	}
+	w.App().Flash().Infof("Watching %s %s for status changes...", singularize(w.GVR().R()), path)
+
+	return nil
+}
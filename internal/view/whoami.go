@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// whoamiDoc documents the identity view.
+var whoamiDoc = ViewDoc{
+	Summary: "Current user credentials and identity as seen by the api server",
+	Columns: model.MenuHints{
+		{Mnemonic: "SOURCE", Description: "kubeconfig, decoded token or client certificate, or api server self subject review"},
+		{Mnemonic: "KEY", Description: "Identity attribute or token claim"},
+		{Mnemonic: "VALUE", Description: "Attribute value"},
+		{Mnemonic: "VALID", Description: "Expired credentials or failed review"},
+	},
+}
+
+// WhoAmI represents the current user identity view.
+type WhoAmI struct {
+	ResourceViewer
+}
+
+// NewWhoAmI returns a new identity view.
+func NewWhoAmI(gvr client.GVR) ResourceViewer {
+	RegisterViewDoc(gvr.R(), whoamiDoc)
+	w := WhoAmI{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	w.GetTable().SetSortCol("SOURCE", true)
+	w.AddBindKeysFn(w.bindKeys)
+
+	return &w
+}
+
+func (w *WhoAmI) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Key", w.GetTable().SortColCmd("KEY", true), false),
+	})
+}
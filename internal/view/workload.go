@@ -15,7 +15,6 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
-	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -98,7 +97,17 @@ func (w *Workload) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 		if len(selections) > 1 {
 			msg = fmt.Sprintf("Delete %d marked %s?", len(selections), w.GVR())
 		}
-		w.resourceDelete(selections, msg)
+		var accept string
+		for _, sel := range selections {
+			if gvr, fqn, ok := parsePath(sel); ok && isProtected(w.App(), gvr, fqn) {
+				accept = protectedAccept([]string{fqn})
+				if len(selections) > 1 {
+					accept = protectedAccept(selections)
+				}
+				break
+			}
+		}
+		w.resourceDelete(selections, msg, accept)
 	}
 
 	return nil
@@ -119,7 +128,7 @@ func (w *Workload) defaultContext(gvr client.GVR, fqn string) context.Context {
 	return ctx
 }
 
-func (w *Workload) resourceDelete(selections []string, msg string) {
+func (w *Workload) resourceDelete(selections []string, msg, accept string) {
 	okFn := func(propagation *metav1.DeletionPropagation, force bool) {
 		w.GetTable().ShowDeleted()
 		if len(selections) > 1 {
@@ -147,6 +156,10 @@ func (w *Workload) resourceDelete(selections []string, msg string) {
 		}
 		w.GetTable().Start()
 	}
+	if accept != "" {
+		dialog.ShowProtectedDelete(w.App().Styles.Dialog(), w.App().Content.Pages, protectedMsg(msg, accept), accept, okFn, func() {})
+		return
+	}
 	dialog.ShowDelete(w.App().Styles.Dialog(), w.App().Content.Pages, msg, okFn, func() {})
 }
 
@@ -21,14 +21,16 @@ import (
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 	"github.com/sahilm/fuzzy"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-const xrayTitle = "Xray"
+const (
+	xrayTitle   = "Xray"
+	ownersTitle = "Owners"
+)
 
 var _ ResourceViewer = (*Xray)(nil)
 
@@ -42,6 +44,8 @@ type Xray struct {
 	model    *model.Tree
 	cancelFn context.CancelFunc
 	envFn    EnvFunc
+	title    string
+	styles   *config.Styles
 }
 
 // NewXray returns a new view.
@@ -50,7 +54,22 @@ func NewXray(gvr client.GVR) ResourceViewer {
 		gvr:   gvr,
 		Tree:  ui.NewTree(),
 		model: model.NewTree(gvr),
+		title: xrayTitle,
+	}
+}
+
+// NewOwners returns a new view showing owners and dependents of a resource.
+func NewOwners(gvr client.GVR, path string) ResourceViewer {
+	x := Xray{
+		gvr:   gvr,
+		Tree:  ui.NewTree(),
+		model: model.NewTree(gvr),
+		title: ownersTitle,
 	}
+	x.model.SetInstance(path)
+	x.model.SetTreeRenderer(new(xray.Owner))
+
+	return &x
 }
 
 func (x *Xray) SetFilter(string)                 {}
@@ -76,11 +95,8 @@ func (x *Xray) Init(ctx context.Context) error {
 	}
 
 	x.bindKeys()
-	x.SetBackgroundColor(x.app.Styles.Xray().BgColor.Color())
-	x.SetBorderColor(x.app.Styles.Xray().FgColor.Color())
-	x.SetBorderFocusColor(x.app.Styles.Frame().Border.FocusColor.Color())
-	x.SetGraphicsColor(x.app.Styles.Xray().GraphicColor.Color())
-	x.SetTitle(fmt.Sprintf(" %s-%s ", xrayTitle, cases.Title(language.Und, cases.NoLower).String(x.gvr.R())))
+	x.StylesChanged(x.app.Styles)
+	x.SetTitle(fmt.Sprintf(" %s-%s ", x.title, cases.Title(language.Und, cases.NoLower).String(x.gvr.R())))
 
 	x.model.SetRefreshRate(time.Duration(x.app.Config.K9s.GetRefreshRate()) * time.Second)
 	x.model.SetNamespace(client.CleanseNamespace(x.app.Config.ActiveNamespace()))
@@ -116,6 +132,17 @@ func (x *Xray) ExtraHints() map[string]string {
 // SetInstance sets specific resource instance.
 func (x *Xray) SetInstance(string) {}
 
+// AcquireInformers leases the viewer informers.
+func (x *Xray) AcquireInformers() {}
+
+// ReleaseInformers releases the viewer informers.
+func (x *Xray) ReleaseInformers() {}
+
+// RefreshActions rebinds the viewer key actions.
+func (x *Xray) RefreshActions() {
+	x.refreshActions()
+}
+
 func (x *Xray) bindKeys() {
 	x.Actions().Bulk(ui.KeyMap{
 		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", x.activateCmd, false),
@@ -504,7 +531,7 @@ func (x *Xray) TreeNodeSelected() {
 	x.app.QueueUpdateDraw(func() {
 		n := x.GetCurrentNode()
 		if n != nil {
-			n.SetColor(x.app.Styles.Xray().CursorColor.Color())
+			n.SetColor(x.styles.Xray().CursorColor.Color())
 		}
 	})
 }
@@ -515,7 +542,7 @@ func (x *Xray) TreeLoadFailed(err error) {
 }
 
 func (x *Xray) update(node *xray.TreeNode) {
-	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.K9s.UI.NoIcons, x.app.Styles)
+	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.K9s.UI.NoIcons, x.styles)
 	if node == nil {
 		x.app.QueueUpdateDraw(func() {
 			x.SetRoot(root)
@@ -562,7 +589,7 @@ func (x *Xray) TreeChanged(node *xray.TreeNode) {
 }
 
 func (x *Xray) hydrate(parent *tview.TreeNode, n *xray.TreeNode) {
-	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.K9s.UI.NoIcons, x.app.Styles)
+	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.K9s.UI.NoIcons, x.styles)
 	for _, c := range n.Children {
 		x.hydrate(node, c)
 	}
@@ -604,6 +631,7 @@ func (x *Xray) defaultContext() context.Context {
 func (x *Xray) Start() {
 	x.Stop()
 	x.CmdBuff().AddListener(x)
+	x.app.Styles.AddListener(x)
 
 	ctx := x.defaultContext()
 	ctx, x.cancelFn = context.WithCancel(ctx)
@@ -619,6 +647,16 @@ func (x *Xray) Stop() {
 	x.cancelFn()
 	x.cancelFn = nil
 	x.CmdBuff().RemoveListener(x)
+	x.app.Styles.RemoveListener(x)
+}
+
+// StylesChanged notifies the skin changed.
+func (x *Xray) StylesChanged(s *config.Styles) {
+	x.styles = s.For(strings.ToLower(x.Name()))
+	x.SetBackgroundColor(x.styles.Xray().BgColor.Color())
+	x.SetBorderColor(x.styles.Xray().FgColor.Color())
+	x.SetBorderFocusColor(x.styles.Frame().Border.FocusColor.Color())
+	x.SetGraphicsColor(x.styles.Xray().GraphicColor.Color())
 }
 
 // AddBindKeysFn sets up extra key bindings.
@@ -650,7 +688,10 @@ func (x *Xray) UpdateTitle() {
 }
 
 func (x *Xray) styleTitle() string {
-	base := fmt.Sprintf("%s-%s", xrayTitle, cases.Title(language.Und, cases.NoLower).String(x.gvr.R()))
+	base := fmt.Sprintf("%s-%s", x.title, cases.Title(language.Und, cases.NoLower).String(x.gvr.R()))
+	if inst := x.model.GetInstance(); inst != "" {
+		base += "(" + inst + ")"
+	}
 	ns := x.model.GetNamespace()
 	if client.IsAllNamespaces(ns) {
 		ns = client.NamespaceAll
@@ -658,9 +699,9 @@ func (x *Xray) styleTitle() string {
 
 	var title string
 	if ns == client.ClusterScope {
-		title = ui.SkinTitle(fmt.Sprintf(ui.TitleFmt, base, render.AsThousands(int64(x.Count))), x.app.Styles.Frame())
+		title = ui.SkinTitle(fmt.Sprintf(ui.TitleFmt, base, render.AsThousands(int64(x.Count))), x.styles.Frame())
 	} else {
-		title = ui.SkinTitle(fmt.Sprintf(ui.NSTitleFmt, base, ns, render.AsThousands(int64(x.Count))), x.app.Styles.Frame())
+		title = ui.SkinTitle(fmt.Sprintf(ui.NSTitleFmt, base, ns, render.AsThousands(int64(x.Count))), x.styles.Frame())
 	}
 
 	buff := x.CmdBuff().GetText()
@@ -671,11 +712,11 @@ func (x *Xray) styleTitle() string {
 		buff = ui.TrimLabelSelector(buff)
 	}
 
-	return title + ui.SkinTitle(fmt.Sprintf(ui.SearchFmt, buff), x.app.Styles.Frame())
+	return title + ui.SkinTitle(fmt.Sprintf(ui.SearchFmt, buff), x.styles.Frame())
 }
 
 func (x *Xray) resourceDelete(gvr client.GVR, spec *xray.NodeSpec, msg string) {
-	dialog.ShowDelete(x.app.Styles.Dialog(), x.app.Content.Pages, msg, func(propagation *metav1.DeletionPropagation, force bool) {
+	okFn := func(propagation *metav1.DeletionPropagation, force bool) {
 		x.app.Flash().Infof("Delete resource %s %s", spec.GVR(), spec.Path())
 		accessor, err := dao.AccessorFor(x.app.factory, gvr)
 		if err != nil {
@@ -699,7 +740,13 @@ func (x *Xray) resourceDelete(gvr client.GVR, spec *xray.NodeSpec, msg string) {
 			x.app.factory.DeleteForwarder(spec.Path())
 		}
 		x.Refresh()
-	}, func() {})
+	}
+	if isProtected(x.app, gvr, spec.Path()) {
+		accept := protectedAccept([]string{spec.Path()})
+		dialog.ShowProtectedDelete(x.styles.Dialog(), x.app.Content.Pages, protectedMsg(msg, accept), accept, okFn, func() {})
+		return
+	}
+	dialog.ShowDelete(x.styles.Dialog(), x.app.Content.Pages, msg, okFn, func() {})
 }
 
 // ----------------------------------------------------------------------------
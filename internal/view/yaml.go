@@ -14,7 +14,6 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 )
 
 var (
@@ -63,7 +62,7 @@ func enableRegion(str string) string {
 	return strings.ReplaceAll(strings.ReplaceAll(str, "<<<", "["), ">>>", "]")
 }
 
-func saveYAML(dir, name, raw string) (string, error) {
+func saveYAML(dir, name, raw string, rp config.ScreenDumps) (string, error) {
 	if err := ensureDir(dir); err != nil {
 		return "", err
 	}
@@ -84,6 +83,7 @@ func saveYAML(dir, name, raw string) (string, error) {
 	if _, err := file.Write([]byte(raw)); err != nil {
 		return "", err
 	}
+	pruneDumps(dir, rp)
 
 	return fpath, nil
 }
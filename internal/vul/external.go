@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ScannerTrivy tracks the trivy scanner.
+	ScannerTrivy = "trivy"
+
+	// ScannerGrype tracks the grype scanner.
+	ScannerGrype = "grype"
+
+	extScanTimeout = 5 * time.Minute
+)
+
+// ExtScanner tracks image scans performed by an external scanner binary.
+var ExtScanner = NewExternalScanner()
+
+// ExternalScanner scans images using a trivy or grype binary.
+// Scans are cached per image digest.
+type ExternalScanner struct {
+	scans Scans
+	mx    sync.RWMutex
+}
+
+// NewExternalScanner returns a new instance.
+func NewExternalScanner() *ExternalScanner {
+	return &ExternalScanner{scans: make(Scans)}
+}
+
+// ScannerKind returns the kind of scanner given its binary ie trivy or grype.
+func ScannerKind(bin string) (string, error) {
+	n := strings.ToLower(filepath.Base(bin))
+	switch {
+	case strings.Contains(n, ScannerTrivy):
+		return ScannerTrivy, nil
+	case strings.Contains(n, ScannerGrype):
+		return ScannerGrype, nil
+	default:
+		return "", fmt.Errorf("unsupported image scanner %q. expecting trivy or grype", bin)
+	}
+}
+
+// Scan scans an image using the given scanner binary. The image digest, when
+// known, identifies cached scans so images retagged to the same digest are only
+// scanned once.
+func (s *ExternalScanner) Scan(ctx context.Context, bin, img, digest string) (*Scan, error) {
+	key := digest
+	if key == "" {
+		key = img
+	}
+	if sc, ok := s.get(key); ok {
+		return sc, nil
+	}
+
+	kind, err := ScannerKind(bin)
+	if err != nil {
+		return nil, err
+	}
+	args := []string{img, "-o", "json", "-q"}
+	if kind == ScannerTrivy {
+		args = []string{"image", "--format", "json", "--quiet", img}
+	}
+	ctx, cancel := context.WithTimeout(ctx, extScanTimeout)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, bin, args...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%s scan of %s failed: %s", kind, img, msg)
+		}
+		return nil, fmt.Errorf("%s scan of %s failed: %w", kind, img, err)
+	}
+
+	sc := newScan(img)
+	if kind == ScannerTrivy {
+		err = parseTrivy(stdout.Bytes(), sc.Table)
+	} else {
+		err = parseGrype(stdout.Bytes(), sc.Table)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sc.Table.sortSev()
+	sc.Tally = newTally(sc.Table)
+	s.set(key, sc)
+
+	return sc, nil
+}
+
+func (s *ExternalScanner) get(key string) (*Scan, bool) {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	sc, ok := s.scans[key]
+
+	return sc, ok
+}
+
+func (s *ExternalScanner) set(key string, sc *Scan) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.scans[key] = sc
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type trivyReport struct {
+	Results []struct {
+		Type            string `json:"Type"`
+		Vulnerabilities []struct {
+			VulnerabilityID  string `json:"VulnerabilityID"`
+			PkgName          string `json:"PkgName"`
+			InstalledVersion string `json:"InstalledVersion"`
+			FixedVersion     string `json:"FixedVersion"`
+			Status           string `json:"Status"`
+			Severity         string `json:"Severity"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+func parseTrivy(raw []byte, t *table) error {
+	var r trivyReport
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return fmt.Errorf("invalid trivy report: %w", err)
+	}
+	for _, res := range r.Results {
+		for _, v := range res.Vulnerabilities {
+			fix := v.FixedVersion
+			if v.Status == "will_not_fix" {
+				fix = wontFix
+			}
+			t.addRow(newRow(v.PkgName, v.InstalledVersion, fix, res.Type, v.VulnerabilityID, titleSev(v.Severity)))
+		}
+	}
+
+	return nil
+}
+
+type grypeReport struct {
+	Matches []struct {
+		Vulnerability struct {
+			ID       string `json:"id"`
+			Severity string `json:"severity"`
+			Fix      struct {
+				Versions []string `json:"versions"`
+				State    string   `json:"state"`
+			} `json:"fix"`
+		} `json:"vulnerability"`
+		Artifact struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+			Type    string `json:"type"`
+		} `json:"artifact"`
+	} `json:"matches"`
+}
+
+func parseGrype(raw []byte, t *table) error {
+	var r grypeReport
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return fmt.Errorf("invalid grype report: %w", err)
+	}
+	for _, m := range r.Matches {
+		fix := strings.Join(m.Vulnerability.Fix.Versions, ", ")
+		switch m.Vulnerability.Fix.State {
+		case "wont-fix":
+			fix = wontFix
+		case "unknown":
+			fix = naValue
+		}
+		t.addRow(newRow(m.Artifact.Name, m.Artifact.Version, fix, m.Artifact.Type, m.Vulnerability.ID, m.Vulnerability.Severity))
+	}
+
+	return nil
+}
+
+// titleSev converts an upper case severity ie CRITICAL to Critical.
+func titleSev(s string) string {
+	if s == "" {
+		return s
+	}
+	s = strings.ToLower(s)
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
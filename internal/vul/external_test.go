@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScannerKind(t *testing.T) {
+	uu := map[string]struct {
+		bin, e string
+		err    bool
+	}{
+		"trivy": {
+			bin: "/usr/local/bin/trivy",
+			e:   ScannerTrivy,
+		},
+		"grype": {
+			bin: "grype",
+			e:   ScannerGrype,
+		},
+		"toast": {
+			bin: "/usr/bin/fred",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			kind, err := ScannerKind(u.bin)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, kind)
+		})
+	}
+}
+
+func TestParseTrivy(t *testing.T) {
+	raw, err := os.ReadFile("testdata/external/trivy.json")
+	assert.NoError(t, err)
+
+	tt := newTable()
+	assert.NoError(t, parseTrivy(raw, tt))
+	assert.Equal(t, []Row{
+		{"libssl3", "3.0.11-1", "3.0.13-1", "debian", "CVE-2024-0001", Sev1},
+		{"libc6", "2.36-9", wontFix, "debian", "CVE-2024-0002", Sev4},
+	}, tt.Rows)
+}
+
+func TestParseGrype(t *testing.T) {
+	raw, err := os.ReadFile("testdata/external/grype.json")
+	assert.NoError(t, err)
+
+	tt := newTable()
+	assert.NoError(t, parseGrype(raw, tt))
+	assert.Equal(t, []Row{
+		{"golang.org/x/net", "1.0.0", "1.2.3", "go-module", "GHSA-xxxx-yyyy-zzzz", Sev2},
+		{"zlib1g", "1.2.13", wontFix, "deb", "CVE-2024-0003", Sev3},
+	}, tt.Rows)
+}
+
+func TestExternalScannerCache(t *testing.T) {
+	s := NewExternalScanner()
+	sc := newScan("nginx:1.25")
+	s.set("sha256:fred", sc)
+
+	got, err := s.Scan(context.Background(), "/bin/toast", "nginx:latest", "sha256:fred")
+	assert.NoError(t, err)
+	assert.Equal(t, sc, got)
+
+	_, err = s.Scan(context.Background(), "/bin/toast", "nginx:latest", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+// sizeSamples tracks the number of cached objects sampled to estimate an
+// informer cache size.
+const sizeSamples = 10
+
+// evictable represents informers that can be stopped together.
+type evictable struct {
+	ns        string
+	shared    bool
+	informers []InformerStats
+	bytes     int64
+}
+
+// Evict stops the largest idle informers until an estimated n bytes of cached
+// objects are dropped and returns the evicted informers. An informer is idle
+// once no lease holds it and it was not accessed within the idle period.
+// Informers managed by a namespace factory can only be stopped along with
+// their factory, hence all of them must be idle.
+func (f *Factory) Evict(n int64, idle time.Duration) []InformerStats {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	ee := f.evictables(time.Now(), idle)
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].bytes > ee[j].bytes
+	})
+
+	var (
+		evicted []InformerStats
+		freed   int64
+	)
+	for _, e := range ee {
+		if freed >= n {
+			break
+		}
+		f.evict(e)
+		evicted, freed = append(evicted, e.informers...), freed+e.bytes
+	}
+
+	return evicted
+}
+
+func (f *Factory) evictables(now time.Time, idle time.Duration) []evictable {
+	var ee []evictable
+	for ns, gg := range f.active {
+		fac, facIdle := evictable{ns: ns}, true
+		for gvr, inf := range gg {
+			k := informerKey{ns: ns, gvr: gvr}
+			st := InformerStats{
+				Namespace: ns,
+				GVR:       gvr,
+				Synced:    inf.Informer().HasSynced(),
+				Objects:   len(inf.Informer().GetStore().ListKeys()),
+				Bytes:     estimateSize(inf.Informer().GetStore()),
+			}
+			isIdle := f.refs[k] == 0 && now.Sub(f.touched[k]) >= idle
+			if _, ok := f.shared[k]; ok {
+				if isIdle {
+					ee = append(ee, evictable{ns: ns, shared: true, informers: []InformerStats{st}, bytes: st.Bytes})
+				}
+				continue
+			}
+			facIdle = facIdle && isIdle
+			fac.informers, fac.bytes = append(fac.informers, st), fac.bytes+st.Bytes
+		}
+		if _, ok := f.factories[ns]; ok && facIdle && len(fac.informers) > 0 {
+			ee = append(ee, fac)
+		}
+	}
+
+	return ee
+}
+
+func (f *Factory) evict(e evictable) {
+	for _, i := range e.informers {
+		k := informerKey{ns: i.Namespace, gvr: i.GVR}
+		log.Debug().Msgf("Evicting informer %q:%q (%d objects)", k.ns, k.gvr, i.Objects)
+		f.metrics.uninstrument(k)
+		delete(f.active[k.ns], k.gvr)
+		delete(f.touched, k)
+		if inf, ok := f.shared[k]; ok {
+			close(inf.stopChan)
+			delete(f.shared, k)
+		}
+	}
+	if len(f.active[e.ns]) == 0 {
+		delete(f.active, e.ns)
+	}
+	if e.shared {
+		return
+	}
+	if c, ok := f.stops[e.ns]; ok {
+		close(c)
+		delete(f.stops, e.ns)
+	}
+	delete(f.factories, e.ns)
+}
+
+// estimateSize estimates an informer cache size by sampling the serialized
+// size of its objects.
+func estimateSize(s cache.Store) int64 {
+	oo := s.List()
+	if len(oo) == 0 {
+		return 0
+	}
+	step := len(oo)/sizeSamples + 1
+
+	var total, count int64
+	for i := 0; i < len(oo); i += step {
+		u, ok := oo[i].(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		raw, err := u.MarshalJSON()
+		if err != nil {
+			continue
+		}
+		total, count = total+int64(len(raw)), count+1
+	}
+	if count == 0 {
+		return 0
+	}
+
+	return total / count * int64(len(oo))
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	di "k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestFactoryEvict(t *testing.T) {
+	f := NewFactory(nil)
+	cms := newSharedInformer(t, "fred", "blee", "zorg")
+	secs := newSharedInformer(t, "fred")
+	svcs := newSharedInformer(t, "fred", "blee", "zorg", "duh")
+	f.shared[newInformerKey("default", "v1/configmaps")] = cms
+	f.shared[newInformerKey("default", "v1/secrets")] = secs
+	f.shared[newInformerKey("default", "v1/services")] = svcs
+	f.track("default", "v1/configmaps", cms)
+	f.track("default", "v1/secrets", secs)
+	f.track("default", "v1/services", svcs)
+	l := f.Lease("default", "v1/services")
+	defer l.Release()
+
+	assert.Empty(t, f.Evict(1, time.Minute), "recently used informers are not idle")
+
+	ii := f.Evict(1, 0)
+	assert.Len(t, ii, 1)
+	assert.Equal(t, "v1/configmaps", ii[0].GVR)
+	assert.Equal(t, 3, ii[0].Objects)
+	assert.True(t, isClosed(cms.stopChan))
+	assert.False(t, isClosed(secs.stopChan))
+	assert.False(t, isClosed(svcs.stopChan), "leased informers are not idle")
+
+	ii = f.Evict(1<<20, 0)
+	assert.Len(t, ii, 1)
+	assert.Equal(t, "v1/secrets", ii[0].GVR)
+	assert.Len(t, f.active["default"], 1)
+	assert.Len(t, f.shared, 1)
+}
+
+func TestEstimateSize(t *testing.T) {
+	s := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	assert.Equal(t, int64(0), estimateSize(s))
+
+	cm := newConfigMap("fred")
+	raw, err := cm.MarshalJSON()
+	assert.NoError(t, err)
+	assert.NoError(t, s.Add(cm))
+	assert.NoError(t, s.Add(newConfigMap("blee")))
+	assert.InDelta(t, 2*len(raw), estimateSize(s), 2)
+}
+
+// Helpers...
+
+func newSharedInformer(t *testing.T, nn ...string) *sharedInformer {
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	dial := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	inf := di.NewFilteredDynamicInformer(dial, gvr, "default", 0, cache.Indexers{}, nil)
+	for _, n := range nn {
+		assert.NoError(t, inf.Informer().GetStore().Add(newConfigMap(n)))
+	}
+
+	return &sharedInformer{GenericInformer: inf, stopChan: make(chan struct{})}
+}
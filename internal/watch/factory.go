@@ -4,19 +4,22 @@
 package watch
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
-	"github.com/rs/zerolog/log"
+	"github.com/derailed/k9s/internal/tracing"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	di "k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
@@ -27,21 +30,100 @@ const (
 // Factory tracks various resource informers.
 type Factory struct {
 	factories  map[string]di.DynamicSharedInformerFactory
+	active     map[string]map[string]informers.GenericInformer
+	shared     map[informerKey]*sharedInformer
+	refs       map[informerKey]int
+	stops      map[string]chan struct{}
+	touched    map[informerKey]time.Time
+	releasing  bool
+	metricsOn  bool
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
+	stats      *factoryStats
+	metrics    *informerMetrics
 	mx         sync.RWMutex
 }
 
+// sharedInformer tracks an informer that can be stopped on its own.
+type sharedInformer struct {
+	informers.GenericInformer
+
+	stopChan chan struct{}
+}
+
 // NewFactory returns a new informers factory.
 func NewFactory(client client.Connection) *Factory {
 	return &Factory{
 		client:     client,
 		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		active:     make(map[string]map[string]informers.GenericInformer),
+		shared:     make(map[informerKey]*sharedInformer),
+		refs:       make(map[informerKey]int),
+		stops:      make(map[string]chan struct{}),
+		touched:    make(map[informerKey]time.Time),
 		forwarders: NewForwarders(),
+		stats:      newFactoryStats(),
+		metrics:    newInformerMetrics(),
 	}
 }
 
+// SetReleaseInformers toggles stopping informers as soon as their last
+// consumer releases them rather than keeping them until the factory terminates.
+func (f *Factory) SetReleaseInformers(b bool) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.releasing = b
+}
+
+// SetMetrics toggles counting the events received by the live informers.
+func (f *Factory) SetMetrics(b bool) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.metricsOn = b
+	if !b {
+		f.metrics.reset()
+		return
+	}
+	for ns, gg := range f.active {
+		for gvr, inf := range gg {
+			f.metrics.instrument(informerKey{ns: ns, gvr: gvr}, inf)
+		}
+	}
+}
+
+// Lease acquires a given resource informer until the lease is released.
+func (f *Factory) Lease(ns, gvr string) *Lease {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	k := newInformerKey(ns, gvr)
+	f.refs[k]++
+
+	return &Lease{factory: f, key: k}
+}
+
+// release drops a consumer of a given informer. When releasing informers, the
+// informer is stopped once no consumers remain.
+func (f *Factory) release(k informerKey) {
+	if f.refs[k] > 1 {
+		f.refs[k]--
+		return
+	}
+	delete(f.refs, k)
+	inf, ok := f.shared[k]
+	if !ok {
+		return
+	}
+	log.Debug().Msgf("Releasing informer %q:%q", k.ns, k.gvr)
+	f.metrics.uninstrument(k)
+	close(inf.stopChan)
+	delete(f.shared, k)
+	delete(f.active[k.ns], k.gvr)
+}
+
 // Start initializes the informers until caller cancels the context.
 func (f *Factory) Start(ns string) {
 	f.mx.Lock()
@@ -51,7 +133,7 @@ func (f *Factory) Start(ns string) {
 	f.stopChan = make(chan struct{})
 	for ns, fac := range f.factories {
 		log.Debug().Msgf("Starting factory in ns %q", ns)
-		fac.Start(f.stopChan)
+		fac.Start(f.stops[ns])
 	}
 }
 
@@ -67,9 +149,73 @@ func (f *Factory) Terminate() {
 	for k := range f.factories {
 		delete(f.factories, k)
 	}
+	for k, c := range f.stops {
+		close(c)
+		delete(f.stops, k)
+	}
+	for k := range f.active {
+		delete(f.active, k)
+	}
+	for k, inf := range f.shared {
+		close(inf.stopChan)
+		delete(f.shared, k)
+	}
+	for k := range f.touched {
+		delete(f.touched, k)
+	}
+	f.metrics.reset()
+	f.stats.clear()
 	f.forwarders.DeleteAll()
 }
 
+// Stats returns the state of all active informers.
+func (f *Factory) Stats() FactoryStats {
+	now := time.Now()
+	var stats FactoryStats
+	if storm, ok := f.stats.storm(now); ok {
+		stats.Storm = &storm
+	}
+
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+	for ns, gg := range f.active {
+		for gvr, inf := range gg {
+			stats.Informers = append(stats.Informers, InformerStats{
+				Namespace:  ns,
+				GVR:        gvr,
+				Synced:     inf.Informer().HasSynced(),
+				Objects:    len(inf.Informer().GetStore().ListKeys()),
+				Bytes:      estimateSize(inf.Informer().GetStore()),
+				Leases:     f.refs[newInformerKey(ns, gvr)],
+				Reconnects: f.stats.reconnectsFor(ns, gvr, now),
+				Events:     f.eventsFor(ns, gvr),
+			})
+		}
+	}
+	sort.Slice(stats.Informers, func(i, j int) bool {
+		if stats.Informers[i].Namespace != stats.Informers[j].Namespace {
+			return stats.Informers[i].Namespace < stats.Informers[j].Namespace
+		}
+		return stats.Informers[i].GVR < stats.Informers[j].GVR
+	})
+
+	return stats
+}
+
+func (f *Factory) eventsFor(ns, gvr string) *InformerEvents {
+	e, ok := f.metrics.eventsFor(informerKey{ns: ns, gvr: gvr})
+	if !ok {
+		return nil
+	}
+
+	return &e
+}
+
+// ReconnectStorm returns the informer reconnecting abnormally often if any.
+func (f *Factory) ReconnectStorm() (ReconnectStorm, bool) {
+	return f.stats.storm(time.Now())
+}
+
 // List returns a resource collection.
 func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]runtime.Object, error) {
 	inf, err := f.CanForResource(ns, gvr, client.ListAccess)
@@ -90,7 +236,7 @@ func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]run
 		return oo, err
 	}
 
-	f.waitForCacheSync(ns)
+	f.waitForCacheSync(gvr, ns, inf)
 	if client.IsClusterScoped(ns) {
 		return inf.Lister().List(labels)
 	}
@@ -124,22 +270,30 @@ func (f *Factory) Get(gvr, fqn string, wait bool, sel labels.Selector) (runtime.
 		return o, err
 	}
 
-	f.waitForCacheSync(ns)
+	f.waitForCacheSync(gvr, ns, inf)
 	if client.IsClusterScoped(ns) {
 		return inf.Lister().Get(n)
 	}
 	return inf.Lister().ByNamespace(ns).Get(n)
 }
 
-func (f *Factory) waitForCacheSync(ns string) {
+func (f *Factory) waitForCacheSync(gvr, ns string, inf informers.GenericInformer) {
 	if client.IsClusterWide(ns) {
 		ns = client.BlankNamespace
 	}
+	_, span := tracing.Start(context.Background(), "informer.sync",
+		tracing.GVRKey.String(gvr),
+		tracing.NamespaceKey.String(ns),
+	)
+	defer func() {
+		span.SetAttributes(tracing.SyncedKey.Bool(inf.Informer().HasSynced()))
+		span.End()
+	}()
 
 	f.mx.RLock()
 	defer f.mx.RUnlock()
 	fac, ok := f.factories[ns]
-	if !ok {
+	if !ok && !f.releasing {
 		return
 	}
 
@@ -149,6 +303,10 @@ func (f *Factory) waitForCacheSync(ns string) {
 		<-time.After(defaultWaitTime)
 		close(c)
 	}(c)
+	if f.releasing {
+		_ = cache.WaitForCacheSync(c, inf.Informer().HasSynced)
+		return
+	}
 	_ = fac.WaitForCacheSync(c)
 }
 
@@ -204,6 +362,9 @@ func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.Gene
 
 // ForResource returns an informer for a given resource.
 func (f *Factory) ForResource(ns, gvr string) (informers.GenericInformer, error) {
+	if f.releaseInformers() {
+		return f.sharedFor(ns, gvr)
+	}
 	fact, err := f.ensureFactory(ns)
 	if err != nil {
 		return nil, err
@@ -214,13 +375,132 @@ func (f *Factory) ForResource(ns, gvr string) (informers.GenericInformer, error)
 		return inf, nil
 	}
 
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if !f.isTracked(ns, gvr) {
+		k := newInformerKey(ns, gvr)
+		if err := inf.Informer().SetWatchErrorHandler(f.stats.watchErrorHandler(k.ns, k.gvr)); err != nil {
+			log.Debug().Err(err).Msgf("Unable to track watch errors for %q:%q", ns, gvr)
+		}
+	}
+	f.track(ns, gvr, inf)
+	fact.Start(f.stops[newInformerKey(ns, gvr).ns])
+
+	return inf, nil
+}
+
+func (f *Factory) releaseInformers() bool {
 	f.mx.RLock()
 	defer f.mx.RUnlock()
-	fact.Start(f.stopChan)
+
+	return f.releasing
+}
+
+// sharedFor returns a standalone informer for a given resource so it can be
+// stopped once released.
+func (f *Factory) sharedFor(ns, gvr string) (informers.GenericInformer, error) {
+	k := newInformerKey(ns, gvr)
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if inf, ok := f.shared[k]; ok {
+		f.touched[k] = time.Now()
+		return inf, nil
+	}
+
+	dial, err := f.client.DynDial()
+	if err != nil {
+		return nil, err
+	}
+	inf := &sharedInformer{
+		GenericInformer: di.NewFilteredDynamicInformer(
+			dial,
+			toGVR(gvr),
+			k.ns,
+			defaultResync,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+			nil,
+		),
+		stopChan: make(chan struct{}),
+	}
+	if err := inf.Informer().SetWatchErrorHandler(f.stats.watchErrorHandler(k.ns, k.gvr)); err != nil {
+		log.Debug().Err(err).Msgf("Unable to track watch errors for %q:%q", ns, gvr)
+	}
+	go inf.Informer().Run(inf.stopChan)
+	f.shared[k] = inf
+	f.track(ns, gvr, inf)
 
 	return inf, nil
 }
 
+// Cached returns the synced content of all active informers keyed by gvr.
+func (f *Factory) Cached() map[string][]runtime.Object {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	oo := make(map[string]map[string]runtime.Object)
+	for _, gg := range f.active {
+		for gvr, inf := range gg {
+			if !inf.Informer().HasSynced() {
+				continue
+			}
+			if _, ok := oo[gvr]; !ok {
+				oo[gvr] = make(map[string]runtime.Object)
+			}
+			// Cluster wide and namespaced informers may overlap.
+			for _, o := range inf.Informer().GetStore().List() {
+				r, ok := o.(runtime.Object)
+				if !ok {
+					continue
+				}
+				if k, err := cache.MetaNamespaceKeyFunc(o); err == nil {
+					oo[gvr][k] = r
+				}
+			}
+		}
+	}
+
+	cached := make(map[string][]runtime.Object, len(oo))
+	for gvr, m := range oo {
+		cached[gvr] = make([]runtime.Object, 0, len(m))
+		for _, o := range m {
+			cached[gvr] = append(cached[gvr], o)
+		}
+	}
+
+	return cached
+}
+
+func newInformerKey(ns, gvr string) informerKey {
+	if client.IsClusterWide(ns) {
+		ns = client.BlankNamespace
+	}
+
+	return informerKey{ns: ns, gvr: gvr}
+}
+
+func (f *Factory) isTracked(ns, gvr string) bool {
+	if client.IsClusterWide(ns) {
+		ns = client.BlankNamespace
+	}
+	_, ok := f.active[ns][gvr]
+
+	return ok
+}
+
+func (f *Factory) track(ns, gvr string, inf informers.GenericInformer) {
+	if client.IsClusterWide(ns) {
+		ns = client.BlankNamespace
+	}
+	if _, ok := f.active[ns]; !ok {
+		f.active[ns] = make(map[string]informers.GenericInformer)
+	}
+	f.active[ns][gvr] = inf
+	f.touched[informerKey{ns: ns, gvr: gvr}] = time.Now()
+	if f.metricsOn {
+		f.metrics.instrument(informerKey{ns: ns, gvr: gvr}, inf)
+	}
+}
+
 func (f *Factory) ensureFactory(ns string) (di.DynamicSharedInformerFactory, error) {
 	if client.IsClusterWide(ns) {
 		ns = client.BlankNamespace
@@ -241,6 +521,7 @@ func (f *Factory) ensureFactory(ns string) (di.DynamicSharedInformerFactory, err
 		ns,
 		nil,
 	)
+	f.stops[ns] = make(chan struct{})
 
 	return f.factories[ns], nil
 }
@@ -281,6 +562,9 @@ func (f *Factory) ForwarderFor(path string) (Forwarder, bool) {
 // BOZO!! Review!!!
 func (f *Factory) ValidatePortForwards() {
 	for k, fwd := range f.forwarders {
+		if r, ok := fwd.(retargeter); ok && r.Retargetable() {
+			continue
+		}
 		tokens := strings.Split(k, ":")
 		if len(tokens) != 2 {
 			log.Error().Msgf("Invalid fwd keys %q", k)
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFactoryLease(t *testing.T) {
+	f := NewFactory(nil)
+	f.SetReleaseInformers(true)
+
+	pods, svcs := newInformerKey("default", "v1/pods"), newInformerKey("default", "v1/services")
+	podsInf := sharedInformer{stopChan: make(chan struct{})}
+	svcsInf := sharedInformer{stopChan: make(chan struct{})}
+	f.shared[pods], f.shared[svcs] = &podsInf, &svcsInf
+
+	l1, l2 := f.Lease("default", "v1/pods"), f.Lease("default", "v1/pods")
+	l3 := f.Lease("all", "v1/services")
+	assert.Equal(t, 2, f.refs[pods])
+	assert.True(t, l1.Matches("default", "v1/pods"))
+	assert.False(t, l1.Matches("fred", "v1/pods"))
+
+	l1.Release()
+	l1.Release()
+	assert.Equal(t, 1, f.refs[pods])
+	assert.False(t, isClosed(podsInf.stopChan))
+
+	l2.Release()
+	assert.True(t, isClosed(podsInf.stopChan))
+	assert.NotContains(t, f.shared, pods)
+	assert.False(t, isClosed(svcsInf.stopChan))
+
+	l3.Release()
+	assert.False(t, isClosed(svcsInf.stopChan), "cluster wide lease does not hold namespaced informers")
+	assert.Empty(t, f.refs)
+}
+
+// Helpers...
+
+func isClosed(c chan struct{}) bool {
+	select {
+	case <-c:
+		return true
+	default:
+		return false
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal/port"
-	"github.com/rs/zerolog/log"
 	"k8s.io/client-go/tools/portforward"
 )
 
@@ -41,10 +40,19 @@ type Forwarder interface {
 	// Age returns forwarder age.
 	Age() time.Time
 
+	// Stats returns forwarder traffic stats.
+	Stats() port.TunnelStats
+
 	// HasPortMapping returns true if port mapping exists.
 	HasPortMapping(string) bool
 }
 
+// retargeter represents a forwarder moving to another backing pod once its
+// pod goes away.
+type retargeter interface {
+	Retargetable() bool
+}
+
 // Forwarders tracks active port forwards.
 type Forwarders map[string]Forwarder
 
@@ -185,3 +185,4 @@ func (m noOpForwarder) Active() bool               { return false }
 func (m noOpForwarder) SetActive(bool)             {}
 func (m noOpForwarder) Age() time.Time             { return time.Now() }
 func (m noOpForwarder) HasPortMapping(string) bool { return false }
+func (m noOpForwarder) Stats() port.TunnelStats    { return port.TunnelStats{} }
@@ -7,7 +7,6 @@ import (
 	"path"
 	"strings"
 
-	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
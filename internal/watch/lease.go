@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import "sync"
+
+// Lease represents a consumer hold on a resource informer.
+type Lease struct {
+	factory *Factory
+	key     informerKey
+	once    sync.Once
+}
+
+// Matches checks if the lease holds a given resource informer.
+func (l *Lease) Matches(ns, gvr string) bool {
+	return l.key == newInformerKey(ns, gvr)
+}
+
+// Release releases the informer hold. Releasing a nil lease or a lease more
+// than once is a no-op.
+func (l *Lease) Release() {
+	if l == nil {
+		return
+	}
+	l.once.Do(func() {
+		l.factory.mx.Lock()
+		defer l.factory.mx.Unlock()
+
+		l.factory.release(l.key)
+	})
+}
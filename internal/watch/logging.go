@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import "github.com/derailed/k9s/internal/logging"
+
+// log tracks the watch subsystem logger.
+var log = logging.For(logging.Watch)
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// InformerEvents represents the events received by an informer.
+type InformerEvents struct {
+	Adds    int       `json:"adds"`
+	Updates int       `json:"updates"`
+	Deletes int       `json:"deletes"`
+	Last    time.Time `json:"last"`
+}
+
+// informerMetrics counts the events received by instrumented informers.
+type informerMetrics struct {
+	events map[informerKey]*InformerEvents
+	regs   map[informerKey]instrumented
+	mx     sync.Mutex
+}
+
+type instrumented struct {
+	informer     informers.GenericInformer
+	registration cache.ResourceEventHandlerRegistration
+}
+
+func newInformerMetrics() *informerMetrics {
+	return &informerMetrics{
+		events: make(map[informerKey]*InformerEvents),
+		regs:   make(map[informerKey]instrumented),
+	}
+}
+
+// instrument adds an event handler counting a given informer events.
+func (m *informerMetrics) instrument(k informerKey, inf informers.GenericInformer) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if _, ok := m.regs[k]; ok {
+		return
+	}
+	reg, err := inf.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(any) { m.record(k, func(e *InformerEvents) { e.Adds++ }) },
+		UpdateFunc: func(any, any) { m.record(k, func(e *InformerEvents) { e.Updates++ }) },
+		DeleteFunc: func(any) { m.record(k, func(e *InformerEvents) { e.Deletes++ }) },
+	})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to instrument informer %q:%q", k.ns, k.gvr)
+		return
+	}
+	m.regs[k] = instrumented{informer: inf, registration: reg}
+	m.events[k] = &InformerEvents{}
+}
+
+// uninstrument removes the event handler of a given informer.
+func (m *informerMetrics) uninstrument(k informerKey) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.remove(k)
+}
+
+// reset removes the event handlers of all instrumented informers.
+func (m *informerMetrics) reset() {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	for k := range m.regs {
+		m.remove(k)
+	}
+}
+
+// eventsFor returns the events received by a given informer if instrumented.
+func (m *informerMetrics) eventsFor(k informerKey) (InformerEvents, bool) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	e, ok := m.events[k]
+	if !ok {
+		return InformerEvents{}, false
+	}
+
+	return *e, true
+}
+
+func (m *informerMetrics) record(k informerKey, f func(*InformerEvents)) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	e, ok := m.events[k]
+	if !ok {
+		return
+	}
+	f(e)
+	e.Last = time.Now()
+}
+
+func (m *informerMetrics) remove(k informerKey) {
+	r, ok := m.regs[k]
+	if !ok {
+		return
+	}
+	if err := r.informer.Informer().RemoveEventHandler(r.registration); err != nil {
+		log.Warn().Err(err).Msgf("Unable to remove informer %q:%q instrumentation", k.ns, k.gvr)
+	}
+	delete(m.regs, k)
+	delete(m.events, k)
+}
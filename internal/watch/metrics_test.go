@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	di "k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestInformerMetrics(t *testing.T) {
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	dial := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "ConfigMapList"},
+		newConfigMap("fred"),
+	)
+	inf := di.NewFilteredDynamicInformer(dial, gvr, "default", 0, cache.Indexers{}, nil)
+	stop := make(chan struct{})
+	defer close(stop)
+	go inf.Informer().Run(stop)
+	assert.True(t, cache.WaitForCacheSync(stop, inf.Informer().HasSynced))
+
+	m, k := newInformerMetrics(), informerKey{ns: "default", gvr: "v1/configmaps"}
+	m.instrument(k, inf)
+	_, err := dial.Resource(gvr).Namespace("default").Create(context.Background(), newConfigMap("blee"), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		e, ok := m.eventsFor(k)
+		return ok && e.Adds == 2
+	}, time.Second, 10*time.Millisecond)
+
+	m.reset()
+	_, ok := m.eventsFor(k)
+	assert.False(t, ok)
+}
+
+// Helpers...
+
+func newConfigMap(n string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"namespace": "default",
+				"name":      n,
+			},
+		},
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// StormWindow tracks the period over which informer reconnects are counted.
+	StormWindow = time.Minute
+
+	// StormThreshold tracks the number of reconnects within the window deemed a storm.
+	StormThreshold = 5
+)
+
+// ReconnectStorm represents an informer reconnecting to the api server abnormally often.
+type ReconnectStorm struct {
+	GVR        string `json:"gvr"`
+	Namespace  string `json:"namespace"`
+	Reconnects int    `json:"reconnects"`
+}
+
+// InformerStats represents an informer state.
+type InformerStats struct {
+	Namespace  string          `json:"namespace"`
+	GVR        string          `json:"gvr"`
+	Synced     bool            `json:"synced"`
+	Objects    int             `json:"objects"`
+	Bytes      int64           `json:"bytes"`
+	Leases     int             `json:"leases"`
+	Reconnects int             `json:"reconnects"`
+	Events     *InformerEvents `json:"events,omitempty"`
+}
+
+// FactoryStats represents the informers factory state.
+type FactoryStats struct {
+	Informers []InformerStats `json:"informers"`
+	Storm     *ReconnectStorm `json:"storm,omitempty"`
+}
+
+type informerKey struct {
+	ns, gvr string
+}
+
+// factoryStats tracks the informers watch disconnects.
+type factoryStats struct {
+	reconnects map[informerKey][]time.Time
+	mx         sync.Mutex
+}
+
+func newFactoryStats() *factoryStats {
+	return &factoryStats{
+		reconnects: make(map[informerKey][]time.Time),
+	}
+}
+
+// watchErrorHandler returns a reflector watch error handler recording
+// disconnects for a given informer.
+func (s *factoryStats) watchErrorHandler(ns, gvr string) cache.WatchErrorHandler {
+	return func(r *cache.Reflector, err error) {
+		cache.DefaultWatchErrorHandler(r, err)
+		if isDisconnect(err) {
+			s.recordReconnect(ns, gvr, time.Now())
+		}
+	}
+}
+
+func (s *factoryStats) recordReconnect(ns, gvr string, t time.Time) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	k := informerKey{ns: ns, gvr: gvr}
+	s.reconnects[k] = append(pruneReconnects(s.reconnects[k], t), t)
+}
+
+// storm returns the informer with the most reconnects within the storm
+// window if it crosses the storm threshold.
+func (s *factoryStats) storm(now time.Time) (ReconnectStorm, bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	var worst ReconnectStorm
+	for k, tt := range s.reconnects {
+		tt = pruneReconnects(tt, now)
+		if len(tt) == 0 {
+			delete(s.reconnects, k)
+			continue
+		}
+		s.reconnects[k] = tt
+		if len(tt) > worst.Reconnects {
+			worst = ReconnectStorm{GVR: k.gvr, Namespace: k.ns, Reconnects: len(tt)}
+		}
+	}
+	if worst.Reconnects < StormThreshold {
+		return ReconnectStorm{}, false
+	}
+
+	return worst, true
+}
+
+// reconnectsFor returns the reconnects of a given informer within the storm window.
+func (s *factoryStats) reconnectsFor(ns, gvr string, now time.Time) int {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return len(pruneReconnects(s.reconnects[informerKey{ns: ns, gvr: gvr}], now))
+}
+
+func (s *factoryStats) clear() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.reconnects = make(map[informerKey][]time.Time)
+}
+
+// Helpers...
+
+// pruneReconnects discards the reconnects outside the storm window.
+func pruneReconnects(tt []time.Time, now time.Time) []time.Time {
+	for i, t := range tt {
+		if now.Sub(t) < StormWindow {
+			return tt[i:]
+		}
+	}
+
+	return nil
+}
+
+// isDisconnect checks if a watch error stems from a dropped connection
+// rather than a stale resource version.
+func isDisconnect(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return !apierrors.IsResourceExpired(err) && !apierrors.IsGone(err)
+}
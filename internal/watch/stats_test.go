@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestFactoryStatsStorm(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		reconnects map[string][]time.Duration
+		storm      ReconnectStorm
+		ok         bool
+	}{
+		"none": {},
+		"calm": {
+			reconnects: map[string][]time.Duration{
+				"v1/pods": {0, time.Second},
+			},
+		},
+		"stale": {
+			reconnects: map[string][]time.Duration{
+				"v1/pods": {2 * StormWindow, 2 * StormWindow, 2 * StormWindow, 2 * StormWindow, 2 * StormWindow},
+			},
+		},
+		"storm": {
+			reconnects: map[string][]time.Duration{
+				"v1/pods":     {0, time.Second, 2 * time.Second, 3 * time.Second, 4 * time.Second, 2 * StormWindow},
+				"v1/services": {0},
+			},
+			storm: ReconnectStorm{GVR: "v1/pods", Namespace: "fred", Reconnects: 5},
+			ok:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := newFactoryStats()
+			for gvr, dd := range u.reconnects {
+				for i := len(dd) - 1; i >= 0; i-- {
+					s.recordReconnect("fred", gvr, now.Add(-dd[i]))
+				}
+			}
+			storm, ok := s.storm(now)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.storm, storm)
+		})
+	}
+}
+
+func TestIsDisconnect(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   bool
+	}{
+		"none": {},
+		"eof": {
+			err: io.EOF,
+			e:   true,
+		},
+		"reset": {
+			err: errors.New("connection reset by peer"),
+			e:   true,
+		},
+		"expired": {
+			err: apierrors.NewResourceExpired("too old"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isDisconnect(u.err))
+		})
+	}
+}
@@ -0,0 +1,283 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const maxOwnerDepth = 10
+
+// DependentGVRs tracks resources scanned for dependents.
+var DependentGVRs = []string{
+	"apps/v1/deployments",
+	"apps/v1/replicasets",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"batch/v1/cronjobs",
+	"batch/v1/jobs",
+	"v1/pods",
+	"v1/persistentvolumeclaims",
+	"discovery.k8s.io/v1/endpointslices",
+}
+
+// Owner renders an owners and dependents graph for a given resource.
+type Owner struct{}
+
+// Render renders an xray node.
+func (o *Owner) Render(ctx context.Context, ns string, obj interface{}) error {
+	u, ok := asUnstructured(obj)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", obj)
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("no factory found in context")
+	}
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	gvr, ok := gvrFor(u.GetAPIVersion(), u.GetKind())
+	if !ok {
+		return fmt.Errorf("unable to resolve resource for kind %q", u.GetKind())
+	}
+	node := newOwnerNode(gvr, u)
+	node.Extras[InfoKey] = "*"
+
+	top := node
+	for _, owner := range o.owners(f, u) {
+		owner.Add(top)
+		top = owner
+	}
+	parent.Add(top)
+
+	visited := map[types.UID]struct{}{u.GetUID(): {}}
+	o.dependents(f, node, u, visited, 0)
+
+	return nil
+}
+
+// Owners walks up the controller owner chain of a given resource.
+func (o *Owner) owners(f dao.Factory, u *unstructured.Unstructured) []*TreeNode {
+	var nn []*TreeNode
+	for i := 0; i < maxOwnerDepth; i++ {
+		ref, ok := controllerRef(u.GetOwnerReferences())
+		if !ok {
+			break
+		}
+		gvr, ok := gvrFor(ref.APIVersion, ref.Kind)
+		if !ok {
+			log.Warn().Msgf("Unable to resolve owner kind %q", ref.Kind)
+			break
+		}
+		fqn := client.FQN(u.GetNamespace(), ref.Name)
+		if !isNamespaced(gvr) {
+			fqn = ref.Name
+		}
+		owner, err := getUnstructured(f, gvr, fqn)
+		if err != nil {
+			n := NewTreeNode(gvr, fqn)
+			n.Extras[StatusKey] = MissingRefStatus
+			nn = append(nn, n)
+			break
+		}
+		nn = append(nn, newOwnerNode(gvr, owner))
+		u = owner
+	}
+
+	return nn
+}
+
+func (o *Owner) dependents(f dao.Factory, parent *TreeNode, u *unstructured.Unstructured, visited map[types.UID]struct{}, depth int) {
+	if depth >= maxOwnerDepth {
+		return
+	}
+	ns := u.GetNamespace()
+	if ns == "" {
+		ns = client.BlankNamespace
+	}
+	for _, gvr := range DependentGVRs {
+		oo, err := f.List(gvr, ns, false, labels.Everything())
+		if err != nil {
+			log.Debug().Err(err).Msgf("Dependents scan skipped for %q", gvr)
+			continue
+		}
+		for _, obj := range oo {
+			d, ok := asUnstructured(obj)
+			if !ok || !isOwnedBy(d, u.GetUID()) {
+				continue
+			}
+			if _, ok := visited[d.GetUID()]; ok {
+				continue
+			}
+			visited[d.GetUID()] = struct{}{}
+			c := newOwnerNode(gvr, d)
+			parent.Add(c)
+			o.dependents(f, c, d, visited, depth+1)
+		}
+	}
+	if u.GetKind() == "Service" {
+		o.serviceEndpoints(f, parent, u)
+	}
+}
+
+// ServiceEndpoints adds services->endpoints->pods edges.
+func (o *Owner) serviceEndpoints(f dao.Factory, parent *TreeNode, u *unstructured.Unstructured) {
+	fqn := client.FQN(u.GetNamespace(), u.GetName())
+	eu, err := getUnstructured(f, "v1/endpoints", fqn)
+	if err != nil {
+		return
+	}
+	var ep v1.Endpoints
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(eu.Object, &ep); err != nil {
+		log.Error().Err(err).Msgf("Endpoints conversion failed for %q", fqn)
+		return
+	}
+	node := NewTreeNode("v1/endpoints", fqn)
+	parent.Add(node)
+	for _, s := range ep.Subsets {
+		for _, a := range s.Addresses {
+			o.addTargetPod(f, node, a.TargetRef, OkStatus)
+		}
+		for _, a := range s.NotReadyAddresses {
+			o.addTargetPod(f, node, a.TargetRef, ToastStatus)
+		}
+	}
+	if node.IsLeaf() {
+		node.Extras[StatusKey] = ToastStatus
+	}
+}
+
+func (o *Owner) addTargetPod(f dao.Factory, parent *TreeNode, ref *v1.ObjectReference, status string) {
+	if ref == nil || ref.Kind != "Pod" {
+		return
+	}
+	fqn := client.FQN(ref.Namespace, ref.Name)
+	if parent.Find("v1/pods", fqn) != nil {
+		return
+	}
+	n := NewTreeNode("v1/pods", fqn)
+	if pu, err := getUnstructured(f, "v1/pods", fqn); err == nil {
+		n = newOwnerNode("v1/pods", pu)
+	}
+	if status != OkStatus {
+		n.Extras[StatusKey] = status
+	}
+	parent.Add(n)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newOwnerNode(gvr string, u *unstructured.Unstructured) *TreeNode {
+	n := NewTreeNode(gvr, client.FQN(u.GetNamespace(), u.GetName()))
+	if gvr != "v1/pods" {
+		return n
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+		return n
+	}
+	var p Pod
+	if err := p.validate(n, po); err != nil {
+		log.Warn().Err(err).Msgf("Pod validation failed for %q", n.ID)
+	}
+
+	return n
+}
+
+func asUnstructured(o interface{}) (*unstructured.Unstructured, bool) {
+	switch u := o.(type) {
+	case *unstructured.Unstructured:
+		return u, true
+	case *render.PodWithMetrics:
+		return u.Raw, true
+	default:
+		return nil, false
+	}
+}
+
+func getUnstructured(f dao.Factory, gvr, fqn string) (*unstructured.Unstructured, error) {
+	o, err := f.Get(gvr, fqn, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := asUnstructured(o)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
+
+func controllerRef(refs []metav1.OwnerReference) (metav1.OwnerReference, bool) {
+	for _, r := range refs {
+		if r.Controller != nil && *r.Controller {
+			return r, true
+		}
+	}
+	if len(refs) > 0 {
+		return refs[0], true
+	}
+
+	return metav1.OwnerReference{}, false
+}
+
+func isOwnedBy(u *unstructured.Unstructured, uid types.UID) bool {
+	for _, r := range u.GetOwnerReferences() {
+		if r.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNamespaced(gvr string) bool {
+	meta, err := dao.MetaAccess.MetaFor(client.NewGVR(gvr))
+	if err != nil {
+		return true
+	}
+
+	return meta.Namespaced
+}
+
+func gvrFor(apiVersion, kind string) (string, bool) {
+	if gvr, ok := dao.MetaAccess.GVRForKind(apiVersion, kind); ok {
+		return gvr.String(), true
+	}
+	gvr, ok := wellKnownKinds[kind]
+
+	return gvr, ok
+}
+
+var wellKnownKinds = map[string]string{
+	"Deployment":            "apps/v1/deployments",
+	"ReplicaSet":            "apps/v1/replicasets",
+	"StatefulSet":           "apps/v1/statefulsets",
+	"DaemonSet":             "apps/v1/daemonsets",
+	"CronJob":               "batch/v1/cronjobs",
+	"Job":                   "batch/v1/jobs",
+	"Pod":                   "v1/pods",
+	"Service":               "v1/services",
+	"Endpoints":             "v1/endpoints",
+	"Node":                  "v1/nodes",
+	"PersistentVolumeClaim": "v1/persistentvolumeclaims",
+	"EndpointSlice":         "discovery.k8s.io/v1/endpointslices",
+}
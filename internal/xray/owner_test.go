@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestOwnerRender(t *testing.T) {
+	uu := map[string]struct {
+		file       string
+		rows       map[string][]runtime.Object
+		level1     string
+		dependents int
+	}{
+		"no-owner": {
+			file:   "dp",
+			level1: "apps/v1/deployments",
+		},
+		"owner": {
+			file: "rs",
+			rows: map[string][]runtime.Object{
+				"apps/v1/deployments": {load(t, "dp")},
+			},
+			level1: "apps/v1/deployments",
+		},
+		"dependents": {
+			file: "dp",
+			rows: map[string][]runtime.Object{
+				"apps/v1/replicasets": {ownedBy(load(t, "rs"), load(t, "dp"))},
+			},
+			level1:     "apps/v1/deployments",
+			dependents: 1,
+		},
+	}
+
+	var re xray.Owner
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = u.rows
+			root := xray.NewTreeNode("owners", "owners")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			assert.Nil(t, re.Render(ctx, "", load(t, u.file)))
+			assert.Equal(t, 1, root.CountChildren())
+			assert.Equal(t, u.level1, root.Children[0].GVR)
+			if u.dependents > 0 {
+				assert.Equal(t, u.dependents, root.Children[0].CountChildren())
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func ownedBy(o, owner *unstructured.Unstructured) *unstructured.Unstructured {
+	refs := o.GetOwnerReferences()
+	for i := range refs {
+		refs[i].UID = owner.GetUID()
+	}
+	o.SetOwnerReferences(refs)
+
+	return o
+}